@@ -5,14 +5,17 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/handler"
 	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/auth"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	otel_pkg "github.com/MamangRust/monolith-point-of-sale-pkg/otel"
@@ -21,6 +24,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"go.uber.org/zap"
@@ -104,6 +108,16 @@ func createServiceConnections(addresses ServiceAddresses, logger logger.LoggerIn
 	return connections, nil
 }
 
+// rateLimitRules are matched in order; the first rule a request matches is
+// the only one it counts against.
+var rateLimitRules = []middlewares.RateLimitRule{
+	{Name: "login", Prefix: "/api/auth/login", Methods: []string{http.MethodPost}, Limit: 10, Window: time.Minute},
+	{Name: "mfa", Prefix: "/api/auth/mfa/verify", Methods: []string{http.MethodPost}, Limit: 10, Window: time.Minute},
+	{Name: "auth", Prefix: "/api/auth", Limit: 60, Window: time.Minute},
+	{Name: "write", Prefix: "/api", Methods: []string{http.MethodPost, http.MethodPut, http.MethodDelete}, Limit: 120, Window: time.Minute},
+	{Name: "read", Prefix: "/", Limit: 600, Window: time.Minute},
+}
+
 // @title PointOfsale gRPC
 // @version 1.0
 // @description gRPC based Point Of Sale service
@@ -114,7 +128,7 @@ func createServiceConnections(addresses ServiceAddresses, logger logger.LoggerIn
 // @securityDefinitions.apikey ApiKeyAuth
 // @in header
 // @name Authorization
-// @description Type "Bearer" followed by a space and the JWT token obtained from login
+// @description Type "Bearer" followed by a space and the JWT token obtained from login, or "ApiKey" followed by a space and an API key
 // @security ApiKeyAuth
 type Client struct {
 	App    *echo.Echo
@@ -146,14 +160,40 @@ func RunClient() (*Client, func(), error) {
 		return nil, nil, fmt.Errorf("failed to connect services: %w", err)
 	}
 
-	e := setupEcho()
-
 	token, err := auth.NewManager(viper.GetString("SECRET_KEY"))
 	if err != nil {
 		log.Fatal("Failed to create token manager", zap.Error(err))
 	}
 
 	ctx := context.Background()
+
+	conn, err := database.NewClient(log)
+	if err != nil {
+		log.Fatal("Failed to connect to database", zap.Error(err))
+	}
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr:         fmt.Sprintf("%s:%s", viper.GetString("REDIS_HOST"), viper.GetString("REDIS_PORT")),
+		Password:     viper.GetString("REDIS_PASSWORD"),
+		DB:           viper.GetInt("REDIS_DB_AUTH"),
+		DialTimeout:  5 * time.Second,
+		ReadTimeout:  3 * time.Second,
+		WriteTimeout: 3 * time.Second,
+		PoolSize:     10,
+		MinIdleConns: 3,
+	})
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		log.Error("Failed to ping redis, token revocation checks will fail open", zap.Error(err))
+	}
+
+	denylist := middlewares.NewTokenDenylist(rdb, log)
+	apiKeys := middlewares.NewApiKeyStore(conn, rdb, log)
+	bulk := middlewares.NewBulkGuard(rdb, log)
+	limiter := middlewares.NewRateLimiter(rdb, middlewares.LoadRateLimitRules(rateLimitRules, log), log)
+
+	e := setupEcho(denylist, apiKeys, limiter, trustedProxies(viper.GetString("TRUSTED_PROXIES"), log))
+
 	shutdownTracer, err := otel_pkg.InitTracerProvider("apigateway", ctx)
 	if err != nil {
 		log.Fatal("Failed to initialize tracer provider", zap.Error(err))
@@ -164,6 +204,10 @@ func RunClient() (*Client, func(), error) {
 
 	depsHandler := &handler.Deps{
 		Token:              token,
+		ApiKeys:            apiKeys,
+		Bulk:               bulk,
+		Redis:              rdb,
+		AuditAddr:          getEnvOrDefault("AUDIT_API_ADDR", "http://localhost:50061"),
 		E:                  e,
 		Logger:             log,
 		Mapping:            mapping,
@@ -203,6 +247,14 @@ func RunClient() (*Client, func(), error) {
 
 		closeConnections(conns, log)
 
+		if err := rdb.Close(); err != nil {
+			log.Error("Failed to close redis connection", zap.Error(err))
+		}
+
+		if err := conn.Close(); err != nil {
+			log.Error("Failed to close database connection", zap.Error(err))
+		}
+
 		if shutdownTracer != nil {
 			if err := shutdownTracer(context.Background()); err != nil {
 				log.Error("Tracer shutdown failed", zap.Error(err))
@@ -213,28 +265,61 @@ func RunClient() (*Client, func(), error) {
 	return &Client{App: e, Logger: log}, shutdown, nil
 }
 
-func setupEcho() *echo.Echo {
+func setupEcho(denylist *middlewares.TokenDenylist, apiKeys *middlewares.ApiKeyStore, limiter *middlewares.RateLimiter, proxies []echo.TrustOption) *echo.Echo {
 	e := echo.New()
 
-	limiter := middlewares.NewRateLimiter(20, 50)
-	e.Use(limiter.Limit, middleware.Recover(), middleware.Logger())
+	// Client IPs key the rate limits, so X-Forwarded-For is only read from
+	// proxies we trust. Echo trusts loopback and private ranges by default.
+	e.IPExtractor = echo.ExtractIPFromXFFHeader(proxies...)
+
+	e.Use(middleware.Recover(), middleware.Logger())
 
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"http://localhost:1420", "http://localhost:33451"},
 		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "X-API-Key"},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "X-API-Key", middlewares.HeaderDeviceName},
 		AllowCredentials: true,
 	}))
 
-	middlewares.WebSecurityConfig(e)
+	middlewares.WebSecurityConfig(e, denylist, apiKeys)
+
+	// After authentication, so signed in clients are limited per user.
+	e.Use(limiter.Limit)
+
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
 	return e
 }
 
+// trustedProxies parses TRUSTED_PROXIES, a comma separated list of CIDR
+// ranges such as the load balancer's subnet. Invalid entries are skipped.
+func trustedProxies(value string, log logger.LoggerInterface) []echo.TrustOption {
+	var options []echo.TrustOption
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		_, ipRange, err := net.ParseCIDR(entry)
+		if err != nil {
+			log.Error("Invalid trusted proxy range, skipping", zap.String("range", entry), zap.Error(err))
+			continue
+		}
+
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return options
+}
+
 func createConnection(address, serviceName string, logger logger.LoggerInterface) (*grpc.ClientConn, error) {
 	logger.Info(fmt.Sprintf("Connecting to %s service at %s", serviceName, address))
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(middlewares.IdentityClientInterceptor(), middlewares.BulkClientInterceptor()),
+	)
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to %s service", serviceName), zap.Error(err))
 		return nil, err
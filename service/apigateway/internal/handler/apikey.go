@@ -0,0 +1,342 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcode "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type createApiKeyRequest struct {
+	Name          string   `json:"name"`
+	MerchantID    int      `json:"merchant_id"`
+	Permissions   []string `json:"permissions"`
+	ExpiresInDays int      `json:"expires_in_days"`
+}
+
+type apiKeyResponse struct {
+	Status  string              `json:"status"`
+	Message string              `json:"message"`
+	Data    *middlewares.ApiKey `json:"data"`
+}
+
+// apiKeySecretResponse is the only response that carries the key itself.
+type apiKeySecretResponse struct {
+	Status  string              `json:"status"`
+	Message string              `json:"message"`
+	Data    *middlewares.ApiKey `json:"data"`
+	Key     string              `json:"key"`
+}
+
+type apiKeysResponse struct {
+	Status  string                `json:"status"`
+	Message string                `json:"message"`
+	Data    []*middlewares.ApiKey `json:"data"`
+}
+
+type apiKeyHandleApi struct {
+	store           *middlewares.ApiKeyStore
+	logger          logger.LoggerInterface
+	trace           trace.Tracer
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func NewHandlerApiKey(router *echo.Echo, store *middlewares.ApiKeyStore, logger logger.LoggerInterface, rbac *middlewares.RoleAuthorizer) *apiKeyHandleApi {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "api_key_handler_requests_total",
+			Help: "Total number of api key requests",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "api_key_handler_request_duration_seconds",
+			Help:    "Duration of api key requests",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "status"},
+	)
+
+	prometheus.MustRegister(requestCounter)
+
+	apiKeyHandler := &apiKeyHandleApi{
+		store:           store,
+		logger:          logger,
+		trace:           otel.Tracer("api-key-handler"),
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+
+	read := rbac.RequirePermission("api_key:read")
+	create := rbac.RequirePermission("api_key:create")
+	update := rbac.RequirePermission("api_key:update")
+	revoke := rbac.RequirePermission("api_key:delete")
+
+	routerApiKey := router.Group("/api/api-keys")
+
+	routerApiKey.GET("", apiKeyHandler.FindAll, read)
+	routerApiKey.POST("", apiKeyHandler.Create, create)
+	routerApiKey.POST("/:id/rotate", apiKeyHandler.Rotate, update)
+	routerApiKey.DELETE("/:id", apiKeyHandler.Revoke, revoke)
+
+	return apiKeyHandler
+}
+
+// @Security Bearer
+// @Summary List API keys
+// @Tags ApiKey
+// @Description List the API keys of every merchant, or of one merchant. Keys themselves are never returned.
+// @Produce json
+// @Param merchant_id query int false "Merchant ID"
+// @Success 200 {object} apiKeysResponse "API keys"
+// @Failure 500 {object} response.ErrorResponse "Failed to list api keys"
+// @Router /api/api-keys [get]
+func (h *apiKeyHandleApi) FindAll(c echo.Context) error {
+	const method = "FindAll"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	merchantID := parseQueryInt(c, "merchant_id", 0)
+
+	keys, err := h.store.List(ctx, merchantID)
+	if err != nil {
+		logError("Failed to list api keys", err, zap.Int("merchant_id", merchantID))
+
+		return response.NewApiErrorResponse(c, "error", "failed to list api keys", http.StatusInternalServerError)
+	}
+
+	logSuccess("Listed api keys", zap.Int("merchant_id", merchantID))
+
+	return c.JSON(http.StatusOK, apiKeysResponse{
+		Status:  "success",
+		Message: "Successfully fetched api keys",
+		Data:    keys,
+	})
+}
+
+// @Security Bearer
+// @Summary Create an API key
+// @Tags ApiKey
+// @Description Create an API key scoped to a merchant and a set of permissions. The key is returned once and cannot be retrieved again.
+// @Accept json
+// @Produce json
+// @Param request body createApiKeyRequest true "API key details"
+// @Success 201 {object} apiKeySecretResponse "Created API key"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 500 {object} response.ErrorResponse "Failed to create api key"
+// @Router /api/api-keys [post]
+func (h *apiKeyHandleApi) Create(c echo.Context) error {
+	const method = "Create"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	var body createApiKeyRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind create api key request", err)
+
+		return response.NewApiErrorResponse(c, "error", "invalid api key request", http.StatusBadRequest)
+	}
+
+	body.Name = strings.TrimSpace(body.Name)
+
+	if body.Name == "" || len(body.Permissions) == 0 || body.ExpiresInDays < 0 {
+		err := errors.New("name, permissions and a non-negative expires_in_days are required")
+
+		logError("Invalid create api key request", err)
+
+		return response.NewApiErrorResponse(c, "error", err.Error(), http.StatusBadRequest)
+	}
+
+	createdBy, _ := middlewares.UserIDFromContext(c)
+
+	key := &middlewares.ApiKey{
+		Name:        body.Name,
+		MerchantID:  body.MerchantID,
+		Permissions: body.Permissions,
+		CreatedBy:   createdBy,
+	}
+
+	if body.ExpiresInDays > 0 {
+		expiresAt := time.Now().UTC().AddDate(0, 0, body.ExpiresInDays)
+		key.ExpiresAt = &expiresAt
+	}
+
+	created, secret, err := h.store.Create(ctx, key)
+	if err != nil {
+		logError("Failed to create api key", err, zap.Int("merchant_id", body.MerchantID))
+
+		if errors.Is(err, middlewares.ErrApiKeyPermission) || errors.Is(err, middlewares.ErrApiKeyMerchant) {
+			return response.NewApiErrorResponse(c, "error", err.Error(), http.StatusBadRequest)
+		}
+
+		return response.NewApiErrorResponse(c, "error", "failed to create api key", http.StatusInternalServerError)
+	}
+
+	logSuccess("Created api key", zap.String("api_key_id", created.ID), zap.Int("merchant_id", created.MerchantID))
+
+	return c.JSON(http.StatusCreated, apiKeySecretResponse{
+		Status:  "success",
+		Message: "Successfully created api key",
+		Data:    created,
+		Key:     secret,
+	})
+}
+
+// @Security Bearer
+// @Summary Rotate an API key
+// @Tags ApiKey
+// @Description Issue a replacement for an API key. The old key keeps working for 24 hours so the integration can switch over.
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 201 {object} apiKeySecretResponse "Replacement API key"
+// @Failure 404 {object} response.ErrorResponse "API key not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to rotate api key"
+// @Router /api/api-keys/{id}/rotate [post]
+func (h *apiKeyHandleApi) Rotate(c echo.Context) error {
+	const method = "Rotate"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id := c.Param("id")
+
+	rotated, secret, err := h.store.Rotate(ctx, id)
+	if err != nil {
+		logError("Failed to rotate api key", err, zap.String("api_key_id", id))
+
+		if errors.Is(err, middlewares.ErrApiKeyNotFound) {
+			return response.NewApiErrorResponse(c, "error", "api key not found", http.StatusNotFound)
+		}
+
+		return response.NewApiErrorResponse(c, "error", "failed to rotate api key", http.StatusInternalServerError)
+	}
+
+	logSuccess("Rotated api key", zap.String("api_key_id", id), zap.String("replacement_id", rotated.ID))
+
+	return c.JSON(http.StatusCreated, apiKeySecretResponse{
+		Status:  "success",
+		Message: "Successfully rotated api key",
+		Data:    rotated,
+		Key:     secret,
+	})
+}
+
+// @Security Bearer
+// @Summary Revoke an API key
+// @Tags ApiKey
+// @Description Revoke an API key immediately.
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} apiKeyResponse "Revoked"
+// @Failure 404 {object} response.ErrorResponse "API key not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to revoke api key"
+// @Router /api/api-keys/{id} [delete]
+func (h *apiKeyHandleApi) Revoke(c echo.Context) error {
+	const method = "Revoke"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id := c.Param("id")
+
+	if err := h.store.Revoke(ctx, id); err != nil {
+		logError("Failed to revoke api key", err, zap.String("api_key_id", id))
+
+		if errors.Is(err, middlewares.ErrApiKeyNotFound) {
+			return response.NewApiErrorResponse(c, "error", "api key not found", http.StatusNotFound)
+		}
+
+		return response.NewApiErrorResponse(c, "error", "failed to revoke api key", http.StatusInternalServerError)
+	}
+
+	logSuccess("Revoked api key", zap.String("api_key_id", id))
+
+	return c.JSON(http.StatusOK, apiKeyResponse{
+		Status:  "success",
+		Message: "Successfully revoked api key",
+	})
+}
+
+func (s *apiKeyHandleApi) startTracingAndLogging(
+	ctx context.Context,
+	method string,
+	attrs ...attribute.KeyValue,
+) (
+	end func(),
+	logSuccess func(string, ...zap.Field),
+	logError func(string, error, ...zap.Field),
+) {
+	start := time.Now()
+	_, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+	s.logger.Debug("Start: " + method)
+
+	status := "success"
+
+	end = func() {
+		s.recordMetrics(method, status, start)
+		code := otelcode.Ok
+		if status != "success" {
+			code = otelcode.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess = func(msg string, fields ...zap.Field) {
+		status = "success"
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	logError = func(msg string, err error, fields ...zap.Field) {
+		status = "error"
+		span.RecordError(err)
+		span.SetStatus(otelcode.Error, msg)
+		span.AddEvent(msg)
+		allFields := append([]zap.Field{zap.Error(err)}, fields...)
+		s.logger.Error(msg, allFields...)
+	}
+
+	return end, logSuccess, logError
+}
+
+func (s *apiKeyHandleApi) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcode "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const auditRequestTimeout = 10 * time.Second
+
+// Query parameters passed on to the audit service; it validates them.
+var auditSearchParams = []string{"actor_id", "entity", "target_id", "action", "from", "to", "page", "page_size"}
+
+// auditLogsResponse documents the body the audit service returns.
+type auditLogsResponse struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []map[string]interface{} `json:"data"`
+	Pagination *response.PaginationMeta `json:"pagination"`
+}
+
+type auditHandleApi struct {
+	baseURL         string
+	client          *http.Client
+	logger          logger.LoggerInterface
+	trace           trace.Tracer
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func NewHandlerAudit(router *echo.Echo, baseURL string, logger logger.LoggerInterface, rbac *middlewares.RoleAuthorizer) *auditHandleApi {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "audit_handler_requests_total",
+			Help: "Total number of audit requests",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "audit_handler_request_duration_seconds",
+			Help:    "Duration of audit requests",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "status"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	auditHandler := &auditHandleApi{
+		baseURL:         strings.TrimSuffix(baseURL, "/"),
+		client:          &http.Client{Timeout: auditRequestTimeout},
+		logger:          logger,
+		trace:           otel.Tracer("audit-handler"),
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+
+	read := rbac.RequirePermission("audit:read")
+
+	routerAudit := router.Group("/api/audit-logs")
+
+	routerAudit.GET("", auditHandler.Search, read)
+
+	return auditHandler
+}
+
+// @Security Bearer
+// @Summary Search the audit trail
+// @Tags Audit
+// @Description Search the create, update, trash, restore and delete calls made against every service, newest first.
+// @Produce json
+// @Param actor_id query int false "ID of the user who made the call"
+// @Param entity query string false "Entity, e.g. order or merchant_document"
+// @Param target_id query int false "ID of the affected entity"
+// @Param action query string false "create, update, trash, restore, restore_all, delete or delete_all"
+// @Param from query string false "Start of the time range (RFC 3339)"
+// @Param to query string false "End of the time range, exclusive (RFC 3339)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} auditLogsResponse "Audit logs"
+// @Failure 400 {object} response.ErrorResponse "Invalid search parameters"
+// @Failure 502 {object} response.ErrorResponse "Audit service unavailable"
+// @Router /api/audit-logs [get]
+func (h *auditHandleApi) Search(c echo.Context) error {
+	const method = "Search"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	query := url.Values{}
+	for _, key := range auditSearchParams {
+		if value := c.QueryParam(key); value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.baseURL+"/audit-logs?"+query.Encode(), nil)
+	if err != nil {
+		logError("Failed to build audit request", err)
+
+		return response.NewApiErrorResponse(c, "error", "failed to search audit logs", http.StatusInternalServerError)
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		logError("Audit service unavailable", err)
+
+		return response.NewApiErrorResponse(c, "error", "audit service unavailable", http.StatusBadGateway)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		logError("Failed to read audit response", err)
+
+		return response.NewApiErrorResponse(c, "error", "audit service unavailable", http.StatusBadGateway)
+	}
+
+	if res.StatusCode >= http.StatusInternalServerError {
+		logError("Audit service error", nil, zap.Int("status", res.StatusCode))
+
+		return response.NewApiErrorResponse(c, "error", "failed to search audit logs", http.StatusBadGateway)
+	}
+
+	logSuccess("Searched audit logs", zap.Int("status", res.StatusCode))
+
+	return c.Blob(res.StatusCode, echo.MIMEApplicationJSONCharsetUTF8, body)
+}
+
+func (s *auditHandleApi) startTracingAndLogging(
+	ctx context.Context,
+	method string,
+	attrs ...attribute.KeyValue,
+) (
+	end func(),
+	logSuccess func(string, ...zap.Field),
+	logError func(string, error, ...zap.Field),
+) {
+	start := time.Now()
+	_, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+	s.logger.Debug("Start: " + method)
+
+	status := "success"
+
+	end = func() {
+		s.recordMetrics(method, status, start)
+		code := otelcode.Ok
+		if status != "success" {
+			code = otelcode.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess = func(msg string, fields ...zap.Field) {
+		status = "success"
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	logError = func(msg string, err error, fields ...zap.Field) {
+		status = "error"
+		span.RecordError(err)
+		span.SetStatus(otelcode.Error, msg)
+		span.AddEvent(msg)
+		allFields := append([]zap.Field{zap.Error(err)}, fields...)
+		s.logger.Error(msg, allFields...)
+	}
+
+	return end, logSuccess, logError
+}
+
+func (s *auditHandleApi) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
+}
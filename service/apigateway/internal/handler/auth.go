@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/auth_errors"
 	response_api "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/api"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel"
@@ -19,8 +23,73 @@ import (
 	otelcode "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// metadataRetryAfter is the header the auth service sets when it throttles
+// or locks out a login.
+const metadataRetryAfter = "retry-after"
+
+// metadataMfaChallenge is the header the auth service sets when a login
+// needs a second factor.
+const metadataMfaChallenge = "x-mfa-challenge"
+
+type logoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type logoutResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type mfaVerifyRequest struct {
+	Challenge string `json:"challenge"`
+	Code      string `json:"code"`
+}
+
+type mfaCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type mfaChallengeResponse struct {
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	Challenge string `json:"mfa_challenge"`
+}
+
+type mfaEnrollmentResponse struct {
+	Status          string `json:"status"`
+	Message         string `json:"message"`
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type mfaRecoveryCodesResponse struct {
+	Status        string   `json:"status"`
+	Message       string   `json:"message"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type sessionResponse struct {
+	ID         int    `json:"id"`
+	DeviceName string `json:"device_name"`
+	IPAddress  string `json:"ip_address"`
+	UserAgent  string `json:"user_agent"`
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+type sessionsResponse struct {
+	Status  string             `json:"status"`
+	Message string             `json:"message"`
+	Data    []*sessionResponse `json:"data"`
+}
+
 type authHandleApi struct {
 	client          pb.AuthServiceClient
 	logger          logger.LoggerInterface
@@ -69,6 +138,14 @@ func NewHandlerAuth(router *echo.Echo, client pb.AuthServiceClient, logger logge
 	routerAuth.POST("/login", authHandler.Login)
 	routerAuth.POST("/refresh-token", authHandler.RefreshToken)
 	routerAuth.GET("/me", authHandler.GetMe)
+	routerAuth.POST("/logout", authHandler.Logout)
+	routerAuth.POST("/logout-all", authHandler.LogoutAllSessions)
+	routerAuth.GET("/sessions", authHandler.ListSessions)
+	routerAuth.DELETE("/sessions/:id", authHandler.RevokeSession)
+	routerAuth.POST("/mfa/verify", authHandler.VerifyMfa)
+	routerAuth.POST("/mfa/enroll", authHandler.BeginMfaEnrollment)
+	routerAuth.POST("/mfa/enroll/confirm", authHandler.ConfirmMfaEnrollment)
+	routerAuth.POST("/mfa/disable", authHandler.DisableMfa)
 
 	return authHandler
 }
@@ -291,8 +368,13 @@ func (h *authHandleApi) Register(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param request body requests.AuthRequest true "User login credentials"
+// @Param X-Device-Name header string false "Name of the device opening the session"
 // @Success 200 {object} response.ApiResponseLogin "Success"
 // @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} mfaChallengeResponse "Two-factor code required, exchange the challenge at /api/auth/mfa/verify"
+// @Failure 403 {object} response.ErrorResponse "Two-factor authentication must be enabled for the user's role"
+// @Failure 423 {object} response.ErrorResponse "Account temporarily locked"
+// @Failure 429 {object} response.ErrorResponse "Too many failed login attempts"
 // @Failure 500 {object} response.ErrorResponse "Internal Server Error"
 // @Router /api/auth/login [post]
 func (h *authHandleApi) Login(c echo.Context) error {
@@ -323,11 +405,36 @@ func (h *authHandleApi) Login(c echo.Context) error {
 		Password: body.Password,
 	}
 
-	res, err := h.client.LoginUser(ctx, data)
+	var header metadata.MD
+
+	res, err := h.client.LoginUser(middlewares.WithClientInfo(c), data, grpc.Header(&header))
 
 	if err != nil {
 		logError("Failed to login user", err, zap.Error(err))
 
+		if retryAfter := header.Get(metadataRetryAfter); len(retryAfter) > 0 {
+			c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter[0])
+		}
+
+		if challenge := header.Get(metadataMfaChallenge); len(challenge) > 0 {
+			logSuccess("Login needs a second factor")
+
+			return c.JSON(http.StatusUnauthorized, mfaChallengeResponse{
+				Status:    "mfa_required",
+				Message:   "Two-factor authentication code required",
+				Challenge: challenge[0],
+			})
+		}
+
+		switch status.Code(err) {
+		case codes.Code(http.StatusTooManyRequests):
+			return response.NewApiErrorResponse(c, "error", "too many failed login attempts, try again later", http.StatusTooManyRequests)
+		case codes.Code(http.StatusLocked):
+			return response.NewApiErrorResponse(c, "error", "account is temporarily locked after too many failed login attempts", http.StatusLocked)
+		case codes.Code(http.StatusForbidden):
+			return auth_errors.ErrApiMfaEnrollmentRequired(c)
+		}
+
 		return auth_errors.ErrApiLogin(c)
 	}
 
@@ -372,7 +479,7 @@ func (h *authHandleApi) RefreshToken(c echo.Context) error {
 		return auth_errors.ErrValidateRefreshToken(c)
 	}
 
-	res, err := h.client.RefreshToken(c.Request().Context(), &pb.RefreshTokenRequest{
+	res, err := h.client.RefreshToken(middlewares.WithClientInfo(c), &pb.RefreshTokenRequest{
 		RefreshToken: body.RefreshToken,
 	})
 
@@ -440,6 +547,502 @@ func (h *authHandleApi) GetMe(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// Logout godoc
+// @Summary Logout the current session
+// @Tags Auth
+// @Security Bearer
+// @Description Revokes the access token from the Authorization header, closes its session and, when given, revokes its refresh token.
+// @Accept json
+// @Produce json
+// @Param request body logoutRequest false "Refresh token to revoke"
+// @Success 200 {object} logoutResponse "Success"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/auth/logout [post]
+func (h *authHandleApi) Logout(c echo.Context) error {
+	const method = "Logout"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	access, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		err := errors.New("missing access token")
+
+		logError("Missing access token", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	var body logoutRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind logout request", err, zap.Error(err))
+
+		return auth_errors.ErrBindLogout(c)
+	}
+
+	subject, _ := access.Claims.GetSubject()
+
+	_, err := h.client.Logout(middlewares.WithClientInfo(c), &pb.LogoutRequest{
+		AccessToken:  access.Raw,
+		RefreshToken: body.RefreshToken,
+	})
+
+	if err != nil {
+		logError("Failed to logout", err, zap.String("user_id", subject), zap.Error(err))
+
+		return auth_errors.ErrApiLogout(c)
+	}
+
+	logSuccess("Logout success", zap.String("user_id", subject))
+
+	return c.JSON(http.StatusOK, logoutResponse{
+		Status:  "success",
+		Message: "Successfully logged out",
+	})
+}
+
+// LogoutAllSessions godoc
+// @Summary Logout every session
+// @Tags Auth
+// @Security Bearer
+// @Description Revokes every access and refresh token of the current user, on every device.
+// @Produce json
+// @Success 200 {object} logoutResponse "Success"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/auth/logout-all [post]
+func (h *authHandleApi) LogoutAllSessions(c echo.Context) error {
+	const method = "LogoutAllSessions"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	access, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		err := errors.New("missing access token")
+
+		logError("Missing access token", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	subject, _ := access.Claims.GetSubject()
+
+	userID, err := strconv.Atoi(subject)
+	if err != nil {
+		logError("Invalid token subject", err, zap.String("user_id", subject))
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	_, err = h.client.LogoutAllSessions(middlewares.WithClientInfo(c), &pb.LogoutAllSessionsRequest{
+		UserId: int32(userID),
+	})
+
+	if err != nil {
+		logError("Failed to logout all sessions", err, zap.String("user_id", subject), zap.Error(err))
+
+		return auth_errors.ErrApiLogout(c)
+	}
+
+	logSuccess("Logout all sessions success", zap.String("user_id", subject))
+
+	return c.JSON(http.StatusOK, logoutResponse{
+		Status:  "success",
+		Message: "Successfully logged out of all sessions",
+	})
+}
+
+// ListSessions godoc
+// @Summary List the current user's sessions
+// @Tags Auth
+// @Security Bearer
+// @Description Lists the devices the current user is signed in on, most recently used first.
+// @Produce json
+// @Success 200 {object} sessionsResponse "Success"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/auth/sessions [get]
+func (h *authHandleApi) ListSessions(c echo.Context) error {
+	const method = "ListSessions"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	userID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	res, err := h.client.ListSessions(middlewares.WithClientInfo(c), &pb.ListSessionsRequest{
+		UserId: int32(userID),
+	})
+
+	if err != nil {
+		logError("Failed to list sessions", err, zap.Int("user_id", userID), zap.Error(err))
+
+		return auth_errors.ErrApiListSessions(c)
+	}
+
+	data := make([]*sessionResponse, len(res.Data))
+	for i, sess := range res.Data {
+		data[i] = &sessionResponse{
+			ID:         int(sess.Id),
+			DeviceName: sess.DeviceName,
+			IPAddress:  sess.IpAddress,
+			UserAgent:  sess.UserAgent,
+			CreatedAt:  sess.CreatedAt,
+			LastUsedAt: sess.LastUsedAt,
+			ExpiresAt:  sess.ExpiresAt,
+		}
+	}
+
+	logSuccess("List sessions success", zap.Int("user_id", userID))
+
+	return c.JSON(http.StatusOK, sessionsResponse{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    data,
+	})
+}
+
+// RevokeSession godoc
+// @Summary Revoke one of the current user's sessions
+// @Tags Auth
+// @Security Bearer
+// @Description Signs one device out. Its refresh token stops working at once and its access tokens are revoked.
+// @Produce json
+// @Param id path int true "Session ID"
+// @Success 200 {object} logoutResponse "Success"
+// @Failure 400 {object} response.ErrorResponse "Invalid session ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Session not found"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/auth/sessions/{id} [delete]
+func (h *authHandleApi) RevokeSession(c echo.Context) error {
+	const method = "RevokeSession"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	userID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	sessionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || sessionID <= 0 {
+		logError("Invalid session id", err, zap.String("id", c.Param("id")))
+
+		return auth_errors.ErrApiInvalidSessionId(c)
+	}
+
+	res, err := h.client.RevokeSession(middlewares.WithClientInfo(c), &pb.RevokeSessionRequest{
+		UserId:    int32(userID),
+		SessionId: int32(sessionID),
+	})
+
+	if err != nil {
+		logError("Failed to revoke session", err, zap.Int("user_id", userID), zap.Int("session_id", sessionID), zap.Error(err))
+
+		if status.Code(err) == codes.Code(http.StatusNotFound) {
+			return auth_errors.ErrApiSessionNotFound(c)
+		}
+
+		return auth_errors.ErrApiRevokeSession(c)
+	}
+
+	logSuccess("Revoke session success", zap.Int("user_id", userID), zap.Int("session_id", sessionID))
+
+	return c.JSON(http.StatusOK, logoutResponse{
+		Status:  res.Status,
+		Message: res.Message,
+	})
+}
+
+// VerifyMfa godoc
+// @Summary Complete a login with a two-factor code
+// @Tags Auth
+// @Description Exchanges the challenge returned by login and a TOTP or recovery code for tokens.
+// @Accept json
+// @Produce json
+// @Param request body mfaVerifyRequest true "Challenge and code"
+// @Success 200 {object} response.ApiResponseLogin "Success"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Invalid code or expired challenge"
+// @Failure 423 {object} response.ErrorResponse "Account temporarily locked"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/auth/mfa/verify [post]
+func (h *authHandleApi) VerifyMfa(c echo.Context) error {
+	const method = "VerifyMfa"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	var body mfaVerifyRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind verify mfa request", err, zap.Error(err))
+
+		return auth_errors.ErrBindMfa(c)
+	}
+
+	if body.Challenge == "" || body.Code == "" {
+		err := errors.New("missing challenge or code")
+
+		logError("Failed to validate verify mfa request", err)
+
+		return auth_errors.ErrValidateMfa(c)
+	}
+
+	var header metadata.MD
+
+	res, err := h.client.VerifyMfa(middlewares.WithClientInfo(c), &pb.VerifyMfaRequest{
+		Challenge: body.Challenge,
+		Code:      body.Code,
+	}, grpc.Header(&header))
+
+	if err != nil {
+		logError("Failed to verify mfa", err, zap.Error(err))
+
+		if retryAfter := header.Get(metadataRetryAfter); len(retryAfter) > 0 {
+			c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter[0])
+		}
+
+		if status.Code(err) == codes.Code(http.StatusLocked) {
+			return response.NewApiErrorResponse(c, "error", "account is temporarily locked after too many failed login attempts", http.StatusLocked)
+		}
+
+		return mfaApiError(c, err)
+	}
+
+	logSuccess("Verify mfa success")
+
+	return c.JSON(http.StatusOK, h.mapping.ToResponseLogin(res))
+}
+
+// BeginMfaEnrollment godoc
+// @Summary Start two-factor enrollment
+// @Tags Auth
+// @Security Bearer
+// @Description Creates a TOTP secret for the current user. It protects logins once confirmed with a code.
+// @Produce json
+// @Success 200 {object} mfaEnrollmentResponse "Secret and otpauth provisioning URI"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 409 {object} response.ErrorResponse "Two-factor authentication already enabled"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/auth/mfa/enroll [post]
+func (h *authHandleApi) BeginMfaEnrollment(c echo.Context) error {
+	const method = "BeginMfaEnrollment"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	userID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	res, err := h.client.BeginMfaEnrollment(middlewares.WithClientInfo(c), &pb.BeginMfaEnrollmentRequest{
+		UserId: int32(userID),
+	})
+
+	if err != nil {
+		logError("Failed to begin mfa enrollment", err, zap.Int("user_id", userID), zap.Error(err))
+
+		return mfaApiError(c, err)
+	}
+
+	logSuccess("Begin mfa enrollment success", zap.Int("user_id", userID))
+
+	return c.JSON(http.StatusOK, mfaEnrollmentResponse{
+		Status:          res.Status,
+		Message:         res.Message,
+		Secret:          res.Data.Secret,
+		ProvisioningURI: res.Data.ProvisioningUri,
+	})
+}
+
+// ConfirmMfaEnrollment godoc
+// @Summary Enable two-factor authentication
+// @Tags Auth
+// @Security Bearer
+// @Description Enables the pending TOTP secret once a code from it verifies. The recovery codes are shown only once.
+// @Accept json
+// @Produce json
+// @Param request body mfaCodeRequest true "TOTP code"
+// @Success 200 {object} mfaRecoveryCodesResponse "Recovery codes"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Invalid code"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/auth/mfa/enroll/confirm [post]
+func (h *authHandleApi) ConfirmMfaEnrollment(c echo.Context) error {
+	const method = "ConfirmMfaEnrollment"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	userID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	code, err := bindMfaCode(c)
+	if err != nil {
+		logError("Failed to bind confirm mfa request", err, zap.Error(err))
+
+		return auth_errors.ErrValidateMfa(c)
+	}
+
+	res, err := h.client.ConfirmMfaEnrollment(middlewares.WithClientInfo(c), &pb.ConfirmMfaEnrollmentRequest{
+		UserId: int32(userID),
+		Code:   code,
+	})
+
+	if err != nil {
+		logError("Failed to confirm mfa enrollment", err, zap.Int("user_id", userID), zap.Error(err))
+
+		return mfaApiError(c, err)
+	}
+
+	logSuccess("Confirm mfa enrollment success", zap.Int("user_id", userID))
+
+	return c.JSON(http.StatusOK, mfaRecoveryCodesResponse{
+		Status:        res.Status,
+		Message:       res.Message,
+		RecoveryCodes: res.RecoveryCodes,
+	})
+}
+
+// DisableMfa godoc
+// @Summary Disable two-factor authentication
+// @Tags Auth
+// @Security Bearer
+// @Description Turns off the second factor of the current user. Needs a current TOTP code or a recovery code.
+// @Accept json
+// @Produce json
+// @Param request body mfaCodeRequest true "TOTP or recovery code"
+// @Success 200 {object} logoutResponse "Success"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Invalid code"
+// @Failure 403 {object} response.ErrorResponse "Two-factor authentication is required for the user's role"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /api/auth/mfa/disable [post]
+func (h *authHandleApi) DisableMfa(c echo.Context) error {
+	const method = "DisableMfa"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	userID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	code, err := bindMfaCode(c)
+	if err != nil {
+		logError("Failed to bind disable mfa request", err, zap.Error(err))
+
+		return auth_errors.ErrValidateMfa(c)
+	}
+
+	res, err := h.client.DisableMfa(middlewares.WithClientInfo(c), &pb.DisableMfaRequest{
+		UserId: int32(userID),
+		Code:   code,
+	})
+
+	if err != nil {
+		logError("Failed to disable mfa", err, zap.Int("user_id", userID), zap.Error(err))
+
+		return mfaApiError(c, err)
+	}
+
+	logSuccess("Disable mfa success", zap.Int("user_id", userID))
+
+	return c.JSON(http.StatusOK, logoutResponse{
+		Status:  res.Status,
+		Message: res.Message,
+	})
+}
+
+func bindMfaCode(c echo.Context) (string, error) {
+	var body mfaCodeRequest
+
+	if err := c.Bind(&body); err != nil {
+		return "", err
+	}
+
+	if body.Code == "" {
+		return "", errors.New("missing code")
+	}
+
+	return body.Code, nil
+}
+
+// mfaApiError maps the statuses the auth service uses for two-factor
+// failures to gateway responses.
+func mfaApiError(c echo.Context, err error) error {
+	switch status.Code(err) {
+	case codes.Code(http.StatusUnauthorized):
+		return auth_errors.ErrApiInvalidMfaCode(c)
+	case codes.Code(http.StatusForbidden):
+		return auth_errors.ErrApiMfaEnrollmentRequired(c)
+	case codes.Code(http.StatusConflict):
+		return auth_errors.ErrApiMfaAlreadyEnabled(c)
+	case codes.Code(http.StatusBadRequest):
+		return auth_errors.ErrApiMfaNotEnabled(c)
+	}
+
+	return auth_errors.ErrApiMfa(c)
+}
+
 func (s *authHandleApi) startTracingAndLogging(
 	ctx context.Context,
 	method string,
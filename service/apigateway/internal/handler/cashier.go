@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/cashier_errors"
@@ -35,6 +36,8 @@ func NewHandlerCashier(
 	client pb.CashierServiceClient,
 	logger logger.LoggerInterface,
 	mapping response_api.CashierResponseMapper,
+	rbac *middlewares.RoleAuthorizer,
+	bulk *middlewares.BulkGuard,
 ) *cashierHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -64,38 +67,58 @@ func NewHandlerCashier(
 		requestDuration: requestDuration,
 	}
 
+	read := rbac.RequirePermission("cashier:read")
+	create := rbac.RequirePermission("cashier:create")
+	update := rbac.RequirePermission("cashier:update")
+	trash := rbac.RequirePermission("cashier:delete")
+	purge := rbac.RequirePermission("cashier:purge")
+	report := rbac.RequirePermission("report:read")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+	shiftRead := rbac.RequirePermission("shift:read")
+	shiftCreate := rbac.RequirePermission("shift:create")
+	shiftUpdate := rbac.RequirePermission("shift:update")
+
 	routerCashier := router.Group("/api/cashier")
 
-	routerCashier.GET("", cashierHandler.FindAllCashier)
-	routerCashier.GET("/:id", cashierHandler.FindById)
-	routerCashier.GET("/active", cashierHandler.FindByActive)
-	routerCashier.GET("/trashed", cashierHandler.FindByTrashed)
+	routerCashier.GET("", cashierHandler.FindAllCashier, read)
+	routerCashier.GET("/:id", cashierHandler.FindById, read)
+	routerCashier.GET("/active", cashierHandler.FindByActive, read)
+	routerCashier.GET("/trashed", cashierHandler.FindByTrashed, read)
+
+	routerCashier.GET("/monthly-total-sales", cashierHandler.FindMonthlyTotalSales, report)
+	routerCashier.GET("/yearly-total-sales", cashierHandler.FindYearTotalSales, report)
+
+	routerCashier.GET("/merchant/monthly-total-sales", cashierHandler.FindMonthlyTotalSalesByMerchant, read)
+	routerCashier.GET("/merchant/yearly-total-sales", cashierHandler.FindYearTotalSalesByMerchant, read)
 
-	routerCashier.GET("/monthly-total-sales", cashierHandler.FindMonthlyTotalSales)
-	routerCashier.GET("/yearly-total-sales", cashierHandler.FindYearTotalSales)
+	routerCashier.GET("/mycashier/monthly-total-sales", cashierHandler.FindMonthlyTotalSalesById, read)
+	routerCashier.GET("/mycashier/yearly-total-sales", cashierHandler.FindYearTotalSalesById, read)
 
-	routerCashier.GET("/merchant/monthly-total-sales", cashierHandler.FindMonthlyTotalSalesByMerchant)
-	routerCashier.GET("/merchant/yearly-total-sales", cashierHandler.FindYearTotalSalesByMerchant)
+	routerCashier.GET("/monthly-sales", cashierHandler.FindMonthSales, report)
+	routerCashier.GET("/yearly-sales", cashierHandler.FindYearSales, report)
+	routerCashier.GET("/merchant/monthly-sales", cashierHandler.FindMonthSalesByMerchant, read)
+	routerCashier.GET("/merchant/yearly-sales", cashierHandler.FindYearSalesByMerchant, read)
+	routerCashier.GET("/mycashier/monthly-sales", cashierHandler.FindMonthSalesById, read)
+	routerCashier.GET("/mycashier/yearly-sales", cashierHandler.FindYearSalesById, read)
 
-	routerCashier.GET("/mycashier/monthly-total-sales", cashierHandler.FindMonthlyTotalSalesById)
-	routerCashier.GET("/mycashier/yearly-total-sales", cashierHandler.FindYearTotalSalesById)
+	routerCashier.GET("/mycashier/shift-sales", cashierHandler.FindShiftSalesById, shiftRead)
 
-	routerCashier.GET("/monthly-sales", cashierHandler.FindMonthSales)
-	routerCashier.GET("/yearly-sales", cashierHandler.FindYearSales)
-	routerCashier.GET("/merchant/monthly-sales", cashierHandler.FindMonthSalesByMerchant)
-	routerCashier.GET("/merchant/yearly-sales", cashierHandler.FindYearSalesByMerchant)
-	routerCashier.GET("/mycashier/monthly-sales", cashierHandler.FindMonthSalesById)
-	routerCashier.GET("/mycashier/yearly-sales", cashierHandler.FindYearSalesById)
+	routerCashier.POST("/:id/shift/open", cashierHandler.OpenShift, shiftCreate)
+	routerCashier.GET("/:id/shift", cashierHandler.FindOpenShift, shiftRead)
+	routerCashier.POST("/shift/:shift_id/movement", cashierHandler.RecordShiftMovement, shiftUpdate)
+	routerCashier.POST("/shift/:shift_id/close", cashierHandler.CloseShift, shiftUpdate)
+	routerCashier.GET("/shift/:shift_id/report", cashierHandler.FindShiftReport, shiftRead)
 
-	routerCashier.POST("/create", cashierHandler.CreateCashier)
-	routerCashier.POST("/update/:id", cashierHandler.UpdateCashier)
+	routerCashier.POST("/create", cashierHandler.CreateCashier, create)
+	routerCashier.POST("/update/:id", cashierHandler.UpdateCashier, update)
 
-	routerCashier.POST("/trashed/:id", cashierHandler.TrashedCashier)
-	routerCashier.POST("/restore/:id", cashierHandler.RestoreCashier)
-	routerCashier.DELETE("/permanent/:id", cashierHandler.DeleteCashierPermanent)
+	routerCashier.POST("/trashed/:id", cashierHandler.TrashedCashier, trash)
+	routerCashier.POST("/restore/:id", cashierHandler.RestoreCashier, trash)
+	routerCashier.DELETE("/permanent/:id", cashierHandler.DeleteCashierPermanent, purge)
 
-	routerCashier.POST("/restore/all", cashierHandler.RestoreAllCashier)
-	routerCashier.POST("/permanent/all", cashierHandler.DeleteAllCashierPermanent)
+	routerCashier.POST("/restore/all", cashierHandler.RestoreAllCashier, bulkRestore, bulk.GuardByMerchant())
+	routerCashier.POST("/permanent/all", cashierHandler.DeleteAllCashierPermanent, bulkPurge, bulk.GuardByMerchant())
 
 	return cashierHandler
 }
@@ -1215,9 +1238,13 @@ func (h *cashierHandleApi) DeleteCashierPermanent(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Cashier ID"
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseCashierAll "Successfully restored cashier all"
 // @Failure 400 {object} response.ErrorResponse "Invalid cashier ID"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore cashier"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/cashier/restore/all [post]
 func (h *cashierHandleApi) RestoreAllCashier(c echo.Context) error {
 	const method = "RestoreAllCashier"
@@ -1250,9 +1277,13 @@ func (h *cashierHandleApi) RestoreAllCashier(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "cashier ID"
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseCashierAll "Successfully deleted cashier record permanently"
 // @Failure 400 {object} response.ErrorResponse "Bad Request: Invalid ID"
 // @Failure 500 {object} response.ErrorResponse "Failed to delete cashier:"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/cashier/delete/all [post]
 func (h *cashierHandleApi) DeleteAllCashierPermanent(c echo.Context) error {
 	const method = "DeleteAllCashierPermanent"
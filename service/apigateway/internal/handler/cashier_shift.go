@@ -0,0 +1,614 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/auth_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/cashier_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type openShiftRequest struct {
+	OpeningFloat int `json:"opening_float"`
+}
+
+type shiftMovementRequest struct {
+	Kind   string `json:"kind"`
+	Amount int    `json:"amount"`
+	Reason string `json:"reason"`
+}
+
+type closeShiftRequest struct {
+	CountedCash int    `json:"counted_cash"`
+	Note        string `json:"note"`
+}
+
+type shiftDrawerResponse struct {
+	OpeningFloat int  `json:"opening_float"`
+	CashSales    int  `json:"cash_sales"`
+	CashRefunds  int  `json:"cash_refunds"`
+	PayIns       int  `json:"pay_ins"`
+	PayOuts      int  `json:"pay_outs"`
+	ExpectedCash int  `json:"expected_cash"`
+	CountedCash  *int `json:"counted_cash,omitempty"`
+	Variance     *int `json:"variance,omitempty"`
+}
+
+type shiftResponse struct {
+	ID           int                  `json:"id"`
+	CashierID    int                  `json:"cashier_id"`
+	MerchantID   int                  `json:"merchant_id"`
+	OpeningFloat int                  `json:"opening_float"`
+	OpenedBy     int                  `json:"opened_by"`
+	OpenedAt     string               `json:"opened_at"`
+	ClosedBy     *int                 `json:"closed_by,omitempty"`
+	ClosedAt     *string              `json:"closed_at,omitempty"`
+	Note         string               `json:"note,omitempty"`
+	Drawer       *shiftDrawerResponse `json:"drawer,omitempty"`
+}
+
+type shiftMovementResponse struct {
+	ID         int    `json:"id"`
+	ShiftID    int    `json:"shift_id"`
+	Kind       string `json:"kind"`
+	Amount     int    `json:"amount"`
+	Reason     string `json:"reason"`
+	OperatorID int    `json:"operator_id"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type shiftMethodSalesResponse struct {
+	Method       string `json:"method"`
+	Transactions int    `json:"transactions"`
+	Amount       int    `json:"amount"`
+}
+
+type shiftSalesResponse struct {
+	Transactions int                         `json:"transactions"`
+	Gross        int                         `json:"gross"`
+	Refunds      int                         `json:"refunds"`
+	CashRefunds  int                         `json:"cash_refunds"`
+	Net          int                         `json:"net"`
+	Methods      []*shiftMethodSalesResponse `json:"methods"`
+}
+
+type shiftReportResponse struct {
+	Shift     *shiftResponse           `json:"shift"`
+	Sales     *shiftSalesResponse      `json:"sales"`
+	Drawer    *shiftDrawerResponse     `json:"drawer"`
+	Movements []*shiftMovementResponse `json:"movements"`
+}
+
+type shiftSalesRowResponse struct {
+	Shift *shiftResponse      `json:"shift"`
+	Sales *shiftSalesResponse `json:"sales"`
+}
+
+type apiResponseShift struct {
+	Status  string         `json:"status"`
+	Message string         `json:"message"`
+	Data    *shiftResponse `json:"data"`
+}
+
+type apiResponseShiftMovement struct {
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Data    *shiftMovementResponse `json:"data"`
+}
+
+type apiResponseShiftReport struct {
+	Status  string               `json:"status"`
+	Message string               `json:"message"`
+	Data    *shiftReportResponse `json:"data"`
+}
+
+type apiResponseShiftSales struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    []*shiftSalesRowResponse `json:"data"`
+}
+
+// OpenShift godoc.
+// @Summary Open a shift
+// @Tags Cashier
+// @Security Bearer
+// @Description Opens a shift on the cashier's drawer with the cash put in it as float. The current user is recorded as the operator.
+// @Accept json
+// @Produce json
+// @Param id path int true "Cashier ID"
+// @Param request body openShiftRequest true "Opening float"
+// @Success 201 {object} apiResponseShift "Opened shift"
+// @Failure 400 {object} response.ErrorResponse "Invalid cashier ID or amount"
+// @Failure 404 {object} response.ErrorResponse "Cashier not found"
+// @Failure 409 {object} response.ErrorResponse "Cashier already has an open shift"
+// @Failure 500 {object} response.ErrorResponse "Failed to open shift"
+// @Router /api/cashier/{id}/shift/open [post]
+func (h *cashierHandleApi) OpenShift(c echo.Context) error {
+	const method = "OpenShift"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	cashierID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || cashierID <= 0 {
+		logError("Invalid cashier ID", err, zap.Error(err))
+
+		return cashier_errors.ErrApiCashierInvalidId(c)
+	}
+
+	operatorID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	var body openShiftRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind open shift request", err, zap.Error(err))
+
+		return cashier_errors.ErrApiBindOpenShift(c)
+	}
+
+	res, err := h.client.OpenShift(ctx, &pb.OpenShiftRequest{
+		CashierId:    int32(cashierID),
+		OpeningFloat: int32(body.OpeningFloat),
+		OperatorId:   int32(operatorID),
+	})
+
+	if err != nil {
+		logError("Failed to open shift", err, zap.Int("cashier_id", cashierID), zap.Error(err))
+
+		return shiftApiError(c, err, cashier_errors.ErrApiShiftAlreadyOpen, cashier_errors.ErrApiFailedOpenShift)
+	}
+
+	logSuccess("Successfully opened shift", zap.Int("cashier_id", cashierID), zap.Int32("shift_id", res.Data.Id))
+
+	return c.JSON(http.StatusCreated, apiResponseShift{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toShiftResponse(res.Data),
+	})
+}
+
+// FindOpenShift godoc.
+// @Summary Get the open shift of a cashier
+// @Tags Cashier
+// @Security Bearer
+// @Description Returns the shift currently open on the cashier's drawer.
+// @Produce json
+// @Param id path int true "Cashier ID"
+// @Success 200 {object} apiResponseShift "Open shift"
+// @Failure 400 {object} response.ErrorResponse "Invalid cashier ID"
+// @Failure 404 {object} response.ErrorResponse "No open shift"
+// @Failure 500 {object} response.ErrorResponse "Failed to find open shift"
+// @Router /api/cashier/{id}/shift [get]
+func (h *cashierHandleApi) FindOpenShift(c echo.Context) error {
+	const method = "FindOpenShift"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	cashierID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || cashierID <= 0 {
+		logError("Invalid cashier ID", err, zap.Error(err))
+
+		return cashier_errors.ErrApiCashierInvalidId(c)
+	}
+
+	res, err := h.client.FindOpenShift(ctx, &pb.FindOpenShiftRequest{
+		CashierId: int32(cashierID),
+	})
+
+	if err != nil {
+		logError("Failed to find open shift", err, zap.Int("cashier_id", cashierID), zap.Error(err))
+
+		return shiftApiError(c, err, cashier_errors.ErrApiFailedFindOpenShift, cashier_errors.ErrApiFailedFindOpenShift)
+	}
+
+	logSuccess("Successfully fetched open shift", zap.Int("cashier_id", cashierID), zap.Int32("shift_id", res.Data.Id))
+
+	return c.JSON(http.StatusOK, apiResponseShift{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toShiftResponse(res.Data),
+	})
+}
+
+// RecordShiftMovement godoc.
+// @Summary Record a pay-in or pay-out
+// @Tags Cashier
+// @Security Bearer
+// @Description Puts cash into (pay_in) or takes cash out of (pay_out) the drawer of an open shift for anything that is not a sale.
+// @Accept json
+// @Produce json
+// @Param shift_id path int true "Shift ID"
+// @Param request body shiftMovementRequest true "Cash movement"
+// @Success 201 {object} apiResponseShiftMovement "Recorded movement"
+// @Failure 400 {object} response.ErrorResponse "Invalid shift ID, kind, amount or reason"
+// @Failure 404 {object} response.ErrorResponse "Shift not found"
+// @Failure 409 {object} response.ErrorResponse "Shift is already closed"
+// @Failure 500 {object} response.ErrorResponse "Failed to record cash movement"
+// @Router /api/cashier/shift/{shift_id}/movement [post]
+func (h *cashierHandleApi) RecordShiftMovement(c echo.Context) error {
+	const method = "RecordShiftMovement"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	shiftID, err := strconv.Atoi(c.Param("shift_id"))
+
+	if err != nil || shiftID <= 0 {
+		logError("Invalid shift ID", err, zap.Error(err))
+
+		return cashier_errors.ErrApiShiftInvalidId(c)
+	}
+
+	operatorID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	var body shiftMovementRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind cash movement request", err, zap.Error(err))
+
+		return cashier_errors.ErrApiBindShiftMovement(c)
+	}
+
+	res, err := h.client.RecordShiftMovement(ctx, &pb.RecordShiftMovementRequest{
+		ShiftId:    int32(shiftID),
+		Kind:       body.Kind,
+		Amount:     int32(body.Amount),
+		Reason:     body.Reason,
+		OperatorId: int32(operatorID),
+	})
+
+	if err != nil {
+		logError("Failed to record cash movement", err, zap.Int("shift_id", shiftID), zap.Error(err))
+
+		return shiftApiError(c, err, cashier_errors.ErrApiShiftClosed, cashier_errors.ErrApiFailedRecordShiftMovement)
+	}
+
+	logSuccess("Successfully recorded cash movement", zap.Int("shift_id", shiftID), zap.Int32("movement_id", res.Data.Id))
+
+	return c.JSON(http.StatusCreated, apiResponseShiftMovement{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toShiftMovementResponse(res.Data),
+	})
+}
+
+// CloseShift godoc.
+// @Summary Close a shift
+// @Tags Cashier
+// @Security Bearer
+// @Description Counts the drawer and closes the shift. The Z-report carries the expected cash and the over/short variance.
+// @Accept json
+// @Produce json
+// @Param shift_id path int true "Shift ID"
+// @Param request body closeShiftRequest true "Counted cash"
+// @Success 200 {object} apiResponseShiftReport "Z-report"
+// @Failure 400 {object} response.ErrorResponse "Invalid shift ID or amount"
+// @Failure 404 {object} response.ErrorResponse "Shift not found"
+// @Failure 409 {object} response.ErrorResponse "Shift is already closed"
+// @Failure 500 {object} response.ErrorResponse "Failed to close shift"
+// @Router /api/cashier/shift/{shift_id}/close [post]
+func (h *cashierHandleApi) CloseShift(c echo.Context) error {
+	const method = "CloseShift"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	shiftID, err := strconv.Atoi(c.Param("shift_id"))
+
+	if err != nil || shiftID <= 0 {
+		logError("Invalid shift ID", err, zap.Error(err))
+
+		return cashier_errors.ErrApiShiftInvalidId(c)
+	}
+
+	operatorID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	var body closeShiftRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind close shift request", err, zap.Error(err))
+
+		return cashier_errors.ErrApiBindCloseShift(c)
+	}
+
+	res, err := h.client.CloseShift(ctx, &pb.CloseShiftRequest{
+		ShiftId:     int32(shiftID),
+		CountedCash: int32(body.CountedCash),
+		OperatorId:  int32(operatorID),
+		Note:        body.Note,
+	})
+
+	if err != nil {
+		logError("Failed to close shift", err, zap.Int("shift_id", shiftID), zap.Error(err))
+
+		return shiftApiError(c, err, cashier_errors.ErrApiShiftClosed, cashier_errors.ErrApiFailedCloseShift)
+	}
+
+	logSuccess("Successfully closed shift", zap.Int("shift_id", shiftID))
+
+	return c.JSON(http.StatusOK, apiResponseShiftReport{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toShiftReportResponse(res.Data),
+	})
+}
+
+// FindShiftReport godoc.
+// @Summary Get the Z-report of a shift
+// @Tags Cashier
+// @Security Bearer
+// @Description Returns the Z-report of a closed shift, or the running totals of an open one.
+// @Produce json
+// @Param shift_id path int true "Shift ID"
+// @Success 200 {object} apiResponseShiftReport "Z-report"
+// @Failure 400 {object} response.ErrorResponse "Invalid shift ID"
+// @Failure 404 {object} response.ErrorResponse "Shift not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to build shift report"
+// @Router /api/cashier/shift/{shift_id}/report [get]
+func (h *cashierHandleApi) FindShiftReport(c echo.Context) error {
+	const method = "FindShiftReport"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	shiftID, err := strconv.Atoi(c.Param("shift_id"))
+
+	if err != nil || shiftID <= 0 {
+		logError("Invalid shift ID", err, zap.Error(err))
+
+		return cashier_errors.ErrApiShiftInvalidId(c)
+	}
+
+	res, err := h.client.FindShiftReport(ctx, &pb.FindShiftRequest{
+		ShiftId: int32(shiftID),
+	})
+
+	if err != nil {
+		logError("Failed to build shift report", err, zap.Int("shift_id", shiftID), zap.Error(err))
+
+		return shiftApiError(c, err, cashier_errors.ErrApiFailedFindShiftReport, cashier_errors.ErrApiFailedFindShiftReport)
+	}
+
+	logSuccess("Successfully fetched shift report", zap.Int("shift_id", shiftID))
+
+	return c.JSON(http.StatusOK, apiResponseShiftReport{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toShiftReportResponse(res.Data),
+	})
+}
+
+// FindShiftSalesById godoc.
+// @Summary Get a cashier's sales by shift
+// @Tags Cashier
+// @Security Bearer
+// @Description Breaks the cashier's sales down by the shifts they opened in the month. A shift still open shows its sales so far.
+// @Produce json
+// @Param year query int true "Year in YYYY format (e.g., 2023)"
+// @Param month query int true "Month"
+// @Param cashier_id query int true "Cashier ID"
+// @Success 200 {object} apiResponseShiftSales "Sales per shift"
+// @Failure 400 {object} response.ErrorResponse "Invalid year, month or cashier ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to find shift sales"
+// @Router /api/cashier/mycashier/shift-sales [get]
+func (h *cashierHandleApi) FindShiftSalesById(c echo.Context) error {
+	const method = "FindShiftSalesById"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	year, err := parseQueryIntWithValidation(c, "year", 1, 9999)
+	if err != nil {
+		logError("Invalid year parameter", err, zap.String("year", c.QueryParam("year")))
+		return cashier_errors.ErrApiCashierInvalidYear(c)
+	}
+
+	month, err := parseQueryIntWithValidation(c, "month", 1, 12)
+	if err != nil {
+		logError("Invalid month parameter", err, zap.String("month", c.QueryParam("month")))
+		return cashier_errors.ErrApiCashierInvalidMonth(c)
+	}
+
+	cashierID, err := strconv.Atoi(c.QueryParam("cashier_id"))
+	if err != nil || cashierID <= 0 {
+		logError("Invalid cashier_id parameter", err, zap.String("cashier_id", c.QueryParam("cashier_id")))
+		return cashier_errors.ErrApiCashierInvalidId(c)
+	}
+
+	res, err := h.client.FindShiftSalesById(ctx, &pb.FindYearMonthTotalSalesById{
+		Year:      int32(year),
+		Month:     int32(month),
+		CashierId: int32(cashierID),
+	})
+
+	if err != nil {
+		logError("Failed to find shift sales", err, zap.Int("cashier_id", cashierID), zap.Error(err))
+
+		return shiftApiError(c, err, cashier_errors.ErrApiFailedFindShiftSales, cashier_errors.ErrApiFailedFindShiftSales)
+	}
+
+	data := make([]*shiftSalesRowResponse, len(res.Data))
+	for i, row := range res.Data {
+		data[i] = &shiftSalesRowResponse{
+			Shift: toShiftResponse(row.Shift),
+			Sales: toShiftSalesResponse(row.Sales),
+		}
+	}
+
+	logSuccess("Successfully fetched shift sales", zap.Int("cashier_id", cashierID), zap.Int("shifts", len(data)))
+
+	return c.JSON(http.StatusOK, apiResponseShiftSales{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    data,
+	})
+}
+
+// shiftApiError maps the statuses the cashier service uses for shift
+// failures to gateway responses. What a conflict means depends on the call,
+// so the caller passes it in.
+func shiftApiError(c echo.Context, err error, conflict func(echo.Context) error, fallback func(echo.Context) error) error {
+	switch status.Code(err) {
+	case codes.Code(http.StatusNotFound):
+		return cashier_errors.ErrApiShiftNotFound(c)
+	case codes.Code(http.StatusConflict):
+		return conflict(c)
+	case codes.Code(http.StatusBadRequest):
+		return cashier_errors.ErrApiShiftInvalid(c)
+	}
+
+	return fallback(c)
+}
+
+func toShiftResponse(s *pb.ShiftResponse) *shiftResponse {
+	if s == nil {
+		return nil
+	}
+
+	res := &shiftResponse{
+		ID:           int(s.Id),
+		CashierID:    int(s.CashierId),
+		MerchantID:   int(s.MerchantId),
+		OpeningFloat: int(s.OpeningFloat),
+		OpenedBy:     int(s.OpenedBy),
+		OpenedAt:     s.OpenedAt,
+		Note:         s.Note,
+		Drawer:       toShiftDrawerResponse(s.Drawer),
+	}
+
+	if s.ClosedBy != nil {
+		closedBy := int(s.ClosedBy.GetValue())
+		res.ClosedBy = &closedBy
+	}
+	if s.ClosedAt != nil {
+		closedAt := s.ClosedAt.GetValue()
+		res.ClosedAt = &closedAt
+	}
+
+	return res
+}
+
+func toShiftDrawerResponse(d *pb.ShiftDrawerResponse) *shiftDrawerResponse {
+	if d == nil {
+		return nil
+	}
+
+	res := &shiftDrawerResponse{
+		OpeningFloat: int(d.OpeningFloat),
+		CashSales:    int(d.CashSales),
+		CashRefunds:  int(d.CashRefunds),
+		PayIns:       int(d.PayIns),
+		PayOuts:      int(d.PayOuts),
+		ExpectedCash: int(d.ExpectedCash),
+	}
+
+	if d.CountedCash != nil {
+		counted := int(d.CountedCash.GetValue())
+		res.CountedCash = &counted
+	}
+	if d.Variance != nil {
+		variance := int(d.Variance.GetValue())
+		res.Variance = &variance
+	}
+
+	return res
+}
+
+func toShiftMovementResponse(m *pb.ShiftMovementResponse) *shiftMovementResponse {
+	return &shiftMovementResponse{
+		ID:         int(m.Id),
+		ShiftID:    int(m.ShiftId),
+		Kind:       m.Kind,
+		Amount:     int(m.Amount),
+		Reason:     m.Reason,
+		OperatorID: int(m.OperatorId),
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func toShiftSalesResponse(s *pb.ShiftSalesResponse) *shiftSalesResponse {
+	if s == nil {
+		return nil
+	}
+
+	methods := make([]*shiftMethodSalesResponse, len(s.Methods))
+	for i, m := range s.Methods {
+		methods[i] = &shiftMethodSalesResponse{
+			Method:       m.Method,
+			Transactions: int(m.Transactions),
+			Amount:       int(m.Amount),
+		}
+	}
+
+	return &shiftSalesResponse{
+		Transactions: int(s.Transactions),
+		Gross:        int(s.Gross),
+		Refunds:      int(s.Refunds),
+		CashRefunds:  int(s.CashRefunds),
+		Net:          int(s.Net),
+		Methods:      methods,
+	}
+}
+
+func toShiftReportResponse(r *pb.ShiftReportResponse) *shiftReportResponse {
+	movements := make([]*shiftMovementResponse, len(r.Movements))
+	for i, m := range r.Movements {
+		movements[i] = toShiftMovementResponse(m)
+	}
+
+	return &shiftReportResponse{
+		Shift:     toShiftResponse(r.Shift),
+		Sales:     toShiftSalesResponse(r.Sales),
+		Drawer:    toShiftDrawerResponse(r.Drawer),
+		Movements: movements,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/category_errors"
@@ -35,6 +36,8 @@ func NewHandlerCategory(
 	client pb.CategoryServiceClient,
 	logger logger.LoggerInterface,
 	mapping response_api.CategoryResponseMapper,
+	rbac *middlewares.RoleAuthorizer,
+	bulk *middlewares.BulkGuard,
 ) *categoryHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -64,36 +67,45 @@ func NewHandlerCategory(
 		requestDuration: requestDuration,
 	}
 
+	read := rbac.RequirePermission("category:read")
+	create := rbac.RequirePermission("category:create")
+	update := rbac.RequirePermission("category:update")
+	trash := rbac.RequirePermission("category:delete")
+	purge := rbac.RequirePermission("category:purge")
+	report := rbac.RequirePermission("report:read")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+
 	routercategory := router.Group("/api/category")
 
-	routercategory.GET("", categoryHandler.FindAllCategory)
-	routercategory.GET("/:id", categoryHandler.FindById)
-	routercategory.GET("/active", categoryHandler.FindByActive)
-	routercategory.GET("/trashed", categoryHandler.FindByTrashed)
+	routercategory.GET("", categoryHandler.FindAllCategory, read)
+	routercategory.GET("/:id", categoryHandler.FindById, read)
+	routercategory.GET("/active", categoryHandler.FindByActive, read)
+	routercategory.GET("/trashed", categoryHandler.FindByTrashed, read)
 
-	routercategory.GET("/monthly-total-pricing", categoryHandler.FindMonthTotalPrice)
-	routercategory.GET("/yearly-total-pricing", categoryHandler.FindYearTotalPrice)
-	routercategory.GET("/merchant/monthly-total-pricing", categoryHandler.FindMonthTotalPriceByMerchant)
-	routercategory.GET("/merchant/yearly-total-pricing", categoryHandler.FindYearTotalPriceByMerchant)
-	routercategory.GET("/mycategory/monthly-total-pricing", categoryHandler.FindMonthTotalPriceById)
-	routercategory.GET("/mycategory/yearly-total-pricing", categoryHandler.FindYearTotalPriceById)
+	routercategory.GET("/monthly-total-pricing", categoryHandler.FindMonthTotalPrice, report)
+	routercategory.GET("/yearly-total-pricing", categoryHandler.FindYearTotalPrice, report)
+	routercategory.GET("/merchant/monthly-total-pricing", categoryHandler.FindMonthTotalPriceByMerchant, read)
+	routercategory.GET("/merchant/yearly-total-pricing", categoryHandler.FindYearTotalPriceByMerchant, read)
+	routercategory.GET("/mycategory/monthly-total-pricing", categoryHandler.FindMonthTotalPriceById, report)
+	routercategory.GET("/mycategory/yearly-total-pricing", categoryHandler.FindYearTotalPriceById, report)
 
-	routercategory.GET("/monthly-pricing", categoryHandler.FindMonthPrice)
-	routercategory.GET("/yearly-pricing", categoryHandler.FindYearPrice)
-	routercategory.GET("/merchant/monthly-pricing", categoryHandler.FindMonthPriceByMerchant)
-	routercategory.GET("/merchant/yearly-pricing", categoryHandler.FindYearPriceByMerchant)
-	routercategory.GET("/mycategory/monthly-pricing", categoryHandler.FindMonthPriceById)
-	routercategory.GET("/mycategory/yearly-pricing", categoryHandler.FindYearPriceById)
+	routercategory.GET("/monthly-pricing", categoryHandler.FindMonthPrice, report)
+	routercategory.GET("/yearly-pricing", categoryHandler.FindYearPrice, report)
+	routercategory.GET("/merchant/monthly-pricing", categoryHandler.FindMonthPriceByMerchant, read)
+	routercategory.GET("/merchant/yearly-pricing", categoryHandler.FindYearPriceByMerchant, read)
+	routercategory.GET("/mycategory/monthly-pricing", categoryHandler.FindMonthPriceById, report)
+	routercategory.GET("/mycategory/yearly-pricing", categoryHandler.FindYearPriceById, report)
 
-	routercategory.POST("/create", categoryHandler.Create)
-	routercategory.POST("/update/:id", categoryHandler.Update)
+	routercategory.POST("/create", categoryHandler.Create, create)
+	routercategory.POST("/update/:id", categoryHandler.Update, update)
 
-	routercategory.POST("/trashed/:id", categoryHandler.TrashedCategory)
-	routercategory.POST("/restore/:id", categoryHandler.RestoreCategory)
-	routercategory.DELETE("/permanent/:id", categoryHandler.DeleteCategoryPermanent)
+	routercategory.POST("/trashed/:id", categoryHandler.TrashedCategory, trash)
+	routercategory.POST("/restore/:id", categoryHandler.RestoreCategory, trash)
+	routercategory.DELETE("/permanent/:id", categoryHandler.DeleteCategoryPermanent, purge)
 
-	routercategory.POST("/restore/all", categoryHandler.RestoreAllCategory)
-	routercategory.POST("/permanent/all", categoryHandler.DeleteAllCategoryPermanent)
+	routercategory.POST("/restore/all", categoryHandler.RestoreAllCategory, bulkRestore, bulk.Guard())
+	routercategory.POST("/permanent/all", categoryHandler.DeleteAllCategoryPermanent, bulkPurge, bulk.Guard())
 
 	return categoryHandler
 }
@@ -1207,9 +1219,13 @@ func (h *categoryHandleApi) DeleteCategoryPermanent(c echo.Context) error {
 // @Description Restore a trashed category record by its ID.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseCategoryAll "Successfully restored category all"
 // @Failure 400 {object} response.ErrorResponse "Invalid category ID"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore category"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/category/restore/all [post]
 func (h *categoryHandleApi) RestoreAllCategory(c echo.Context) error {
 	const method = "RestoreAllCategory"
@@ -1243,9 +1259,13 @@ func (h *categoryHandleApi) RestoreAllCategory(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "category ID"
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseCategoryAll "Successfully deleted category record permanently"
 // @Failure 400 {object} response.ErrorResponse "Bad Request: Invalid ID"
 // @Failure 500 {object} response.ErrorResponse "Failed to delete category:"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/category/delete/all [post]
 func (h *categoryHandleApi) DeleteAllCategoryPermanent(c echo.Context) error {
 	const method = "DeleteAllCategoryPermanent"
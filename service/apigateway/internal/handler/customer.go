@@ -0,0 +1,904 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/customer_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcode "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type customerHandleApi struct {
+	client          pb.CustomerServiceClient
+	logger          logger.LoggerInterface
+	trace           trace.Tracer
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+type createCustomerRequest struct {
+	MerchantID int    `json:"merchant_id"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	Phone      string `json:"phone"`
+}
+
+type updateCustomerRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+}
+
+type attachCustomerOrderRequest struct {
+	OrderID int `json:"order_id"`
+}
+
+type customerResponse struct {
+	ID         int     `json:"id"`
+	MerchantID int     `json:"merchant_id"`
+	Name       string  `json:"name"`
+	Email      string  `json:"email,omitempty"`
+	Phone      string  `json:"phone,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+	DeletedAt  *string `json:"deleted_at,omitempty"`
+}
+
+type customerPurchaseResponse struct {
+	OrderID       int    `json:"order_id"`
+	MerchantID    int    `json:"merchant_id"`
+	CashierID     int    `json:"cashier_id"`
+	TotalPrice    int    `json:"total_price"`
+	TransactionID int    `json:"transaction_id,omitempty"`
+	PaymentStatus string `json:"payment_status,omitempty"`
+	Paid          int    `json:"paid"`
+	Refunded      int    `json:"refunded"`
+	OrderedAt     string `json:"ordered_at"`
+}
+
+type customerStatsResponse struct {
+	CustomerID        int     `json:"customer_id"`
+	Orders            int     `json:"orders"`
+	Visits            int     `json:"visits"`
+	LifetimeSpend     int     `json:"lifetime_spend"`
+	Refunded          int     `json:"refunded"`
+	AverageOrder      int     `json:"average_order"`
+	FirstVisit        *string `json:"first_visit,omitempty"`
+	LastVisit         *string `json:"last_visit,omitempty"`
+	DaysBetweenVisits float64 `json:"days_between_visits"`
+}
+
+type apiResponseCustomer struct {
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Data    *customerResponse `json:"data"`
+}
+
+type apiResponsePaginationCustomer struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*customerResponse      `json:"data"`
+	Pagination *response.PaginationMeta `json:"pagination"`
+}
+
+type apiResponseCustomerDelete struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type apiResponsePaginationCustomerPurchase struct {
+	Status     string                      `json:"status"`
+	Message    string                      `json:"message"`
+	Data       []*customerPurchaseResponse `json:"data"`
+	Pagination *response.PaginationMeta    `json:"pagination"`
+}
+
+type apiResponseCustomerStats struct {
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Data    *customerStatsResponse `json:"data"`
+}
+
+func NewHandlerCustomer(
+	router *echo.Echo,
+	client pb.CustomerServiceClient,
+	logger logger.LoggerInterface,
+	rbac *middlewares.RoleAuthorizer,
+) *customerHandleApi {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "customer_handler_requests_total",
+			Help: "Total number of customer requests",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "customer_handler_request_duration_seconds",
+			Help:    "Duration of customer requests",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "status"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	customerHandler := &customerHandleApi{
+		client:          client,
+		logger:          logger,
+		trace:           otel.Tracer("customer-handler"),
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+
+	read := rbac.RequirePermission("customer:read")
+	create := rbac.RequirePermission("customer:create")
+	update := rbac.RequirePermission("customer:update")
+	trash := rbac.RequirePermission("customer:delete")
+	purge := rbac.RequirePermission("customer:purge")
+
+	routerCustomer := router.Group("/api/customers")
+
+	routerCustomer.GET("/merchant/:merchant_id", customerHandler.FindByMerchant, read)
+	routerCustomer.GET("/merchant/:merchant_id/trashed", customerHandler.FindTrashedByMerchant, trash)
+	routerCustomer.GET("/merchant/:merchant_id/lookup", customerHandler.FindByContact, read)
+	routerCustomer.GET("/:id", customerHandler.FindById, read)
+	routerCustomer.GET("/:id/purchases", customerHandler.FindPurchases, read)
+	routerCustomer.GET("/:id/stats", customerHandler.FindStats, read)
+
+	routerCustomer.POST("/create", customerHandler.Create, create)
+	routerCustomer.POST("/update/:id", customerHandler.Update, update)
+	routerCustomer.POST("/:id/attach-order", customerHandler.AttachOrder, update)
+
+	routerCustomer.POST("/trashed/:id", customerHandler.TrashedCustomer, trash)
+	routerCustomer.POST("/restore/:id", customerHandler.RestoreCustomer, trash)
+	routerCustomer.DELETE("/permanent/:id", customerHandler.DeleteCustomerPermanent, purge)
+
+	return customerHandler
+}
+
+// FindByMerchant godoc
+// @Summary List a merchant's customers
+// @Tags Customer
+// @Security Bearer
+// @Description Lists the merchant's customers. search matches the name, email or phone number.
+// @Produce json
+// @Param merchant_id path int true "Merchant ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Param search query string false "Search query"
+// @Success 200 {object} apiResponsePaginationCustomer "Customers"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch customers"
+// @Router /api/customers/merchant/{merchant_id} [get]
+func (h *customerHandleApi) FindByMerchant(c echo.Context) error {
+	return h.findPage(c, "FindByMerchant", h.client.FindByMerchant)
+}
+
+// FindTrashedByMerchant godoc
+// @Summary List a merchant's trashed customers
+// @Tags Customer
+// @Security Bearer
+// @Produce json
+// @Param merchant_id path int true "Merchant ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Param search query string false "Search query"
+// @Success 200 {object} apiResponsePaginationCustomer "Trashed customers"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch customers"
+// @Router /api/customers/merchant/{merchant_id}/trashed [get]
+func (h *customerHandleApi) FindTrashedByMerchant(c echo.Context) error {
+	return h.findPage(c, "FindTrashedByMerchant", h.client.FindTrashedByMerchant)
+}
+
+func (h *customerHandleApi) findPage(
+	c echo.Context,
+	method string,
+	find func(context.Context, *pb.FindAllCustomerRequest, ...grpc.CallOption) (*pb.ApiResponsePaginationCustomer, error),
+) error {
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	merchantID, err := strconv.Atoi(c.Param("merchant_id"))
+
+	if err != nil || merchantID <= 0 {
+		logError("Invalid merchant ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidMerchantId(c)
+	}
+
+	res, err := find(ctx, &pb.FindAllCustomerRequest{
+		MerchantId: int32(merchantID),
+		Search:     c.QueryParam("search"),
+		Page:       int32(parseQueryInt(c, "page", 1)),
+		PageSize:   int32(parseQueryInt(c, "page_size", 10)),
+	})
+
+	if err != nil {
+		logError("Failed to fetch customers", err, zap.Int("merchant_id", merchantID), zap.Error(err))
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedFindCustomers)
+	}
+
+	data := make([]*customerResponse, len(res.Data))
+	for i, customer := range res.Data {
+		data[i] = toCustomerResponse(customer)
+	}
+
+	logSuccess("Successfully fetched customers", zap.Int("merchant_id", merchantID), zap.Int("count", len(data)))
+
+	return c.JSON(http.StatusOK, apiResponsePaginationCustomer{
+		Status:     res.Status,
+		Message:    res.Message,
+		Data:       data,
+		Pagination: toPaginationMeta(res.Pagination),
+	})
+}
+
+// FindByContact godoc
+// @Summary Look a customer up by phone or email
+// @Tags Customer
+// @Security Bearer
+// @Description Finds the merchant's customer with the phone number or email given at the till. The phone number wins when both match someone.
+// @Produce json
+// @Param merchant_id path int true "Merchant ID"
+// @Param phone query string false "Phone number"
+// @Param email query string false "Email"
+// @Success 200 {object} apiResponseCustomer "Customer"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID or no phone or email"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch customer"
+// @Router /api/customers/merchant/{merchant_id}/lookup [get]
+func (h *customerHandleApi) FindByContact(c echo.Context) error {
+	const method = "FindByContact"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	merchantID, err := strconv.Atoi(c.Param("merchant_id"))
+
+	if err != nil || merchantID <= 0 {
+		logError("Invalid merchant ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidMerchantId(c)
+	}
+
+	phone, email := c.QueryParam("phone"), c.QueryParam("email")
+
+	if phone == "" && email == "" {
+		logError("Missing phone and email", nil, zap.Int("merchant_id", merchantID))
+
+		return customer_errors.ErrApiContactRequired(c)
+	}
+
+	res, err := h.client.FindByContact(ctx, &pb.FindCustomerByContactRequest{
+		MerchantId: int32(merchantID),
+		Phone:      phone,
+		Email:      email,
+	})
+
+	if err != nil {
+		logError("Failed to look customer up", err, zap.Int("merchant_id", merchantID), zap.Error(err))
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedFindCustomer)
+	}
+
+	logSuccess("Successfully looked customer up", zap.Int32("customer_id", res.Data.Id))
+
+	return c.JSON(http.StatusOK, apiResponseCustomer{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toCustomerResponse(res.Data),
+	})
+}
+
+// FindById godoc
+// @Summary Find a customer by ID
+// @Tags Customer
+// @Security Bearer
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} apiResponseCustomer "Customer"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch customer"
+// @Router /api/customers/{id} [get]
+func (h *customerHandleApi) FindById(c echo.Context) error {
+	const method = "FindById"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	res, err := h.client.FindById(ctx, &pb.FindByIdCustomerRequest{
+		Id: int32(id),
+	})
+
+	if err != nil {
+		logError("Failed to fetch customer", err, zap.Int("customer_id", id), zap.Error(err))
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedFindCustomer)
+	}
+
+	logSuccess("Successfully fetched customer", zap.Int("customer_id", id))
+
+	return c.JSON(http.StatusOK, apiResponseCustomer{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toCustomerResponse(res.Data),
+	})
+}
+
+// FindPurchases godoc
+// @Summary Get a customer's purchase history
+// @Tags Customer
+// @Security Bearer
+// @Description Lists the customer's orders, newest first, with how each was paid and refunded.
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} apiResponsePaginationCustomerPurchase "Purchases"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch customer purchases"
+// @Router /api/customers/{id}/purchases [get]
+func (h *customerHandleApi) FindPurchases(c echo.Context) error {
+	const method = "FindPurchases"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	res, err := h.client.FindPurchases(ctx, &pb.FindCustomerPurchasesRequest{
+		CustomerId: int32(id),
+		Page:       int32(parseQueryInt(c, "page", 1)),
+		PageSize:   int32(parseQueryInt(c, "page_size", 10)),
+	})
+
+	if err != nil {
+		logError("Failed to fetch customer purchases", err, zap.Int("customer_id", id), zap.Error(err))
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedFindCustomerPurchases)
+	}
+
+	data := make([]*customerPurchaseResponse, len(res.Data))
+	for i, p := range res.Data {
+		data[i] = &customerPurchaseResponse{
+			OrderID:       int(p.OrderId),
+			MerchantID:    int(p.MerchantId),
+			CashierID:     int(p.CashierId),
+			TotalPrice:    int(p.TotalPrice),
+			TransactionID: int(p.TransactionId),
+			PaymentStatus: p.PaymentStatus,
+			Paid:          int(p.Paid),
+			Refunded:      int(p.Refunded),
+			OrderedAt:     p.OrderedAt,
+		}
+	}
+
+	logSuccess("Successfully fetched customer purchases", zap.Int("customer_id", id))
+
+	return c.JSON(http.StatusOK, apiResponsePaginationCustomerPurchase{
+		Status:     res.Status,
+		Message:    res.Message,
+		Data:       data,
+		Pagination: toPaginationMeta(res.Pagination),
+	})
+}
+
+// FindStats godoc
+// @Summary Get a customer's stats
+// @Tags Customer
+// @Security Bearer
+// @Description Lifetime spend, average order and visit frequency of the customer, computed from their paid orders. A visit is a day with at least one paid order.
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} apiResponseCustomerStats "Customer stats"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch customer stats"
+// @Router /api/customers/{id}/stats [get]
+func (h *customerHandleApi) FindStats(c echo.Context) error {
+	const method = "FindStats"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	res, err := h.client.FindStats(ctx, &pb.FindByIdCustomerRequest{
+		Id: int32(id),
+	})
+
+	if err != nil {
+		logError("Failed to fetch customer stats", err, zap.Int("customer_id", id), zap.Error(err))
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedFindCustomerStats)
+	}
+
+	stats := &customerStatsResponse{
+		CustomerID:        int(res.Data.CustomerId),
+		Orders:            int(res.Data.Orders),
+		Visits:            int(res.Data.Visits),
+		LifetimeSpend:     int(res.Data.LifetimeSpend),
+		Refunded:          int(res.Data.Refunded),
+		AverageOrder:      int(res.Data.AverageOrder),
+		DaysBetweenVisits: res.Data.DaysBetweenVisits,
+	}
+
+	if res.Data.FirstVisit != nil {
+		firstVisit := res.Data.FirstVisit.GetValue()
+		stats.FirstVisit = &firstVisit
+	}
+	if res.Data.LastVisit != nil {
+		lastVisit := res.Data.LastVisit.GetValue()
+		stats.LastVisit = &lastVisit
+	}
+
+	logSuccess("Successfully fetched customer stats", zap.Int("customer_id", id))
+
+	return c.JSON(http.StatusOK, apiResponseCustomerStats{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    stats,
+	})
+}
+
+// Create godoc
+// @Summary Create a customer
+// @Tags Customer
+// @Security Bearer
+// @Description Registers a customer with a merchant. An email or a phone number is required; each identifies one live customer of the merchant.
+// @Accept json
+// @Produce json
+// @Param request body createCustomerRequest true "Customer"
+// @Success 201 {object} apiResponseCustomer "Created customer"
+// @Failure 400 {object} response.ErrorResponse "Invalid name, email or phone number"
+// @Failure 409 {object} response.ErrorResponse "Email or phone number already in use"
+// @Failure 500 {object} response.ErrorResponse "Failed to create customer"
+// @Router /api/customers/create [post]
+func (h *customerHandleApi) Create(c echo.Context) error {
+	const method = "Create"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	var body createCustomerRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind create customer request", err, zap.Error(err))
+
+		return customer_errors.ErrApiBindCreateCustomer(c)
+	}
+
+	if body.MerchantID <= 0 {
+		logError("Invalid merchant ID", nil, zap.Int("merchant_id", body.MerchantID))
+
+		return customer_errors.ErrApiInvalidMerchantId(c)
+	}
+
+	res, err := h.client.Create(ctx, &pb.CreateCustomerRequest{
+		MerchantId: int32(body.MerchantID),
+		Name:       body.Name,
+		Email:      body.Email,
+		Phone:      body.Phone,
+	})
+
+	if err != nil {
+		logError("Failed to create customer", err, zap.Int("merchant_id", body.MerchantID), zap.Error(err))
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedCreateCustomer)
+	}
+
+	logSuccess("Successfully created customer", zap.Int32("customer_id", res.Data.Id))
+
+	return c.JSON(http.StatusCreated, apiResponseCustomer{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toCustomerResponse(res.Data),
+	})
+}
+
+// Update godoc
+// @Summary Update a customer
+// @Tags Customer
+// @Security Bearer
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param request body updateCustomerRequest true "Customer"
+// @Success 200 {object} apiResponseCustomer "Updated customer"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID, name, email or phone number"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 409 {object} response.ErrorResponse "Email or phone number already in use"
+// @Failure 500 {object} response.ErrorResponse "Failed to update customer"
+// @Router /api/customers/update/{id} [post]
+func (h *customerHandleApi) Update(c echo.Context) error {
+	const method = "Update"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	var body updateCustomerRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind update customer request", err, zap.Error(err))
+
+		return customer_errors.ErrApiBindUpdateCustomer(c)
+	}
+
+	res, err := h.client.Update(ctx, &pb.UpdateCustomerRequest{
+		CustomerId: int32(id),
+		Name:       body.Name,
+		Email:      body.Email,
+		Phone:      body.Phone,
+	})
+
+	if err != nil {
+		logError("Failed to update customer", err, zap.Int("customer_id", id), zap.Error(err))
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedUpdateCustomer)
+	}
+
+	logSuccess("Successfully updated customer", zap.Int("customer_id", id))
+
+	return c.JSON(http.StatusOK, apiResponseCustomer{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toCustomerResponse(res.Data),
+	})
+}
+
+// AttachOrder godoc
+// @Summary Attach a customer to an order
+// @Tags Customer
+// @Security Bearer
+// @Description Records who an order was placed for, for orders created without a customer_id.
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Param request body attachCustomerOrderRequest true "Order"
+// @Success 200 {object} apiResponseCustomerDelete "Customer attached"
+// @Failure 400 {object} response.ErrorResponse "Invalid ID, or customer and order of different merchants"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to attach customer to order"
+// @Router /api/customers/{id}/attach-order [post]
+func (h *customerHandleApi) AttachOrder(c echo.Context) error {
+	const method = "AttachOrder"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	var body attachCustomerOrderRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind attach customer request", err, zap.Error(err))
+
+		return customer_errors.ErrApiBindAttachCustomer(c)
+	}
+
+	if body.OrderID <= 0 {
+		logError("Invalid order ID", nil, zap.Int("order_id", body.OrderID))
+
+		return customer_errors.ErrApiInvalidOrderId(c)
+	}
+
+	res, err := h.client.AttachOrder(ctx, &pb.AttachCustomerOrderRequest{
+		CustomerId: int32(id),
+		OrderId:    int32(body.OrderID),
+	})
+
+	if err != nil {
+		logError("Failed to attach customer to order", err, zap.Int("customer_id", id), zap.Int("order_id", body.OrderID), zap.Error(err))
+
+		if status.Code(err) == codes.Code(http.StatusBadRequest) {
+			return customer_errors.ErrApiCustomerOrderMerchant(c)
+		}
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedAttachCustomer)
+	}
+
+	logSuccess("Successfully attached customer to order", zap.Int("customer_id", id), zap.Int("order_id", body.OrderID))
+
+	return c.JSON(http.StatusOK, apiResponseCustomerDelete{
+		Status:  res.Status,
+		Message: res.Message,
+	})
+}
+
+// TrashedCustomer godoc
+// @Summary Move a customer to trash
+// @Tags Customer
+// @Security Bearer
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} apiResponseCustomer "Trashed customer"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to move customer to trash"
+// @Router /api/customers/trashed/{id} [post]
+func (h *customerHandleApi) TrashedCustomer(c echo.Context) error {
+	return h.changeState(c, "TrashedCustomer", h.client.TrashedCustomer, customer_errors.ErrApiFailedTrashCustomer)
+}
+
+// RestoreCustomer godoc
+// @Summary Restore a trashed customer
+// @Tags Customer
+// @Security Bearer
+// @Description Brings a trashed customer back, unless their phone number or email has been given to someone else in the meantime.
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} apiResponseCustomer "Restored customer"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 409 {object} response.ErrorResponse "Email or phone number already in use"
+// @Failure 500 {object} response.ErrorResponse "Failed to restore customer"
+// @Router /api/customers/restore/{id} [post]
+func (h *customerHandleApi) RestoreCustomer(c echo.Context) error {
+	return h.changeState(c, "RestoreCustomer", h.client.RestoreCustomer, customer_errors.ErrApiFailedRestoreCustomer)
+}
+
+func (h *customerHandleApi) changeState(
+	c echo.Context,
+	method string,
+	change func(context.Context, *pb.FindByIdCustomerRequest, ...grpc.CallOption) (*pb.ApiResponseCustomer, error),
+	fallback func(echo.Context) error,
+) error {
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	res, err := change(ctx, &pb.FindByIdCustomerRequest{
+		Id: int32(id),
+	})
+
+	if err != nil {
+		logError("Failed to "+method, err, zap.Int("customer_id", id), zap.Error(err))
+
+		return customerApiError(c, err, fallback)
+	}
+
+	logSuccess("Successfully completed "+method, zap.Int("customer_id", id))
+
+	return c.JSON(http.StatusOK, apiResponseCustomer{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toCustomerResponse(res.Data),
+	})
+}
+
+// DeleteCustomerPermanent godoc
+// @Summary Permanently delete a customer
+// @Tags Customer
+// @Security Bearer
+// @Description Deletes a trashed customer. Their orders are kept without a customer.
+// @Produce json
+// @Param id path int true "Customer ID"
+// @Success 200 {object} apiResponseCustomerDelete "Customer deleted"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to permanently delete customer"
+// @Router /api/customers/permanent/{id} [delete]
+func (h *customerHandleApi) DeleteCustomerPermanent(c echo.Context) error {
+	const method = "DeleteCustomerPermanent"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return customer_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	res, err := h.client.DeleteCustomerPermanent(ctx, &pb.FindByIdCustomerRequest{
+		Id: int32(id),
+	})
+
+	if err != nil {
+		logError("Failed to permanently delete customer", err, zap.Int("customer_id", id), zap.Error(err))
+
+		return customerApiError(c, err, customer_errors.ErrApiFailedDeleteCustomer)
+	}
+
+	logSuccess("Successfully deleted customer permanently", zap.Int("customer_id", id))
+
+	return c.JSON(http.StatusOK, apiResponseCustomerDelete{
+		Status:  res.Status,
+		Message: res.Message,
+	})
+}
+
+// customerApiError maps the statuses the order service uses for customer
+// failures to gateway responses.
+func customerApiError(c echo.Context, err error, fallback func(echo.Context) error) error {
+	switch status.Code(err) {
+	case codes.Code(http.StatusNotFound):
+		return customer_errors.ErrApiCustomerNotFound(c)
+	case codes.Code(http.StatusBadRequest), codes.InvalidArgument:
+		return customer_errors.ErrApiInvalidCustomer(c)
+	case codes.Code(http.StatusConflict):
+		return customer_errors.ErrApiCustomerContactTaken(c)
+	}
+
+	return fallback(c)
+}
+
+func toCustomerResponse(c *pb.CustomerResponse) *customerResponse {
+	res := &customerResponse{
+		ID:         int(c.Id),
+		MerchantID: int(c.MerchantId),
+		Name:       c.Name,
+		Email:      c.Email,
+		Phone:      c.Phone,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+
+	if c.DeletedAt != nil {
+		deletedAt := c.DeletedAt.GetValue()
+		res.DeletedAt = &deletedAt
+	}
+
+	return res
+}
+
+func toPaginationMeta(p *pb.PaginationMeta) *response.PaginationMeta {
+	if p == nil {
+		return nil
+	}
+
+	return &response.PaginationMeta{
+		CurrentPage:  int(p.CurrentPage),
+		PageSize:     int(p.PageSize),
+		TotalPages:   int(p.TotalPages),
+		TotalRecords: int(p.TotalRecords),
+	}
+}
+
+func (s *customerHandleApi) startTracingAndLogging(
+	ctx context.Context,
+	method string,
+	attrs ...attribute.KeyValue,
+) (
+	end func(),
+	logSuccess func(string, ...zap.Field),
+	logError func(string, error, ...zap.Field),
+) {
+	start := time.Now()
+	_, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+	s.logger.Debug("Start: " + method)
+
+	status := "success"
+
+	end = func() {
+		s.recordMetrics(method, status, start)
+		code := otelcode.Ok
+		if status != "success" {
+			code = otelcode.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess = func(msg string, fields ...zap.Field) {
+		status = "success"
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	logError = func(msg string, err error, fields ...zap.Field) {
+		status = "error"
+		span.RecordError(err)
+		span.SetStatus(otelcode.Error, msg)
+		span.AddEvent(msg)
+		allFields := append([]zap.Field{zap.Error(err)}, fields...)
+		s.logger.Error(msg, allFields...)
+	}
+
+	return end, logSuccess, logError
+}
+
+func (s *customerHandleApi) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
+}
@@ -1,19 +1,25 @@
 package handler
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/auth"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/upload_image"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
 	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 
 	response_api "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/api"
 )
 
+const roleCacheTTL = 5 * time.Minute
+
 type ServiceConnections struct {
 	Auth        *grpc.ClientConn
 	Role        *grpc.ClientConn
@@ -29,6 +35,10 @@ type ServiceConnections struct {
 
 type Deps struct {
 	Token              auth.TokenManager
+	ApiKeys            *middlewares.ApiKeyStore
+	Bulk               *middlewares.BulkGuard
+	Redis              *redis.Client
+	AuditAddr          string
 	E                  *echo.Echo
 	Logger             logger.LoggerInterface
 	Mapping            *response_api.ResponseApiMapper
@@ -46,20 +56,31 @@ func NewHandler(deps *Deps) {
 	clientMerchantDocument := pb.NewMerchantDocumentServiceClient(deps.ServiceConnections.Merchant)
 	clientOrderItem := pb.NewOrderItemServiceClient(deps.ServiceConnections.OrderItem)
 	clientOrder := pb.NewOrderServiceClient(deps.ServiceConnections.Order)
+	clientPromotion := pb.NewPromotionServiceClient(deps.ServiceConnections.Order)
+	clientCustomer := pb.NewCustomerServiceClient(deps.ServiceConnections.Order)
 	clientProduct := pb.NewProductServiceClient(deps.ServiceConnections.Product)
 	clientTransaction := pb.NewTransactionServiceClient(deps.ServiceConnections.Transaction)
+	clientLoyalty := pb.NewLoyaltyServiceClient(deps.ServiceConnections.Transaction)
+
+	rbac := middlewares.NewRoleAuthorizer(clientRole, deps.Logger, roleCacheTTL)
+	go rbac.Listen(context.Background(), deps.Redis)
 
 	NewHandlerAuth(deps.E, clientAuth, deps.Logger, deps.Mapping.AuthResponseMapper)
-	NewHandlerRole(deps.E, clientRole, deps.Logger, deps.Mapping.RoleResponseMapper)
-	NewHandlerUser(deps.E, clientUser, deps.Logger, deps.Mapping.UserResponseMapper)
-	NewHandlerCategory(deps.E, clientCategory, deps.Logger, deps.Mapping.CategoryResponseMapper)
-	NewHandlerCashier(deps.E, clientCashier, deps.Logger, deps.Mapping.CashierResponseMapper)
-	NewHandlerMerchant(deps.E, clientMerchant, deps.Logger, deps.Mapping.MerchantResponseMapper)
-	NewHandlerMerchantDocument(deps.E, clientMerchantDocument, deps.Logger, deps.Mapping.MerchantDocumentProMapper)
-	NewHandlerOrderItem(deps.E, clientOrderItem, deps.Logger, deps.Mapping.OrderItemResponseMapper)
-	NewHandlerOrder(deps.E, clientOrder, deps.Logger, deps.Mapping.OrderResponseMapper)
-	NewHandlerProduct(deps.E, clientProduct, deps.Logger, deps.Mapping.ProductResponseMapper, deps.ImageUpload)
-	NewHandlerTransaction(deps.E, clientTransaction, deps.Logger, deps.Mapping.TransactionResponseMapper)
+	NewHandlerRole(deps.E, clientRole, deps.Logger, deps.Mapping.RoleResponseMapper, rbac, deps.Bulk)
+	NewHandlerUser(deps.E, clientUser, deps.Logger, deps.Mapping.UserResponseMapper, rbac, deps.Bulk)
+	NewHandlerCategory(deps.E, clientCategory, deps.Logger, deps.Mapping.CategoryResponseMapper, rbac, deps.Bulk)
+	NewHandlerCashier(deps.E, clientCashier, deps.Logger, deps.Mapping.CashierResponseMapper, rbac, deps.Bulk)
+	NewHandlerMerchant(deps.E, clientMerchant, deps.Logger, deps.Mapping.MerchantResponseMapper, rbac, deps.Bulk)
+	NewHandlerMerchantDocument(deps.E, clientMerchantDocument, deps.Logger, deps.Mapping.MerchantDocumentProMapper, rbac, deps.Bulk)
+	NewHandlerOrderItem(deps.E, clientOrderItem, deps.Logger, deps.Mapping.OrderItemResponseMapper, rbac)
+	NewHandlerOrder(deps.E, clientOrder, deps.Logger, deps.Mapping.OrderResponseMapper, rbac, deps.Bulk)
+	NewHandlerPromotion(deps.E, clientPromotion, deps.Logger, rbac)
+	NewHandlerCustomer(deps.E, clientCustomer, deps.Logger, rbac)
+	NewHandlerProduct(deps.E, clientProduct, deps.Logger, deps.Mapping.ProductResponseMapper, deps.ImageUpload, rbac, deps.Bulk)
+	NewHandlerTransaction(deps.E, clientTransaction, deps.Logger, deps.Mapping.TransactionResponseMapper, rbac, deps.Bulk)
+	NewHandlerLoyalty(deps.E, clientLoyalty, deps.Logger, rbac)
+	NewHandlerApiKey(deps.E, deps.ApiKeys, deps.Logger, rbac)
+	NewHandlerAudit(deps.E, deps.AuditAddr, deps.Logger, rbac)
 }
 
 func parseQueryInt(c echo.Context, key string, defaultValue int) int {
@@ -0,0 +1,441 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/loyalty_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcode "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type loyaltyHandleApi struct {
+	client          pb.LoyaltyServiceClient
+	logger          logger.LoggerInterface
+	trace           trace.Tracer
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+type saveLoyaltyProgramRequest struct {
+	SpendPerPoint int  `json:"spend_per_point"`
+	PointValue    int  `json:"point_value"`
+	ExpiryDays    int  `json:"expiry_days"`
+	Active        bool `json:"active"`
+}
+
+type loyaltyProgramResponse struct {
+	MerchantID    int  `json:"merchant_id"`
+	SpendPerPoint int  `json:"spend_per_point"`
+	PointValue    int  `json:"point_value"`
+	ExpiryDays    int  `json:"expiry_days"`
+	Active        bool `json:"active"`
+}
+
+type loyaltyBalanceResponse struct {
+	CustomerID int `json:"customer_id"`
+	MerchantID int `json:"merchant_id"`
+	Points     int `json:"points"`
+	Value      int `json:"value"`
+}
+
+type loyaltyEntryResponse struct {
+	ID            int64   `json:"id"`
+	CustomerID    int     `json:"customer_id"`
+	MerchantID    int     `json:"merchant_id"`
+	TransactionID int     `json:"transaction_id,omitempty"`
+	Kind          string  `json:"kind"`
+	Points        int     `json:"points"`
+	ExpiresAt     *string `json:"expires_at,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+type apiResponseLoyaltyProgram struct {
+	Status  string                  `json:"status"`
+	Message string                  `json:"message"`
+	Data    *loyaltyProgramResponse `json:"data"`
+}
+
+type apiResponseLoyaltyBalance struct {
+	Status  string                  `json:"status"`
+	Message string                  `json:"message"`
+	Data    *loyaltyBalanceResponse `json:"data"`
+}
+
+type apiResponsePaginationLoyaltyEntry struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*loyaltyEntryResponse  `json:"data"`
+	Pagination *response.PaginationMeta `json:"pagination"`
+}
+
+func NewHandlerLoyalty(
+	router *echo.Echo,
+	client pb.LoyaltyServiceClient,
+	logger logger.LoggerInterface,
+	rbac *middlewares.RoleAuthorizer,
+) *loyaltyHandleApi {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "loyalty_handler_requests_total",
+			Help: "Total number of loyalty requests",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "loyalty_handler_request_duration_seconds",
+			Help:    "Duration of loyalty requests",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "status"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	loyaltyHandler := &loyaltyHandleApi{
+		client:          client,
+		logger:          logger,
+		trace:           otel.Tracer("loyalty-handler"),
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+
+	read := rbac.RequirePermission("loyalty:read")
+	update := rbac.RequirePermission("loyalty:update")
+
+	routerLoyalty := router.Group("/api/loyalty")
+
+	routerLoyalty.GET("/merchant/:merchant_id/program", loyaltyHandler.FindProgram, read)
+	routerLoyalty.POST("/merchant/:merchant_id/program", loyaltyHandler.SaveProgram, update)
+	routerLoyalty.GET("/customer/:customer_id/balance", loyaltyHandler.GetBalance, read)
+	routerLoyalty.GET("/customer/:customer_id/history", loyaltyHandler.FindHistory, read)
+
+	return loyaltyHandler
+}
+
+// FindProgram godoc
+// @Summary Get a merchant's loyalty program
+// @Tags Loyalty
+// @Security Bearer
+// @Produce json
+// @Param merchant_id path int true "Merchant ID"
+// @Success 200 {object} apiResponseLoyaltyProgram "Loyalty program"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID"
+// @Failure 404 {object} response.ErrorResponse "Merchant has no loyalty program"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch loyalty program"
+// @Router /api/loyalty/merchant/{merchant_id}/program [get]
+func (h *loyaltyHandleApi) FindProgram(c echo.Context) error {
+	const method = "FindProgram"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	merchantID, err := strconv.Atoi(c.Param("merchant_id"))
+
+	if err != nil || merchantID <= 0 {
+		logError("Invalid merchant ID", err, zap.Error(err))
+
+		return loyalty_errors.ErrApiInvalidMerchantId(c)
+	}
+
+	res, err := h.client.FindProgram(ctx, &pb.FindLoyaltyProgramRequest{
+		MerchantId: int32(merchantID),
+	})
+
+	if err != nil {
+		logError("Failed to fetch loyalty program", err, zap.Int("merchant_id", merchantID), zap.Error(err))
+
+		return loyaltyApiError(c, err, loyalty_errors.ErrApiLoyaltyProgramNotFound, loyalty_errors.ErrApiInvalidMerchantId, loyalty_errors.ErrApiFailedFindLoyaltyProgram)
+	}
+
+	logSuccess("Successfully fetched loyalty program", zap.Int("merchant_id", merchantID))
+
+	return c.JSON(http.StatusOK, apiResponseLoyaltyProgram{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toLoyaltyProgramResponse(res.Data),
+	})
+}
+
+// SaveProgram godoc
+// @Summary Set a merchant's loyalty program
+// @Tags Loyalty
+// @Security Bearer
+// @Description Sets the merchant's rule: a point for every spend_per_point paid, worth point_value when redeemed and kept for expiry_days, or forever when zero. A changed rule applies to payments from then on.
+// @Accept json
+// @Produce json
+// @Param merchant_id path int true "Merchant ID"
+// @Param request body saveLoyaltyProgramRequest true "Loyalty program"
+// @Success 200 {object} apiResponseLoyaltyProgram "Saved loyalty program"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID or program"
+// @Failure 500 {object} response.ErrorResponse "Failed to save loyalty program"
+// @Router /api/loyalty/merchant/{merchant_id}/program [post]
+func (h *loyaltyHandleApi) SaveProgram(c echo.Context) error {
+	const method = "SaveProgram"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	merchantID, err := strconv.Atoi(c.Param("merchant_id"))
+
+	if err != nil || merchantID <= 0 {
+		logError("Invalid merchant ID", err, zap.Error(err))
+
+		return loyalty_errors.ErrApiInvalidMerchantId(c)
+	}
+
+	var body saveLoyaltyProgramRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind save loyalty program request", err, zap.Error(err))
+
+		return loyalty_errors.ErrApiBindSaveLoyaltyProgram(c)
+	}
+
+	res, err := h.client.SaveProgram(ctx, &pb.SaveLoyaltyProgramRequest{
+		MerchantId:    int32(merchantID),
+		SpendPerPoint: int32(body.SpendPerPoint),
+		PointValue:    int32(body.PointValue),
+		ExpiryDays:    int32(body.ExpiryDays),
+		Active:        body.Active,
+	})
+
+	if err != nil {
+		logError("Failed to save loyalty program", err, zap.Int("merchant_id", merchantID), zap.Error(err))
+
+		return loyaltyApiError(c, err, loyalty_errors.ErrApiLoyaltyProgramNotFound, loyalty_errors.ErrApiInvalidLoyaltyProgram, loyalty_errors.ErrApiFailedSaveLoyaltyProgram)
+	}
+
+	logSuccess("Successfully saved loyalty program", zap.Int("merchant_id", merchantID))
+
+	return c.JSON(http.StatusOK, apiResponseLoyaltyProgram{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toLoyaltyProgramResponse(res.Data),
+	})
+}
+
+// GetBalance godoc
+// @Summary Get a customer's loyalty balance
+// @Tags Loyalty
+// @Security Bearer
+// @Description The points the customer holds with their merchant and what they are worth under the merchant's program.
+// @Produce json
+// @Param customer_id path int true "Customer ID"
+// @Success 200 {object} apiResponseLoyaltyBalance "Loyalty balance"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch loyalty balance"
+// @Router /api/loyalty/customer/{customer_id}/balance [get]
+func (h *loyaltyHandleApi) GetBalance(c echo.Context) error {
+	const method = "GetBalance"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	customerID, err := strconv.Atoi(c.Param("customer_id"))
+
+	if err != nil || customerID <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return loyalty_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	res, err := h.client.GetBalance(ctx, &pb.FindLoyaltyBalanceRequest{
+		CustomerId: int32(customerID),
+	})
+
+	if err != nil {
+		logError("Failed to fetch loyalty balance", err, zap.Int("customer_id", customerID), zap.Error(err))
+
+		return loyaltyApiError(c, err, loyalty_errors.ErrApiLoyaltyCustomerNotFound, loyalty_errors.ErrApiInvalidCustomerId, loyalty_errors.ErrApiFailedFindLoyaltyBalance)
+	}
+
+	logSuccess("Successfully fetched loyalty balance", zap.Int("customer_id", customerID))
+
+	return c.JSON(http.StatusOK, apiResponseLoyaltyBalance{
+		Status:  res.Status,
+		Message: res.Message,
+		Data: &loyaltyBalanceResponse{
+			CustomerID: int(res.Data.CustomerId),
+			MerchantID: int(res.Data.MerchantId),
+			Points:     int(res.Data.Points),
+			Value:      int(res.Data.Value),
+		},
+	})
+}
+
+// FindHistory godoc
+// @Summary Get a customer's loyalty history
+// @Tags Loyalty
+// @Security Bearer
+// @Description Lists the ledger entries of the customer, newest first. Points earned are positive; redemptions, reversals and expiries are negative.
+// @Produce json
+// @Param customer_id path int true "Customer ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} apiResponsePaginationLoyaltyEntry "Loyalty history"
+// @Failure 400 {object} response.ErrorResponse "Invalid customer ID"
+// @Failure 404 {object} response.ErrorResponse "Customer not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch loyalty history"
+// @Router /api/loyalty/customer/{customer_id}/history [get]
+func (h *loyaltyHandleApi) FindHistory(c echo.Context) error {
+	const method = "FindHistory"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	customerID, err := strconv.Atoi(c.Param("customer_id"))
+
+	if err != nil || customerID <= 0 {
+		logError("Invalid customer ID", err, zap.Error(err))
+
+		return loyalty_errors.ErrApiInvalidCustomerId(c)
+	}
+
+	res, err := h.client.FindHistory(ctx, &pb.FindLoyaltyHistoryRequest{
+		CustomerId: int32(customerID),
+		Page:       int32(parseQueryInt(c, "page", 1)),
+		PageSize:   int32(parseQueryInt(c, "page_size", 10)),
+	})
+
+	if err != nil {
+		logError("Failed to fetch loyalty history", err, zap.Int("customer_id", customerID), zap.Error(err))
+
+		return loyaltyApiError(c, err, loyalty_errors.ErrApiLoyaltyCustomerNotFound, loyalty_errors.ErrApiInvalidCustomerId, loyalty_errors.ErrApiFailedFindLoyaltyHistory)
+	}
+
+	data := make([]*loyaltyEntryResponse, len(res.Data))
+	for i, e := range res.Data {
+		entry := &loyaltyEntryResponse{
+			ID:            e.Id,
+			CustomerID:    int(e.CustomerId),
+			MerchantID:    int(e.MerchantId),
+			TransactionID: int(e.TransactionId),
+			Kind:          e.Kind,
+			Points:        int(e.Points),
+			CreatedAt:     e.CreatedAt,
+		}
+
+		if e.ExpiresAt != nil {
+			expiresAt := e.ExpiresAt.GetValue()
+			entry.ExpiresAt = &expiresAt
+		}
+
+		data[i] = entry
+	}
+
+	logSuccess("Successfully fetched loyalty history", zap.Int("customer_id", customerID), zap.Int("count", len(data)))
+
+	return c.JSON(http.StatusOK, apiResponsePaginationLoyaltyEntry{
+		Status:     res.Status,
+		Message:    res.Message,
+		Data:       data,
+		Pagination: toPaginationMeta(res.Pagination),
+	})
+}
+
+// loyaltyApiError maps the statuses the transaction service uses for
+// loyalty failures to gateway responses; notFound and invalid are the
+// responses for the row the call is about.
+func loyaltyApiError(c echo.Context, err error, notFound, invalid, fallback func(echo.Context) error) error {
+	switch status.Code(err) {
+	case codes.Code(http.StatusNotFound):
+		return notFound(c)
+	case codes.Code(http.StatusBadRequest), codes.InvalidArgument:
+		return invalid(c)
+	}
+
+	return fallback(c)
+}
+
+func toLoyaltyProgramResponse(p *pb.LoyaltyProgramResponse) *loyaltyProgramResponse {
+	return &loyaltyProgramResponse{
+		MerchantID:    int(p.MerchantId),
+		SpendPerPoint: int(p.SpendPerPoint),
+		PointValue:    int(p.PointValue),
+		ExpiryDays:    int(p.ExpiryDays),
+		Active:        p.Active,
+	}
+}
+
+func (s *loyaltyHandleApi) startTracingAndLogging(
+	ctx context.Context,
+	method string,
+	attrs ...attribute.KeyValue,
+) (
+	end func(),
+	logSuccess func(string, ...zap.Field),
+	logError func(string, error, ...zap.Field),
+) {
+	start := time.Now()
+	_, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+	s.logger.Debug("Start: " + method)
+
+	status := "success"
+
+	end = func() {
+		s.recordMetrics(method, status, start)
+		code := otelcode.Ok
+		if status != "success" {
+			code = otelcode.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess = func(msg string, fields ...zap.Field) {
+		status = "success"
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	logError = func(msg string, err error, fields ...zap.Field) {
+		status = "error"
+		span.RecordError(err)
+		span.SetStatus(otelcode.Error, msg)
+		span.AddEvent(msg)
+		allFields := append([]zap.Field{zap.Error(err)}, fields...)
+		s.logger.Error(msg, allFields...)
+	}
+
+	return end, logSuccess, logError
+}
+
+func (s *loyaltyHandleApi) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/merchant_errors"
@@ -35,6 +36,8 @@ func NewHandlerMerchant(
 	client pb.MerchantServiceClient,
 	logger logger.LoggerInterface,
 	mapping response_api.MerchantResponseMapper,
+	rbac *middlewares.RoleAuthorizer,
+	bulk *middlewares.BulkGuard,
 ) *merchantHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -64,23 +67,32 @@ func NewHandlerMerchant(
 		requestDuration: requestDuration,
 	}
 
+	read := rbac.RequirePermission("merchant:read")
+	create := rbac.RequirePermission("merchant:create")
+	update := rbac.RequirePermission("merchant:update")
+	trash := rbac.RequirePermission("merchant:delete")
+	purge := rbac.RequirePermission("merchant:purge")
+	approve := rbac.RequirePermission("merchant:approve")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+
 	routercategory := router.Group("/api/merchant")
 
-	routercategory.GET("", merchantHandler.FindAllMerchant)
-	routercategory.GET("/:id", merchantHandler.FindById)
-	routercategory.GET("/active", merchantHandler.FindByActive)
-	routercategory.GET("/trashed", merchantHandler.FindByTrashed)
+	routercategory.GET("", merchantHandler.FindAllMerchant, read)
+	routercategory.GET("/:id", merchantHandler.FindById, read)
+	routercategory.GET("/active", merchantHandler.FindByActive, read)
+	routercategory.GET("/trashed", merchantHandler.FindByTrashed, read)
 
-	routercategory.POST("/create", merchantHandler.Create)
-	routercategory.POST("/update/:id", merchantHandler.Update)
-	routercategory.POST("/update-status/:id", merchantHandler.UpdateStatus)
+	routercategory.POST("/create", merchantHandler.Create, create)
+	routercategory.POST("/update/:id", merchantHandler.Update, update)
+	routercategory.POST("/update-status/:id", merchantHandler.UpdateStatus, approve)
 
-	routercategory.POST("/trashed/:id", merchantHandler.TrashedMerchant)
-	routercategory.POST("/restore/:id", merchantHandler.RestoreMerchant)
-	routercategory.DELETE("/permanent/:id", merchantHandler.DeleteMerchantPermanent)
+	routercategory.POST("/trashed/:id", merchantHandler.TrashedMerchant, trash)
+	routercategory.POST("/restore/:id", merchantHandler.RestoreMerchant, trash)
+	routercategory.DELETE("/permanent/:id", merchantHandler.DeleteMerchantPermanent, purge)
 
-	routercategory.POST("/restore/all", merchantHandler.RestoreAllMerchant)
-	routercategory.POST("/permanent/all", merchantHandler.DeleteAllMerchantPermanent)
+	routercategory.POST("/restore/all", merchantHandler.RestoreAllMerchant, bulkRestore, bulk.GuardByMerchant())
+	routercategory.POST("/permanent/all", merchantHandler.DeleteAllMerchantPermanent, bulkPurge, bulk.GuardByMerchant())
 
 	return merchantHandler
 }
@@ -647,9 +659,13 @@ func (h *merchantHandleApi) DeleteMerchantPermanent(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "merchant ID"
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseMerchantAll "Successfully restored merchant all"
 // @Failure 400 {object} response.ErrorResponse "Invalid merchant ID"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore merchant"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/merchant/restore/all [post]
 func (h *merchantHandleApi) RestoreAllMerchant(c echo.Context) error {
 	const method = "RestoreAllMerchant"
@@ -683,9 +699,13 @@ func (h *merchantHandleApi) RestoreAllMerchant(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "merchant ID"
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseMerchantAll "Successfully deleted merchant record permanently"
 // @Failure 400 {object} response.ErrorResponse "Bad Request: Invalid ID"
 // @Failure 500 {object} response.ErrorResponse "Failed to delete merchant:"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/merchant/delete/all [post]
 func (h *merchantHandleApi) DeleteAllMerchantPermanent(c echo.Context) error {
 	const method = "DeleteAllMerchantPermanent"
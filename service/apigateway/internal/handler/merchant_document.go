@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	merchantdocument_errors "github.com/MamangRust/monolith-point-of-sale-shared/errors/merchant_document_errors"
@@ -30,7 +31,7 @@ type merchantDocumentHandleApi struct {
 	requestDuration  *prometheus.HistogramVec
 }
 
-func NewHandlerMerchantDocument(router *echo.Echo, merchantDocument pb.MerchantDocumentServiceClient, logger logger.LoggerInterface, ma response_api.MerchantDocumentResponseMapper) *merchantDocumentHandleApi {
+func NewHandlerMerchantDocument(router *echo.Echo, merchantDocument pb.MerchantDocumentServiceClient, logger logger.LoggerInterface, ma response_api.MerchantDocumentResponseMapper, rbac *middlewares.RoleAuthorizer, bulk *middlewares.BulkGuard) *merchantDocumentHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "merchant_document_handler_requests_total",
@@ -57,23 +58,32 @@ func NewHandlerMerchantDocument(router *echo.Echo, merchantDocument pb.MerchantD
 		requestDuration:  requestDuration,
 	}
 
+	read := rbac.RequirePermission("merchant_document:read")
+	create := rbac.RequirePermission("merchant_document:create")
+	update := rbac.RequirePermission("merchant_document:update")
+	trash := rbac.RequirePermission("merchant_document:delete")
+	purge := rbac.RequirePermission("merchant_document:purge")
+	approve := rbac.RequirePermission("merchant:approve")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+
 	routerMerchantDocument := router.Group("/api/merchant-documents")
 
-	routerMerchantDocument.GET("", merchantDocumentHandler.FindAll)
-	routerMerchantDocument.GET("/:id", merchantDocumentHandler.FindById)
-	routerMerchantDocument.GET("/active", merchantDocumentHandler.FindAllActive)
-	routerMerchantDocument.GET("/trashed", merchantDocumentHandler.FindAllTrashed)
+	routerMerchantDocument.GET("", merchantDocumentHandler.FindAll, read)
+	routerMerchantDocument.GET("/:id", merchantDocumentHandler.FindById, read)
+	routerMerchantDocument.GET("/active", merchantDocumentHandler.FindAllActive, read)
+	routerMerchantDocument.GET("/trashed", merchantDocumentHandler.FindAllTrashed, read)
 
-	routerMerchantDocument.POST("/create", merchantDocumentHandler.Create)
-	routerMerchantDocument.POST("/updates/:id", merchantDocumentHandler.Update)
-	routerMerchantDocument.POST("/update-status/:id", merchantDocumentHandler.UpdateStatus)
+	routerMerchantDocument.POST("/create", merchantDocumentHandler.Create, create)
+	routerMerchantDocument.POST("/updates/:id", merchantDocumentHandler.Update, update)
+	routerMerchantDocument.POST("/update-status/:id", merchantDocumentHandler.UpdateStatus, approve)
 
-	routerMerchantDocument.POST("/trashed/:id", merchantDocumentHandler.TrashedDocument)
-	routerMerchantDocument.POST("/restore/:id", merchantDocumentHandler.RestoreDocument)
-	routerMerchantDocument.DELETE("/permanent/:id", merchantDocumentHandler.Delete)
+	routerMerchantDocument.POST("/trashed/:id", merchantDocumentHandler.TrashedDocument, trash)
+	routerMerchantDocument.POST("/restore/:id", merchantDocumentHandler.RestoreDocument, trash)
+	routerMerchantDocument.DELETE("/permanent/:id", merchantDocumentHandler.Delete, purge)
 
-	routerMerchantDocument.POST("/restore/all", merchantDocumentHandler.RestoreAllDocuments)
-	routerMerchantDocument.POST("/permanent/all", merchantDocumentHandler.DeleteAllDocumentsPermanent)
+	routerMerchantDocument.POST("/restore/all", merchantDocumentHandler.RestoreAllDocuments, bulkRestore, bulk.GuardByMerchant())
+	routerMerchantDocument.POST("/permanent/all", merchantDocumentHandler.DeleteAllDocumentsPermanent, bulkPurge, bulk.GuardByMerchant())
 
 	return merchantDocumentHandler
 }
@@ -654,8 +664,12 @@ func (h *merchantDocumentHandleApi) Delete(c echo.Context) error {
 // @Description Restore all merchant documents that were previously deleted
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseMerchantDocumentAll "Successfully restored all documents"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore all documents"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/merchant-documents/restore/all [post]
 func (h *merchantDocumentHandleApi) RestoreAllDocuments(c echo.Context) error {
 	const method = "RestoreAllDocuments"
@@ -690,8 +704,12 @@ func (h *merchantDocumentHandleApi) RestoreAllDocuments(c echo.Context) error {
 // @Description Permanently delete all merchant documents from the database
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseMerchantDocumentAll "Successfully deleted all documents permanently"
 // @Failure 500 {object} response.ErrorResponse "Failed to permanently delete all documents"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/merchant-documents/permanent/all [post]
 func (h *merchantDocumentHandleApi) DeleteAllDocumentsPermanent(c echo.Context) error {
 	const method = "DeleteAllDocumentsPermanent"
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/order_errors"
@@ -35,6 +36,8 @@ func NewHandlerOrder(
 	client pb.OrderServiceClient,
 	logger logger.LoggerInterface,
 	mapping response_api.OrderResponseMapper,
+	rbac *middlewares.RoleAuthorizer,
+	bulk *middlewares.BulkGuard,
 ) *orderHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -64,32 +67,41 @@ func NewHandlerOrder(
 		requestDuration: requestDuration,
 	}
 
+	read := rbac.RequirePermission("order:read")
+	create := rbac.RequirePermission("order:create")
+	update := rbac.RequirePermission("order:update")
+	trash := rbac.RequirePermission("order:delete")
+	purge := rbac.RequirePermission("order:purge")
+	report := rbac.RequirePermission("report:read")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+
 	routerOrder := router.Group("/api/order")
 
-	routerOrder.GET("", orderHandler.FindAllOrders)
-	routerOrder.GET("/:id", orderHandler.FindById)
-	routerOrder.GET("/active", orderHandler.FindByActive)
-	routerOrder.GET("/trashed", orderHandler.FindByTrashed)
+	routerOrder.GET("", orderHandler.FindAllOrders, read)
+	routerOrder.GET("/:id", orderHandler.FindById, read)
+	routerOrder.GET("/active", orderHandler.FindByActive, read)
+	routerOrder.GET("/trashed", orderHandler.FindByTrashed, read)
 
-	routerOrder.GET("/monthly-total-revenue", orderHandler.FindMonthlyTotalRevenue)
-	routerOrder.GET("/yearly-total-revenue", orderHandler.FindYearlyTotalRevenue)
-	routerOrder.GET("/merchant/monthly-total-revenue", orderHandler.FindMonthlyTotalRevenueByMerchant)
-	routerOrder.GET("/merchant/yearly-total-revenue", orderHandler.FindYearlyTotalRevenueByMerchant)
+	routerOrder.GET("/monthly-total-revenue", orderHandler.FindMonthlyTotalRevenue, report)
+	routerOrder.GET("/yearly-total-revenue", orderHandler.FindYearlyTotalRevenue, report)
+	routerOrder.GET("/merchant/monthly-total-revenue", orderHandler.FindMonthlyTotalRevenueByMerchant, read)
+	routerOrder.GET("/merchant/yearly-total-revenue", orderHandler.FindYearlyTotalRevenueByMerchant, read)
 
-	routerOrder.GET("/monthly-revenue", orderHandler.FindMonthlyRevenue)
-	routerOrder.GET("/yearly-revenue", orderHandler.FindYearlyRevenue)
-	routerOrder.GET("/merchant/monthly-revenue", orderHandler.FindMonthlyRevenueByMerchant)
-	routerOrder.GET("/merchant/yearly-revenue", orderHandler.FindYearlyRevenueByMerchant)
+	routerOrder.GET("/monthly-revenue", orderHandler.FindMonthlyRevenue, report)
+	routerOrder.GET("/yearly-revenue", orderHandler.FindYearlyRevenue, report)
+	routerOrder.GET("/merchant/monthly-revenue", orderHandler.FindMonthlyRevenueByMerchant, read)
+	routerOrder.GET("/merchant/yearly-revenue", orderHandler.FindYearlyRevenueByMerchant, read)
 
-	routerOrder.POST("/create", orderHandler.Create)
-	routerOrder.POST("/update/:id", orderHandler.Update)
+	routerOrder.POST("/create", orderHandler.Create, create)
+	routerOrder.POST("/update/:id", orderHandler.Update, update)
 
-	routerOrder.POST("/trashed/:id", orderHandler.TrashedOrder)
-	routerOrder.POST("/restore/:id", orderHandler.RestoreOrder)
-	routerOrder.DELETE("/permanent/:id", orderHandler.DeleteOrderPermanent)
+	routerOrder.POST("/trashed/:id", orderHandler.TrashedOrder, trash)
+	routerOrder.POST("/restore/:id", orderHandler.RestoreOrder, trash)
+	routerOrder.DELETE("/permanent/:id", orderHandler.DeleteOrderPermanent, purge)
 
-	routerOrder.POST("/restore/all", orderHandler.RestoreAllOrder)
-	routerOrder.POST("/permanent/all", orderHandler.DeleteAllOrderPermanent)
+	routerOrder.POST("/restore/all", orderHandler.RestoreAllOrder, bulkRestore, bulk.GuardByMerchant())
+	routerOrder.POST("/permanent/all", orderHandler.DeleteAllOrderPermanent, bulkPurge, bulk.GuardByMerchant())
 
 	return orderHandler
 }
@@ -755,8 +767,10 @@ func (h *orderHandleApi) Create(c echo.Context) error {
 	}
 
 	grpcReq := &pb.CreateOrderRequest{
-		MerchantId: int32(body.MerchantID),
-		CashierId:  int32(body.CashierID),
+		MerchantId:  int32(body.MerchantID),
+		CashierId:   int32(body.CashierID),
+		CouponCodes: body.CouponCodes,
+		CustomerId:  int32(body.CustomerID),
 	}
 
 	for _, item := range body.Items {
@@ -766,6 +780,13 @@ func (h *orderHandleApi) Create(c echo.Context) error {
 		})
 	}
 
+	for _, item := range body.Barcodes {
+		grpcReq.Barcodes = append(grpcReq.Barcodes, &pb.ScannedOrderItemRequest{
+			Barcode:  item.Barcode,
+			Quantity: int32(item.Quantity),
+		})
+	}
+
 	res, err := h.client.Create(ctx, grpcReq)
 
 	if err != nil {
@@ -827,8 +848,10 @@ func (h *orderHandleApi) Update(c echo.Context) error {
 	}
 
 	grpcReq := &pb.UpdateOrderRequest{
-		OrderId: int32(idInt),
-		Items:   []*pb.UpdateOrderItemRequest{},
+		OrderId:     int32(idInt),
+		Items:       []*pb.UpdateOrderItemRequest{},
+		CouponCodes: body.CouponCodes,
+		CustomerId:  int32(body.CustomerID),
 	}
 
 	for _, item := range body.Items {
@@ -1005,8 +1028,12 @@ func (h *orderHandleApi) DeleteOrderPermanent(c echo.Context) error {
 // @Description Restore all trashed order records.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseOrderAll "Successfully restored all orders"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore orders"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/order/restore/all [post]
 func (h *orderHandleApi) RestoreAllOrder(c echo.Context) error {
 	const method = "RestoreAllOrder"
@@ -1039,8 +1066,12 @@ func (h *orderHandleApi) RestoreAllOrder(c echo.Context) error {
 // @Description Permanently delete all order records.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseOrderAll "Successfully deleted all orders permanently"
 // @Failure 500 {object} response.ErrorResponse "Failed to delete orders"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/order/delete/all [post]
 func (h *orderHandleApi) DeleteAllOrderPermanent(c echo.Context) error {
 	const method = "DeleteAllOrderPermanent"
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	orderitem_errors "github.com/MamangRust/monolith-point-of-sale-shared/errors/order_item_errors"
 	response_api "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/api"
@@ -33,6 +34,7 @@ func NewHandlerOrderItem(
 	client pb.OrderItemServiceClient,
 	logger logger.LoggerInterface,
 	mapping response_api.OrderItemResponseMapper,
+	rbac *middlewares.RoleAuthorizer,
 ) *orderItemHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -62,12 +64,14 @@ func NewHandlerOrderItem(
 		requestDuration: requestDuration,
 	}
 
+	orderRead := rbac.RequirePermission("order:read")
+
 	routercategory := router.Group("/api/order-item")
 
-	routercategory.GET("", categoryHandler.FindAllOrderItems)
-	routercategory.GET("/:order_id", categoryHandler.FindOrderItemByOrder)
-	routercategory.GET("/active", categoryHandler.FindByActive)
-	routercategory.GET("/trashed", categoryHandler.FindByTrashed)
+	routercategory.GET("", categoryHandler.FindAllOrderItems, orderRead)
+	routercategory.GET("/:order_id", categoryHandler.FindOrderItemByOrder, orderRead)
+	routercategory.GET("/active", categoryHandler.FindByActive, orderRead)
+	routercategory.GET("/trashed", categoryHandler.FindByTrashed, orderRead)
 
 	return categoryHandler
 }
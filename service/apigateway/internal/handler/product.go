@@ -3,10 +3,12 @@ package handler
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/upload_image"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -21,7 +23,10 @@ import (
 	otelcode "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type productHandleApi struct {
@@ -40,6 +45,8 @@ func NewHandlerProduct(
 	logger logger.LoggerInterface,
 	mapping response_api.ProductResponseMapper,
 	upload_image upload_image.ImageUploads,
+	rbac *middlewares.RoleAuthorizer,
+	bulk *middlewares.BulkGuard,
 ) *productHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -70,25 +77,34 @@ func NewHandlerProduct(
 		requestDuration: requestDuration,
 	}
 
+	read := rbac.RequirePermission("product:read")
+	create := rbac.RequirePermission("product:create")
+	update := rbac.RequirePermission("product:update")
+	trash := rbac.RequirePermission("product:delete")
+	purge := rbac.RequirePermission("product:purge")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+
 	routercategory := router.Group("/api/product")
 
-	routercategory.GET("", productHandler.FindAllProduct)
-	routercategory.GET("/:id", productHandler.FindById)
-	routercategory.GET("/merchant/:merchant_id", productHandler.FindByMerchant)
-	routercategory.GET("/category/:category_name", productHandler.FindByCategory)
+	routercategory.GET("", productHandler.FindAllProduct, read)
+	routercategory.GET("/:id", productHandler.FindById, read)
+	routercategory.GET("/merchant/:merchant_id", productHandler.FindByMerchant, read)
+	routercategory.GET("/merchant/:merchant_id/barcode/:barcode", productHandler.FindByBarcode, read)
+	routercategory.GET("/category/:category_name", productHandler.FindByCategory, read)
 
-	routercategory.GET("/active", productHandler.FindByActive)
-	routercategory.GET("/trashed", productHandler.FindByTrashed)
+	routercategory.GET("/active", productHandler.FindByActive, read)
+	routercategory.GET("/trashed", productHandler.FindByTrashed, read)
 
-	routercategory.POST("/create", productHandler.Create)
-	routercategory.POST("/update/:id", productHandler.Update)
+	routercategory.POST("/create", productHandler.Create, create)
+	routercategory.POST("/update/:id", productHandler.Update, update)
 
-	routercategory.POST("/trashed/:id", productHandler.TrashedProduct)
-	routercategory.POST("/restore/:id", productHandler.RestoreProduct)
-	routercategory.DELETE("/permanent/:id", productHandler.DeleteProductPermanent)
+	routercategory.POST("/trashed/:id", productHandler.TrashedProduct, trash)
+	routercategory.POST("/restore/:id", productHandler.RestoreProduct, trash)
+	routercategory.DELETE("/permanent/:id", productHandler.DeleteProductPermanent, purge)
 
-	routercategory.POST("/restore/all", productHandler.RestoreAllProduct)
-	routercategory.POST("/permanent/all", productHandler.DeleteAllProductPermanent)
+	routercategory.POST("/restore/all", productHandler.RestoreAllProduct, bulkRestore, bulk.GuardByMerchant())
+	routercategory.POST("/permanent/all", productHandler.DeleteAllProductPermanent, bulkPurge, bulk.GuardByMerchant())
 
 	return productHandler
 }
@@ -348,6 +364,63 @@ func (h *productHandleApi) FindById(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// @Summary Find product by barcode
+// @Tags Product
+// @Description Retrieve the merchant's live product with a scanned EAN-13, UPC-A or EAN-8 barcode
+// @Accept json
+// @Produce json
+// @Param merchant_id path int true "Merchant ID"
+// @Param barcode path string true "Barcode"
+// @Success 200 {object} response.ApiResponseProduct "Product data"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID or barcode"
+// @Failure 404 {object} response.ErrorResponse "Product with this barcode not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to retrieve product data"
+// @Router /api/product/merchant/{merchant_id}/barcode/{barcode} [get]
+func (h *productHandleApi) FindByBarcode(c echo.Context) error {
+	const method = "FindByBarcode"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	merchantID, err := strconv.Atoi(c.Param("merchant_id"))
+
+	if err != nil || merchantID <= 0 {
+		logError("Failed to parse merchant id", err, zap.Error(err))
+
+		return product_errors.ErrApiProductInvalidMerchantId(c)
+	}
+
+	req := &pb.FindByBarcodeProductRequest{
+		MerchantId: int32(merchantID),
+		Barcode:    c.Param("barcode"),
+	}
+
+	res, err := h.client.FindByBarcode(ctx, req)
+
+	if err != nil {
+		logError("Failed to retrieve product data by barcode", err, zap.Error(err))
+
+		switch status.Code(err) {
+		case codes.Code(http.StatusNotFound):
+			return product_errors.ErrApiProductBarcodeNotFound(c)
+		case codes.Code(http.StatusBadRequest), codes.InvalidArgument:
+			return product_errors.ErrApiProductInvalidBarcode(c)
+		}
+
+		return product_errors.ErrApiProductFailedFindByBarcode(c)
+	}
+
+	so := h.mapping.ToApiResponseProduct(res)
+
+	logSuccess("Successfully retrieve product data by barcode", zap.Bool("success", true))
+
+	return c.JSON(http.StatusOK, so)
+}
+
 // @Security Bearer
 // @Summary Retrieve active products
 // @Tags Product
@@ -477,7 +550,8 @@ func (h *productHandleApi) FindByTrashed(c echo.Context) error {
 // @Param rating formData number true "Product rating"
 // @Param slug_product formData string true "Product slug"
 // @Param image formData file true "Product image file"
-// @Param barcode formData string true "Product barcode"
+// @Param barcode formData string false "EAN-13, UPC-A or EAN-8 barcode, unique per merchant; empty clears it"
+// @Param sku formData string false "Stock keeping unit, unique per merchant; empty clears it"
 // @Success 200 {object} response.ApiResponseProduct "Successfully created product"
 // @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
 // @Failure 500 {object} response.ErrorResponse "Failed to create product"
@@ -509,6 +583,8 @@ func (h *productHandleApi) Create(c echo.Context) error {
 		Brand:        formData.Brand,
 		Weight:       int32(formData.Weight),
 		ImageProduct: formData.ImagePath,
+		Sku:          toStringValue(formData.SKU),
+		Barcode:      toStringValue(formData.Barcode),
 	}
 
 	res, err := h.client.Create(ctx, req)
@@ -547,7 +623,8 @@ func (h *productHandleApi) Create(c echo.Context) error {
 // @Param rating formData number true "Product rating"
 // @Param slug_product formData string true "Product slug"
 // @Param image formData file true "Product image file"
-// @Param barcode formData string true "Product barcode"
+// @Param barcode formData string false "EAN-13, UPC-A or EAN-8 barcode, unique per merchant; empty clears it"
+// @Param sku formData string false "Stock keeping unit, unique per merchant; empty clears it"
 // @Success 200 {object} response.ApiResponseProduct "Successfully created product"
 // @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
 // @Failure 500 {object} response.ErrorResponse "Failed to create product"
@@ -588,6 +665,8 @@ func (h *productHandleApi) Update(c echo.Context) error {
 		Brand:        formData.Brand,
 		Weight:       int32(formData.Weight),
 		ImageProduct: formData.ImagePath,
+		Sku:          toStringValue(formData.SKU),
+		Barcode:      toStringValue(formData.Barcode),
 	}
 
 	res, err := h.client.Update(ctx, req)
@@ -759,8 +838,12 @@ func (h *productHandleApi) DeleteProductPermanent(c echo.Context) error {
 // @Description Restore all trashed product records.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseProductAll "Successfully restored all products"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore all products"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/product/restore/all [post]
 func (h *productHandleApi) RestoreAllProduct(c echo.Context) error {
 	const method = "RestoreAllProduct"
@@ -793,8 +876,12 @@ func (h *productHandleApi) RestoreAllProduct(c echo.Context) error {
 // @Description Permanently delete all product records.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseProductAll "Successfully deleted all product records permanently"
 // @Failure 500 {object} response.ErrorResponse "Failed to delete all products"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/product/delete/all [post]
 func (h *productHandleApi) DeleteAllProductPermanent(c echo.Context) error {
 	const method = "DeleteAllProductPermanent"
@@ -881,6 +968,12 @@ func (h *productHandleApi) parseProductForm(c echo.Context, requireImage bool) (
 		})
 	}
 
+	form, err := c.FormParams()
+	if err == nil {
+		formData.SKU = formField(form, "sku")
+		formData.Barcode = formField(form, "barcode")
+	}
+
 	file, err := c.FormFile("image_product")
 	if err != nil {
 		if requireImage {
@@ -904,6 +997,25 @@ func (h *productHandleApi) parseProductForm(c echo.Context, requireImage bool) (
 	return formData, nil
 }
 
+func toStringValue(value *string) *wrapperspb.StringValue {
+	if value == nil {
+		return nil
+	}
+
+	return wrapperspb.String(*value)
+}
+
+// formField returns the value of a form field, or nil when the form leaves
+// it out, so an empty field can be told apart from a missing one.
+func formField(form url.Values, name string) *string {
+	values, ok := form[name]
+	if !ok || len(values) == 0 {
+		return nil
+	}
+
+	return &values[0]
+}
+
 func (s *productHandleApi) startTracingAndLogging(
 	ctx context.Context,
 	method string,
@@ -0,0 +1,514 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/promotion_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcode "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type promotionHandleApi struct {
+	client          pb.PromotionServiceClient
+	logger          logger.LoggerInterface
+	trace           trace.Tracer
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+type createPromotionRequest struct {
+	MerchantID  int    `json:"merchant_id"`
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Value       int    `json:"value"`
+	BuyQuantity int    `json:"buy_quantity"`
+	GetQuantity int    `json:"get_quantity"`
+	ProductID   int    `json:"product_id"`
+	CategoryID  int    `json:"category_id"`
+	CouponCode  string `json:"coupon_code"`
+	StartsAt    string `json:"starts_at"`
+	EndsAt      string `json:"ends_at"`
+	DailyStart  int    `json:"daily_start"`
+	DailyEnd    int    `json:"daily_end"`
+	Priority    int    `json:"priority"`
+	Stackable   bool   `json:"stackable"`
+}
+
+type promotionResponse struct {
+	ID          int     `json:"id"`
+	MerchantID  int     `json:"merchant_id"`
+	Name        string  `json:"name"`
+	Kind        string  `json:"kind"`
+	Value       int     `json:"value"`
+	BuyQuantity int     `json:"buy_quantity,omitempty"`
+	GetQuantity int     `json:"get_quantity,omitempty"`
+	ProductID   int     `json:"product_id,omitempty"`
+	CategoryID  int     `json:"category_id,omitempty"`
+	CouponCode  string  `json:"coupon_code,omitempty"`
+	StartsAt    string  `json:"starts_at"`
+	EndsAt      *string `json:"ends_at,omitempty"`
+	DailyStart  int     `json:"daily_start"`
+	DailyEnd    int     `json:"daily_end"`
+	Priority    int     `json:"priority"`
+	Stackable   bool    `json:"stackable"`
+}
+
+type orderDiscountResponse struct {
+	OrderItemID   int    `json:"order_item_id"`
+	PromotionID   int    `json:"promotion_id,omitempty"`
+	PromotionName string `json:"promotion_name"`
+	Amount        int    `json:"amount"`
+}
+
+type apiResponsePromotion struct {
+	Status  string             `json:"status"`
+	Message string             `json:"message"`
+	Data    *promotionResponse `json:"data"`
+}
+
+type apiResponsesPromotion struct {
+	Status  string               `json:"status"`
+	Message string               `json:"message"`
+	Data    []*promotionResponse `json:"data"`
+}
+
+type apiResponsePromotionDelete struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type apiResponseOrderDiscounts struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    []*orderDiscountResponse `json:"data"`
+}
+
+func NewHandlerPromotion(
+	router *echo.Echo,
+	client pb.PromotionServiceClient,
+	logger logger.LoggerInterface,
+	rbac *middlewares.RoleAuthorizer,
+) *promotionHandleApi {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "promotion_handler_requests_total",
+			Help: "Total number of promotion requests",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "promotion_handler_request_duration_seconds",
+			Help:    "Duration of promotion requests",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "status"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	promotionHandler := &promotionHandleApi{
+		client:          client,
+		logger:          logger,
+		trace:           otel.Tracer("promotion-handler"),
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+
+	read := rbac.RequirePermission("promotion:read")
+	create := rbac.RequirePermission("promotion:create")
+	trash := rbac.RequirePermission("promotion:delete")
+
+	routerPromotion := router.Group("/api/promotions")
+
+	routerPromotion.GET("/merchant/:merchant_id", promotionHandler.FindByMerchant, read)
+	routerPromotion.GET("/order/:order_id/discounts", promotionHandler.FindOrderDiscounts, read)
+	routerPromotion.GET("/:id", promotionHandler.FindById, read)
+
+	routerPromotion.POST("/create", promotionHandler.Create, create)
+	routerPromotion.POST("/trashed/:id", promotionHandler.Trashed, trash)
+
+	return promotionHandler
+}
+
+// Create godoc
+// @Summary Create a promotion
+// @Tags Promotion
+// @Security Bearer
+// @Description Adds a discount rule to a merchant. Kinds are percent_off, amount_off, buy_x_get_y and fixed_price. daily_start and daily_end are minutes after midnight in the merchant's time zone.
+// @Accept json
+// @Produce json
+// @Param request body createPromotionRequest true "Promotion"
+// @Success 201 {object} apiResponsePromotion "Created promotion"
+// @Failure 400 {object} response.ErrorResponse "Invalid rule or validity window"
+// @Failure 409 {object} response.ErrorResponse "Coupon code already in use"
+// @Failure 500 {object} response.ErrorResponse "Failed to create promotion"
+// @Router /api/promotions/create [post]
+func (h *promotionHandleApi) Create(c echo.Context) error {
+	const method = "Create"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	var body createPromotionRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind create promotion request", err, zap.Error(err))
+
+		return promotion_errors.ErrApiBindCreatePromotion(c)
+	}
+
+	if body.MerchantID <= 0 {
+		logError("Invalid merchant ID", nil, zap.Int("merchant_id", body.MerchantID))
+
+		return promotion_errors.ErrApiInvalidMerchantId(c)
+	}
+
+	res, err := h.client.Create(ctx, &pb.CreatePromotionRequest{
+		MerchantId:  int32(body.MerchantID),
+		Name:        body.Name,
+		Kind:        body.Kind,
+		Value:       int32(body.Value),
+		BuyQuantity: int32(body.BuyQuantity),
+		GetQuantity: int32(body.GetQuantity),
+		ProductId:   int32(body.ProductID),
+		CategoryId:  int32(body.CategoryID),
+		CouponCode:  body.CouponCode,
+		StartsAt:    body.StartsAt,
+		EndsAt:      body.EndsAt,
+		DailyStart:  int32(body.DailyStart),
+		DailyEnd:    int32(body.DailyEnd),
+		Priority:    int32(body.Priority),
+		Stackable:   body.Stackable,
+	})
+
+	if err != nil {
+		logError("Failed to create promotion", err, zap.Int("merchant_id", body.MerchantID), zap.Error(err))
+
+		return promotionApiError(c, err, promotion_errors.ErrApiFailedCreatePromotion)
+	}
+
+	logSuccess("Successfully created promotion", zap.Int32("promotion_id", res.Data.Id))
+
+	return c.JSON(http.StatusCreated, apiResponsePromotion{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toPromotionResponse(res.Data),
+	})
+}
+
+// FindById godoc
+// @Summary Find a promotion by ID
+// @Tags Promotion
+// @Security Bearer
+// @Produce json
+// @Param id path int true "Promotion ID"
+// @Success 200 {object} apiResponsePromotion "Promotion"
+// @Failure 400 {object} response.ErrorResponse "Invalid promotion ID"
+// @Failure 404 {object} response.ErrorResponse "Promotion not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch promotion"
+// @Router /api/promotions/{id} [get]
+func (h *promotionHandleApi) FindById(c echo.Context) error {
+	const method = "FindById"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid promotion ID", err, zap.Error(err))
+
+		return promotion_errors.ErrApiInvalidPromotionId(c)
+	}
+
+	res, err := h.client.FindById(ctx, &pb.FindByIdPromotionRequest{
+		Id: int32(id),
+	})
+
+	if err != nil {
+		logError("Failed to fetch promotion", err, zap.Int("promotion_id", id), zap.Error(err))
+
+		return promotionApiError(c, err, promotion_errors.ErrApiFailedFindPromotion)
+	}
+
+	logSuccess("Successfully fetched promotion", zap.Int("promotion_id", id))
+
+	return c.JSON(http.StatusOK, apiResponsePromotion{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    toPromotionResponse(res.Data),
+	})
+}
+
+// FindByMerchant godoc
+// @Summary List a merchant's promotions
+// @Tags Promotion
+// @Security Bearer
+// @Description Lists the merchant's promotions that are not trashed, highest priority first.
+// @Produce json
+// @Param merchant_id path int true "Merchant ID"
+// @Success 200 {object} apiResponsesPromotion "Promotions"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch promotions"
+// @Router /api/promotions/merchant/{merchant_id} [get]
+func (h *promotionHandleApi) FindByMerchant(c echo.Context) error {
+	const method = "FindByMerchant"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	merchantID, err := strconv.Atoi(c.Param("merchant_id"))
+
+	if err != nil || merchantID <= 0 {
+		logError("Invalid merchant ID", err, zap.Error(err))
+
+		return promotion_errors.ErrApiInvalidMerchantId(c)
+	}
+
+	res, err := h.client.FindByMerchant(ctx, &pb.FindPromotionsByMerchantRequest{
+		MerchantId: int32(merchantID),
+	})
+
+	if err != nil {
+		logError("Failed to fetch promotions", err, zap.Int("merchant_id", merchantID), zap.Error(err))
+
+		return promotionApiError(c, err, promotion_errors.ErrApiFailedFindPromotions)
+	}
+
+	data := make([]*promotionResponse, len(res.Data))
+	for i, p := range res.Data {
+		data[i] = toPromotionResponse(p)
+	}
+
+	logSuccess("Successfully fetched promotions", zap.Int("merchant_id", merchantID), zap.Int("count", len(data)))
+
+	return c.JSON(http.StatusOK, apiResponsesPromotion{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    data,
+	})
+}
+
+// Trashed godoc
+// @Summary Trash a promotion
+// @Tags Promotion
+// @Security Bearer
+// @Description Ends a promotion. Orders already priced with it keep their discounts.
+// @Produce json
+// @Param id path int true "Promotion ID"
+// @Success 200 {object} apiResponsePromotionDelete "Promotion trashed"
+// @Failure 400 {object} response.ErrorResponse "Invalid promotion ID"
+// @Failure 404 {object} response.ErrorResponse "Promotion not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to trash promotion"
+// @Router /api/promotions/trashed/{id} [post]
+func (h *promotionHandleApi) Trashed(c echo.Context) error {
+	const method = "Trashed"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || id <= 0 {
+		logError("Invalid promotion ID", err, zap.Error(err))
+
+		return promotion_errors.ErrApiInvalidPromotionId(c)
+	}
+
+	res, err := h.client.Trashed(ctx, &pb.FindByIdPromotionRequest{
+		Id: int32(id),
+	})
+
+	if err != nil {
+		logError("Failed to trash promotion", err, zap.Int("promotion_id", id), zap.Error(err))
+
+		return promotionApiError(c, err, promotion_errors.ErrApiFailedTrashPromotion)
+	}
+
+	logSuccess("Successfully trashed promotion", zap.Int("promotion_id", id))
+
+	return c.JSON(http.StatusOK, apiResponsePromotionDelete{
+		Status:  res.Status,
+		Message: res.Message,
+	})
+}
+
+// FindOrderDiscounts godoc
+// @Summary List an order's discounts
+// @Tags Promotion
+// @Security Bearer
+// @Description Lists the discounts each item of the order was sold with.
+// @Produce json
+// @Param order_id path int true "Order ID"
+// @Success 200 {object} apiResponseOrderDiscounts "Order discounts"
+// @Failure 400 {object} response.ErrorResponse "Invalid order ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch order discounts"
+// @Router /api/promotions/order/{order_id}/discounts [get]
+func (h *promotionHandleApi) FindOrderDiscounts(c echo.Context) error {
+	const method = "FindOrderDiscounts"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	orderID, err := strconv.Atoi(c.Param("order_id"))
+
+	if err != nil || orderID <= 0 {
+		logError("Invalid order ID", err, zap.Error(err))
+
+		return promotion_errors.ErrApiInvalidOrderId(c)
+	}
+
+	res, err := h.client.FindOrderDiscounts(ctx, &pb.FindOrderDiscountsRequest{
+		OrderId: int32(orderID),
+	})
+
+	if err != nil {
+		logError("Failed to fetch order discounts", err, zap.Int("order_id", orderID), zap.Error(err))
+
+		return promotion_errors.ErrApiFailedFindOrderDiscounts(c)
+	}
+
+	data := make([]*orderDiscountResponse, len(res.Data))
+	for i, d := range res.Data {
+		data[i] = &orderDiscountResponse{
+			OrderItemID:   int(d.OrderItemId),
+			PromotionID:   int(d.PromotionId),
+			PromotionName: d.PromotionName,
+			Amount:        int(d.Amount),
+		}
+	}
+
+	logSuccess("Successfully fetched order discounts", zap.Int("order_id", orderID))
+
+	return c.JSON(http.StatusOK, apiResponseOrderDiscounts{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    data,
+	})
+}
+
+// promotionApiError maps the statuses the order service uses for promotion
+// failures to gateway responses.
+func promotionApiError(c echo.Context, err error, fallback func(echo.Context) error) error {
+	switch status.Code(err) {
+	case codes.Code(http.StatusNotFound):
+		return promotion_errors.ErrApiPromotionNotFound(c)
+	case codes.Code(http.StatusBadRequest), codes.InvalidArgument:
+		return promotion_errors.ErrApiInvalidPromotion(c)
+	case codes.Code(http.StatusConflict):
+		return promotion_errors.ErrApiPromotionCouponTaken(c)
+	}
+
+	return fallback(c)
+}
+
+func toPromotionResponse(p *pb.PromotionResponse) *promotionResponse {
+	res := &promotionResponse{
+		ID:          int(p.Id),
+		MerchantID:  int(p.MerchantId),
+		Name:        p.Name,
+		Kind:        p.Kind,
+		Value:       int(p.Value),
+		BuyQuantity: int(p.BuyQuantity),
+		GetQuantity: int(p.GetQuantity),
+		ProductID:   int(p.ProductId),
+		CategoryID:  int(p.CategoryId),
+		CouponCode:  p.CouponCode,
+		StartsAt:    p.StartsAt,
+		DailyStart:  int(p.DailyStart),
+		DailyEnd:    int(p.DailyEnd),
+		Priority:    int(p.Priority),
+		Stackable:   p.Stackable,
+	}
+
+	if p.EndsAt != nil {
+		endsAt := p.EndsAt.GetValue()
+		res.EndsAt = &endsAt
+	}
+
+	return res
+}
+
+func (s *promotionHandleApi) startTracingAndLogging(
+	ctx context.Context,
+	method string,
+	attrs ...attribute.KeyValue,
+) (
+	end func(),
+	logSuccess func(string, ...zap.Field),
+	logError func(string, error, ...zap.Field),
+) {
+	start := time.Now()
+	_, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+	s.logger.Debug("Start: " + method)
+
+	status := "success"
+
+	end = func() {
+		s.recordMetrics(method, status, start)
+		code := otelcode.Ok
+		if status != "success" {
+			code = otelcode.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess = func(msg string, fields ...zap.Field) {
+		status = "success"
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	logError = func(msg string, err error, fields ...zap.Field) {
+		status = "error"
+		span.RecordError(err)
+		span.SetStatus(otelcode.Error, msg)
+		span.AddEvent(msg)
+		allFields := append([]zap.Field{zap.Error(err)}, fields...)
+		s.logger.Error(msg, allFields...)
+	}
+
+	return end, logSuccess, logError
+}
+
+func (s *promotionHandleApi) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
+}
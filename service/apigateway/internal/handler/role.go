@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/role_errors"
@@ -23,6 +24,7 @@ import (
 
 type roleHandleApi struct {
 	role            pb.RoleServiceClient
+	rbac            *middlewares.RoleAuthorizer
 	logger          logger.LoggerInterface
 	mapping         response_api.RoleResponseMapper
 	trace           trace.Tracer
@@ -30,7 +32,7 @@ type roleHandleApi struct {
 	requestDuration *prometheus.HistogramVec
 }
 
-func NewHandlerRole(router *echo.Echo, role pb.RoleServiceClient, logger logger.LoggerInterface, mapping response_api.RoleResponseMapper) *roleHandleApi {
+func NewHandlerRole(router *echo.Echo, role pb.RoleServiceClient, logger logger.LoggerInterface, mapping response_api.RoleResponseMapper, rbac *middlewares.RoleAuthorizer, bulk *middlewares.BulkGuard) *roleHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "role_handler_requests_total",
@@ -52,6 +54,7 @@ func NewHandlerRole(router *echo.Echo, role pb.RoleServiceClient, logger logger.
 
 	roleHandler := &roleHandleApi{
 		role:            role,
+		rbac:            rbac,
 		logger:          logger,
 		mapping:         mapping,
 		trace:           otel.Tracer("role-handler"),
@@ -59,20 +62,36 @@ func NewHandlerRole(router *echo.Echo, role pb.RoleServiceClient, logger logger.
 		requestDuration: requestDuration,
 	}
 
+	read := rbac.RequirePermission("role:read")
+	create := rbac.RequirePermission("role:create")
+	update := rbac.RequirePermission("role:update")
+	trash := rbac.RequirePermission("role:delete")
+	purge := rbac.RequirePermission("role:purge")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+
 	routerRole := router.Group("/api/role")
 
-	routerRole.GET("", roleHandler.FindAll)
-	routerRole.GET("/:id", roleHandler.FindById)
-	routerRole.GET("/active", roleHandler.FindByActive)
-	routerRole.GET("/trashed", roleHandler.FindByTrashed)
-	routerRole.GET("/user/:user_id", roleHandler.FindByUserId)
-	routerRole.POST("", roleHandler.Create)
-	routerRole.POST("/update/:id", roleHandler.Update)
-	routerRole.POST("/trashed/:id", roleHandler.Trashed)
-	routerRole.POST("/restore/:id", roleHandler.Restore)
-	routerRole.DELETE("/permanent/:id", roleHandler.DeletePermanent)
-	routerRole.POST("/restore/all", roleHandler.RestoreAll)
-	routerRole.DELETE("/permanent-all", roleHandler.DeleteAllPermanent)
+	routerRole.GET("", roleHandler.FindAll, read)
+	routerRole.GET("/:id", roleHandler.FindById, read)
+	routerRole.GET("/active", roleHandler.FindByActive, read)
+	routerRole.GET("/trashed", roleHandler.FindByTrashed, read)
+	routerRole.GET("/user/:user_id", roleHandler.FindByUserId, read)
+	routerRole.POST("/user/:user_id", roleHandler.AssignRoleToUser, update)
+	routerRole.DELETE("/user/:user_id/:role_id", roleHandler.RemoveRoleFromUser, update)
+	routerRole.POST("", roleHandler.Create, create)
+	routerRole.POST("/update/:id", roleHandler.Update, update)
+	routerRole.POST("/trashed/:id", roleHandler.Trashed, trash)
+	routerRole.POST("/restore/:id", roleHandler.Restore, trash)
+	routerRole.DELETE("/permanent/:id", roleHandler.DeletePermanent, purge)
+	routerRole.POST("/restore/all", roleHandler.RestoreAll, bulkRestore, bulk.Guard())
+	routerRole.DELETE("/permanent-all", roleHandler.DeleteAllPermanent, bulkPurge, bulk.Guard())
+
+	routerRole.GET("/permissions", roleHandler.FindAllPermissions, read)
+	routerRole.GET("/permissions/check", roleHandler.CheckPermission)
+	routerRole.GET("/:id/permissions", roleHandler.FindPermissionsByRole, read)
+	routerRole.POST("/:id/permissions", roleHandler.AssignPermission, update)
+	routerRole.DELETE("/:id/permissions/:permission", roleHandler.RevokePermission, update)
 
 	return roleHandler
 }
@@ -616,8 +635,12 @@ func (h *roleHandleApi) DeletePermanent(c echo.Context) error {
 // @Description Restore all soft-deleted roles.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseRoleAll "Restored roles data"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore all roles"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/role/restore/all [post]
 func (h *roleHandleApi) RestoreAll(c echo.Context) error {
 	const method = "RestoreAll"
@@ -650,8 +673,12 @@ func (h *roleHandleApi) RestoreAll(c echo.Context) error {
 // @Description Permanently delete all roles.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseRoleAll "Permanently deleted roles data"
 // @Failure 500 {object} response.ErrorResponse "Failed to delete all roles permanently"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/role/permanent/all [delete]
 func (h *roleHandleApi) DeleteAllPermanent(c echo.Context) error {
 	const method = "FindById"
@@ -663,7 +690,7 @@ func (h *roleHandleApi) DeleteAllPermanent(c echo.Context) error {
 	defer func() { end() }()
 
 	res, err := h.role.DeleteAllRolePermanent(ctx, &emptypb.Empty{})
-	
+
 	if err != nil {
 		logError("Failed to delete all roles permanently", err, zap.Error(err))
 
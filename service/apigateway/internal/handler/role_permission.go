@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/auth_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/role_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type permissionRequest struct {
+	Permission string `json:"permission"`
+}
+
+type permissionResponse struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type permissionsResponse struct {
+	Status  string                `json:"status"`
+	Message string                `json:"message"`
+	Data    []*permissionResponse `json:"data"`
+}
+
+type checkPermissionResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Allowed bool   `json:"allowed"`
+}
+
+// FindAllPermissions godoc.
+// @Summary Get all permissions
+// @Tags Role
+// @Security Bearer
+// @Description Lists every permission a role can be granted, named resource:action.
+// @Produce json
+// @Success 200 {object} permissionsResponse "List of permissions"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch permissions"
+// @Router /api/role/permissions [get]
+func (h *roleHandleApi) FindAllPermissions(c echo.Context) error {
+	const method = "FindAllPermissions"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	res, err := h.role.FindAllPermissions(ctx, &emptypb.Empty{})
+
+	if err != nil {
+		logError("Failed to fetch permissions", err, zap.Error(err))
+
+		return role_errors.ErrApiFailedFindPermissions(c)
+	}
+
+	logSuccess("Successfully fetched permissions", zap.Int("count", len(res.Data)))
+
+	return c.JSON(http.StatusOK, toPermissionsResponse(res))
+}
+
+// FindPermissionsByRole godoc.
+// @Summary Get the permissions of a role
+// @Tags Role
+// @Security Bearer
+// @Description Lists the permissions granted to a role.
+// @Produce json
+// @Param id path int true "Role ID"
+// @Success 200 {object} permissionsResponse "Role permissions"
+// @Failure 400 {object} response.ErrorResponse "Invalid role ID"
+// @Failure 404 {object} response.ErrorResponse "Role not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch permissions"
+// @Router /api/role/{id}/permissions [get]
+func (h *roleHandleApi) FindPermissionsByRole(c echo.Context) error {
+	const method = "FindPermissionsByRole"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	roleID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || roleID <= 0 {
+		logError("Invalid role ID", err, zap.Error(err))
+
+		return role_errors.ErrApiRoleInvalidId(c)
+	}
+
+	res, err := h.role.FindPermissionsByRole(ctx, &pb.FindByIdRoleRequest{
+		RoleId: int32(roleID),
+	})
+
+	if err != nil {
+		logError("Failed to fetch role permissions", err, zap.Int("role_id", roleID), zap.Error(err))
+
+		return permissionApiError(c, err, role_errors.ErrApiFailedFindPermissions)
+	}
+
+	logSuccess("Successfully fetched role permissions", zap.Int("role_id", roleID))
+
+	return c.JSON(http.StatusOK, toPermissionsResponse(res))
+}
+
+// AssignPermission godoc.
+// @Summary Grant a permission to a role
+// @Tags Role
+// @Security Bearer
+// @Description Grants a permission to a role and returns everything the role is granted afterwards.
+// @Accept json
+// @Produce json
+// @Param id path int true "Role ID"
+// @Param request body permissionRequest true "Permission name"
+// @Success 200 {object} permissionsResponse "Role permissions"
+// @Failure 400 {object} response.ErrorResponse "Invalid role ID or permission"
+// @Failure 404 {object} response.ErrorResponse "Role or permission not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to assign permission"
+// @Router /api/role/{id}/permissions [post]
+func (h *roleHandleApi) AssignPermission(c echo.Context) error {
+	const method = "AssignPermission"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	roleID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || roleID <= 0 {
+		logError("Invalid role ID", err, zap.Error(err))
+
+		return role_errors.ErrApiRoleInvalidId(c)
+	}
+
+	var body permissionRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind permission request", err, zap.Error(err))
+
+		return role_errors.ErrApiBindPermission(c)
+	}
+
+	res, err := h.role.AssignPermission(ctx, &pb.RolePermissionRequest{
+		RoleId:     int32(roleID),
+		Permission: body.Permission,
+	})
+
+	if err != nil {
+		logError("Failed to assign permission", err, zap.Int("role_id", roleID), zap.String("permission", body.Permission), zap.Error(err))
+
+		return permissionApiError(c, err, role_errors.ErrApiFailedAssignPermission)
+	}
+
+	logSuccess("Successfully assigned permission", zap.Int("role_id", roleID), zap.String("permission", body.Permission))
+
+	return c.JSON(http.StatusOK, toPermissionsResponse(res))
+}
+
+// RevokePermission godoc.
+// @Summary Revoke a permission from a role
+// @Tags Role
+// @Security Bearer
+// @Description Removes a permission from a role and returns what the role is still granted.
+// @Produce json
+// @Param id path int true "Role ID"
+// @Param permission path string true "Permission name, e.g. order:create"
+// @Success 200 {object} permissionsResponse "Role permissions"
+// @Failure 400 {object} response.ErrorResponse "Invalid role ID or permission"
+// @Failure 404 {object} response.ErrorResponse "Role or permission not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to revoke permission"
+// @Router /api/role/{id}/permissions/{permission} [delete]
+func (h *roleHandleApi) RevokePermission(c echo.Context) error {
+	const method = "RevokePermission"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	roleID, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil || roleID <= 0 {
+		logError("Invalid role ID", err, zap.Error(err))
+
+		return role_errors.ErrApiRoleInvalidId(c)
+	}
+
+	name := c.Param("permission")
+
+	res, err := h.role.RevokePermission(ctx, &pb.RolePermissionRequest{
+		RoleId:     int32(roleID),
+		Permission: name,
+	})
+
+	if err != nil {
+		logError("Failed to revoke permission", err, zap.Int("role_id", roleID), zap.String("permission", name), zap.Error(err))
+
+		return permissionApiError(c, err, role_errors.ErrApiFailedRevokePermission)
+	}
+
+	logSuccess("Successfully revoked permission", zap.Int("role_id", roleID), zap.String("permission", name))
+
+	return c.JSON(http.StatusOK, toPermissionsResponse(res))
+}
+
+// CheckPermission godoc.
+// @Summary Check a permission of the current user
+// @Tags Role
+// @Security Bearer
+// @Description Reports whether any of the current user's roles grants the permission, for a merchant when merchant_id is set.
+// @Produce json
+// @Param permission query string true "Permission name, e.g. order:create"
+// @Param merchant_id query int false "Merchant ID"
+// @Success 200 {object} checkPermissionResponse "Check result"
+// @Failure 400 {object} response.ErrorResponse "Invalid permission"
+// @Failure 500 {object} response.ErrorResponse "Failed to check permission"
+// @Router /api/role/permissions/check [get]
+func (h *roleHandleApi) CheckPermission(c echo.Context) error {
+	const method = "CheckPermission"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	userID, ok := middlewares.UserIDFromContext(c)
+	if !ok {
+		err := errors.New("missing user id")
+
+		logError("Missing user id", err)
+
+		return auth_errors.ErrInvalidAccessToken(c)
+	}
+
+	name := c.QueryParam("permission")
+	merchantID := parseQueryInt(c, "merchant_id", 0)
+
+	res, err := h.role.CheckPermission(ctx, &pb.CheckPermissionRequest{
+		UserId:     int32(userID),
+		Permission: name,
+		MerchantId: int32(merchantID),
+	})
+
+	if err != nil {
+		logError("Failed to check permission", err, zap.Int("user_id", userID), zap.String("permission", name), zap.Error(err))
+
+		return permissionApiError(c, err, role_errors.ErrApiFailedCheckPermission)
+	}
+
+	logSuccess("Successfully checked permission", zap.Int("user_id", userID), zap.String("permission", name), zap.Bool("allowed", res.Allowed))
+
+	return c.JSON(http.StatusOK, checkPermissionResponse{
+		Status:  res.Status,
+		Message: res.Message,
+		Allowed: res.Allowed,
+	})
+}
+
+// permissionApiError maps the statuses the role service uses for permission
+// failures to gateway responses.
+func permissionApiError(c echo.Context, err error, fallback func(echo.Context) error) error {
+	switch status.Code(err) {
+	case codes.Code(http.StatusNotFound):
+		return role_errors.ErrApiPermissionNotFound(c)
+	case codes.Code(http.StatusBadRequest):
+		return role_errors.ErrApiInvalidPermission(c)
+	}
+
+	return fallback(c)
+}
+
+func toPermissionsResponse(res *pb.ApiResponsesPermission) permissionsResponse {
+	data := make([]*permissionResponse, len(res.Data))
+	for i, p := range res.Data {
+		data[i] = &permissionResponse{
+			ID:          int(p.Id),
+			Name:        p.Name,
+			Description: p.Description,
+		}
+	}
+
+	return permissionsResponse{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    data,
+	}
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/role_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type userRoleRequest struct {
+	RoleID int `json:"role_id"`
+}
+
+// AssignRoleToUser godoc.
+// @Summary Assign a role to a user
+// @Tags Role
+// @Security Bearer
+// @Description Gives a user a role and returns the roles they hold afterwards. The change is audited.
+// @Accept json
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Param request body userRoleRequest true "Role to assign"
+// @Success 200 {object} response.ApiResponsesRole "Roles of the user"
+// @Failure 400 {object} response.ErrorResponse "Invalid user or role ID"
+// @Failure 404 {object} response.ErrorResponse "User or role not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to assign role"
+// @Router /api/role/user/{user_id} [post]
+func (h *roleHandleApi) AssignRoleToUser(c echo.Context) error {
+	const method = "AssignRoleToUser"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	userID, err := strconv.Atoi(c.Param("user_id"))
+
+	if err != nil || userID <= 0 {
+		logError("Invalid user ID", err, zap.Error(err))
+
+		return role_errors.ErrApiRoleInvalidId(c)
+	}
+
+	var body userRoleRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind user role request", err, zap.Error(err))
+
+		return role_errors.ErrApiBindUserRole(c)
+	}
+
+	if body.RoleID <= 0 {
+		logError("Invalid role ID", nil, zap.Int("role_id", body.RoleID))
+
+		return role_errors.ErrApiRoleInvalidId(c)
+	}
+
+	res, err := h.role.AssignRoleToUser(ctx, &pb.UserRoleRequest{
+		UserId: int32(userID),
+		RoleId: int32(body.RoleID),
+	})
+
+	if err != nil {
+		logError("Failed to assign role to user", err, zap.Int("user_id", userID), zap.Int("role_id", body.RoleID), zap.Error(err))
+
+		return userRoleApiError(c, err, role_errors.ErrApiFailedAssignUserRole)
+	}
+
+	h.rbac.Invalidate(userID)
+
+	logSuccess("Successfully assigned role to user", zap.Int("user_id", userID), zap.Int("role_id", body.RoleID))
+
+	return c.JSON(http.StatusOK, h.mapping.ToApiResponsesRole(res))
+}
+
+// RemoveRoleFromUser godoc.
+// @Summary Remove a role from a user
+// @Tags Role
+// @Security Bearer
+// @Description Takes a role away from a user and returns the roles they still hold. The last administrator keeps the administrator role. The change is audited.
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Param role_id path int true "Role ID"
+// @Success 200 {object} response.ApiResponsesRole "Roles of the user"
+// @Failure 400 {object} response.ErrorResponse "Invalid user or role ID"
+// @Failure 404 {object} response.ErrorResponse "User, role or assignment not found"
+// @Failure 409 {object} response.ErrorResponse "Last administrator"
+// @Failure 500 {object} response.ErrorResponse "Failed to remove role"
+// @Router /api/role/user/{user_id}/{role_id} [delete]
+func (h *roleHandleApi) RemoveRoleFromUser(c echo.Context) error {
+	const method = "RemoveRoleFromUser"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	userID, err := strconv.Atoi(c.Param("user_id"))
+
+	if err != nil || userID <= 0 {
+		logError("Invalid user ID", err, zap.Error(err))
+
+		return role_errors.ErrApiRoleInvalidId(c)
+	}
+
+	roleID, err := strconv.Atoi(c.Param("role_id"))
+
+	if err != nil || roleID <= 0 {
+		logError("Invalid role ID", err, zap.Error(err))
+
+		return role_errors.ErrApiRoleInvalidId(c)
+	}
+
+	res, err := h.role.RemoveRoleFromUser(ctx, &pb.UserRoleRequest{
+		UserId: int32(userID),
+		RoleId: int32(roleID),
+	})
+
+	if err != nil {
+		logError("Failed to remove role from user", err, zap.Int("user_id", userID), zap.Int("role_id", roleID), zap.Error(err))
+
+		return userRoleApiError(c, err, role_errors.ErrApiFailedRemoveUserRole)
+	}
+
+	h.rbac.Invalidate(userID)
+
+	logSuccess("Successfully removed role from user", zap.Int("user_id", userID), zap.Int("role_id", roleID))
+
+	return c.JSON(http.StatusOK, h.mapping.ToApiResponsesRole(res))
+}
+
+// userRoleApiError maps the statuses the role service uses for user role
+// failures to gateway responses.
+func userRoleApiError(c echo.Context, err error, fallback func(echo.Context) error) error {
+	switch status.Code(err) {
+	case codes.Code(http.StatusNotFound):
+		return role_errors.ErrApiUserRoleNotFound(c)
+	case codes.Code(http.StatusConflict):
+		return role_errors.ErrApiUserRoleLastAdmin(c)
+	case codes.Code(http.StatusBadRequest):
+		return role_errors.ErrApiRoleInvalidId(c)
+	}
+
+	return fallback(c)
+}
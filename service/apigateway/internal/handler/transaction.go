@@ -6,8 +6,10 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/transaction_errors"
 	response_api "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/api"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
@@ -19,6 +21,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -36,6 +40,8 @@ func NewHandlerTransaction(
 	client pb.TransactionServiceClient,
 	logger logger.LoggerInterface,
 	mapping response_api.TransactionResponseMapper,
+	rbac *middlewares.RoleAuthorizer,
+	bulk *middlewares.BulkGuard,
 ) *transactionHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -65,45 +71,57 @@ func NewHandlerTransaction(
 		requestDuration: requestDuration,
 	}
 
+	read := rbac.RequirePermission("transaction:read")
+	create := rbac.RequirePermission("transaction:create")
+	update := rbac.RequirePermission("transaction:update")
+	refund := rbac.RequirePermission("transaction:refund")
+	trash := rbac.RequirePermission("transaction:delete")
+	purge := rbac.RequirePermission("transaction:purge")
+	report := rbac.RequirePermission("report:read")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+
 	routerTransaction := router.Group("/api/transaction")
 
-	routerTransaction.GET("", transactionHandle.FindAllTransaction)
-	routerTransaction.GET("/:id", transactionHandle.FindById)
-	routerTransaction.GET("/merchant/:merchant_id", transactionHandle.FindByMerchant)
-	routerTransaction.GET("/active", transactionHandle.FindByActive)
-	routerTransaction.GET("/trashed", transactionHandle.FindByTrashed)
+	routerTransaction.GET("", transactionHandle.FindAllTransaction, read)
+	routerTransaction.GET("/:id", transactionHandle.FindById, read)
+	routerTransaction.GET("/merchant/:merchant_id", transactionHandle.FindByMerchant, read)
+	routerTransaction.GET("/active", transactionHandle.FindByActive, read)
+	routerTransaction.GET("/trashed", transactionHandle.FindByTrashed, read)
 
-	routerTransaction.GET("/monthly-success", transactionHandle.FindMonthStatusSuccess)
-	routerTransaction.GET("/yearly-success", transactionHandle.FindYearStatusSuccess)
-	routerTransaction.GET("/monthly-failed", transactionHandle.FindMonthStatusFailed)
-	routerTransaction.GET("/yearly-failed", transactionHandle.FindYearStatusFailed)
+	routerTransaction.GET("/monthly-success", transactionHandle.FindMonthStatusSuccess, report)
+	routerTransaction.GET("/yearly-success", transactionHandle.FindYearStatusSuccess, report)
+	routerTransaction.GET("/monthly-failed", transactionHandle.FindMonthStatusFailed, report)
+	routerTransaction.GET("/yearly-failed", transactionHandle.FindYearStatusFailed, report)
 
-	routerTransaction.GET("/merchant/monthly-success", transactionHandle.FindMonthStatusSuccessByMerchant)
-	routerTransaction.GET("/merchant/yearly-success", transactionHandle.FindYearStatusSuccessByMerchant)
-	routerTransaction.GET("/merchant/monthly-failed", transactionHandle.FindMonthStatusFailedByMerchant)
-	routerTransaction.GET("/merchant/yearly-failed", transactionHandle.FindYearStatusFailedByMerchant)
+	routerTransaction.GET("/merchant/monthly-success", transactionHandle.FindMonthStatusSuccessByMerchant, read)
+	routerTransaction.GET("/merchant/yearly-success", transactionHandle.FindYearStatusSuccessByMerchant, read)
+	routerTransaction.GET("/merchant/monthly-failed", transactionHandle.FindMonthStatusFailedByMerchant, read)
+	routerTransaction.GET("/merchant/yearly-failed", transactionHandle.FindYearStatusFailedByMerchant, read)
 
-	routerTransaction.GET("/monthly-method-success", transactionHandle.FindMonthMethodSuccess)
-	routerTransaction.GET("/yearly-method-success", transactionHandle.FindYearMethodSuccess)
+	routerTransaction.GET("/monthly-method-success", transactionHandle.FindMonthMethodSuccess, report)
+	routerTransaction.GET("/yearly-method-success", transactionHandle.FindYearMethodSuccess, report)
 
-	routerTransaction.GET("/merchant/monthly-method-success/:merchant_id", transactionHandle.FindMonthMethodByMerchantSuccess)
-	routerTransaction.GET("/merchant/yearly-method-success/:merchant_id", transactionHandle.FindYearMethodByMerchantSuccess)
+	routerTransaction.GET("/merchant/monthly-method-success/:merchant_id", transactionHandle.FindMonthMethodByMerchantSuccess, read)
+	routerTransaction.GET("/merchant/yearly-method-success/:merchant_id", transactionHandle.FindYearMethodByMerchantSuccess, read)
 
-	routerTransaction.GET("/monthly-method-failed", transactionHandle.FindMonthMethodFailed)
-	routerTransaction.GET("/yearly-method-failed", transactionHandle.FindYearMethodFailed)
+	routerTransaction.GET("/monthly-method-failed", transactionHandle.FindMonthMethodFailed, report)
+	routerTransaction.GET("/yearly-method-failed", transactionHandle.FindYearMethodFailed, report)
 
-	routerTransaction.GET("/merchant/monthly-method-failed/:merchant_id", transactionHandle.FindMonthMethodByMerchantFailed)
-	routerTransaction.GET("/merchant/yearly-method-failed/:merchant_id", transactionHandle.FindYearMethodByMerchantFailed)
+	routerTransaction.GET("/merchant/monthly-method-failed/:merchant_id", transactionHandle.FindMonthMethodByMerchantFailed, read)
+	routerTransaction.GET("/merchant/yearly-method-failed/:merchant_id", transactionHandle.FindYearMethodByMerchantFailed, read)
 
-	routerTransaction.POST("/create", transactionHandle.Create)
-	routerTransaction.POST("/update/:id", transactionHandle.Update)
+	routerTransaction.POST("/create", transactionHandle.Create, create)
+	routerTransaction.POST("/update/:id", transactionHandle.Update, update)
+	routerTransaction.POST("/refund/:id", transactionHandle.RefundTransaction, refund)
+	routerTransaction.POST("/void/:id", transactionHandle.VoidTransaction, refund)
 
-	routerTransaction.POST("/trashed/:id", transactionHandle.TrashedTransaction)
-	routerTransaction.POST("/restore/:id", transactionHandle.RestoreTransaction)
-	routerTransaction.DELETE("/permanent/:id", transactionHandle.DeleteTransactionPermanent)
+	routerTransaction.POST("/trashed/:id", transactionHandle.TrashedTransaction, trash)
+	routerTransaction.POST("/restore/:id", transactionHandle.RestoreTransaction, trash)
+	routerTransaction.DELETE("/permanent/:id", transactionHandle.DeleteTransactionPermanent, purge)
 
-	routerTransaction.POST("/restore/all", transactionHandle.RestoreAllTransaction)
-	routerTransaction.POST("/permanent/all", transactionHandle.DeleteAllTransactionPermanent)
+	routerTransaction.POST("/restore/all", transactionHandle.RestoreAllTransaction, bulkRestore, bulk.GuardByMerchant())
+	routerTransaction.POST("/permanent/all", transactionHandle.DeleteAllTransactionPermanent, bulkPurge, bulk.GuardByMerchant())
 
 	return transactionHandle
 }
@@ -1298,6 +1316,7 @@ func (h *transactionHandleApi) Create(c echo.Context) error {
 		CashierId:     int32(body.CashierID),
 		PaymentMethod: body.PaymentMethod,
 		Amount:        int32(body.Amount),
+		Tenders:       toProtoTenders(body.Tenders),
 	}
 
 	res, err := h.client.Create(ctx, grpcReq)
@@ -1305,6 +1324,10 @@ func (h *transactionHandleApi) Create(c echo.Context) error {
 	if err != nil {
 		logError("Failed to create transaction", err, zap.Error(err))
 
+		if status.Code(err) == codes.InvalidArgument {
+			return response.NewApiErrorResponse(c, "error", status.Convert(err).Message(), http.StatusBadRequest)
+		}
+
 		return transaction_errors.ErrApiTransactionFailedCreate(c)
 	}
 
@@ -1366,6 +1389,7 @@ func (h *transactionHandleApi) Update(c echo.Context) error {
 		CashierId:     int32(body.CashierID),
 		PaymentMethod: body.PaymentMethod,
 		Amount:        int32(body.Amount),
+		Tenders:       toProtoTenders(body.Tenders),
 	}
 
 	res, err := h.client.Update(ctx, grpcReq)
@@ -1373,6 +1397,10 @@ func (h *transactionHandleApi) Update(c echo.Context) error {
 	if err != nil {
 		logError("Transaction update failed", err, zap.Error(err))
 
+		if status.Code(err) == codes.InvalidArgument {
+			return response.NewApiErrorResponse(c, "error", status.Convert(err).Message(), http.StatusBadRequest)
+		}
+
 		return transaction_errors.ErrApiTransactionFailedUpdate(c)
 	}
 
@@ -1383,6 +1411,150 @@ func (h *transactionHandleApi) Update(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// @Summary Refund a transaction
+// @Tags Transaction
+// @Description Refund some or all items of a paid transaction and return their stock
+// @Accept json
+// @Produce json
+// @Param id path int true "Transaction ID"
+// @Param request body requests.RefundTransactionRequest true "Refund reason and items; no items refunds everything left"
+// @Success 200 {object} response.ApiResponseTransaction "Successfully refunded transaction"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or refund"
+// @Failure 409 {object} response.ErrorResponse "Transaction changed while the refund was prepared"
+// @Failure 500 {object} response.ErrorResponse "Failed to refund transaction"
+// @Router /api/transaction/refund/{id} [post]
+func (h *transactionHandleApi) RefundTransaction(c echo.Context) error {
+	const method = "RefundTransaction"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		logError("Invalid transaction id", err, zap.Error(err))
+
+		return transaction_errors.ErrApiTransactionInvalidId(c)
+	}
+
+	var body requests.RefundTransactionRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind request body", err, zap.Error(err))
+
+		return transaction_errors.ErrApiBindRefundTransaction(c)
+	}
+
+	if err := body.Validate(); err != nil {
+		logError("Failed to validate request body", err, zap.Error(err))
+
+		return transaction_errors.ErrApiValidateRefundTransaction(c)
+	}
+
+	operatorID, _ := middlewares.UserIDFromContext(c)
+
+	items := make([]*pb.RefundTransactionItem, len(body.Items))
+	for i, item := range body.Items {
+		items[i] = &pb.RefundTransactionItem{OrderItemId: int32(item.OrderItemID), Quantity: int32(item.Quantity)}
+	}
+
+	res, err := h.client.RefundTransaction(ctx, &pb.RefundTransactionRequest{
+		TransactionId: int32(id),
+		OperatorId:    int32(operatorID),
+		Reason:        body.Reason,
+		Items:         items,
+	})
+
+	if err != nil {
+		logError("Failed to refund transaction", err, zap.Error(err))
+
+		if apiErr := refundError(c, err); apiErr != nil {
+			return apiErr
+		}
+
+		return transaction_errors.ErrApiTransactionFailedRefund(c)
+	}
+
+	so := h.mapping.ToApiResponseTransaction(res)
+
+	logSuccess("Successfully refunded transaction", zap.Bool("success", true))
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// @Summary Void a transaction
+// @Tags Transaction
+// @Description Cancel a paid transaction outright and return its stock
+// @Accept json
+// @Produce json
+// @Param id path int true "Transaction ID"
+// @Param request body requests.VoidTransactionRequest true "Void reason"
+// @Success 200 {object} response.ApiResponseTransaction "Successfully voided transaction"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or void"
+// @Failure 409 {object} response.ErrorResponse "Transaction changed while the void was prepared"
+// @Failure 500 {object} response.ErrorResponse "Failed to void transaction"
+// @Router /api/transaction/void/{id} [post]
+func (h *transactionHandleApi) VoidTransaction(c echo.Context) error {
+	const method = "VoidTransaction"
+
+	ctx := c.Request().Context()
+
+	end, logSuccess, logError := h.startTracingAndLogging(ctx, method)
+
+	defer func() { end() }()
+
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		logError("Invalid transaction id", err, zap.Error(err))
+
+		return transaction_errors.ErrApiTransactionInvalidId(c)
+	}
+
+	var body requests.VoidTransactionRequest
+
+	if err := c.Bind(&body); err != nil {
+		logError("Failed to bind request body", err, zap.Error(err))
+
+		return transaction_errors.ErrApiBindVoidTransaction(c)
+	}
+
+	if err := body.Validate(); err != nil {
+		logError("Failed to validate request body", err, zap.Error(err))
+
+		return transaction_errors.ErrApiValidateVoidTransaction(c)
+	}
+
+	operatorID, _ := middlewares.UserIDFromContext(c)
+
+	res, err := h.client.VoidTransaction(ctx, &pb.VoidTransactionRequest{
+		TransactionId: int32(id),
+		OperatorId:    int32(operatorID),
+		Reason:        body.Reason,
+	})
+
+	if err != nil {
+		logError("Failed to void transaction", err, zap.Error(err))
+
+		if apiErr := refundError(c, err); apiErr != nil {
+			return apiErr
+		}
+
+		return transaction_errors.ErrApiTransactionFailedVoid(c)
+	}
+
+	so := h.mapping.ToApiResponseTransaction(res)
+
+	logSuccess("Successfully voided transaction", zap.Bool("success", true))
+
+	return c.JSON(http.StatusOK, so)
+}
+
 // @Security Bearer
 // TrashedTransaction retrieves a trashed transaction record by its ID.
 // @Summary Retrieve a trashed transaction
@@ -1534,8 +1706,12 @@ func (h *transactionHandleApi) DeleteTransactionPermanent(c echo.Context) error
 // @Description Restore all trashed transactions.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseTransactionAll "Successfully restored all transactions"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore transactions"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/transaction/restore/all [post]
 func (h *transactionHandleApi) RestoreAllTransaction(c echo.Context) error {
 	const method = "RestoreAllTransaction"
@@ -1568,8 +1744,12 @@ func (h *transactionHandleApi) RestoreAllTransaction(c echo.Context) error {
 // @Description Permanently delete all transactions.
 // @Accept json
 // @Produce json
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseTransactionAll "Successfully deleted all transactions permanently"
 // @Failure 500 {object} response.ErrorResponse "Failed to delete transactions"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/transaction/delete/all [post]
 func (h *transactionHandleApi) DeleteAllTransactionPermanent(c echo.Context) error {
 	const method = "DeleteAllTransactionPermanent"
@@ -1648,3 +1828,29 @@ func (s *transactionHandleApi) recordMetrics(method string, status string, start
 	s.requestCounter.WithLabelValues(method, status).Inc()
 	s.requestDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
 }
+
+func toProtoTenders(tenders []requests.TenderRequest) []*pb.TransactionTender {
+	out := make([]*pb.TransactionTender, len(tenders))
+	for i, t := range tenders {
+		out[i] = &pb.TransactionTender{Method: t.Method, Amount: int32(t.Amount)}
+	}
+
+	return out
+}
+
+// refundError passes on the refund errors the caller can act on: a bad
+// refund, a transaction that cannot be refunded, or one that changed
+// meanwhile. It returns nil for anything else.
+func refundError(c echo.Context, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+
+	switch st.Code() {
+	case codes.Code(http.StatusBadRequest), codes.Code(http.StatusNotFound), codes.Code(http.StatusConflict):
+		return response.NewApiErrorResponse(c, "error", st.Message(), int(st.Code()))
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-apigateway/internal/middlewares"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/user_errors"
@@ -30,7 +31,7 @@ type userHandleApi struct {
 	requestDuration *prometheus.HistogramVec
 }
 
-func NewHandlerUser(router *echo.Echo, client pb.UserServiceClient, logger logger.LoggerInterface, mapping response_api.UserResponseMapper) *userHandleApi {
+func NewHandlerUser(router *echo.Echo, client pb.UserServiceClient, logger logger.LoggerInterface, mapping response_api.UserResponseMapper, rbac *middlewares.RoleAuthorizer, bulk *middlewares.BulkGuard) *userHandleApi {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "user_handler_requests_total",
@@ -59,22 +60,30 @@ func NewHandlerUser(router *echo.Echo, client pb.UserServiceClient, logger logge
 		requestDuration: requestDuration,
 	}
 
+	read := rbac.RequirePermission("user:read")
+	create := rbac.RequirePermission("user:create")
+	update := rbac.RequirePermission("user:update")
+	trash := rbac.RequirePermission("user:delete")
+	purge := rbac.RequirePermission("user:purge")
+	bulkRestore := rbac.RequirePermission("bulk:restore")
+	bulkPurge := rbac.RequirePermission("bulk:purge")
+
 	routerUser := router.Group("/api/user")
 
-	routerUser.GET("", userHandler.FindAllUser)
-	routerUser.GET("/:id", userHandler.FindById)
-	routerUser.GET("/active", userHandler.FindByActive)
-	routerUser.GET("/trashed", userHandler.FindByTrashed)
+	routerUser.GET("", userHandler.FindAllUser, read)
+	routerUser.GET("/:id", userHandler.FindById, read)
+	routerUser.GET("/active", userHandler.FindByActive, read)
+	routerUser.GET("/trashed", userHandler.FindByTrashed, read)
 
-	routerUser.POST("/create", userHandler.Create)
-	routerUser.POST("/update/:id", userHandler.Update)
+	routerUser.POST("/create", userHandler.Create, create)
+	routerUser.POST("/update/:id", userHandler.Update, update)
 
-	routerUser.POST("/trashed/:id", userHandler.TrashedUser)
-	routerUser.POST("/restore/:id", userHandler.RestoreUser)
-	routerUser.DELETE("/permanent/:id", userHandler.DeleteUserPermanent)
+	routerUser.POST("/trashed/:id", userHandler.TrashedUser, trash)
+	routerUser.POST("/restore/:id", userHandler.RestoreUser, trash)
+	routerUser.DELETE("/permanent/:id", userHandler.DeleteUserPermanent, purge)
 
-	routerUser.POST("/restore/all", userHandler.RestoreAllUser)
-	routerUser.POST("/permanent/all", userHandler.DeleteAllUserPermanent)
+	routerUser.POST("/restore/all", userHandler.RestoreAllUser, bulkRestore, bulk.Guard())
+	routerUser.POST("/permanent/all", userHandler.DeleteAllUserPermanent, bulkPurge, bulk.Guard())
 
 	return userHandler
 }
@@ -574,9 +583,13 @@ func (h *userHandleApi) DeleteUserPermanent(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseUserAll "Successfully restored user all"
 // @Failure 400 {object} response.ErrorResponse "Invalid user ID"
 // @Failure 500 {object} response.ErrorResponse "Failed to restore user"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/user/restore/all [post]
 func (h *userHandleApi) RestoreAllUser(c echo.Context) error {
 	const method = "RestoreAllUser"
@@ -610,9 +623,13 @@ func (h *userHandleApi) RestoreAllUser(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
+// @Param request body middlewares.BulkRequest true "Scope of the call. Send dry_run first, then the confirmation_token it returns"
 // @Success 200 {object} response.ApiResponseUserDelete "Successfully deleted user record permanently"
 // @Failure 400 {object} response.ErrorResponse "Bad Request: Invalid ID"
 // @Failure 500 {object} response.ErrorResponse "Failed to delete user:"
+// @Failure 400 {object} response.ErrorResponse "Missing or invalid scope"
+// @Failure 428 {object} response.ErrorResponse "Confirmation token required"
+// @Failure 412 {object} response.ErrorResponse "Confirmation token is invalid, expired or for another scope"
 // @Router /api/user/delete/all [post]
 func (h *userHandleApi) DeleteAllUserPermanent(c echo.Context) error {
 	const method = "DeleteAllUserPermanent"
@@ -0,0 +1,576 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// AuthSchemeApiKey is accepted in the Authorization header next to Bearer.
+const AuthSchemeApiKey = "ApiKey"
+
+const apiKeyPrefix = "pos_"
+
+const (
+	keyApiKeyByHash   = "apigateway:apikey:hash:%s"
+	keyApiKeyLastUsed = "apigateway:apikey:used:%s"
+)
+
+// apiKeyCacheTTL bounds how long Redis serves a key without going back to
+// Postgres.
+const apiKeyCacheTTL = 5 * time.Minute
+
+// apiKeyUseInterval is how often a key's last use is written to Postgres;
+// uses in between only touch Redis.
+const apiKeyUseInterval = time.Minute
+
+// ApiKeyRotationGrace is how long a rotated key keeps working, so an
+// integration can be redeployed with its replacement.
+const ApiKeyRotationGrace = 24 * time.Hour
+
+// ApiKeyPermissions are the scopes a key can hold, named like the role
+// permissions the routes require. Keys only reach services that scope their
+// data to the key's merchant.
+var ApiKeyPermissions = []string{
+	"category:read",
+	"product:read",
+	"product:create",
+	"product:update",
+	"product:delete",
+	"order:read",
+	"order:create",
+	"order:update",
+	"order:delete",
+	"transaction:read",
+	"transaction:create",
+	"transaction:update",
+	"transaction:delete",
+	"transaction:refund",
+}
+
+var (
+	ErrApiKeyNotFound   = errors.New("api key not found")
+	ErrApiKeyInvalid    = errors.New("api key is invalid or expired")
+	ErrApiKeyPermission = errors.New("unknown api key permission")
+	ErrApiKeyMerchant   = errors.New("api key must belong to a merchant")
+)
+
+const contextApiKey = "apiKey"
+
+// ApiKey is what is stored for a key. The key itself is only returned when
+// it is created or rotated; at rest it is kept as a SHA-256 hash.
+type ApiKey struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	MerchantID  int        `json:"merchant_id"`
+	Permissions []string   `json:"permissions"`
+	CreatedBy   int        `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RotatedFrom string     `json:"rotated_from,omitempty"`
+	RotatedTo   string     `json:"rotated_to,omitempty"`
+
+	hash string
+}
+
+// storedApiKey is the cached form of a key, which keeps the hash.
+type storedApiKey struct {
+	ApiKey
+	Hash string `json:"hash"`
+}
+
+func (k *ApiKey) Allows(permission string) bool {
+	for _, p := range k.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k *ApiKey) expiration() time.Duration {
+	if k.ExpiresAt == nil {
+		return 0
+	}
+
+	return time.Until(*k.ExpiresAt)
+}
+
+// ApiKeyStore keeps API keys in Postgres. Redis caches them by hash, so
+// authenticating a key usually costs a single GET.
+type ApiKeyStore struct {
+	db     *sql.DB
+	client *redis.Client
+	logger logger.LoggerInterface
+}
+
+func NewApiKeyStore(db *sql.DB, client *redis.Client, logger logger.LoggerInterface) *ApiKeyStore {
+	return &ApiKeyStore{
+		db:     db,
+		client: client,
+		logger: logger,
+	}
+}
+
+// apiKeyQuerier is what the queries need from a connection or a
+// transaction.
+type apiKeyQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+const apiKeyColumns = `
+    k.api_key_id, k.name, k.prefix, k.key_hash, k.merchant_id, k.scopes,
+    k.created_by, k.created_at, k.expires_at, k.rotated_from, k.last_used_at,
+    COALESCE((
+        SELECT r.api_key_id FROM api_keys r
+        WHERE r.rotated_from = k.api_key_id
+        ORDER BY r.created_at DESC
+        LIMIT 1
+    ), '')`
+
+const createApiKey = `-- name: CreateApiKey :exec
+INSERT INTO api_keys (
+    api_key_id, name, prefix, key_hash, merchant_id, scopes,
+    created_by, created_at, expires_at, rotated_from
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+// Expired keys are left in the table but read as missing.
+const getApiKeyByID = `-- name: GetApiKeyByID :one
+SELECT` + apiKeyColumns + `
+FROM api_keys k
+WHERE k.api_key_id = $1
+    AND (k.expires_at IS NULL OR k.expires_at > $2)
+`
+
+const getApiKeyByHash = `-- name: GetApiKeyByHash :one
+SELECT` + apiKeyColumns + `
+FROM api_keys k
+WHERE k.key_hash = $1
+    AND (k.expires_at IS NULL OR k.expires_at > $2)
+`
+
+const listApiKeys = `-- name: ListApiKeys :many
+SELECT` + apiKeyColumns + `
+FROM api_keys k
+WHERE ($1 = 0 OR k.merchant_id = $1)
+    AND (k.expires_at IS NULL OR k.expires_at > $2)
+ORDER BY k.created_at
+`
+
+const expireApiKey = `-- name: ExpireApiKey :exec
+UPDATE api_keys
+SET expires_at = $2
+WHERE api_key_id = $1
+`
+
+const deleteApiKey = `-- name: DeleteApiKey :one
+DELETE FROM api_keys
+WHERE api_key_id = $1
+RETURNING key_hash
+`
+
+const touchApiKey = `-- name: TouchApiKey :exec
+UPDATE api_keys
+SET last_used_at = $2
+WHERE api_key_id = $1
+`
+
+// Create returns the stored key and the secret to hand to the integration.
+func (s *ApiKeyStore) Create(ctx context.Context, key *ApiKey) (*ApiKey, string, error) {
+	created, secret, err := newApiKey(key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := insertApiKey(ctx, s.db, created); err != nil {
+		return nil, "", err
+	}
+
+	return created, secret, nil
+}
+
+// Rotate issues a replacement with the same merchant and permissions. The
+// old key keeps working for ApiKeyRotationGrace, or until it expires if that
+// is sooner.
+func (s *ApiKeyStore) Rotate(ctx context.Context, id string) (*ApiKey, string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	defer tx.Rollback()
+
+	old, err := findApiKey(ctx, tx, getApiKeyByID, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	replacement, secret, err := newApiKey(&ApiKey{
+		Name:        old.Name,
+		MerchantID:  old.MerchantID,
+		Permissions: old.Permissions,
+		CreatedBy:   old.CreatedBy,
+		ExpiresAt:   old.ExpiresAt,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	replacement.RotatedFrom = old.ID
+
+	if err := insertApiKey(ctx, tx, replacement); err != nil {
+		return nil, "", err
+	}
+
+	graceEnd := time.Now().UTC().Add(ApiKeyRotationGrace)
+	if old.ExpiresAt == nil || old.ExpiresAt.After(graceEnd) {
+		if _, err := tx.ExecContext(ctx, expireApiKey, old.ID, graceEnd); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", err
+	}
+
+	s.forget(ctx, old.hash)
+
+	return replacement, secret, nil
+}
+
+func (s *ApiKeyStore) Revoke(ctx context.Context, id string) error {
+	var hash string
+
+	err := s.db.QueryRowContext(ctx, deleteApiKey, id).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrApiKeyNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	s.forget(ctx, hash)
+
+	return nil
+}
+
+// List returns the live keys of a merchant, or every live key when
+// merchantID is 0.
+func (s *ApiKeyStore) List(ctx context.Context, merchantID int) ([]*ApiKey, error) {
+	rows, err := s.db.QueryContext(ctx, listApiKeys, merchantID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []*ApiKey{}
+
+	for rows.Next() {
+		key, err := scanApiKey(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// Authenticate resolves a presented key and records its use. Keys are read
+// through the Redis cache; a cache that cannot be reached only costs a
+// query.
+func (s *ApiKeyStore) Authenticate(ctx context.Context, secret string) (*ApiKey, error) {
+	if !strings.HasPrefix(secret, apiKeyPrefix) {
+		return nil, ErrApiKeyInvalid
+	}
+
+	hash := hashApiKey(secret)
+
+	key, err := s.cached(ctx, hash)
+	if err != nil {
+		s.logger.Error("Failed to read cached api key", zap.Error(err))
+	}
+
+	if key == nil {
+		key, err = findApiKey(ctx, s.db, getApiKeyByHash, hash)
+		if errors.Is(err, ErrApiKeyNotFound) {
+			return nil, ErrApiKeyInvalid
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		s.cache(ctx, key)
+	}
+
+	if key.ExpiresAt != nil && key.expiration() <= 0 {
+		return nil, ErrApiKeyInvalid
+	}
+
+	s.recordUse(ctx, key.ID)
+
+	return key, nil
+}
+
+func (s *ApiKeyStore) cached(ctx context.Context, hash string) (*ApiKey, error) {
+	raw, err := s.client.Get(ctx, fmt.Sprintf(keyApiKeyByHash, hash)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stored storedApiKey
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		return nil, err
+	}
+
+	key := stored.ApiKey
+	key.hash = stored.Hash
+
+	return &key, nil
+}
+
+// cache keeps the key for apiKeyCacheTTL, or until it expires if that is
+// sooner.
+func (s *ApiKeyStore) cache(ctx context.Context, key *ApiKey) {
+	ttl := apiKeyCacheTTL
+	if key.ExpiresAt != nil && key.expiration() < ttl {
+		ttl = key.expiration()
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	data, err := json.Marshal(storedApiKey{ApiKey: *key, Hash: key.hash})
+	if err != nil {
+		s.logger.Error("Failed to encode api key", zap.String("api_key_id", key.ID), zap.Error(err))
+		return
+	}
+
+	if err := s.client.Set(ctx, fmt.Sprintf(keyApiKeyByHash, key.hash), data, ttl).Err(); err != nil {
+		s.logger.Error("Failed to cache api key", zap.String("api_key_id", key.ID), zap.Error(err))
+	}
+}
+
+// forget drops a changed key from the cache so the next request reads it
+// from Postgres.
+func (s *ApiKeyStore) forget(ctx context.Context, hash string) {
+	if err := s.client.Del(ctx, fmt.Sprintf(keyApiKeyByHash, hash)).Err(); err != nil {
+		s.logger.Error("Failed to drop cached api key", zap.Error(err))
+	}
+}
+
+// recordUse writes the key's last use to Postgres at most once every
+// apiKeyUseInterval.
+func (s *ApiKeyStore) recordUse(ctx context.Context, id string) {
+	due, err := s.client.SetNX(ctx, fmt.Sprintf(keyApiKeyLastUsed, id), 1, apiKeyUseInterval).Result()
+	if err != nil {
+		s.logger.Error("Failed to record api key use", zap.String("api_key_id", id), zap.Error(err))
+		return
+	}
+	if !due {
+		return
+	}
+
+	if _, err := s.db.ExecContext(ctx, touchApiKey, id, time.Now().UTC()); err != nil {
+		s.logger.Error("Failed to record api key use", zap.String("api_key_id", id), zap.Error(err))
+	}
+}
+
+// newApiKey checks key and fills in a fresh id and secret.
+func newApiKey(key *ApiKey) (*ApiKey, string, error) {
+	if key.MerchantID <= 0 {
+		return nil, "", ErrApiKeyMerchant
+	}
+
+	for _, p := range key.Permissions {
+		if !validApiKeyPermission(p) {
+			return nil, "", fmt.Errorf("%w: %s", ErrApiKeyPermission, p)
+		}
+	}
+
+	if key.ExpiresAt != nil && key.expiration() <= 0 {
+		return nil, "", ErrApiKeyInvalid
+	}
+
+	id, err := randomHex(8)
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, err := randomHex(24)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret := apiKeyPrefix + token
+
+	created := *key
+	created.ID = id
+	created.Prefix = secret[:len(apiKeyPrefix)+8]
+	created.CreatedAt = time.Now().UTC()
+	created.LastUsedAt = nil
+	created.RotatedFrom = ""
+	created.RotatedTo = ""
+	created.hash = hashApiKey(secret)
+
+	return &created, secret, nil
+}
+
+func insertApiKey(ctx context.Context, q apiKeyQuerier, key *ApiKey) error {
+	_, err := q.ExecContext(ctx, createApiKey,
+		key.ID,
+		key.Name,
+		key.Prefix,
+		key.hash,
+		key.MerchantID,
+		pq.Array(key.Permissions),
+		key.CreatedBy,
+		key.CreatedAt,
+		key.ExpiresAt,
+		sql.NullString{String: key.RotatedFrom, Valid: key.RotatedFrom != ""},
+	)
+
+	return err
+}
+
+func findApiKey(ctx context.Context, q apiKeyQuerier, query string, arg string) (*ApiKey, error) {
+	key, err := scanApiKey(q.QueryRowContext(ctx, query, arg, time.Now().UTC()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrApiKeyNotFound
+	}
+
+	return key, err
+}
+
+func scanApiKey(row interface{ Scan(dest ...any) error }) (*ApiKey, error) {
+	var (
+		key         ApiKey
+		expiresAt   sql.NullTime
+		rotatedFrom sql.NullString
+		lastUsedAt  sql.NullTime
+	)
+
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.hash,
+		&key.MerchantID,
+		pq.Array(&key.Permissions),
+		&key.CreatedBy,
+		&key.CreatedAt,
+		&expiresAt,
+		&rotatedFrom,
+		&lastUsedAt,
+		&key.RotatedTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	key.RotatedFrom = rotatedFrom.String
+
+	if expiresAt.Valid {
+		key.ExpiresAt = &expiresAt.Time
+	}
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+
+	return &key, nil
+}
+
+// ApiKeyAuth authenticates requests sent with "Authorization: ApiKey <key>".
+// Bearer requests are left to the JWT middleware, which skips these.
+func ApiKeyAuth(store *ApiKeyStore) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			secret, ok := apiKeyFromHeader(c.Request())
+			if !ok {
+				return next(c)
+			}
+
+			key, err := store.Authenticate(c.Request().Context(), secret)
+			if err != nil {
+				if !errors.Is(err, ErrApiKeyInvalid) {
+					store.logger.Error("Failed to authenticate api key", zap.Error(err))
+				}
+				return unauthorizedApiKey(c)
+			}
+
+			c.Set(contextApiKey, key)
+			c.Set(ContextAPIKeyID, key.ID)
+
+			return next(c)
+		}
+	}
+}
+
+// ApiKeyFromContext returns the key a request was authenticated with.
+func ApiKeyFromContext(c echo.Context) (*ApiKey, bool) {
+	key, ok := c.Get(contextApiKey).(*ApiKey)
+	return key, ok
+}
+
+func apiKeyFromHeader(req *http.Request) (string, bool) {
+	scheme, secret, ok := strings.Cut(req.Header.Get(echo.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, AuthSchemeApiKey) {
+		return "", false
+	}
+
+	secret = strings.TrimSpace(secret)
+
+	return secret, secret != ""
+}
+
+func validApiKeyPermission(permission string) bool {
+	for _, p := range ApiKeyPermissions {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hashApiKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func unauthorizedApiKey(c echo.Context) error {
+	return response.NewApiErrorResponse(c, "error", "invalid or expired api key", http.StatusUnauthorized)
+}
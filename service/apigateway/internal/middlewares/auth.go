@@ -1,6 +1,9 @@
 package middlewares
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -11,6 +14,7 @@ import (
 
 var whiteListPaths = []string{
 	"/api/auth/login",
+	"/api/auth/mfa/verify",
 	"/api/auth/register",
 	"/api/auth/hello",
 	"/docs/",
@@ -18,10 +22,23 @@ var whiteListPaths = []string{
 	"/swagger",
 }
 
-func WebSecurityConfig(e *echo.Echo) {
+var errTokenRevoked = errors.New("token has been revoked")
+
+func WebSecurityConfig(e *echo.Echo, denylist *TokenDenylist, apiKeys *ApiKeyStore) {
 	config := echojwt.Config{
-		SigningKey: []byte(viper.GetString("SECRET_KEY")),
-		Skipper:    skipAuth,
+		Skipper: skipAuth,
+		ParseTokenFunc: func(c echo.Context, auth string) (interface{}, error) {
+			token, err := ParseToken(auth)
+			if err != nil {
+				return nil, err
+			}
+
+			if isRevoked(c.Request().Context(), denylist, token) {
+				return nil, errTokenRevoked
+			}
+
+			return token, nil
+		},
 		SuccessHandler: func(c echo.Context) {
 			user := c.Get("user").(*jwt.Token)
 
@@ -34,11 +51,33 @@ func WebSecurityConfig(e *echo.Echo) {
 			return echo.ErrUnauthorized
 		},
 	}
-	e.Use(echojwt.WithConfig(config))
+	e.Use(echojwt.WithConfig(config), ApiKeyAuth(apiKeys))
+}
+
+// ParseToken verifies a token signed by the auth service.
+func ParseToken(auth string) (*jwt.Token, error) {
+	return jwt.Parse(auth, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return []byte(viper.GetString("SECRET_KEY")), nil
+	})
+}
+
+func isRevoked(ctx context.Context, denylist *TokenDenylist, token *jwt.Token) bool {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return false
+	}
 
+	return denylist.IsRevoked(ctx, claims)
 }
 
 func skipAuth(e echo.Context) bool {
+	if _, ok := apiKeyFromHeader(e.Request()); ok {
+		return true
+	}
+
 	path := e.Path()
 
 	for _, p := range whiteListPaths {
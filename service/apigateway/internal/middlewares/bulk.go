@@ -0,0 +1,343 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys read by the bulk middleware of the services; keep them in
+// sync with their bulk package.
+const (
+	MetadataBulkMerchantID    = "x-bulk-merchant-id"
+	MetadataBulkTrashedBefore = "x-bulk-trashed-before"
+	MetadataBulkIDs           = "x-bulk-ids"
+	MetadataBulkDryRun        = "x-bulk-dry-run"
+	MetadataBulkAffected      = "x-bulk-affected"
+)
+
+// HeaderBulkAffected tells how many rows a bulk call changed.
+const HeaderBulkAffected = "X-Bulk-Affected"
+
+const keyBulkConfirmation = "apigateway:bulk:confirm:%s"
+
+// BulkConfirmationTTL is how long the token of a dry run can confirm the
+// call it previewed.
+const BulkConfirmationTTL = 5 * time.Minute
+
+// BulkMaxIDs caps the ids of a single call.
+const BulkMaxIDs = 1000
+
+// BulkRequest is the body of the DeleteAll and RestoreAll routes. At least
+// one of merchant_id, trashed_before and ids is required; rows must match
+// all that are set.
+type BulkRequest struct {
+	MerchantID        int        `json:"merchant_id"`
+	TrashedBefore     *time.Time `json:"trashed_before"`
+	IDs               []int      `json:"ids"`
+	DryRun            bool       `json:"dry_run"`
+	ConfirmationToken string     `json:"confirmation_token"`
+}
+
+type BulkPreview struct {
+	Affected          int64     `json:"affected"`
+	ConfirmationToken string    `json:"confirmation_token"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+type ApiResponseBulkPreview struct {
+	Status  string       `json:"status"`
+	Message string       `json:"message"`
+	Data    *BulkPreview `json:"data"`
+}
+
+var (
+	errBulkScopeRequired   = errors.New("a merchant_id, trashed_before or ids scope is required")
+	errBulkMerchantScope   = errors.New("merchant_id is not supported for these rows")
+	errBulkInvalidIDs      = fmt.Errorf("ids must be at most %d positive numbers", BulkMaxIDs)
+	errBulkInvalidMerchant = errors.New("merchant_id must be positive")
+)
+
+type bulkCallKey struct{}
+
+// bulkCall carries the scope of a route to the gRPC call it makes, and the
+// affected count back.
+type bulkCall struct {
+	request  BulkRequest
+	affected int64
+	reported bool
+}
+
+// BulkGuard protects the routes that restore or permanently delete every
+// trashed row. A call has to be scoped, and has to be previewed with a dry
+// run first: the dry run returns the affected count and a single use token
+// that confirms the same scope for the same user.
+type BulkGuard struct {
+	client *redis.Client
+	logger logger.LoggerInterface
+}
+
+func NewBulkGuard(client *redis.Client, logger logger.LoggerInterface) *BulkGuard {
+	return &BulkGuard{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Guard is for rows that do not belong to a merchant.
+func (g *BulkGuard) Guard() echo.MiddlewareFunc {
+	return g.guard(false)
+}
+
+// GuardByMerchant is for rows that can also be scoped to a merchant.
+func (g *BulkGuard) GuardByMerchant() echo.MiddlewareFunc {
+	return g.guard(true)
+}
+
+func (g *BulkGuard) guard(byMerchant bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			var req BulkRequest
+			if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+				return response.NewApiErrorResponse(c, "error", "Invalid request body", http.StatusBadRequest)
+			}
+
+			if err := req.normalize(byMerchant); err != nil {
+				return response.NewApiErrorResponse(c, "error", err.Error(), http.StatusBadRequest)
+			}
+
+			ctx := c.Request().Context()
+			userID, _ := UserIDFromContext(c)
+			fingerprint := req.fingerprint(c.Request().Method, c.Path(), userID)
+
+			if !req.DryRun {
+				if req.ConfirmationToken == "" {
+					return response.NewApiErrorResponse(c, "error", "A confirmation token from a dry run is required", http.StatusPreconditionRequired)
+				}
+
+				confirmed, err := g.client.GetDel(ctx, fmt.Sprintf(keyBulkConfirmation, req.ConfirmationToken)).Result()
+				if err != nil && !errors.Is(err, redis.Nil) {
+					g.logger.Error("Failed to check bulk confirmation token", zap.Int("user_id", userID), zap.Error(err))
+					return response.NewApiErrorResponse(c, "error", "Failed to check confirmation token", http.StatusServiceUnavailable)
+				}
+
+				if confirmed != fingerprint {
+					return response.NewApiErrorResponse(c, "error", "The confirmation token is invalid, expired or for another scope", http.StatusPreconditionFailed)
+				}
+			}
+
+			call := &bulkCall{request: req}
+			c.SetRequest(c.Request().WithContext(context.WithValue(ctx, bulkCallKey{}, call)))
+
+			res := c.Response()
+			buffer := newBufferedResponse()
+			c.SetResponse(echo.NewResponse(buffer, c.Echo()))
+
+			err := next(c)
+
+			c.SetResponse(res)
+			if err != nil {
+				return err
+			}
+
+			if buffer.status != http.StatusOK || !call.reported {
+				return buffer.flush(res)
+			}
+
+			if !req.DryRun {
+				res.Header().Set(HeaderBulkAffected, strconv.FormatInt(call.affected, 10))
+				return buffer.flush(res)
+			}
+
+			token, err := newBulkToken()
+			if err != nil {
+				return response.NewApiErrorResponse(c, "error", "Failed to issue confirmation token", http.StatusInternalServerError)
+			}
+
+			if err := g.client.Set(ctx, fmt.Sprintf(keyBulkConfirmation, token), fingerprint, BulkConfirmationTTL).Err(); err != nil {
+				g.logger.Error("Failed to store bulk confirmation token", zap.Int("user_id", userID), zap.Error(err))
+				return response.NewApiErrorResponse(c, "error", "Failed to issue confirmation token", http.StatusServiceUnavailable)
+			}
+
+			return c.JSON(http.StatusOK, ApiResponseBulkPreview{
+				Status:  "success",
+				Message: fmt.Sprintf("Dry run: %d rows would be affected", call.affected),
+				Data: &BulkPreview{
+					Affected:          call.affected,
+					ConfirmationToken: token,
+					ExpiresAt:         time.Now().Add(BulkConfirmationTTL).UTC(),
+				},
+			})
+		}
+	}
+}
+
+// BulkClientInterceptor forwards the scope checked by BulkGuard to the
+// service and reads back how many rows the call touched.
+func BulkClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		call, ok := ctx.Value(bulkCallKey{}).(*bulkCall)
+		if !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx = metadata.AppendToOutgoingContext(ctx, call.request.metadata()...)
+
+		var header metadata.MD
+		if err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...); err != nil {
+			return err
+		}
+
+		if values := header.Get(MetadataBulkAffected); len(values) > 0 {
+			if affected, err := strconv.ParseInt(values[0], 10, 64); err == nil {
+				call.affected = affected
+				call.reported = true
+			}
+		}
+
+		return nil
+	}
+}
+
+func (r *BulkRequest) normalize(byMerchant bool) error {
+	if r.MerchantID < 0 {
+		return errBulkInvalidMerchant
+	}
+
+	if r.MerchantID > 0 && !byMerchant {
+		return errBulkMerchantScope
+	}
+
+	if len(r.IDs) > BulkMaxIDs {
+		return errBulkInvalidIDs
+	}
+
+	ids := make([]int, 0, len(r.IDs))
+	seen := make(map[int]struct{}, len(r.IDs))
+	for _, id := range r.IDs {
+		if id <= 0 {
+			return errBulkInvalidIDs
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	r.IDs = ids
+
+	if r.TrashedBefore != nil && r.TrashedBefore.IsZero() {
+		r.TrashedBefore = nil
+	}
+
+	if r.MerchantID == 0 && r.TrashedBefore == nil && len(r.IDs) == 0 {
+		return errBulkScopeRequired
+	}
+
+	return nil
+}
+
+func (r *BulkRequest) metadata() []string {
+	kv := r.scope()
+
+	if r.DryRun {
+		kv = append(kv, MetadataBulkDryRun, "true")
+	}
+
+	return kv
+}
+
+func (r *BulkRequest) scope() []string {
+	var kv []string
+
+	if r.MerchantID > 0 {
+		kv = append(kv, MetadataBulkMerchantID, strconv.Itoa(r.MerchantID))
+	}
+
+	if r.TrashedBefore != nil {
+		kv = append(kv, MetadataBulkTrashedBefore, r.TrashedBefore.UTC().Format(time.RFC3339Nano))
+	}
+
+	if len(r.IDs) > 0 {
+		ids := make([]string, len(r.IDs))
+		for i, id := range r.IDs {
+			ids[i] = strconv.Itoa(id)
+		}
+		kv = append(kv, MetadataBulkIDs, strings.Join(ids, ","))
+	}
+
+	return kv
+}
+
+// fingerprint ties a confirmation token to the route, the user and the
+// scope it was issued for.
+func (r *BulkRequest) fingerprint(method, path string, userID int) string {
+	sum := sha256.Sum256([]byte(strings.Join(append([]string{method, path, strconv.Itoa(userID)}, r.scope()...), "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func newBulkToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// bufferedResponse holds what a handler wrote until BulkGuard decides
+// whether to pass it on.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponse() *bufferedResponse {
+	return &bufferedResponse{
+		header: http.Header{},
+		status: http.StatusOK,
+	}
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponse) flush(res *echo.Response) error {
+	for key, values := range b.header {
+		res.Header()[key] = values
+	}
+
+	res.WriteHeader(b.status)
+	_, err := res.Write(b.body.Bytes())
+
+	return err
+}
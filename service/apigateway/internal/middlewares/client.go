@@ -0,0 +1,28 @@
+package middlewares
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	HeaderDeviceName = "X-Device-Name"
+
+	MetadataDeviceName = "x-device-name"
+	MetadataClientIP   = "x-client-ip"
+	MetadataUserAgent  = "x-client-user-agent"
+)
+
+// WithClientInfo forwards the device making the request, so the auth
+// service can label the session it opens or refreshes.
+func WithClientInfo(c echo.Context) context.Context {
+	req := c.Request()
+
+	return metadata.AppendToOutgoingContext(req.Context(),
+		MetadataDeviceName, req.Header.Get(HeaderDeviceName),
+		MetadataClientIP, c.RealIP(),
+		MetadataUserAgent, req.UserAgent(),
+	)
+}
@@ -0,0 +1,81 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// The auth service writes the same keys; keep the formats in sync with its
+// revocation cache.
+const (
+	keyRevokedToken      = "auth:revoked:jti:%s"
+	keyRevokedUserTokens = "auth:revoked:user:%s"
+	keyRevokedSession    = "auth:revoked:session:%d"
+)
+
+// TokenDenylist reads the access tokens the auth service revoked on logout,
+// either one token by its jti, a whole session, or every token of a user
+// issued up to a point in time.
+type TokenDenylist struct {
+	client *redis.Client
+	logger logger.LoggerInterface
+}
+
+func NewTokenDenylist(client *redis.Client, logger logger.LoggerInterface) *TokenDenylist {
+	return &TokenDenylist{
+		client: client,
+		logger: logger,
+	}
+}
+
+// IsRevoked fails open: when Redis is unreachable the token is accepted and
+// the failure logged, so an outage does not lock every user out.
+func (d *TokenDenylist) IsRevoked(ctx context.Context, claims jwt.MapClaims) bool {
+	subject, _ := claims.GetSubject()
+	jti, _ := claims["jti"].(string)
+
+	// A missing jti or sid maps to a key that is never written.
+	values, err := d.client.MGet(ctx,
+		fmt.Sprintf(keyRevokedToken, jti),
+		fmt.Sprintf(keyRevokedSession, sessionID(claims)),
+		fmt.Sprintf(keyRevokedUserTokens, subject),
+	).Result()
+	if err != nil {
+		d.logger.Error("Failed to check token denylist", zap.String("user_id", subject), zap.Error(err))
+		return false
+	}
+
+	if values[0] != nil || values[1] != nil {
+		return true
+	}
+
+	value, ok := values[2].(string)
+	if !ok {
+		return false
+	}
+
+	revokedAt, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		d.logger.Error("Invalid user token denylist entry", zap.String("user_id", subject), zap.String("value", value))
+		return false
+	}
+
+	var issuedAt time.Time
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		issuedAt = iat.Time
+	}
+
+	return issuedAt.Unix() <= revokedAt
+}
+
+func sessionID(claims jwt.MapClaims) int {
+	sid, _ := claims["sid"].(float64)
+	return int(sid)
+}
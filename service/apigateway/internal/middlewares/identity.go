@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	MetadataUserID    = "x-user-id"
+	MetadataUserRoles = "x-user-roles"
+
+	// MetadataApiKeyMerchantID replaces the user for calls made with an
+	// API key, which may only see its own merchant.
+	MetadataApiKeyMerchantID = "x-api-key-merchant-id"
+)
+
+type identityKey struct{}
+
+type identity struct {
+	userID     int
+	roles      []string
+	merchantID int
+}
+
+func withIdentity(ctx context.Context, userID int, roles map[string]struct{}) context.Context {
+	names := make([]string, 0, len(roles))
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return context.WithValue(ctx, identityKey{}, identity{userID: userID, roles: names})
+}
+
+func withApiKeyIdentity(ctx context.Context, merchantID int) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity{merchantID: merchantID})
+}
+
+// IdentityClientInterceptor forwards the caller resolved by RoleAuthorizer
+// to the backing services, which scope their queries to the caller's
+// merchant from it.
+func IdentityClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		id, ok := ctx.Value(identityKey{}).(identity)
+
+		switch {
+		case ok && id.merchantID > 0:
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataApiKeyMerchantID, strconv.Itoa(id.merchantID))
+		case ok:
+			ctx = metadata.AppendToOutgoingContext(ctx,
+				MetadataUserID, strconv.Itoa(id.userID),
+				MetadataUserRoles, strings.Join(id.roles, ","),
+			)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
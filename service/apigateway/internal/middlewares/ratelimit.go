@@ -0,0 +1,223 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// ContextAPIKeyID is where API key authentication stores the id of the key a
+// request was made with.
+const ContextAPIKeyID = "apiKeyID"
+
+const keyRateLimit = "apigateway:ratelimit:%s:%s:%d"
+
+// Counters are fixed windows aligned to the clock, so every gateway replica
+// counts into the same key. A window's key expires once the window is over.
+var rateLimitScript = redis.NewScript(`
+local current = redis.call("INCR", KEYS[1])
+if current == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return current
+`)
+
+// rateCounter counts the hits on key within a window that ends when the key
+// expires.
+type rateCounter interface {
+	Incr(ctx context.Context, key string, window time.Duration) (int, error)
+}
+
+type redisRateCounter struct {
+	client *redis.Client
+}
+
+func (r redisRateCounter) Incr(ctx context.Context, key string, window time.Duration) (int, error) {
+	return rateLimitScript.Run(ctx, r.client, []string{key}, window.Milliseconds()).Int()
+}
+
+// RateLimitRule limits the requests a client makes to one route group. A rule
+// with no methods matches every method.
+type RateLimitRule struct {
+	Name    string
+	Prefix  string
+	Methods []string
+	Limit   int
+	Window  time.Duration
+}
+
+func (r RateLimitRule) matches(method, path string) bool {
+	if !strings.HasPrefix(path, r.Prefix) {
+		return false
+	}
+
+	if len(r.Methods) == 0 {
+		return true
+	}
+
+	for _, m := range r.Methods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LoadRateLimitRules overrides the defaults from RATE_LIMIT_<NAME> settings
+// written as "<limit>/<window>", for example RATE_LIMIT_LOGIN=10/1m.
+func LoadRateLimitRules(rules []RateLimitRule, log logger.LoggerInterface) []RateLimitRule {
+	loaded := make([]RateLimitRule, len(rules))
+
+	for i, rule := range rules {
+		loaded[i] = rule
+
+		key := "RATE_LIMIT_" + strings.ToUpper(rule.Name)
+		value := viper.GetString(key)
+		if value == "" {
+			continue
+		}
+
+		limit, window, err := parseRateLimit(value)
+		if err != nil {
+			log.Error("Invalid rate limit setting, using default", zap.String("key", key), zap.String("value", value), zap.Error(err))
+			continue
+		}
+
+		loaded[i].Limit = limit
+		loaded[i].Window = window
+	}
+
+	return loaded
+}
+
+func parseRateLimit(value string) (int, time.Duration, error) {
+	limitPart, windowPart, ok := strings.Cut(value, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("expected <limit>/<window>")
+	}
+
+	limit, err := strconv.Atoi(strings.TrimSpace(limitPart))
+	if err != nil || limit <= 0 {
+		return 0, 0, fmt.Errorf("invalid limit %q", limitPart)
+	}
+
+	window, err := time.ParseDuration(strings.TrimSpace(windowPart))
+	if err != nil || window < time.Second {
+		return 0, 0, fmt.Errorf("invalid window %q", windowPart)
+	}
+
+	return limit, window, nil
+}
+
+// RateLimiter counts requests per client and route group in Redis. Clients
+// are told apart by user, then API key, then IP address, so it has to run
+// after authentication.
+type RateLimiter struct {
+	counter rateCounter
+	rules   []RateLimitRule
+	logger  logger.LoggerInterface
+	now     func() time.Time
+}
+
+func NewRateLimiter(client *redis.Client, rules []RateLimitRule, logger logger.LoggerInterface) *RateLimiter {
+	return &RateLimiter{
+		counter: redisRateCounter{client: client},
+		rules:   rules,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Limit applies the first rule matching the request. Like the token denylist
+// it fails open when Redis is unreachable.
+func (rl *RateLimiter) Limit(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+
+		rule, ok := rl.match(req.Method, req.URL.Path)
+		if !ok {
+			return next(c)
+		}
+
+		count, resetIn, err := rl.hit(req.Context(), rule, clientKey(c))
+		if err != nil {
+			rl.logger.Error("Failed to check rate limit", zap.String("rule", rule.Name), zap.Error(err))
+			return next(c)
+		}
+
+		remaining := rule.Limit - count
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		reset := strconv.Itoa(int(math.Ceil(resetIn.Seconds())))
+
+		header := c.Response().Header()
+		header.Set(HeaderRateLimitLimit, strconv.Itoa(rule.Limit))
+		header.Set(HeaderRateLimitRemaining, strconv.Itoa(remaining))
+		header.Set(HeaderRateLimitReset, reset)
+
+		if count > rule.Limit {
+			header.Set(echo.HeaderRetryAfter, reset)
+
+			return response.NewApiErrorResponse(c, "error", "Too many requests, please try again later", http.StatusTooManyRequests)
+		}
+
+		return next(c)
+	}
+}
+
+func (rl *RateLimiter) match(method, path string) (RateLimitRule, bool) {
+	for _, rule := range rl.rules {
+		if rule.matches(method, path) {
+			return rule, true
+		}
+	}
+
+	return RateLimitRule{}, false
+}
+
+func (rl *RateLimiter) hit(ctx context.Context, rule RateLimitRule, client string) (int, time.Duration, error) {
+	window := rule.Window.Milliseconds()
+	now := rl.now().UnixMilli()
+	key := fmt.Sprintf(keyRateLimit, rule.Name, client, now/window)
+
+	count, err := rl.counter.Incr(ctx, key, rule.Window)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return count, time.Duration(window-now%window) * time.Millisecond, nil
+}
+
+func clientKey(c echo.Context) string {
+	if userID := c.Get("userID"); userID != nil {
+		if id := fmt.Sprint(userID); id != "" {
+			return "user:" + id
+		}
+	}
+
+	if keyID := c.Get(ContextAPIKeyID); keyID != nil {
+		return "apikey:" + fmt.Sprint(keyID)
+	}
+
+	return "ip:" + c.RealIP()
+}
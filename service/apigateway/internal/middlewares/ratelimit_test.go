@@ -0,0 +1,302 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Info(string, ...zap.Field)  {}
+func (nopLogger) Fatal(string, ...zap.Field) {}
+func (nopLogger) Debug(string, ...zap.Field) {}
+func (nopLogger) Error(string, ...zap.Field) {}
+
+// fakeCounter counts in memory, keyed like Redis would be.
+type fakeCounter struct {
+	counts map[string]int
+	err    error
+}
+
+func (f *fakeCounter) Incr(_ context.Context, key string, _ time.Duration) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+
+	f.counts[key]++
+
+	return f.counts[key], nil
+}
+
+var testRules = []RateLimitRule{
+	{Name: "login", Prefix: "/api/auth/login", Methods: []string{http.MethodPost}, Limit: 2, Window: time.Minute},
+	{Name: "auth", Prefix: "/api/auth", Limit: 5, Window: time.Minute},
+	{Name: "read", Prefix: "/api", Methods: []string{http.MethodGet}, Limit: 3, Window: 10 * time.Second},
+}
+
+func newTestLimiter(counter *fakeCounter, now time.Time) *RateLimiter {
+	return &RateLimiter{
+		counter: counter,
+		rules:   testRules,
+		logger:  nopLogger{},
+		now:     func() time.Time { return now },
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		limit   int
+		window  time.Duration
+		wantErr bool
+	}{
+		{name: "per minute", value: "10/1m", limit: 10, window: time.Minute},
+		{name: "spaces", value: " 60 / 30s ", limit: 60, window: 30 * time.Second},
+		{name: "missing window", value: "10", wantErr: true},
+		{name: "zero limit", value: "0/1m", wantErr: true},
+		{name: "negative limit", value: "-1/1m", wantErr: true},
+		{name: "bad limit", value: "ten/1m", wantErr: true},
+		{name: "bad window", value: "10/minute", wantErr: true},
+		{name: "window under a second", value: "10/500ms", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, window, err := parseRateLimit(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseRateLimit(%q) = %d, %v, want error", tt.value, limit, window)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseRateLimit(%q) error = %v", tt.value, err)
+			}
+
+			if limit != tt.limit || window != tt.window {
+				t.Errorf("parseRateLimit(%q) = %d, %v, want %d, %v", tt.value, limit, window, tt.limit, tt.window)
+			}
+		})
+	}
+}
+
+func TestLoadRateLimitRules(t *testing.T) {
+	viper.Set("RATE_LIMIT_LOGIN", "20/2m")
+	viper.Set("RATE_LIMIT_AUTH", "not-a-limit")
+	t.Cleanup(func() {
+		viper.Set("RATE_LIMIT_LOGIN", "")
+		viper.Set("RATE_LIMIT_AUTH", "")
+	})
+
+	rules := LoadRateLimitRules(testRules, nopLogger{})
+
+	tests := []struct {
+		name   string
+		rule   RateLimitRule
+		limit  int
+		window time.Duration
+	}{
+		{name: "overridden", rule: rules[0], limit: 20, window: 2 * time.Minute},
+		{name: "invalid keeps default", rule: rules[1], limit: 5, window: time.Minute},
+		{name: "unset keeps default", rule: rules[2], limit: 3, window: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.rule.Limit != tt.limit || tt.rule.Window != tt.window {
+				t.Errorf("rule %s = %d/%v, want %d/%v", tt.rule.Name, tt.rule.Limit, tt.rule.Window, tt.limit, tt.window)
+			}
+		})
+	}
+
+	if testRules[0].Limit != 2 {
+		t.Errorf("defaults were modified: login limit = %d", testRules[0].Limit)
+	}
+}
+
+func TestRateLimiterMatch(t *testing.T) {
+	rl := newTestLimiter(&fakeCounter{counts: map[string]int{}}, time.Now())
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		rule   string
+	}{
+		{name: "login post", method: http.MethodPost, path: "/api/auth/login", rule: "login"},
+		{name: "login get falls through to auth", method: http.MethodGet, path: "/api/auth/login", rule: "auth"},
+		{name: "auth any method", method: http.MethodDelete, path: "/api/auth/sessions/1", rule: "auth"},
+		{name: "read", method: http.MethodGet, path: "/api/product", rule: "read"},
+		{name: "unmatched method", method: http.MethodPost, path: "/api/product"},
+		{name: "unmatched path", method: http.MethodGet, path: "/swagger/index.html"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, ok := rl.match(tt.method, tt.path)
+			if ok != (tt.rule != "") {
+				t.Fatalf("match(%s %s) ok = %v", tt.method, tt.path, ok)
+			}
+
+			if rule.Name != tt.rule {
+				t.Errorf("match(%s %s) = %q, want %q", tt.method, tt.path, rule.Name, tt.rule)
+			}
+		})
+	}
+}
+
+func TestRateLimiterLimit(t *testing.T) {
+	now := time.Unix(1_699_999_995, 0)
+
+	e := echo.New()
+	counter := &fakeCounter{counts: map[string]int{}}
+	handler := newTestLimiter(counter, now).Limit(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		rec := httptest.NewRecorder()
+
+		if err := handler(e.NewContext(req, rec)); err != nil {
+			t.Fatalf("handler error = %v", err)
+		}
+
+		return rec
+	}
+
+	tests := []struct {
+		name       string
+		status     int
+		remaining  string
+		retryAfter string
+	}{
+		{name: "first request", status: http.StatusOK, remaining: "1"},
+		{name: "last allowed", status: http.StatusOK, remaining: "0"},
+		{name: "over the limit", status: http.StatusTooManyRequests, remaining: "0", retryAfter: "45"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve()
+
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.status)
+			}
+
+			if got := rec.Header().Get(HeaderRateLimitLimit); got != "2" {
+				t.Errorf("%s = %q, want %q", HeaderRateLimitLimit, got, "2")
+			}
+
+			if got := rec.Header().Get(HeaderRateLimitRemaining); got != tt.remaining {
+				t.Errorf("%s = %q, want %q", HeaderRateLimitRemaining, got, tt.remaining)
+			}
+
+			if got := rec.Header().Get(HeaderRateLimitReset); got != "45" {
+				t.Errorf("%s = %q, want %q", HeaderRateLimitReset, got, "45")
+			}
+
+			if got := rec.Header().Get(echo.HeaderRetryAfter); got != tt.retryAfter {
+				t.Errorf("%s = %q, want %q", echo.HeaderRetryAfter, got, tt.retryAfter)
+			}
+
+			if tt.status == http.StatusTooManyRequests {
+				var body response.ErrorResponse
+				if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				if body.Status != "error" || body.Code != http.StatusTooManyRequests {
+					t.Errorf("body = %+v, want an error response with code %d", body, http.StatusTooManyRequests)
+				}
+			}
+		})
+	}
+
+	if _, ok := counter.counts["apigateway:ratelimit:login:ip:10.0.0.1:28333333"]; !ok {
+		t.Errorf("counter keys = %v, want the login window for ip:10.0.0.1", counter.counts)
+	}
+}
+
+func TestRateLimiterFailsOpen(t *testing.T) {
+	e := echo.New()
+	rl := newTestLimiter(&fakeCounter{err: errors.New("redis down")}, time.Now())
+
+	called := false
+	handler := rl.Limit(func(c echo.Context) error {
+		called = true
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+	rec := httptest.NewRecorder()
+
+	if err := handler(e.NewContext(req, rec)); err != nil {
+		t.Fatalf("handler error = %v", err)
+	}
+
+	if !called || rec.Code != http.StatusOK {
+		t.Errorf("called = %v, status = %d, want the request let through", called, rec.Code)
+	}
+
+	if got := rec.Header().Get(HeaderRateLimitLimit); got != "" {
+		t.Errorf("%s = %q, want no rate limit headers", HeaderRateLimitLimit, got)
+	}
+}
+
+func TestClientKey(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("203.0.113.0/24")
+
+	tests := []struct {
+		name       string
+		userID     interface{}
+		apiKeyID   interface{}
+		remoteAddr string
+		xff        string
+		want       string
+	}{
+		{name: "user", userID: "42", apiKeyID: 7, remoteAddr: "10.0.0.1:1234", want: "user:42"},
+		{name: "api key", apiKeyID: 7, remoteAddr: "10.0.0.1:1234", want: "apikey:7"},
+		{name: "remote address", remoteAddr: "198.51.100.9:1234", want: "ip:198.51.100.9"},
+		{name: "forwarded by trusted proxy", remoteAddr: "203.0.113.5:1234", xff: "198.51.100.9", want: "ip:198.51.100.9"},
+		{name: "forwarded by untrusted client", remoteAddr: "198.51.100.20:1234", xff: "1.2.3.4", want: "ip:198.51.100.20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.IPExtractor = echo.ExtractIPFromXFFHeader(echo.TrustIPRange(proxies))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/product", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.xff != "" {
+				req.Header.Set(echo.HeaderXForwardedFor, tt.xff)
+			}
+
+			c := e.NewContext(req, httptest.NewRecorder())
+			if tt.userID != nil {
+				c.Set("userID", tt.userID)
+			}
+			if tt.apiKeyID != nil {
+				c.Set(ContextAPIKeyID, tt.apiKeyID)
+			}
+
+			if got := clientKey(c); got != tt.want {
+				t.Errorf("clientKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
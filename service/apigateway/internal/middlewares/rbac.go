@@ -0,0 +1,201 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const roleLookupTimeout = 3 * time.Second
+
+// ChannelRolesChanged is the Redis channel the role service publishes a user
+// id on after that user's roles change.
+const ChannelRolesChanged = "apigateway:roles:changed"
+
+type cachedRoles struct {
+	roles map[string]struct{}
+	// permissions holds the answers the role service gave for this user
+	// while the roles were cached.
+	permissions map[string]bool
+	expiresAt   time.Time
+}
+
+// RoleAuthorizer resolves the caller's roles and permissions through the
+// role service and keeps them per user for ttl, so a route check costs one
+// lookup per user rather than one per request.
+type RoleAuthorizer struct {
+	client pb.RoleServiceClient
+	logger logger.LoggerInterface
+	ttl    time.Duration
+
+	mu    sync.RWMutex
+	cache map[int]cachedRoles
+}
+
+func NewRoleAuthorizer(client pb.RoleServiceClient, logger logger.LoggerInterface, ttl time.Duration) *RoleAuthorizer {
+	return &RoleAuthorizer{
+		client: client,
+		logger: logger,
+		ttl:    ttl,
+		cache:  make(map[int]cachedRoles),
+	}
+}
+
+// RequirePermission lets the request through when one of the caller's roles
+// grants permission, named resource:action as in the permissions table. An
+// API key needs the permission among its own.
+func (a *RoleAuthorizer) RequirePermission(permission string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if key, ok := ApiKeyFromContext(c); ok {
+				if key.MerchantID <= 0 || !key.Allows(permission) {
+					return forbidden(c)
+				}
+
+				ctx := withApiKeyIdentity(c.Request().Context(), key.MerchantID)
+				c.SetRequest(c.Request().WithContext(ctx))
+
+				return next(c)
+			}
+
+			userID, ok := UserIDFromContext(c)
+			if !ok {
+				return forbidden(c)
+			}
+
+			granted, err := a.rolesFor(c.Request().Context(), userID)
+			if err != nil {
+				a.logger.Error("failed to resolve user roles", zap.Int("user_id", userID), zap.Error(err))
+				return forbidden(c)
+			}
+
+			allowed, err := a.permissionFor(c.Request().Context(), userID, permission)
+			if err != nil {
+				a.logger.Error("failed to check user permission", zap.Int("user_id", userID), zap.String("permission", permission), zap.Error(err))
+				return forbidden(c)
+			}
+			if !allowed {
+				return forbidden(c)
+			}
+
+			ctx := withIdentity(c.Request().Context(), userID, granted)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// Invalidate drops the cached roles of a user, e.g. after a role change.
+func (a *RoleAuthorizer) Invalidate(userID int) {
+	a.mu.Lock()
+	delete(a.cache, userID)
+	a.mu.Unlock()
+}
+
+// Listen drops cached roles as the role service announces changes, so every
+// gateway replica sees a promotion or demotion before the ttl runs out. It
+// returns when the subscription closes.
+func (a *RoleAuthorizer) Listen(ctx context.Context, client *redis.Client) {
+	sub := client.Subscribe(ctx, ChannelRolesChanged)
+	defer sub.Close()
+
+	for msg := range sub.Channel() {
+		userID, err := strconv.Atoi(msg.Payload)
+		if err != nil {
+			a.logger.Error("invalid role change message", zap.String("payload", msg.Payload), zap.Error(err))
+			continue
+		}
+
+		a.Invalidate(userID)
+	}
+}
+
+func (a *RoleAuthorizer) rolesFor(ctx context.Context, userID int) (map[string]struct{}, error) {
+	a.mu.RLock()
+	entry, ok := a.cache[userID]
+	a.mu.RUnlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.roles, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, roleLookupTimeout)
+	defer cancel()
+
+	res, err := a.client.FindByUserId(ctx, &pb.FindByIdUserRoleRequest{UserId: int32(userID)})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(map[string]struct{}, len(res.GetData()))
+	for _, role := range res.GetData() {
+		roles[role.GetName()] = struct{}{}
+	}
+
+	a.mu.Lock()
+	a.cache[userID] = cachedRoles{roles: roles, permissions: make(map[string]bool), expiresAt: time.Now().Add(a.ttl)}
+	a.mu.Unlock()
+
+	return roles, nil
+}
+
+// permissionFor asks the role service whether the user holds permission,
+// and remembers the answer next to the user's cached roles.
+func (a *RoleAuthorizer) permissionFor(ctx context.Context, userID int, permission string) (bool, error) {
+	a.mu.RLock()
+	entry, ok := a.cache[userID]
+	allowed, found := entry.permissions[permission]
+	a.mu.RUnlock()
+
+	if ok && found && time.Now().Before(entry.expiresAt) {
+		return allowed, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, roleLookupTimeout)
+	defer cancel()
+
+	res, err := a.client.CheckPermission(ctx, &pb.CheckPermissionRequest{UserId: int32(userID), Permission: permission})
+	if err != nil {
+		return false, err
+	}
+
+	allowed = res.GetAllowed()
+
+	a.mu.Lock()
+	if entry, ok := a.cache[userID]; ok && time.Now().Before(entry.expiresAt) {
+		entry.permissions[permission] = allowed
+	}
+	a.mu.Unlock()
+
+	return allowed, nil
+}
+
+// UserIDFromContext returns the user id that WebSecurityConfig stored from
+// the token subject.
+func UserIDFromContext(c echo.Context) (int, bool) {
+	subject, ok := c.Get("userID").(string)
+	if !ok {
+		return 0, false
+	}
+
+	userID, err := strconv.Atoi(subject)
+	if err != nil || userID <= 0 {
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func forbidden(c echo.Context) error {
+	return response.NewApiErrorResponse(c, "error", "You do not have permission to access this resource", http.StatusForbidden)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/api"
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/event"
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/handler"
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/metrics"
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+func main() {
+	logger, err := logger.NewLogger("audit")
+	if err != nil {
+		log.Fatalf("Error creating logger: %v", err)
+	}
+
+	if err := dotenv.Viper(); err != nil {
+		logger.Fatal("Failed to load .env file", zap.Error(err))
+	}
+
+	conn, err := database.NewClient(logger)
+	if err != nil {
+		logger.Fatal("Failed to connect to database", zap.Error(err))
+	}
+
+	repo := repository.NewAuditRepository(conn)
+
+	metricsAddr := fmt.Sprintf(":%s", viper.GetString("METRIC_AUDIT_ADDR"))
+
+	metrics.Register()
+	go func() {
+		http.Handle("/metrics", promhttp.Handler())
+		log.Fatal(http.ListenAndServe(metricsAddr, nil))
+	}()
+
+	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
+
+	err = myKafka.StartConsumers([]string{event.Topic}, "audit-service-group", &handler.AuditHandler{
+		Repository: repo,
+		Logger:     logger,
+	})
+	if err != nil {
+		logger.Fatal("Error starting consumer", zap.Error(err))
+	}
+
+	apiHandler := &api.Handler{Repository: repo, Logger: logger}
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%s", viper.GetString("HTTP_AUDIT_ADDR")),
+		Handler:           apiHandler.Routes(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	logger.Info("Audit API listening", zap.String("addr", server.Addr))
+
+	if err := server.ListenAndServe(); err != nil {
+		logger.Fatal("Audit API error", zap.Error(err))
+	}
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/event"
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"go.uber.org/zap"
+)
+
+const maxPageSize = 100
+
+type auditLogsResponse struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*event.Log             `json:"data"`
+	Pagination *response.PaginationMeta `json:"pagination"`
+}
+
+// Handler serves the audit trail to the API gateway. It is only reachable
+// inside the cluster; the gateway checks who may read it.
+type Handler struct {
+	Repository *repository.AuditRepository
+	Logger     logger.LoggerInterface
+}
+
+func (h *Handler) Routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /audit-logs", h.search)
+
+	return mux
+}
+
+func (h *Handler) search(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseFilter(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, response.ErrorResponse{Status: "error", Message: err.Error(), Code: http.StatusBadRequest})
+		return
+	}
+
+	logs, total, err := h.Repository.Search(r.Context(), filter)
+	if err != nil {
+		h.Logger.Error("Failed to search audit logs", zap.Error(err))
+		writeJSON(w, http.StatusInternalServerError, response.ErrorResponse{Status: "error", Message: "failed to search audit logs", Code: http.StatusInternalServerError})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, auditLogsResponse{
+		Status:  "success",
+		Message: "Successfully fetched audit logs",
+		Data:    logs,
+		Pagination: &response.PaginationMeta{
+			CurrentPage:  filter.Page,
+			PageSize:     filter.PageSize,
+			TotalPages:   (total + filter.PageSize - 1) / filter.PageSize,
+			TotalRecords: total,
+		},
+	})
+}
+
+type filterError string
+
+func (e filterError) Error() string { return string(e) }
+
+func parseFilter(r *http.Request) (*event.Filter, error) {
+	q := r.URL.Query()
+
+	f := &event.Filter{
+		Entity:   q.Get("entity"),
+		Action:   q.Get("action"),
+		Page:     1,
+		PageSize: 10,
+	}
+
+	ints := []struct {
+		key string
+		set func(int)
+	}{
+		{"actor_id", func(v int) { f.ActorID = v }},
+		{"target_id", func(v int) { f.TargetID = int64(v) }},
+		{"page", func(v int) { f.Page = v }},
+		{"page_size", func(v int) { f.PageSize = v }},
+	}
+
+	for _, p := range ints {
+		raw := q.Get(p.key)
+		if raw == "" {
+			continue
+		}
+
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			return nil, filterError(p.key + " must be a positive integer")
+		}
+		p.set(v)
+	}
+
+	if f.PageSize > maxPageSize {
+		f.PageSize = maxPageSize
+	}
+
+	times := []struct {
+		key string
+		set func(time.Time)
+	}{
+		{"from", func(t time.Time) { f.From = t }},
+		{"to", func(t time.Time) { f.To = t }},
+	}
+
+	for _, p := range times {
+		raw := q.Get(p.key)
+		if raw == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, filterError(p.key + " must be an RFC 3339 time")
+		}
+		p.set(t.UTC())
+	}
+
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		return nil, filterError("from must be before to")
+	}
+
+	return f, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
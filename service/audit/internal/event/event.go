@@ -0,0 +1,46 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Topic carries the audit events every gRPC service publishes from its
+// audit middleware. Keep Event in sync with middleware.AuditEvent there.
+const Topic = "audit-service-topic-events"
+
+type Event struct {
+	EventID          string          `json:"event_id"`
+	Service          string          `json:"service"`
+	Method           string          `json:"method"`
+	Action           string          `json:"action"`
+	Entity           string          `json:"entity"`
+	TargetIDs        []int64         `json:"target_ids"`
+	ActorID          int             `json:"actor_id,omitempty"`
+	ApiKeyMerchantID int             `json:"api_key_merchant_id,omitempty"`
+	RequestID        string          `json:"request_id,omitempty"`
+	Request          json.RawMessage `json:"request,omitempty"`
+	Before           json.RawMessage `json:"before,omitempty"`
+	After            json.RawMessage `json:"after,omitempty"`
+	Code             string          `json:"code"`
+	Error            string          `json:"error,omitempty"`
+	OccurredAt       time.Time       `json:"occurred_at"`
+}
+
+// Log is an event as stored in the audit table.
+type Log struct {
+	ID int64 `json:"id"`
+	Event
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+type Filter struct {
+	ActorID  int
+	Entity   string
+	TargetID int64
+	Action   string
+	From     time.Time
+	To       time.Time
+	Page     int
+	PageSize int
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/event"
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/metrics"
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	insertAttempts = 3
+	insertBackoff  = time.Second
+	insertTimeout  = 5 * time.Second
+)
+
+type AuditHandler struct {
+	Repository *repository.AuditRepository
+	Logger     logger.LoggerInterface
+}
+
+func (h *AuditHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
+func (h *AuditHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim only marks an event once it is stored. When the database
+// stays unavailable the session is ended, so the event is consumed again
+// instead of being skipped.
+func (h *AuditHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		var e event.Event
+		if err := json.Unmarshal(msg.Value, &e); err != nil || e.EventID == "" {
+			h.Logger.Error("Dropping malformed audit event", zap.Int64("offset", msg.Offset), zap.Error(err))
+			metrics.AuditFailed.Inc()
+			sess.MarkMessage(msg, "")
+			continue
+		}
+
+		if err := h.store(sess.Context(), &e); err != nil {
+			h.Logger.Error("Failed to store audit event", zap.String("event_id", e.EventID), zap.Error(err))
+			metrics.AuditFailed.Inc()
+			return err
+		}
+
+		metrics.AuditStored.Inc()
+		sess.MarkMessage(msg, "")
+	}
+
+	return nil
+}
+
+func (h *AuditHandler) store(ctx context.Context, e *event.Event) error {
+	var err error
+
+	for attempt := 0; attempt < insertAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(insertBackoff * time.Duration(attempt)):
+			}
+		}
+
+		insertCtx, cancel := context.WithTimeout(ctx, insertTimeout)
+		err = h.Repository.Insert(insertCtx, e)
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
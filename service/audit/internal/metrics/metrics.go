@@ -0,0 +1,19 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	AuditStored = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "audit_events_stored_total",
+		Help: "Total audit events stored",
+	})
+
+	AuditFailed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "audit_events_failed_total",
+		Help: "Total audit events that could not be stored",
+	})
+)
+
+func Register() {
+	prometheus.MustRegister(AuditStored, AuditFailed)
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-audit/internal/event"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrInsertAuditLog  = errors.New("failed to insert audit log")
+	ErrSearchAuditLogs = errors.New("failed to search audit logs")
+)
+
+// Events are delivered at least once; a redelivered event is ignored.
+const insertAuditLog = `-- name: InsertAuditLog :exec
+INSERT INTO audit_logs (
+    event_id, service, method, action, entity, target_ids, actor_id, api_key_merchant_id,
+    request_id, request, before, after, code, error, occurred_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+)
+ON CONFLICT (event_id) DO NOTHING
+`
+
+const searchAuditLogs = `-- name: SearchAuditLogs :many
+SELECT
+    audit_log_id, event_id, service, method, action, entity, target_ids, actor_id, api_key_merchant_id,
+    request_id, request, before, after, code, error, occurred_at, recorded_at,
+    COUNT(*) OVER() AS total_count
+FROM audit_logs
+WHERE ($1::int = 0 OR actor_id = $1)
+  AND ($2::text = '' OR entity = $2)
+  AND ($3::bigint = 0 OR target_ids @> ARRAY[$3::bigint])
+  AND ($4::text = '' OR action = $4)
+  AND ($5::timestamp IS NULL OR occurred_at >= $5)
+  AND ($6::timestamp IS NULL OR occurred_at < $6)
+ORDER BY occurred_at DESC, audit_log_id DESC
+LIMIT $7 OFFSET $8
+`
+
+type AuditRepository struct {
+	conn *sql.DB
+}
+
+func NewAuditRepository(conn *sql.DB) *AuditRepository {
+	return &AuditRepository{conn: conn}
+}
+
+func (r *AuditRepository) Insert(ctx context.Context, e *event.Event) error {
+	targetIDs := e.TargetIDs
+	if targetIDs == nil {
+		targetIDs = []int64{}
+	}
+
+	_, err := r.conn.ExecContext(ctx, insertAuditLog,
+		e.EventID,
+		e.Service,
+		e.Method,
+		e.Action,
+		e.Entity,
+		pq.Array(targetIDs),
+		nullInt(e.ActorID),
+		nullInt(e.ApiKeyMerchantID),
+		e.RequestID,
+		nullJSON(e.Request),
+		nullJSON(e.Before),
+		nullJSON(e.After),
+		e.Code,
+		e.Error,
+		e.OccurredAt,
+	)
+	if err != nil {
+		return ErrInsertAuditLog
+	}
+
+	return nil
+}
+
+func (r *AuditRepository) Search(ctx context.Context, f *event.Filter) ([]*event.Log, int, error) {
+	rows, err := r.conn.QueryContext(ctx, searchAuditLogs,
+		f.ActorID,
+		f.Entity,
+		f.TargetID,
+		f.Action,
+		nullTime(f.From),
+		nullTime(f.To),
+		f.PageSize,
+		(f.Page-1)*f.PageSize,
+	)
+	if err != nil {
+		return nil, 0, ErrSearchAuditLogs
+	}
+	defer rows.Close()
+
+	logs := []*event.Log{}
+	total := 0
+
+	for rows.Next() {
+		var (
+			l                         event.Log
+			actorID, apiKeyMerchantID sql.NullInt64
+			request, before, after    []byte
+		)
+
+		if err := rows.Scan(
+			&l.ID, &l.EventID, &l.Service, &l.Method, &l.Action, &l.Entity, pq.Array(&l.TargetIDs),
+			&actorID, &apiKeyMerchantID, &l.RequestID, &request, &before, &after,
+			&l.Code, &l.Error, &l.OccurredAt, &l.RecordedAt, &total,
+		); err != nil {
+			return nil, 0, ErrSearchAuditLogs
+		}
+
+		l.ActorID = int(actorID.Int64)
+		l.ApiKeyMerchantID = int(apiKeyMerchantID.Int64)
+		l.Request, l.Before, l.After = request, before, after
+
+		logs = append(logs, &l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, ErrSearchAuditLogs
+	}
+
+	return logs, total, nil
+}
+
+func nullInt(v int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(v), Valid: v != 0}
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// lib/pq sends []byte as bytea, so JSON goes over the wire as text.
+func nullJSON(raw json.RawMessage) sql.NullString {
+	return sql.NullString{String: string(raw), Valid: len(raw) > 0}
+}
@@ -3,8 +3,10 @@ module github.com/MamangRust/monolith-point-of-sale-auth
 go 1.23.4
 
 require (
+	github.com/MamangRust/monolith-point-of-sale-common v0.0.0
 	github.com/MamangRust/monolith-point-of-sale-pkg v1.0.7
-	github.com/MamangRust/monolith-point-of-sale-shared v1.0.8
+	github.com/MamangRust/monolith-point-of-sale-shared v1.0.9
+	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/prometheus/client_golang v1.22.0
 	github.com/redis/go-redis/v9 v9.10.0
 	github.com/spf13/viper v1.20.1
@@ -33,7 +35,6 @@ require (
 	github.com/go-playground/universal-translator v0.18.1 // indirect
 	github.com/go-playground/validator/v10 v10.26.0 // indirect
 	github.com/go-viper/mapstructure/v2 v2.2.1 // indirect
-	github.com/golang-jwt/jwt/v5 v5.2.2 // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.3 // indirect
@@ -83,3 +84,6 @@ require (
 	google.golang.org/protobuf v1.36.6 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/MamangRust/monolith-point-of-sale-common => ../common
+replace github.com/MamangRust/monolith-point-of-sale-shared => ../shared
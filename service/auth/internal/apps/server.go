@@ -12,11 +12,14 @@ import (
 
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/errorhandler"
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/handler"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/mfa"
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/middleware"
 	mencache "github.com/MamangRust/monolith-point-of-sale-auth/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/repository"
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/service"
-	"github.com/MamangRust/monolith-point-of-sale-pkg/auth"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/token"
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
@@ -50,9 +53,11 @@ func init() {
 type Server struct {
 	Logger       logger.LoggerInterface
 	DB           *db.Queries
-	TokenManager *auth.Manager
+	TokenManager *token.Manager
 	Services     *service.Service
 	Handlers     *handler.Handler
+	Relay        *outbox.Relay
+	Conn         *audit.DB
 	Ctx          context.Context
 }
 
@@ -70,25 +75,26 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		return nil, nil, err
 	}
 
-	tokenManager, err := auth.NewManager(viper.GetString("SECRET_KEY"))
+	tokenManager, err := token.NewManager(viper.GetString("SECRET_KEY"))
 	if err != nil {
 		logger.Fatal("Failed to create token manager", zap.Error(err))
 
 		return nil, nil, err
 	}
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 
 		return nil, nil, err
 	}
 
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
 	hash := hash.NewHashingPassword()
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 
 	kafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
 
@@ -127,9 +133,11 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Hash:         hash,
 		Token:        tokenManager,
 		Logger:       logger,
-		Kafka:        kafka,
+		MfaPolicy:    mfa.ParsePolicy(viper.GetString("MFA_REQUIRED_ROLES")),
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, kafka, logger)
+
 	handlers := handler.NewHandler(&handler.Deps{
 		Service: services,
 	})
@@ -140,6 +148,8 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		TokenManager: tokenManager,
 		Services:     services,
 		Handlers:     handlers,
+		Relay:        relay,
+		Conn:         conn,
 		Ctx:          ctx,
 	}, shutdownTracerProvider, nil
 }
@@ -170,6 +180,31 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "auth",
+				RequestID: middleware.RequestID,
+				Entities: map[string]string{
+					"pb.AuthService": "user",
+				},
+				Actions: map[string]string{
+					pb.AuthService_RegisterUser_FullMethodName:         "create",
+					pb.AuthService_VerifyCode_FullMethodName:           "verify_email",
+					pb.AuthService_ForgotPassword_FullMethodName:       "forgot_password",
+					pb.AuthService_ResetPassword_FullMethodName:        "reset_password",
+					pb.AuthService_LoginUser_FullMethodName:            "login",
+					pb.AuthService_VerifyMfa_FullMethodName:            "verify_mfa",
+					pb.AuthService_RefreshToken_FullMethodName:         "refresh_token",
+					pb.AuthService_Logout_FullMethodName:               "logout",
+					pb.AuthService_LogoutAllSessions_FullMethodName:    "logout_all",
+					pb.AuthService_RevokeSession_FullMethodName:        "revoke_session",
+					pb.AuthService_BeginMfaEnrollment_FullMethodName:   "begin_mfa_enrollment",
+					pb.AuthService_ConfirmMfaEnrollment_FullMethodName: "enable_mfa",
+					pb.AuthService_DisableMfa_FullMethodName:           "disable_mfa",
+				},
+				// One-time codes and the enrollment secret are as good as
+				// a password.
+				Redact: []string{"code", "challenge", "recovery_codes", "provisioning_uri"},
+			}, s.Logger),
 		),
 	)
 
@@ -180,6 +215,8 @@ func (s *Server) Run() {
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
@@ -7,6 +7,7 @@ type ErrorHandler struct {
 	KafkaError         KafkaErrorHandler
 	LoginError         LoginErrorHandler
 	MarshalError       MarshalErrorHandler
+	MfaError           MfaErrorHandler
 	PasswordError      PasswordErrorHandler
 	PasswordResetError PasswordResetErrorHandler
 	RandomString       RandomStringErrorHandler
@@ -20,6 +21,7 @@ func NewErrorHandler(logger logger.LoggerInterface) *ErrorHandler {
 		KafkaError:         NewKafkaError(logger),
 		LoginError:         NewLoginError(logger),
 		MarshalError:       NewMarshalError(logger),
+		MfaError:           NewMfaError(logger),
 		PasswordError:      NewPasswordError(logger),
 		PasswordResetError: NewPasswordResetError(logger),
 		RandomString:       NewRandomStringError(logger),
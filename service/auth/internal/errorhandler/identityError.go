@@ -1,6 +1,10 @@
 package errorhandler
 
 import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	refreshtoken_errors "github.com/MamangRust/monolith-point-of-sale-shared/errors/refresh_token_errors"
@@ -9,6 +13,15 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	ErrFailedTokenRevoked  = response.NewErrorResponse("Token has been revoked", http.StatusUnauthorized)
+	ErrFailedLogout        = response.NewErrorResponse("Failed to logout", http.StatusInternalServerError)
+	ErrRefreshTokenReused  = response.NewErrorResponse("Refresh token has already been used", http.StatusUnauthorized)
+	ErrFailedFindSessions  = response.NewErrorResponse("Failed to find sessions", http.StatusInternalServerError)
+	ErrSessionNotFound     = response.NewErrorResponse("Session not found", http.StatusNotFound)
+	ErrFailedRevokeSession = response.NewErrorResponse("Failed to revoke session", http.StatusInternalServerError)
+)
+
 type identityError struct {
 	logger logger.LoggerInterface
 }
@@ -143,3 +156,98 @@ func (e *identityError) HandleGetMeError(
 		fields...,
 	)
 }
+
+func (e *identityError) HandleRevokedTokenError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (*response.TokenResponse, *response.ErrorResponse) {
+	return HandleTokenError[*response.TokenResponse](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		ErrFailedTokenRevoked,
+		fields...,
+	)
+}
+
+func (e *identityError) HandleLogoutError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (bool, *response.ErrorResponse) {
+	return handleErrorRepository[bool](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		ErrFailedLogout,
+		fields...,
+	)
+}
+
+func (e *identityError) HandleLogoutTokenError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (bool, *response.ErrorResponse) {
+	return HandleTokenError[bool](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		refreshtoken_errors.ErrFailedInValidToken,
+		fields...,
+	)
+}
+
+func (e *identityError) HandleRefreshTokenReusedError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (*response.TokenResponse, *response.ErrorResponse) {
+	return HandleTokenError[*response.TokenResponse](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		ErrRefreshTokenReused,
+		fields...,
+	)
+}
+
+func (e *identityError) HandleFindSessionsError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) ([]*session.Session, *response.ErrorResponse) {
+	return handleErrorRepository[[]*session.Session](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		ErrFailedFindSessions,
+		fields...,
+	)
+}
+
+func (e *identityError) HandleRevokeSessionError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (bool, *response.ErrorResponse) {
+	errResp := ErrFailedRevokeSession
+	if errors.Is(err, session.ErrNotFound) {
+		errResp = ErrSessionNotFound
+	}
+
+	return handleErrorRepository[bool](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		errResp,
+		fields...,
+	)
+}
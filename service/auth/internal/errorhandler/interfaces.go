@@ -1,6 +1,8 @@
 package errorhandler
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/mfa"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -28,6 +30,39 @@ type IdentityErrorHandler interface {
 	HandleFindByIdError(
 		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
 	) (*response.UserResponse, *response.ErrorResponse)
+	HandleRevokedTokenError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (*response.TokenResponse, *response.ErrorResponse)
+	HandleLogoutError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (bool, *response.ErrorResponse)
+	HandleLogoutTokenError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (bool, *response.ErrorResponse)
+	HandleRefreshTokenReusedError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (*response.TokenResponse, *response.ErrorResponse)
+	HandleFindSessionsError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) ([]*session.Session, *response.ErrorResponse)
+	HandleRevokeSessionError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (bool, *response.ErrorResponse)
+}
+
+type MfaErrorHandler interface {
+	HandleEnrollError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (*mfa.Enrollment, *response.ErrorResponse)
+	HandleConfirmEnrollmentError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) ([]string, *response.ErrorResponse)
+	HandleDisableError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (bool, *response.ErrorResponse)
+	HandleVerifyError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (*response.TokenResponse, *response.ErrorResponse)
 }
 
 type KafkaErrorHandler interface {
@@ -46,6 +81,12 @@ type LoginErrorHandler interface {
 	HandleFindEmailError(
 		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
 	) (*response.TokenResponse, *response.ErrorResponse)
+	HandleTooManyAttemptsError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (*response.TokenResponse, *response.ErrorResponse)
+	HandleAccountLockedError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (*response.TokenResponse, *response.ErrorResponse)
 }
 
 type MarshalErrorHandler interface {
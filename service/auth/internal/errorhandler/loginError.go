@@ -1,6 +1,8 @@
 package errorhandler
 
 import (
+	"net/http"
+
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/user_errors"
@@ -8,6 +10,11 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	ErrTooManyLoginAttempts = response.NewErrorResponse("Too many failed login attempts, try again later", http.StatusTooManyRequests)
+	ErrAccountLocked        = response.NewErrorResponse("Account is temporarily locked after too many failed login attempts", http.StatusLocked)
+)
+
 type loginError struct {
 	logger logger.LoggerInterface
 }
@@ -32,3 +39,33 @@ func (e *loginError) HandleFindEmailError(
 		fields...,
 	)
 }
+
+func (e *loginError) HandleTooManyAttemptsError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (*response.TokenResponse, *response.ErrorResponse) {
+	return handleErrorRepository[*response.TokenResponse](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		ErrTooManyLoginAttempts,
+		fields...,
+	)
+}
+
+func (e *loginError) HandleAccountLockedError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (*response.TokenResponse, *response.ErrorResponse) {
+	return handleErrorRepository[*response.TokenResponse](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		ErrAccountLocked,
+		fields...,
+	)
+}
@@ -0,0 +1,113 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/mfa"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrMfaRequired           = response.NewErrorResponse("Two-factor authentication code required", http.StatusUnauthorized)
+	ErrMfaEnrollmentRequired = response.NewErrorResponse("Two-factor authentication is required for your role", http.StatusForbidden)
+	ErrInvalidMfaCode        = response.NewErrorResponse("Invalid two-factor authentication code", http.StatusUnauthorized)
+	ErrMfaChallengeInvalid   = response.NewErrorResponse("Two-factor challenge is invalid or expired", http.StatusUnauthorized)
+	ErrMfaAlreadyEnabled     = response.NewErrorResponse("Two-factor authentication is already enabled", http.StatusConflict)
+	ErrMfaNotEnabled         = response.NewErrorResponse("Two-factor authentication is not enabled", http.StatusBadRequest)
+	ErrFailedMfaEnrollment   = response.NewErrorResponse("Failed to enroll two-factor authentication", http.StatusInternalServerError)
+	ErrFailedDisableMfa      = response.NewErrorResponse("Failed to disable two-factor authentication", http.StatusInternalServerError)
+	ErrFailedVerifyMfa       = response.NewErrorResponse("Failed to verify two-factor authentication", http.StatusInternalServerError)
+)
+
+type mfaError struct {
+	logger logger.LoggerInterface
+}
+
+func NewMfaError(logger logger.LoggerInterface) *mfaError {
+	return &mfaError{
+		logger: logger,
+	}
+}
+
+func (e *mfaError) HandleEnrollError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (*mfa.Enrollment, *response.ErrorResponse) {
+	return handleErrorRepository[*mfa.Enrollment](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		mfaErrorResponse(err, ErrFailedMfaEnrollment),
+		fields...,
+	)
+}
+
+func (e *mfaError) HandleConfirmEnrollmentError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) ([]string, *response.ErrorResponse) {
+	return handleErrorRepository[[]string](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		mfaErrorResponse(err, ErrFailedMfaEnrollment),
+		fields...,
+	)
+}
+
+func (e *mfaError) HandleDisableError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (bool, *response.ErrorResponse) {
+	return handleErrorRepository[bool](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		mfaErrorResponse(err, ErrFailedDisableMfa),
+		fields...,
+	)
+}
+
+func (e *mfaError) HandleVerifyError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (*response.TokenResponse, *response.ErrorResponse) {
+	return handleErrorRepository[*response.TokenResponse](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		mfaErrorResponse(err, ErrFailedVerifyMfa),
+		fields...,
+	)
+}
+
+func mfaErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, mfa.ErrRequired):
+		return ErrMfaRequired
+	case errors.Is(err, mfa.ErrEnrollmentRequired):
+		return ErrMfaEnrollmentRequired
+	case errors.Is(err, mfa.ErrInvalidCode):
+		return ErrInvalidMfaCode
+	case errors.Is(err, mfa.ErrChallengeInvalid):
+		return ErrMfaChallengeInvalid
+	case errors.Is(err, mfa.ErrAlreadyEnabled):
+		return ErrMfaAlreadyEnabled
+	case errors.Is(err, mfa.ErrNotEnrolled):
+		return ErrMfaNotEnabled
+	default:
+		return fallback
+	}
+}
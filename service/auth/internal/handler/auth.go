@@ -2,8 +2,10 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/service"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	protomapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/proto"
@@ -16,6 +18,7 @@ type authHandleGrpc struct {
 	loginService         service.LoginService
 	passwordResetService service.PasswordResetService
 	identifyService      service.IdentifyService
+	mfaService           service.MfaService
 	mapping              protomapper.AuthProtoMapper
 }
 
@@ -25,6 +28,7 @@ func NewAuthHandleGrpc(authService *service.Service) pb.AuthServiceServer {
 		loginService:         authService.Login,
 		passwordResetService: authService.PasswordReset,
 		identifyService:      authService.Identify,
+		mfaService:           authService.Mfa,
 		mapping:              protomapper.NewAuthProtoMapper(),
 	}
 }
@@ -98,6 +102,102 @@ func (s *authHandleGrpc) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.A
 	return s.mapping.ToProtoResponseGetMe("success", "Refresh token successful", res), nil
 }
 
+func (s *authHandleGrpc) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.ApiResponseLogout, error) {
+	_, err := s.identifyService.Logout(ctx, req.AccessToken, req.RefreshToken)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return s.mapping.ToProtoResponseLogout("success", "Successfully logged out"), nil
+}
+
+func (s *authHandleGrpc) LogoutAllSessions(ctx context.Context, req *pb.LogoutAllSessionsRequest) (*pb.ApiResponseLogout, error) {
+	_, err := s.identifyService.LogoutAllSessions(ctx, int(req.UserId))
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return s.mapping.ToProtoResponseLogout("success", "Successfully logged out of all sessions"), nil
+}
+
+func (s *authHandleGrpc) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ApiResponseSessions, error) {
+	sessions, err := s.identifyService.ListSessions(ctx, int(req.UserId))
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	data := make([]*pb.SessionResponse, len(sessions))
+	for i, sess := range sessions {
+		data[i] = toProtoSession(sess)
+	}
+
+	return &pb.ApiResponseSessions{
+		Status:  "success",
+		Message: "Successfully fetched sessions",
+		Data:    data,
+	}, nil
+}
+
+func (s *authHandleGrpc) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.ApiResponseRevokeSession, error) {
+	_, err := s.identifyService.RevokeSession(ctx, int(req.UserId), int(req.SessionId))
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return s.mapping.ToProtoResponseRevokeSession("success", "Successfully revoked session"), nil
+}
+
+func (s *authHandleGrpc) VerifyMfa(ctx context.Context, req *pb.VerifyMfaRequest) (*pb.ApiResponseLogin, error) {
+	res, err := s.loginService.VerifyMfa(ctx, req.Challenge, req.Code)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return s.mapping.ToProtoResponseLogin("success", "Login successful", res), nil
+}
+
+func (s *authHandleGrpc) BeginMfaEnrollment(ctx context.Context, req *pb.BeginMfaEnrollmentRequest) (*pb.ApiResponseMfaEnrollment, error) {
+	enrollment, err := s.mfaService.BeginEnrollment(ctx, int(req.UserId))
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseMfaEnrollment{
+		Status:  "success",
+		Message: "Scan the provisioning URI and confirm with a code to enable two-factor authentication",
+		Data: &pb.MfaEnrollmentResponse{
+			Secret:          enrollment.Secret,
+			ProvisioningUri: enrollment.ProvisioningURI,
+		},
+	}, nil
+}
+
+func (s *authHandleGrpc) ConfirmMfaEnrollment(ctx context.Context, req *pb.ConfirmMfaEnrollmentRequest) (*pb.ApiResponseMfaRecoveryCodes, error) {
+	codes, err := s.mfaService.ConfirmEnrollment(ctx, int(req.UserId), req.Code)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return s.mapping.ToProtoResponseMfaRecoveryCodes("success", "Two-factor authentication enabled", codes), nil
+}
+
+func (s *authHandleGrpc) DisableMfa(ctx context.Context, req *pb.DisableMfaRequest) (*pb.ApiResponseDisableMfa, error) {
+	_, err := s.mfaService.Disable(ctx, int(req.UserId), req.Code)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return s.mapping.ToProtoResponseDisableMfa("success", "Two-factor authentication disabled"), nil
+}
+
 func (s *authHandleGrpc) RegisterUser(ctx context.Context, req *pb.RegisterRequest) (*pb.ApiResponseRegister, error) {
 	request := &requests.RegisterRequest{
 		FirstName:       req.Firstname,
@@ -114,3 +214,15 @@ func (s *authHandleGrpc) RegisterUser(ctx context.Context, req *pb.RegisterReque
 
 	return s.mapping.ToProtoResponseRegister("success", "Registration successful", res), nil
 }
+
+func toProtoSession(sess *session.Session) *pb.SessionResponse {
+	return &pb.SessionResponse{
+		Id:         int32(sess.ID),
+		DeviceName: sess.DeviceName,
+		IpAddress:  sess.IPAddress,
+		UserAgent:  sess.UserAgent,
+		CreatedAt:  sess.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: sess.LastUsedAt.Format(time.RFC3339),
+		ExpiresAt:  sess.ExpiresAt.Format(time.RFC3339),
+	}
+}
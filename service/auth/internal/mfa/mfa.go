@@ -0,0 +1,90 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Issuer is the service name authenticator apps show above the account.
+const Issuer = "PointOfSale"
+
+// ChallengeTTL is how long the second step of a login may take.
+const ChallengeTTL = 5 * time.Minute
+
+// MaxChallengeAttempts is how many wrong codes a challenge survives.
+const MaxChallengeAttempts = 5
+
+// MetadataChallenge is the response header carrying the challenge token when
+// Login asks for a second factor.
+const MetadataChallenge = "x-mfa-challenge"
+
+var (
+	ErrNotEnrolled        = errors.New("two-factor authentication is not enabled")
+	ErrAlreadyEnabled     = errors.New("two-factor authentication is already enabled")
+	ErrInvalidCode        = errors.New("invalid two-factor authentication code")
+	ErrChallengeInvalid   = errors.New("two-factor challenge is invalid or expired")
+	ErrRequired           = errors.New("two-factor authentication code required")
+	ErrEnrollmentRequired = errors.New("role requires two-factor authentication")
+)
+
+// Factor is a user's TOTP secret. It only protects logins once Enabled, which
+// happens when the first code generated from it is verified.
+type Factor struct {
+	UserID       int
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64
+}
+
+// Enrollment is what an authenticator app needs to start generating codes.
+type Enrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// Policy lists the roles whose members must use a second factor.
+type Policy struct {
+	roles map[string]struct{}
+}
+
+// ParsePolicy reads a comma separated list of role names, for example
+// "ROLE_ADMIN,ROLE_MERCHANT".
+func ParsePolicy(value string) Policy {
+	policy := Policy{roles: make(map[string]struct{})}
+
+	for _, role := range strings.Split(value, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			policy.roles[role] = struct{}{}
+		}
+	}
+
+	return policy
+}
+
+func (p Policy) Empty() bool {
+	return len(p.roles) == 0
+}
+
+func (p Policy) Requires(roles []string) bool {
+	for _, role := range roles {
+		if _, ok := p.roles[role]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewChallenge returns the opaque token Login hands out in place of tokens
+// when a second factor is needed.
+func NewChallenge() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -59,3 +59,48 @@ func DeleteFromCache(ctx context.Context, store *CacheStore, key string) {
 		store.logger.Error("Failed to delete cache", zap.Error(err), zap.String("cacheKey", key))
 	}
 }
+
+// IncrementInCache bumps a counter whose window starts at its first
+// increment and lasts for window.
+func IncrementInCache(ctx context.Context, store *CacheStore, key string, window time.Duration) (int64, bool) {
+	count, err := store.redis.Incr(ctx, key).Result()
+	if err != nil {
+		store.logger.Error("Failed to increment cache", zap.Error(err), zap.String("cacheKey", key))
+		return 0, false
+	}
+
+	if count == 1 {
+		if err := store.redis.Expire(ctx, key, window).Err(); err != nil {
+			store.logger.Error("Failed to set cache expiration", zap.Error(err), zap.String("cacheKey", key))
+		}
+	}
+
+	return count, true
+}
+
+// TTLFromCache returns how long key has left, or false when it is missing
+// or never expires.
+func TTLFromCache(ctx context.Context, store *CacheStore, key string) (time.Duration, bool) {
+	ttl, err := store.redis.PTTL(ctx, key).Result()
+	if err != nil {
+		store.logger.Error("Redis ttl error", zap.Error(err), zap.String("cacheKey", key))
+		return 0, false
+	}
+
+	if ttl <= 0 {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+// TakeFromCache deletes key and reports whether it existed.
+func TakeFromCache(ctx context.Context, store *CacheStore, key string) bool {
+	n, err := store.redis.Del(ctx, key).Result()
+	if err != nil {
+		store.logger.Error("Failed to delete cache", zap.Error(err), zap.String("cacheKey", key))
+		return false
+	}
+
+	return n > 0
+}
@@ -19,6 +19,22 @@ type IdentityCache interface {
 type LoginCache interface {
 	SetCachedLogin(ctx context.Context, email string, data *response.TokenResponse, expiration time.Duration)
 	GetCachedLogin(ctx context.Context, email string) (*response.TokenResponse, bool)
+	DeleteCachedLogin(ctx context.Context, email string)
+	RecordFailedLogin(ctx context.Context, email string, ip string, window time.Duration) (int, int)
+	FailedLoginsByIP(ctx context.Context, ip string) (int, time.Duration)
+	ClearFailedLogins(ctx context.Context, email string)
+	SetLoginBackoff(ctx context.Context, email string, backoff time.Duration)
+	LoginBackoff(ctx context.Context, email string) (time.Duration, bool)
+	LockAccount(ctx context.Context, email string, duration time.Duration)
+	AccountLockedFor(ctx context.Context, email string) (time.Duration, bool)
+	UnlockAccount(ctx context.Context, email string) bool
+}
+
+type MfaCache interface {
+	SetMfaChallenge(ctx context.Context, challenge string, userID int, expiration time.Duration)
+	GetMfaChallenge(ctx context.Context, challenge string) (int, bool)
+	FailMfaChallenge(ctx context.Context, challenge string, expiration time.Duration) int
+	TakeMfaChallenge(ctx context.Context, challenge string) bool
 }
 
 type PasswordResetCache interface {
@@ -31,3 +47,12 @@ type PasswordResetCache interface {
 type RegisterCache interface {
 	SetVerificationCodeCache(ctx context.Context, email string, code string, expiration time.Duration)
 }
+
+type RevocationCache interface {
+	RevokeToken(ctx context.Context, jti string, expiration time.Duration)
+	IsTokenRevoked(ctx context.Context, jti string) bool
+	RevokeUserTokens(ctx context.Context, userID int, before time.Time, expiration time.Duration)
+	UserTokensRevokedAt(ctx context.Context, userID int) (time.Time, bool)
+	RevokeSession(ctx context.Context, sessionID int, expiration time.Duration)
+	IsSessionRevoked(ctx context.Context, sessionID int) bool
+}
@@ -8,7 +8,13 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
 
-var keylogin = "auth:login:%s"
+var (
+	keylogin              = "auth:login:%s"
+	keyLoginFailedByEmail = "auth:login:failed:email:%s"
+	keyLoginFailedByIP    = "auth:login:failed:ip:%s"
+	keyLoginBackoff       = "auth:login:backoff:%s"
+	keyLoginLocked        = "auth:login:locked:%s"
+)
 
 type loginCache struct {
 	store *CacheStore
@@ -39,3 +45,67 @@ func (s *loginCache) SetCachedLogin(ctx context.Context, email string, data *res
 
 	SetToCache(ctx, s.store, key, data, expiration)
 }
+
+func (s *loginCache) DeleteCachedLogin(ctx context.Context, email string) {
+	key := fmt.Sprintf(keylogin, email)
+
+	DeleteFromCache(ctx, s.store, key)
+}
+
+// RecordFailedLogin counts a failed attempt against the email and, when
+// known, the client IP. Counters reset window after their first failure.
+func (s *loginCache) RecordFailedLogin(ctx context.Context, email string, ip string, window time.Duration) (int, int) {
+	byEmail, _ := IncrementInCache(ctx, s.store, fmt.Sprintf(keyLoginFailedByEmail, email), window)
+
+	var byIP int64
+	if ip != "" {
+		byIP, _ = IncrementInCache(ctx, s.store, fmt.Sprintf(keyLoginFailedByIP, ip), window)
+	}
+
+	return int(byEmail), int(byIP)
+}
+
+func (s *loginCache) FailedLoginsByIP(ctx context.Context, ip string) (int, time.Duration) {
+	key := fmt.Sprintf(keyLoginFailedByIP, ip)
+
+	count, found := GetFromCache[int](ctx, s.store, key)
+	if !found || count == nil {
+		return 0, 0
+	}
+
+	ttl, _ := TTLFromCache(ctx, s.store, key)
+
+	return *count, ttl
+}
+
+func (s *loginCache) ClearFailedLogins(ctx context.Context, email string) {
+	DeleteFromCache(ctx, s.store, fmt.Sprintf(keyLoginFailedByEmail, email))
+	DeleteFromCache(ctx, s.store, fmt.Sprintf(keyLoginBackoff, email))
+}
+
+func (s *loginCache) SetLoginBackoff(ctx context.Context, email string, backoff time.Duration) {
+	blocked := true
+
+	SetToCache(ctx, s.store, fmt.Sprintf(keyLoginBackoff, email), &blocked, backoff)
+}
+
+func (s *loginCache) LoginBackoff(ctx context.Context, email string) (time.Duration, bool) {
+	return TTLFromCache(ctx, s.store, fmt.Sprintf(keyLoginBackoff, email))
+}
+
+func (s *loginCache) LockAccount(ctx context.Context, email string, duration time.Duration) {
+	locked := true
+
+	SetToCache(ctx, s.store, fmt.Sprintf(keyLoginLocked, email), &locked, duration)
+}
+
+func (s *loginCache) AccountLockedFor(ctx context.Context, email string) (time.Duration, bool) {
+	return TTLFromCache(ctx, s.store, fmt.Sprintf(keyLoginLocked, email))
+}
+
+// UnlockAccount lifts a lockout early and reports whether there was one.
+func (s *loginCache) UnlockAccount(ctx context.Context, email string) bool {
+	s.ClearFailedLogins(ctx, email)
+
+	return TakeFromCache(ctx, s.store, fmt.Sprintf(keyLoginLocked, email))
+}
@@ -0,0 +1,54 @@
+package mencache
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+var (
+	keyMfaChallenge         = "auth:mfa:challenge:%s"
+	keyMfaChallengeAttempts = "auth:mfa:challenge:attempts:%s"
+)
+
+type mfaCache struct {
+	store *CacheStore
+}
+
+func NewMfaCache(store *CacheStore) *mfaCache {
+	return &mfaCache{store: store}
+}
+
+func (c *mfaCache) SetMfaChallenge(ctx context.Context, challenge string, userID int, expiration time.Duration) {
+	key := fmt.Sprintf(keyMfaChallenge, challenge)
+
+	SetToCache(ctx, c.store, key, &userID, expiration)
+}
+
+func (c *mfaCache) GetMfaChallenge(ctx context.Context, challenge string) (int, bool) {
+	key := fmt.Sprintf(keyMfaChallenge, challenge)
+
+	userID, found := GetFromCache[int](ctx, c.store, key)
+	if !found || userID == nil {
+		return 0, false
+	}
+
+	return *userID, true
+}
+
+// FailMfaChallenge counts a wrong code and returns the failures so far.
+func (c *mfaCache) FailMfaChallenge(ctx context.Context, challenge string, expiration time.Duration) int {
+	key := fmt.Sprintf(keyMfaChallengeAttempts, challenge)
+
+	count, _ := IncrementInCache(ctx, c.store, key, expiration)
+
+	return int(count)
+}
+
+// TakeMfaChallenge consumes the challenge and reports whether it was still
+// there, so it can only be exchanged once.
+func (c *mfaCache) TakeMfaChallenge(ctx context.Context, challenge string) bool {
+	DeleteFromCache(ctx, c.store, fmt.Sprintf(keyMfaChallengeAttempts, challenge))
+
+	return TakeFromCache(ctx, c.store, fmt.Sprintf(keyMfaChallenge, challenge))
+}
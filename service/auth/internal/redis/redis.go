@@ -8,8 +8,10 @@ import (
 type Mencache struct {
 	IdentityCache      IdentityCache
 	LoginCache         LoginCache
+	MfaCache           MfaCache
 	PasswordResetCache PasswordResetCache
 	RegisterCache      RegisterCache
+	RevocationCache    RevocationCache
 }
 
 type Deps struct {
@@ -23,7 +25,9 @@ func NewMencache(deps *Deps) *Mencache {
 	return &Mencache{
 		IdentityCache:      NewidentityCache(cacheStore),
 		LoginCache:         NewLoginCache(cacheStore),
+		MfaCache:           NewMfaCache(cacheStore),
 		PasswordResetCache: NewPasswordResetCache(cacheStore),
 		RegisterCache:      NewRegisterCache(cacheStore),
+		RevocationCache:    NewRevocationCache(cacheStore),
 	}
 }
@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/mfa"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 )
@@ -27,9 +31,15 @@ type RefreshTokenRepository interface {
 	FindByToken(ctx context.Context, token string) (*record.RefreshTokenRecord, error)
 	FindByUserId(ctx context.Context, user_id int) (*record.RefreshTokenRecord, error)
 	CreateRefreshToken(ctx context.Context, req *requests.CreateRefreshToken) (*record.RefreshTokenRecord, error)
-	UpdateRefreshToken(ctx context.Context, req *requests.UpdateRefreshToken) (*record.RefreshTokenRecord, error)
 	DeleteRefreshToken(ctx context.Context, token string) error
 	DeleteRefreshTokenByUserId(ctx context.Context, user_id int) error
+	CreateSession(ctx context.Context, userId int, client session.Client, expiresAt time.Time) (*session.Session, error)
+	CreateSessionRefreshToken(ctx context.Context, sessionId int, userId int, token string, expiresAt time.Time) error
+	FindSessionToken(ctx context.Context, token string) (*session.Token, error)
+	RotateRefreshToken(ctx context.Context, sessionId int, userId int, oldToken string, newToken string, expiresAt time.Time, client session.Client) error
+	FindSessionsByUserId(ctx context.Context, userId int) ([]*session.Session, error)
+	RevokeSession(ctx context.Context, userId int, sessionId int, reason string) error
+	RevokeSessionsByUserId(ctx context.Context, userId int, reason string) ([]int, error)
 }
 
 type UserRoleRepository interface {
@@ -40,4 +50,28 @@ type UserRoleRepository interface {
 type RoleRepository interface {
 	FindById(ctx context.Context, role_id int) (*record.RoleRecord, error)
 	FindByName(ctx context.Context, name string) (*record.RoleRecord, error)
+	FindByUserId(ctx context.Context, user_id int) ([]*record.RoleRecord, error)
+}
+
+type MfaRepository interface {
+	FindByUserId(ctx context.Context, userId int) (*mfa.Factor, error)
+	SavePendingFactor(ctx context.Context, userId int, secret string) error
+	EnableFactor(ctx context.Context, userId int, step int64) error
+	RecordStep(ctx context.Context, userId int, step int64) (bool, error)
+	DeleteFactor(ctx context.Context, userId int) error
+	ReplaceRecoveryCodes(ctx context.Context, userId int, hashes []string) error
+	UseRecoveryCode(ctx context.Context, userId int, hash string) (bool, error)
+}
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
+
+type UnitOfWork interface {
+	WithTx(ctx context.Context, fn func(tx *TxRepositories) error) error
 }
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/mfa"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+)
+
+var (
+	ErrFindMfaFactor     = errors.New("failed to find two-factor secret")
+	ErrSaveMfaFactor     = errors.New("failed to save two-factor secret")
+	ErrDeleteMfaFactor   = errors.New("failed to delete two-factor secret")
+	ErrSaveRecoveryCodes = errors.New("failed to save recovery codes")
+	ErrUseRecoveryCode   = errors.New("failed to use recovery code")
+	ErrRecordMfaStep     = errors.New("failed to record two-factor code use")
+)
+
+const findMfaFactor = `-- name: FindMfaFactor :one
+SELECT user_id, secret, enabled_at IS NOT NULL, last_used_step
+FROM user_mfa
+WHERE user_id = $1
+`
+
+// A pending secret is replaced by a new enrollment; an enabled one is not.
+const savePendingMfaFactor = `-- name: SavePendingMfaFactor :execrows
+INSERT INTO user_mfa (user_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET secret = EXCLUDED.secret, last_used_step = 0, updated_at = current_timestamp
+WHERE user_mfa.enabled_at IS NULL
+`
+
+const enableMfaFactor = `-- name: EnableMfaFactor :execrows
+UPDATE user_mfa
+SET enabled_at = current_timestamp, last_used_step = $2, updated_at = current_timestamp
+WHERE user_id = $1 AND enabled_at IS NULL
+`
+
+// Steps only move forward, so a code cannot be replayed within its window.
+const recordMfaStep = `-- name: RecordMfaStep :execrows
+UPDATE user_mfa
+SET last_used_step = $2, updated_at = current_timestamp
+WHERE user_id = $1 AND last_used_step < $2
+`
+
+const deleteMfaFactor = `-- name: DeleteMfaFactor :exec
+DELETE FROM user_mfa WHERE user_id = $1
+`
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM user_mfa_recovery_codes WHERE user_id = $1
+`
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :exec
+INSERT INTO user_mfa_recovery_codes (user_id, code_hash)
+VALUES ($1, $2)
+`
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE user_mfa_recovery_codes
+SET used_at = current_timestamp
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+`
+
+type mfaRepository struct {
+	conn db.DBTX
+}
+
+func NewMfaRepository(conn db.DBTX) *mfaRepository {
+	return &mfaRepository{conn: conn}
+}
+
+func (r *mfaRepository) FindByUserId(ctx context.Context, userId int) (*mfa.Factor, error) {
+	var res mfa.Factor
+
+	err := r.conn.QueryRowContext(ctx, findMfaFactor, userId).Scan(&res.UserID, &res.Secret, &res.Enabled, &res.LastUsedStep)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, mfa.ErrNotEnrolled
+		}
+		return nil, ErrFindMfaFactor
+	}
+
+	return &res, nil
+}
+
+func (r *mfaRepository) SavePendingFactor(ctx context.Context, userId int, secret string) error {
+	res, err := r.conn.ExecContext(ctx, savePendingMfaFactor, userId, secret)
+	if err != nil {
+		return ErrSaveMfaFactor
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return mfa.ErrAlreadyEnabled
+	}
+
+	return nil
+}
+
+func (r *mfaRepository) EnableFactor(ctx context.Context, userId int, step int64) error {
+	res, err := r.conn.ExecContext(ctx, enableMfaFactor, userId, step)
+	if err != nil {
+		return ErrSaveMfaFactor
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return mfa.ErrAlreadyEnabled
+	}
+
+	return nil
+}
+
+// RecordStep reports false when the step, or a later one, was already used.
+func (r *mfaRepository) RecordStep(ctx context.Context, userId int, step int64) (bool, error) {
+	res, err := r.conn.ExecContext(ctx, recordMfaStep, userId, step)
+	if err != nil {
+		return false, ErrRecordMfaStep
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrRecordMfaStep
+	}
+
+	return n > 0, nil
+}
+
+func (r *mfaRepository) DeleteFactor(ctx context.Context, userId int) error {
+	if _, err := r.conn.ExecContext(ctx, deleteRecoveryCodes, userId); err != nil {
+		return ErrDeleteMfaFactor
+	}
+
+	if _, err := r.conn.ExecContext(ctx, deleteMfaFactor, userId); err != nil {
+		return ErrDeleteMfaFactor
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes drops the user's previous codes, used or not. Run it
+// in a transaction.
+func (r *mfaRepository) ReplaceRecoveryCodes(ctx context.Context, userId int, hashes []string) error {
+	if _, err := r.conn.ExecContext(ctx, deleteRecoveryCodes, userId); err != nil {
+		return ErrSaveRecoveryCodes
+	}
+
+	for _, hash := range hashes {
+		if _, err := r.conn.ExecContext(ctx, createRecoveryCode, userId, hash); err != nil {
+			return ErrSaveRecoveryCodes
+		}
+	}
+
+	return nil
+}
+
+func (r *mfaRepository) UseRecoveryCode(ctx context.Context, userId int, hash string) (bool, error) {
+	res, err := r.conn.ExecContext(ctx, useRecoveryCode, userId, hash)
+	if err != nil {
+		return false, ErrUseRecoveryCode
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrUseRecoveryCode
+	}
+
+	return n > 0, nil
+}
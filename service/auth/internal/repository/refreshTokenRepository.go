@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -11,14 +14,78 @@ import (
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+var (
+	ErrCreateSession      = errors.New("failed to create session")
+	ErrFindSessions       = errors.New("failed to find sessions")
+	ErrRevokeSession      = errors.New("failed to revoke session")
+	ErrRotateRefreshToken = errors.New("failed to rotate refresh token")
+)
+
+const createSession = `-- name: CreateSession :one
+INSERT INTO user_sessions (user_id, device_name, ip_address, user_agent, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING session_id, user_id, device_name, ip_address, user_agent, created_at, last_used_at, expires_at
+`
+
+const createSessionRefreshToken = `-- name: CreateSessionRefreshToken :exec
+INSERT INTO refresh_tokens (user_id, token, expiration, session_id, created_at, updated_at)
+VALUES ($1, $2, $3, $4, current_timestamp, current_timestamp)
+`
+
+// Rotated tokens are looked up too: presenting one again is how reuse is
+// detected.
+const findSessionRefreshToken = `-- name: FindSessionRefreshToken :one
+SELECT rt.session_id, rt.user_id, rt.deleted_at IS NOT NULL, s.revoked_at IS NOT NULL OR s.expires_at <= current_timestamp
+FROM refresh_tokens rt
+JOIN user_sessions s ON s.session_id = rt.session_id
+WHERE rt.token = $1
+`
+
+const retireSessionRefreshToken = `-- name: RetireSessionRefreshToken :execrows
+UPDATE refresh_tokens
+SET deleted_at = current_timestamp, updated_at = current_timestamp
+WHERE token = $1 AND session_id = $2 AND deleted_at IS NULL
+`
+
+const touchSession = `-- name: TouchSession :execrows
+UPDATE user_sessions
+SET last_used_at = current_timestamp,
+    expires_at = $2,
+    ip_address = COALESCE(NULLIF($3, ''), ip_address),
+    user_agent = COALESCE(NULLIF($4, ''), user_agent)
+WHERE session_id = $1 AND revoked_at IS NULL
+`
+
+const findActiveSessionsByUserId = `-- name: FindActiveSessionsByUserId :many
+SELECT session_id, user_id, device_name, ip_address, user_agent, created_at, last_used_at, expires_at
+FROM user_sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > current_timestamp
+ORDER BY last_used_at DESC
+`
+
+const revokeSession = `-- name: RevokeSession :execrows
+UPDATE user_sessions
+SET revoked_at = current_timestamp, revoked_reason = $3
+WHERE session_id = $1 AND user_id = $2 AND revoked_at IS NULL
+`
+
+const revokeSessionsByUserId = `-- name: RevokeSessionsByUserId :many
+UPDATE user_sessions
+SET revoked_at = current_timestamp, revoked_reason = $2
+WHERE user_id = $1 AND revoked_at IS NULL
+RETURNING session_id
+`
+
 type refreshTokenRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.RefreshTokenRecordMapping
 }
 
-func NewRefreshTokenRepository(db *db.Queries, mapping recordmapper.RefreshTokenRecordMapping) *refreshTokenRepository {
+func NewRefreshTokenRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.RefreshTokenRecordMapping) *refreshTokenRepository {
 	return &refreshTokenRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -63,40 +130,156 @@ func (r *refreshTokenRepository) CreateRefreshToken(ctx context.Context, req *re
 	return r.mapping.ToRefreshTokenRecord(res), nil
 }
 
-func (r *refreshTokenRepository) UpdateRefreshToken(ctx context.Context, req *requests.UpdateRefreshToken) (*record.RefreshTokenRecord, error) {
-	layout := "2006-01-02 15:04:05"
-	expirationTime, err := time.Parse(layout, req.ExpiresAt)
+func (r *refreshTokenRepository) DeleteRefreshToken(ctx context.Context, token string) error {
+	err := r.db.DeleteRefreshToken(ctx, token)
+
 	if err != nil {
-		return nil, refreshtoken_errors.ErrParseDate
+		return refreshtoken_errors.ErrDeleteRefreshToken
 	}
 
-	res, err := r.db.UpdateRefreshTokenByUserId(ctx, db.UpdateRefreshTokenByUserIdParams{
-		UserID:     int32(req.UserId),
-		Token:      req.Token,
-		Expiration: expirationTime,
-	})
+	return nil
+}
+
+func (r *refreshTokenRepository) DeleteRefreshTokenByUserId(ctx context.Context, user_id int) error {
+	err := r.db.DeleteRefreshTokenByUserId(ctx, int32(user_id))
+
 	if err != nil {
-		return nil, refreshtoken_errors.ErrUpdateRefreshToken
+		return refreshtoken_errors.ErrDeleteByUserID
 	}
 
-	return r.mapping.ToRefreshTokenRecord(res), nil
+	return nil
 }
-func (r *refreshTokenRepository) DeleteRefreshToken(ctx context.Context, token string) error {
-	err := r.db.DeleteRefreshToken(ctx, token)
 
+func (r *refreshTokenRepository) CreateSession(ctx context.Context, userId int, client session.Client, expiresAt time.Time) (*session.Session, error) {
+	row := r.conn.QueryRowContext(ctx, createSession, userId, client.DeviceName, client.IPAddress, client.UserAgent, expiresAt)
+
+	res, err := scanSession(row)
 	if err != nil {
-		return refreshtoken_errors.ErrDeleteRefreshToken
+		return nil, ErrCreateSession
+	}
+
+	return res, nil
+}
+
+func (r *refreshTokenRepository) CreateSessionRefreshToken(ctx context.Context, sessionId int, userId int, token string, expiresAt time.Time) error {
+	if _, err := r.conn.ExecContext(ctx, createSessionRefreshToken, userId, token, expiresAt, sessionId); err != nil {
+		return refreshtoken_errors.ErrCreateRefreshToken
 	}
 
 	return nil
 }
 
-func (r *refreshTokenRepository) DeleteRefreshTokenByUserId(ctx context.Context, user_id int) error {
-	err := r.db.DeleteRefreshTokenByUserId(ctx, int32(user_id))
+func (r *refreshTokenRepository) FindSessionToken(ctx context.Context, token string) (*session.Token, error) {
+	var res session.Token
 
+	err := r.conn.QueryRowContext(ctx, findSessionRefreshToken, token).Scan(&res.SessionID, &res.UserID, &res.Rotated, &res.Revoked)
 	if err != nil {
-		return refreshtoken_errors.ErrDeleteByUserID
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, session.ErrTokenUnknown
+		}
+		return nil, refreshtoken_errors.ErrTokenNotFound
+	}
+
+	return &res, nil
+}
+
+// RotateRefreshToken replaces the session's current refresh token. Run it
+// in a transaction: the old token is retired first, so of two concurrent
+// rotations only one succeeds and the other sees session.ErrTokenReused.
+func (r *refreshTokenRepository) RotateRefreshToken(ctx context.Context, sessionId int, userId int, oldToken string, newToken string, expiresAt time.Time, client session.Client) error {
+	res, err := r.conn.ExecContext(ctx, retireSessionRefreshToken, oldToken, sessionId)
+	if err != nil {
+		return ErrRotateRefreshToken
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return session.ErrTokenReused
+	}
+
+	if err := r.CreateSessionRefreshToken(ctx, sessionId, userId, newToken, expiresAt); err != nil {
+		return err
+	}
+
+	res, err = r.conn.ExecContext(ctx, touchSession, sessionId, expiresAt, client.IPAddress, client.UserAgent)
+	if err != nil {
+		return ErrRotateRefreshToken
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return session.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepository) FindSessionsByUserId(ctx context.Context, userId int) ([]*session.Session, error) {
+	rows, err := r.conn.QueryContext(ctx, findActiveSessionsByUserId, userId)
+	if err != nil {
+		return nil, ErrFindSessions
+	}
+	defer rows.Close()
+
+	var sessions []*session.Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, ErrFindSessions
+		}
+		sessions = append(sessions, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrFindSessions
+	}
+
+	return sessions, nil
+}
+
+func (r *refreshTokenRepository) RevokeSession(ctx context.Context, userId int, sessionId int, reason string) error {
+	res, err := r.conn.ExecContext(ctx, revokeSession, sessionId, userId, reason)
+	if err != nil {
+		return ErrRevokeSession
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return session.ErrNotFound
 	}
 
 	return nil
 }
+
+func (r *refreshTokenRepository) RevokeSessionsByUserId(ctx context.Context, userId int, reason string) ([]int, error) {
+	rows, err := r.conn.QueryContext(ctx, revokeSessionsByUserId, userId, reason)
+	if err != nil {
+		return nil, ErrRevokeSession
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, ErrRevokeSession
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrRevokeSession
+	}
+
+	return ids, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSession(row rowScanner) (*session.Session, error) {
+	var s session.Session
+
+	err := row.Scan(&s.ID, &s.UserID, &s.DeviceName, &s.IPAddress, &s.UserAgent, &s.CreatedAt, &s.LastUsedAt, &s.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
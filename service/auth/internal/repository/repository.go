@@ -1,19 +1,27 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "auth"
+
 type Repositories struct {
 	User         UserRepository
 	RefreshToken RefreshTokenRepository
 	UserRole     UserRoleRepository
 	Role         RoleRepository
 	ResetToken   ResetTokenRepository
+	Outbox       OutboxRepository
+	Mfa          MfaRepository
+	UnitOfWork   UnitOfWork
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	mapperUserRole := recordmapper.NewUserRoleRecordMapper()
 	mapperUser := recordmapper.NewUserRecordMapper()
 	mapperRefreshToken := recordmapper.NewRefreshTokenRecordMapper()
@@ -22,9 +30,12 @@ func NewRepositories(DB *db.Queries) *Repositories {
 
 	return &Repositories{
 		User:         NewUserRepository(DB, mapperUser),
-		RefreshToken: NewRefreshTokenRepository(DB, mapperRefreshToken),
+		RefreshToken: NewRefreshTokenRepository(DB, conn, mapperRefreshToken),
 		UserRole:     NewUserRoleRepository(DB, mapperUserRole),
 		Role:         NewRoleRepository(DB, mapperRole),
 		ResetToken:   NewResetTokenRepository(DB, mapperResetToken),
+		Outbox:       outbox.NewRepository(conn, outboxSource),
+		Mfa:          NewMfaRepository(conn),
+		UnitOfWork:   NewUnitOfWork(conn, DB, mapperUser, mapperUserRole, mapperResetToken, mapperRefreshToken),
 	}
 }
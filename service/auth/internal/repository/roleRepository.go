@@ -36,3 +36,11 @@ func (r *roleRepository) FindByName(ctx context.Context, name string) (*record.R
 	}
 	return r.mapping.ToRoleRecord(res), nil
 }
+
+func (r *roleRepository) FindByUserId(ctx context.Context, user_id int) ([]*record.RoleRecord, error) {
+	res, err := r.db.GetUserRoles(ctx, int32(user_id))
+	if err != nil {
+		return nil, role_errors.ErrRoleNotFound
+	}
+	return r.mapping.ToRolesRecord(res), nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+)
+
+var (
+	ErrBeginTransaction  = errors.New("failed to begin database transaction")
+	ErrCommitTransaction = errors.New("failed to commit database transaction")
+)
+
+type TxRepositories struct {
+	User         UserRepository
+	UserRole     UserRoleRepository
+	ResetToken   ResetTokenRepository
+	RefreshToken RefreshTokenRepository
+	Outbox       OutboxRepository
+	Mfa          MfaRepository
+}
+
+type unitOfWork struct {
+	conn               *audit.DB
+	db                 *db.Queries
+	mapperUser         recordmapper.UserRecordMapping
+	mapperUserRole     recordmapper.UserRoleRecordMapping
+	mapperResetToken   recordmapper.ResetTokenRecordMapping
+	mapperRefreshToken recordmapper.RefreshTokenRecordMapping
+}
+
+func NewUnitOfWork(
+	conn *audit.DB,
+	db *db.Queries,
+	mapperUser recordmapper.UserRecordMapping,
+	mapperUserRole recordmapper.UserRoleRecordMapping,
+	mapperResetToken recordmapper.ResetTokenRecordMapping,
+	mapperRefreshToken recordmapper.RefreshTokenRecordMapping,
+) *unitOfWork {
+	return &unitOfWork{
+		conn:               conn,
+		db:                 db,
+		mapperUser:         mapperUser,
+		mapperUserRole:     mapperUserRole,
+		mapperResetToken:   mapperResetToken,
+		mapperRefreshToken: mapperRefreshToken,
+	}
+}
+
+// WithTx commits when fn returns nil and rolls back on error or panic.
+func (u *unitOfWork) WithTx(ctx context.Context, fn func(tx *TxRepositories) error) error {
+	tx, err := u.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBeginTransaction, err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	q := u.db.WithTx(tx.Tx)
+
+	repos := &TxRepositories{
+		User:         NewUserRepository(q, u.mapperUser),
+		UserRole:     NewUserRoleRepository(q, u.mapperUserRole),
+		ResetToken:   NewResetTokenRepository(q, u.mapperResetToken),
+		RefreshToken: NewRefreshTokenRepository(q, tx, u.mapperRefreshToken),
+		Outbox:       outbox.NewRepository(tx, outboxSource),
+		Mfa:          NewMfaRepository(tx),
+	}
+
+	if err := fn(repos); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", ErrCommitTransaction, err)
+	}
+
+	return nil
+}
@@ -9,9 +9,10 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/errorhandler"
 	mencache "github.com/MamangRust/monolith-point-of-sale-auth/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/token"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/auth"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
-	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	response_service "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/service"
 	"github.com/prometheus/client_golang/prometheus"
@@ -22,13 +23,19 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	errTokenRevoked         = errors.New("token has been revoked")
+	errTokenSubjectMismatch = errors.New("refresh token belongs to another user")
+)
+
 type identityService struct {
 	errorhandler    errorhandler.IdentityErrorHandler
 	errorToken      errorhandler.TokenErrorHandler
 	mencache        mencache.IdentityCache
+	revocation      mencache.RevocationCache
 	trace           trace.Tracer
 	logger          logger.LoggerInterface
-	token           auth.TokenManager
+	token           token.TokenManager
 	refreshToken    repository.RefreshTokenRepository
 	user            repository.UserRepository
 	mapping         response_service.UserResponseMapper
@@ -37,7 +44,7 @@ type identityService struct {
 	requestDuration *prometheus.HistogramVec
 }
 
-func NewIdentityService(errohandler errorhandler.IdentityErrorHandler, errorToken errorhandler.TokenErrorHandler, mencache mencache.IdentityCache, token auth.TokenManager, refreshToken repository.RefreshTokenRepository, user repository.UserRepository, logger logger.LoggerInterface, mapping response_service.UserResponseMapper, tokenService tokenService) *identityService {
+func NewIdentityService(errohandler errorhandler.IdentityErrorHandler, errorToken errorhandler.TokenErrorHandler, mencache mencache.IdentityCache, revocation mencache.RevocationCache, token token.TokenManager, refreshToken repository.RefreshTokenRepository, user repository.UserRepository, logger logger.LoggerInterface, mapping response_service.UserResponseMapper, tokenService tokenService) *identityService {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "identity_service_requests_total",
@@ -58,6 +65,7 @@ func NewIdentityService(errohandler errorhandler.IdentityErrorHandler, errorToke
 		errorhandler:    errohandler,
 		errorToken:      errorToken,
 		mencache:        mencache,
+		revocation:      revocation,
 		trace:           otel.Tracer("identity-service"),
 		logger:          logger,
 		token:           token,
@@ -79,44 +87,9 @@ func (s *identityService) RefreshToken(ctx context.Context, token string) (*resp
 		end(status)
 	}()
 
-	if cachedUserID, found := s.mencache.GetRefreshToken(ctx, token); found {
-		userId, err := strconv.Atoi(cachedUserID)
-		if err == nil {
-			s.mencache.DeleteRefreshToken(ctx, token)
-			s.logger.Debug("Invalidated old refresh token from cache", zap.String("token", token))
-
-			accessToken, err := s.tokenService.createAccessToken(ctx, userId)
-			if err != nil {
-				return s.errorToken.HandleCreateAccessTokenError(err, method, "CREATE_ACCESS_TOKEN_FAILED", span, &status, zap.Int("user.id", userId))
-			}
-
-			refreshToken, err := s.tokenService.createRefreshToken(ctx, userId)
-			if err != nil {
-				return s.errorToken.HandleCreateRefreshTokenError(err, method, "CREATE_REFRESH_TOKEN_FAILED", span, &status, zap.Int("user.id", userId))
-			}
-
-			expiryTime := time.Now().Add(24 * time.Hour)
-			expirationDuration := time.Until(expiryTime)
-
-			s.mencache.SetRefreshToken(ctx, refreshToken, expirationDuration)
-			s.logger.Debug("Stored new refresh token in cache",
-				zap.String("new_token", refreshToken),
-				zap.Duration("expiration", expirationDuration))
-
-			s.logger.Debug("Refresh token refreshed successfully (cached)", zap.Int("user_id", userId))
-			span.SetStatus(codes.Ok, "Token refreshed successfully from cache")
-
-			return &response.TokenResponse{
-				AccessToken:  accessToken,
-				RefreshToken: refreshToken,
-			}, nil
-		}
-	}
-
-	userIdStr, err := s.token.ValidateToken(token)
+	claims, err := s.token.Parse(token)
 	if err != nil {
 		if errors.Is(err, auth.ErrTokenExpired) {
-			s.mencache.DeleteRefreshToken(ctx, token)
 			if err := s.refreshToken.DeleteRefreshToken(ctx, token); err != nil {
 
 				return s.errorhandler.HandleDeleteRefreshTokenError(err, method, "DELETE_REFRESH_TOKEN", span, &status, zap.String("token", token))
@@ -128,7 +101,11 @@ func (s *identityService) RefreshToken(ctx context.Context, token string) (*resp
 		return s.errorhandler.HandleInvalidTokenError(err, method, "INVALID_TOKEN", span, &status, zap.String("token", token))
 	}
 
-	userId, err := strconv.Atoi(userIdStr)
+	if s.isRevoked(ctx, claims) {
+		return s.errorhandler.HandleRevokedTokenError(errTokenRevoked, method, "TOKEN_REVOKED", span, &status, zap.String("subject", claims.Subject))
+	}
+
+	userId, err := strconv.Atoi(claims.Subject)
 	if err != nil {
 
 		return errorhandler.HandleInvalidFormatUserIDError[*response.TokenResponse](s.logger, err, method, "INVALID_USER_ID", span, &status, zap.Int("user.id", userId))
@@ -136,48 +113,58 @@ func (s *identityService) RefreshToken(ctx context.Context, token string) (*resp
 
 	span.SetAttributes(attribute.Int("user.id", userId))
 
-	s.mencache.DeleteRefreshToken(ctx, token)
-	if err := s.refreshToken.DeleteRefreshToken(ctx, token); err != nil {
-		s.logger.Debug("Failed to delete old refresh token", zap.Error(err))
+	current, err := s.refreshToken.FindSessionToken(ctx, token)
+	if err != nil {
+		return s.errorhandler.HandleInvalidTokenError(err, method, "INVALID_TOKEN", span, &status, zap.Int("user.id", userId))
+	}
 
-		return s.errorhandler.HandleDeleteRefreshTokenError(err, method, "DELETE_REFRESH_TOKEN", span, &status, zap.String("token", token))
+	if current.UserID != userId {
+		return s.errorhandler.HandleInvalidTokenError(errTokenSubjectMismatch, method, "INVALID_TOKEN", span, &status, zap.Int("user.id", userId))
 	}
 
-	accessToken, err := s.tokenService.createAccessToken(ctx, userId)
-	if err != nil {
+	if current.Revoked {
+		return s.errorhandler.HandleRevokedTokenError(errTokenRevoked, method, "SESSION_REVOKED", span, &status, zap.Int("user.id", userId), zap.Int("session.id", current.SessionID))
+	}
 
-		return s.errorToken.HandleCreateAccessTokenError(err, method, "CREATE_ACCESS_TOKEN_FAILED", span, &status, zap.Int("user.id", userId))
+	if current.Rotated {
+		return s.killSession(ctx, current, method, span, &status)
 	}
 
-	refreshToken, err := s.tokenService.createRefreshToken(ctx, userId)
+	tokens, err := s.tokenService.rotateSession(ctx, current.SessionID, userId, token)
 	if err != nil {
+		if errors.Is(err, session.ErrTokenReused) {
+			return s.killSession(ctx, current, method, span, &status)
+		}
+		if errors.Is(err, session.ErrNotFound) {
+			return s.errorhandler.HandleRevokedTokenError(err, method, "SESSION_REVOKED", span, &status, zap.Int("user.id", userId), zap.Int("session.id", current.SessionID))
+		}
 
 		return s.errorToken.HandleCreateRefreshTokenError(err, method, "CREATE_REFRESH_TOKEN_FAILED", span, &status, zap.Int("user.id", userId))
 	}
 
-	expiryTime := time.Now().Add(24 * time.Hour)
-	updateRequest := &requests.UpdateRefreshToken{
-		UserId:    userId,
-		Token:     refreshToken,
-		ExpiresAt: expiryTime.Format("2006-01-02 15:04:05"),
-	}
+	logSuccess("Refresh token refreshed successfully", zap.Int("user.id", userId), zap.Int("session.id", current.SessionID))
 
-	if _, err = s.refreshToken.UpdateRefreshToken(ctx, updateRequest); err != nil {
-		s.mencache.DeleteRefreshToken(ctx, refreshToken)
+	return tokens, nil
+}
 
-		return s.errorhandler.HandleUpdateRefreshTokenError(err, method, "UPDATE_REFRESH_TOKEN_FAILED", span, &status, zap.Int("user.id", userId))
-	}
+// killSession handles a refresh token presented after it was rotated. Either
+// the client or an attacker holds a stolen copy, so the whole session
+// family is revoked, access tokens included.
+func (s *identityService) killSession(ctx context.Context, reused *session.Token, method string, span trace.Span, status *string) (*response.TokenResponse, *response.ErrorResponse) {
+	s.logger.Error("Refresh token reuse detected, revoking session",
+		zap.Int("user.id", reused.UserID),
+		zap.Int("session.id", reused.SessionID),
+	)
 
-	expirationDuration := time.Until(expiryTime)
-	s.mencache.SetRefreshToken(ctx, refreshToken, expirationDuration)
+	if err := s.refreshToken.RevokeSession(ctx, reused.UserID, reused.SessionID, session.ReasonReuse); err != nil && !errors.Is(err, session.ErrNotFound) {
+		s.logger.Error("Failed to revoke reused session", zap.Int("session.id", reused.SessionID), zap.Error(err))
+	}
 
-	logSuccess("Refresh token refreshed successfully", zap.Int("user.id", userId))
+	s.revocation.RevokeSession(ctx, reused.SessionID, token.TTL)
 
-	return &response.TokenResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	return s.errorhandler.HandleRefreshTokenReusedError(session.ErrTokenReused, method, "REFRESH_TOKEN_REUSED", span, status, zap.Int("user.id", reused.UserID), zap.Int("session.id", reused.SessionID))
 }
+
 func (s *identityService) GetMe(ctx context.Context, token string) (*response.UserResponse, *response.ErrorResponse) {
 	const method = "GetMe"
 	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.String("token", token))
@@ -188,13 +175,19 @@ func (s *identityService) GetMe(ctx context.Context, token string) (*response.Us
 
 	s.logger.Debug("Fetching user details", zap.String("token", token))
 
-	userIdStr, err := s.token.ValidateToken(token)
+	claims, err := s.token.Parse(token)
 	if err != nil {
 		status = "error"
 
 		return s.errorhandler.HandleValidateTokenError(err, method, "INVALID_TOKEN", span, &status, zap.String("token", token))
 	}
 
+	if s.isRevoked(ctx, claims) {
+		return errorhandler.HandleTokenError[*response.UserResponse](s.logger, errTokenRevoked, method, "TOKEN_REVOKED", span, &status, errorhandler.ErrFailedTokenRevoked, zap.String("subject", claims.Subject))
+	}
+
+	userIdStr := claims.Subject
+
 	userId, err := strconv.Atoi(userIdStr)
 	if err != nil {
 		status = "error"
@@ -227,6 +220,155 @@ func (s *identityService) GetMe(ctx context.Context, token string) (*response.Us
 	return userResponse, nil
 }
 
+// Logout revokes the access token until it expires, closes the session it
+// belongs to and, when given, revokes the refresh token issued alongside it.
+func (s *identityService) Logout(ctx context.Context, accessToken string, refreshToken string) (bool, *response.ErrorResponse) {
+	const method = "Logout"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method)
+
+	defer func() {
+		end(status)
+	}()
+
+	access, err := s.token.Parse(accessToken)
+	if err != nil {
+		return s.errorhandler.HandleLogoutTokenError(err, method, "INVALID_TOKEN", span, &status)
+	}
+
+	userId, err := strconv.Atoi(access.Subject)
+	if err != nil {
+		return errorhandler.HandleInvalidFormatUserIDError[bool](s.logger, err, method, "INVALID_USER_ID", span, &status, zap.String("subject", access.Subject))
+	}
+
+	span.SetAttributes(attribute.Int("user.id", userId))
+
+	if access.SessionID != 0 {
+		if err := s.refreshToken.RevokeSession(ctx, userId, access.SessionID, session.ReasonLogout); err != nil && !errors.Is(err, session.ErrNotFound) {
+			return s.errorhandler.HandleLogoutError(err, method, "REVOKE_SESSION", span, &status, zap.Int("user.id", userId))
+		}
+
+		s.revocation.RevokeSession(ctx, access.SessionID, token.TTL)
+	}
+
+	if refreshToken != "" {
+		refresh, err := s.token.Parse(refreshToken)
+		if err != nil && !errors.Is(err, auth.ErrTokenExpired) {
+			return s.errorhandler.HandleLogoutTokenError(err, method, "INVALID_REFRESH_TOKEN", span, &status, zap.Int("user.id", userId))
+		}
+
+		if refresh != nil {
+			if refresh.Subject != access.Subject {
+				return s.errorhandler.HandleLogoutTokenError(errTokenSubjectMismatch, method, "INVALID_REFRESH_TOKEN", span, &status, zap.Int("user.id", userId))
+			}
+
+			s.revocation.RevokeToken(ctx, refresh.ID, time.Until(refresh.ExpiresAt))
+		}
+
+		if err := s.refreshToken.DeleteRefreshToken(ctx, refreshToken); err != nil {
+			return s.errorhandler.HandleLogoutError(err, method, "DELETE_REFRESH_TOKEN", span, &status, zap.Int("user.id", userId))
+		}
+	}
+
+	s.revocation.RevokeToken(ctx, access.ID, time.Until(access.ExpiresAt))
+	s.forgetUser(ctx, userId)
+
+	logSuccess("Successfully logged out", zap.Int("user.id", userId))
+
+	return true, nil
+}
+
+// LogoutAllSessions revokes every token the user holds, on every device.
+func (s *identityService) LogoutAllSessions(ctx context.Context, userId int) (bool, *response.ErrorResponse) {
+	const method = "LogoutAllSessions"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("user.id", userId))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.refreshToken.RevokeSessionsByUserId(ctx, userId, session.ReasonLogout); err != nil {
+		return s.errorhandler.HandleLogoutError(err, method, "REVOKE_SESSIONS", span, &status, zap.Int("user.id", userId))
+	}
+
+	if err := s.refreshToken.DeleteRefreshTokenByUserId(ctx, userId); err != nil {
+		return s.errorhandler.HandleLogoutError(err, method, "DELETE_REFRESH_TOKEN", span, &status, zap.Int("user.id", userId))
+	}
+
+	s.revocation.RevokeUserTokens(ctx, userId, time.Now(), token.TTL)
+	s.forgetUser(ctx, userId)
+
+	logSuccess("Successfully logged out all sessions", zap.Int("user.id", userId))
+
+	return true, nil
+}
+
+// ListSessions returns the user's open sessions, most recently used first.
+func (s *identityService) ListSessions(ctx context.Context, userId int) ([]*session.Session, *response.ErrorResponse) {
+	const method = "ListSessions"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("user.id", userId))
+
+	defer func() {
+		end(status)
+	}()
+
+	sessions, err := s.refreshToken.FindSessionsByUserId(ctx, userId)
+	if err != nil {
+		return s.errorhandler.HandleFindSessionsError(err, method, "FIND_SESSIONS", span, &status, zap.Int("user.id", userId))
+	}
+
+	logSuccess("Successfully listed sessions", zap.Int("user.id", userId), zap.Int("sessions", len(sessions)))
+
+	return sessions, nil
+}
+
+// RevokeSession signs one of the user's devices out. Its refresh token stops
+// working at once and its access tokens are denylisted until they expire.
+func (s *identityService) RevokeSession(ctx context.Context, userId int, sessionId int) (bool, *response.ErrorResponse) {
+	const method = "RevokeSession"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("user.id", userId), attribute.Int("session.id", sessionId))
+
+	defer func() {
+		end(status)
+	}()
+
+	if err := s.refreshToken.RevokeSession(ctx, userId, sessionId, session.ReasonRevoked); err != nil {
+		return s.errorhandler.HandleRevokeSessionError(err, method, "REVOKE_SESSION", span, &status, zap.Int("user.id", userId), zap.Int("session.id", sessionId))
+	}
+
+	s.revocation.RevokeSession(ctx, sessionId, token.TTL)
+
+	logSuccess("Successfully revoked session", zap.Int("user.id", userId), zap.Int("session.id", sessionId))
+
+	return true, nil
+}
+
+func (s *identityService) isRevoked(ctx context.Context, claims *token.Claims) bool {
+	if claims.ID != "" && s.revocation.IsTokenRevoked(ctx, claims.ID) {
+		return true
+	}
+
+	if claims.SessionID != 0 && s.revocation.IsSessionRevoked(ctx, claims.SessionID) {
+		return true
+	}
+
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return false
+	}
+
+	revokedAt, found := s.revocation.UserTokensRevokedAt(ctx, userId)
+
+	return found && !claims.IssuedAt.After(revokedAt)
+}
+
+func (s *identityService) forgetUser(ctx context.Context, userId int) {
+	s.mencache.DeleteCachedUserInfo(ctx, strconv.Itoa(userId))
+}
+
 func (s *identityService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
 	context.Context,
 	trace.Span,
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/mfa"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
@@ -13,6 +15,13 @@ type RegistrationService interface {
 
 type LoginService interface {
 	Login(ctx context.Context, request *requests.AuthRequest) (*response.TokenResponse, *response.ErrorResponse)
+	VerifyMfa(ctx context.Context, challenge string, code string) (*response.TokenResponse, *response.ErrorResponse)
+}
+
+type MfaService interface {
+	BeginEnrollment(ctx context.Context, userId int) (*mfa.Enrollment, *response.ErrorResponse)
+	ConfirmEnrollment(ctx context.Context, userId int, code string) ([]string, *response.ErrorResponse)
+	Disable(ctx context.Context, userId int, code string) (bool, *response.ErrorResponse)
 }
 
 type PasswordResetService interface {
@@ -24,4 +33,8 @@ type PasswordResetService interface {
 type IdentifyService interface {
 	RefreshToken(ctx context.Context, token string) (*response.TokenResponse, *response.ErrorResponse)
 	GetMe(ctx context.Context, token string) (*response.UserResponse, *response.ErrorResponse)
+	Logout(ctx context.Context, accessToken string, refreshToken string) (bool, *response.ErrorResponse)
+	LogoutAllSessions(ctx context.Context, userId int) (bool, *response.ErrorResponse)
+	ListSessions(ctx context.Context, userId int) ([]*session.Session, *response.ErrorResponse)
+	RevokeSession(ctx context.Context, userId int, sessionId int) (bool, *response.ErrorResponse)
 }
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/email"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Brute-force protection for Login. Failures are counted per email and per
+// client IP; a counter resets loginFailureWindow after its first failure.
+const (
+	loginFailureWindow    = 15 * time.Minute
+	loginBackoffAfter     = 3
+	loginBaseBackoff      = time.Second
+	loginMaxBackoff       = 5 * time.Minute
+	loginLockAfter        = 10
+	loginLockDuration     = 15 * time.Minute
+	loginMaxFailuresPerIP = 50
+)
+
+const (
+	topicAccountLocked   = "email-service-topic-auth-account-locked"
+	topicAccountUnlocked = "email-service-topic-auth-account-unlocked"
+)
+
+// MetadataRetryAfter is the response header telling the gateway how long a
+// throttled or locked out caller has to wait.
+const MetadataRetryAfter = "retry-after"
+
+// loginBackoff doubles the wait for every failure past loginBackoffAfter.
+func loginBackoff(failures int) time.Duration {
+	exp := failures - loginBackoffAfter
+	if exp < 0 {
+		return 0
+	}
+
+	delay := time.Duration(float64(loginBaseBackoff) * math.Pow(2, float64(exp)))
+	if delay <= 0 || delay > loginMaxBackoff {
+		return loginMaxBackoff
+	}
+
+	return delay
+}
+
+func loginKey(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
+
+func setRetryAfter(ctx context.Context, wait time.Duration) {
+	seconds := int(math.Ceil(wait.Seconds()))
+	_ = grpc.SetHeader(ctx, metadata.Pairs(MetadataRetryAfter, strconv.Itoa(seconds)))
+}
+
+func lockoutEmail(to string, locked bool, lockedFor time.Duration) ([]byte, error) {
+	subject := "Your account has been unlocked"
+	message := "Your account has been unlocked. You can sign in again."
+	button, link := "Login Now", "https://sanedge.example.com/login"
+
+	if locked {
+		subject = "Your account has been temporarily locked"
+		message = fmt.Sprintf(
+			"We locked your account for %d minutes after too many failed sign-in attempts. If this was not you, reset your password.",
+			int(math.Ceil(lockedFor.Minutes())),
+		)
+		button, link = "Reset Password", "https://sanedge.example.com/forgot-password"
+	}
+
+	htmlBody := email.GenerateEmailHTML(map[string]string{
+		"Title":   subject,
+		"Message": message,
+		"Button":  button,
+		"Link":    link,
+	})
+
+	return json.Marshal(map[string]any{
+		"email":   to,
+		"subject": subject,
+		"body":    htmlBody,
+	})
+}
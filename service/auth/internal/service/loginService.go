@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/mfa"
 	mencache "github.com/MamangRust/monolith-point-of-sale-auth/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/auth"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/hash"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
@@ -18,17 +21,30 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	errAccountLocked        = errors.New("account is locked")
+	errTooManyLoginAttempts = errors.New("too many failed login attempts")
 )
 
 type loginService struct {
 	errorPassword   errorhandler.PasswordErrorHandler
 	errorToken      errorhandler.TokenErrorHandler
 	errorHandler    errorhandler.LoginErrorHandler
+	errorMfa        errorhandler.MfaErrorHandler
 	mencache        mencache.LoginCache
+	mfaCache        mencache.MfaCache
 	logger          logger.LoggerInterface
 	hash            hash.HashPassword
 	user            repository.UserRepository
+	role            repository.RoleRepository
 	refreshToken    repository.RefreshTokenRepository
+	outbox          repository.OutboxRepository
+	mfa             repository.MfaRepository
+	mfaPolicy       mfa.Policy
 	token           auth.TokenManager
 	trace           trace.Tracer
 	tokenService    tokenService
@@ -40,11 +56,17 @@ func NewLoginService(
 	errorPassword errorhandler.PasswordErrorHandler,
 	errorToken errorhandler.TokenErrorHandler,
 	errorHandler errorhandler.LoginErrorHandler,
+	errorMfa errorhandler.MfaErrorHandler,
 	mencache mencache.LoginCache,
+	mfaCache mencache.MfaCache,
 	logger logger.LoggerInterface,
 	hash hash.HashPassword,
 	userRepository repository.UserRepository,
+	roleRepository repository.RoleRepository,
 	refreshToken repository.RefreshTokenRepository,
+	outbox repository.OutboxRepository,
+	mfaRepository repository.MfaRepository,
+	mfaPolicy mfa.Policy,
 	token auth.TokenManager,
 	tokenService tokenService,
 ) *loginService {
@@ -70,11 +92,17 @@ func NewLoginService(
 		errorPassword:   errorPassword,
 		errorToken:      errorToken,
 		errorHandler:    errorHandler,
+		errorMfa:        errorMfa,
 		mencache:        mencache,
+		mfaCache:        mfaCache,
 		logger:          logger,
 		hash:            hash,
 		user:            userRepository,
+		role:            roleRepository,
 		refreshToken:    refreshToken,
+		outbox:          outbox,
+		mfa:             mfaRepository,
+		mfaPolicy:       mfaPolicy,
 		token:           token,
 		trace:           otel.Tracer("login-service"),
 		tokenService:    tokenService,
@@ -92,43 +120,191 @@ func (s *loginService) Login(ctx context.Context, request *requests.AuthRequest)
 		end(status)
 	}()
 
-	if cachedToken, found := s.mencache.GetCachedLogin(ctx, request.Email); found {
-		logSuccess("Successfully logged in", zap.String("email", request.Email))
-		return cachedToken, nil
+	key := loginKey(request.Email)
+	ip := session.FromContext(ctx).IPAddress
+
+	if lockedFor, locked := s.mencache.AccountLockedFor(ctx, key); locked {
+		setRetryAfter(ctx, lockedFor)
+
+		return s.errorHandler.HandleAccountLockedError(errAccountLocked, method, "ACCOUNT_LOCKED", span, &status, zap.String("email", request.Email))
+	}
+
+	if ip != "" {
+		if failures, resetIn := s.mencache.FailedLoginsByIP(ctx, ip); failures >= loginMaxFailuresPerIP {
+			setRetryAfter(ctx, resetIn)
+
+			return s.errorHandler.HandleTooManyAttemptsError(errTooManyLoginAttempts, method, "LOGIN_THROTTLED", span, &status, zap.String("ip", ip))
+		}
+	}
+
+	if wait, throttled := s.mencache.LoginBackoff(ctx, key); throttled {
+		setRetryAfter(ctx, wait)
+
+		return s.errorHandler.HandleTooManyAttemptsError(errTooManyLoginAttempts, method, "LOGIN_THROTTLED", span, &status, zap.String("email", request.Email))
 	}
 
 	res, err := s.user.FindByEmailAndVerify(ctx, request.Email)
 	if err != nil {
+		s.recordFailedLogin(ctx, key, ip, "")
+
 		return s.errorHandler.HandleFindEmailError(err, method, "LOGIN_ERR", span, &status, zap.Error(err))
 	}
 
 	err = s.hash.ComparePassword(res.Password, request.Password)
 	if err != nil {
+		if s.recordFailedLogin(ctx, key, ip, res.Email) {
+			setRetryAfter(ctx, loginLockDuration)
+
+			return s.errorHandler.HandleAccountLockedError(err, method, "ACCOUNT_LOCKED", span, &status, zap.String("email", request.Email))
+		}
+
 		return s.errorPassword.HandleComparePasswordError(err, method, "COMPARE_PASSWORD_ERR", span, &status, zap.Error(err))
 	}
 
-	token, err := s.tokenService.createAccessToken(ctx, res.ID)
+	s.mencache.ClearFailedLogins(ctx, key)
+
+	factor, err := s.mfa.FindByUserId(ctx, res.ID)
+	if err != nil && !errors.Is(err, mfa.ErrNotEnrolled) {
+		return s.errorMfa.HandleVerifyError(err, method, "FIND_MFA_ERR", span, &status, zap.Int("user_id", res.ID))
+	}
+
+	if factor != nil && factor.Enabled {
+		challenge, err := mfa.NewChallenge()
+		if err != nil {
+			return s.errorMfa.HandleVerifyError(err, method, "CREATE_MFA_CHALLENGE_ERR", span, &status, zap.Int("user_id", res.ID))
+		}
+
+		s.mfaCache.SetMfaChallenge(ctx, challenge, res.ID, mfa.ChallengeTTL)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(mfa.MetadataChallenge, challenge))
+
+		return s.errorMfa.HandleVerifyError(mfa.ErrRequired, method, "MFA_REQUIRED", span, &status, zap.Int("user_id", res.ID))
+	}
+
+	required, err := requiresMfa(ctx, s.role, s.mfaPolicy, res.ID)
 	if err != nil {
-		return s.errorToken.HandleCreateAccessTokenError(err, method, "CREATE_ACCESS_TOKEN_ERR", span, &status, zap.Error(err))
+		return s.errorMfa.HandleVerifyError(err, method, "FIND_ROLES_ERR", span, &status, zap.Int("user_id", res.ID))
+	}
+
+	if required {
+		return s.errorMfa.HandleVerifyError(mfa.ErrEnrollmentRequired, method, "MFA_ENROLLMENT_REQUIRED", span, &status, zap.Int("user_id", res.ID))
 	}
 
-	refreshToken, err := s.tokenService.createRefreshToken(ctx, res.ID)
+	tokenResp, err := s.tokenService.openSession(ctx, res.ID)
 	if err != nil {
 		return s.errorToken.HandleCreateRefreshTokenError(err, method, "CREATE_REFRESH_TOKEN_ERR", span, &status, zap.Error(err))
 	}
 
-	tokenResp := &response.TokenResponse{
-		AccessToken:  token,
-		RefreshToken: refreshToken,
+	logSuccess("Successfully logged in", zap.String("email", request.Email))
+
+	return tokenResp, nil
+}
+
+// VerifyMfa is the second step of a login that returned a challenge. A wrong
+// code counts as a failed login, so guessing codes runs into the same
+// backoff and lockout as guessing passwords.
+func (s *loginService) VerifyMfa(ctx context.Context, challenge string, code string) (*response.TokenResponse, *response.ErrorResponse) {
+	const method = "VerifyMfa"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method)
+
+	defer func() {
+		end(status)
+	}()
+
+	userId, ok := s.mfaCache.GetMfaChallenge(ctx, challenge)
+	if !ok {
+		return s.errorMfa.HandleVerifyError(mfa.ErrChallengeInvalid, method, "MFA_CHALLENGE_INVALID", span, &status)
 	}
 
-	s.mencache.SetCachedLogin(ctx, request.Email, tokenResp, time.Minute)
+	user, err := s.user.FindById(ctx, userId)
+	if err != nil {
+		return s.errorMfa.HandleVerifyError(err, method, "FIND_USER_ERR", span, &status, zap.Int("user_id", userId))
+	}
 
-	logSuccess("Successfully logged in", zap.String("email", request.Email))
+	key := loginKey(user.Email)
+	ip := session.FromContext(ctx).IPAddress
+
+	if lockedFor, locked := s.mencache.AccountLockedFor(ctx, key); locked {
+		s.mfaCache.TakeMfaChallenge(ctx, challenge)
+		setRetryAfter(ctx, lockedFor)
+
+		return s.errorHandler.HandleAccountLockedError(errAccountLocked, method, "ACCOUNT_LOCKED", span, &status, zap.Int("user_id", userId))
+	}
+
+	factor, err := s.mfa.FindByUserId(ctx, userId)
+	if err != nil {
+		return s.errorMfa.HandleVerifyError(err, method, "FIND_MFA_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	valid, err := verifyMfaCode(ctx, s.mfa, factor, code)
+	if err != nil {
+		return s.errorMfa.HandleVerifyError(err, method, "VERIFY_MFA_CODE_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	if !valid {
+		if s.mfaCache.FailMfaChallenge(ctx, challenge, mfa.ChallengeTTL) >= mfa.MaxChallengeAttempts {
+			s.mfaCache.TakeMfaChallenge(ctx, challenge)
+		}
+
+		if s.recordFailedLogin(ctx, key, ip, user.Email) {
+			s.mfaCache.TakeMfaChallenge(ctx, challenge)
+			setRetryAfter(ctx, loginLockDuration)
+
+			return s.errorHandler.HandleAccountLockedError(mfa.ErrInvalidCode, method, "ACCOUNT_LOCKED", span, &status, zap.Int("user_id", userId))
+		}
+
+		return s.errorMfa.HandleVerifyError(mfa.ErrInvalidCode, method, "INVALID_MFA_CODE", span, &status, zap.Int("user_id", userId))
+	}
+
+	if !s.mfaCache.TakeMfaChallenge(ctx, challenge) {
+		return s.errorMfa.HandleVerifyError(mfa.ErrChallengeInvalid, method, "MFA_CHALLENGE_INVALID", span, &status, zap.Int("user_id", userId))
+	}
+
+	s.mencache.ClearFailedLogins(ctx, key)
+
+	tokenResp, err := s.tokenService.openSession(ctx, userId)
+	if err != nil {
+		return s.errorToken.HandleCreateRefreshTokenError(err, method, "CREATE_REFRESH_TOKEN_ERR", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully logged in with two-factor authentication", zap.Int("user_id", userId))
 
 	return tokenResp, nil
 }
 
+// recordFailedLogin counts a failure and applies the backoff or lockout it
+// earns. It reports whether the account is now locked. Unknown emails are
+// counted too, so probing them is throttled the same way.
+func (s *loginService) recordFailedLogin(ctx context.Context, key string, ip string, address string) bool {
+	failures, _ := s.mencache.RecordFailedLogin(ctx, key, ip, loginFailureWindow)
+
+	if failures < loginLockAfter {
+		if backoff := loginBackoff(failures); backoff > 0 {
+			s.mencache.SetLoginBackoff(ctx, key, backoff)
+		}
+		return false
+	}
+
+	s.mencache.LockAccount(ctx, key, loginLockDuration)
+	s.mencache.ClearFailedLogins(ctx, key)
+
+	s.logger.Info("Locked account after repeated failed logins", zap.String("email", key), zap.String("ip", ip))
+
+	if address == "" {
+		return true
+	}
+
+	payload, err := lockoutEmail(address, true, loginLockDuration)
+	if err == nil {
+		err = s.outbox.Enqueue(ctx, topicAccountLocked, key, payload)
+	}
+	if err != nil {
+		s.logger.Error("Failed to enqueue account locked email", zap.String("email", key), zap.Error(err))
+	}
+
+	return true
+}
+
 func (s *loginService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
 	context.Context,
 	trace.Span,
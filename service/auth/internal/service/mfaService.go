@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/mfa"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type mfaService struct {
+	errorHandler    errorhandler.MfaErrorHandler
+	logger          logger.LoggerInterface
+	user            repository.UserRepository
+	role            repository.RoleRepository
+	mfa             repository.MfaRepository
+	unitOfWork      repository.UnitOfWork
+	policy          mfa.Policy
+	trace           trace.Tracer
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func NewMfaService(
+	errorHandler errorhandler.MfaErrorHandler,
+	logger logger.LoggerInterface,
+	user repository.UserRepository,
+	role repository.RoleRepository,
+	mfaRepository repository.MfaRepository,
+	unitOfWork repository.UnitOfWork,
+	policy mfa.Policy,
+) *mfaService {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "mfa_service_requests_total",
+			Help: "Total number of two-factor authentication requests",
+		},
+		[]string{"method", "status"},
+	)
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "mfa_service_request_duration_seconds",
+			Help:    "Duration of two-factor authentication requests",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return &mfaService{
+		errorHandler:    errorHandler,
+		logger:          logger,
+		user:            user,
+		role:            role,
+		mfa:             mfaRepository,
+		unitOfWork:      unitOfWork,
+		policy:          policy,
+		trace:           otel.Tracer("mfa-service"),
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+}
+
+// BeginEnrollment stores a new secret for the user. It does not protect
+// logins until ConfirmEnrollment sees a code generated from it, so a user who
+// never finishes scanning the QR code is not locked out.
+func (s *mfaService) BeginEnrollment(ctx context.Context, userId int) (*mfa.Enrollment, *response.ErrorResponse) {
+	const method = "BeginEnrollment"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("user_id", userId))
+
+	defer func() {
+		end(status)
+	}()
+
+	user, err := s.user.FindById(ctx, userId)
+	if err != nil {
+		return s.errorHandler.HandleEnrollError(err, method, "FIND_USER_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	secret, err := mfa.GenerateSecret()
+	if err != nil {
+		return s.errorHandler.HandleEnrollError(err, method, "GENERATE_SECRET_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	if err := s.mfa.SavePendingFactor(ctx, userId, secret); err != nil {
+		return s.errorHandler.HandleEnrollError(err, method, "SAVE_SECRET_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	logSuccess("Started two-factor enrollment", zap.Int("user_id", userId))
+
+	return &mfa.Enrollment{
+		Secret:          secret,
+		ProvisioningURI: mfa.ProvisioningURI(mfa.Issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment turns the pending secret on and returns the recovery
+// codes. They are not stored in plain text, so this is the only time the user
+// can see them.
+func (s *mfaService) ConfirmEnrollment(ctx context.Context, userId int, code string) ([]string, *response.ErrorResponse) {
+	const method = "ConfirmEnrollment"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("user_id", userId))
+
+	defer func() {
+		end(status)
+	}()
+
+	factor, err := s.mfa.FindByUserId(ctx, userId)
+	if err != nil {
+		return s.errorHandler.HandleConfirmEnrollmentError(err, method, "FIND_SECRET_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	if factor.Enabled {
+		return s.errorHandler.HandleConfirmEnrollmentError(mfa.ErrAlreadyEnabled, method, "MFA_ALREADY_ENABLED", span, &status, zap.Int("user_id", userId))
+	}
+
+	step, ok := mfa.Validate(factor.Secret, code, time.Now())
+	if !ok {
+		return s.errorHandler.HandleConfirmEnrollmentError(mfa.ErrInvalidCode, method, "INVALID_MFA_CODE", span, &status, zap.Int("user_id", userId))
+	}
+
+	recovery, err := mfa.GenerateRecoveryCodes()
+	if err != nil {
+		return s.errorHandler.HandleConfirmEnrollmentError(err, method, "GENERATE_RECOVERY_CODES_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	hashes := make([]string, len(recovery))
+	for i, c := range recovery {
+		hashes[i] = mfa.HashRecoveryCode(c)
+	}
+
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		if err := tx.Mfa.EnableFactor(ctx, userId, step); err != nil {
+			return err
+		}
+
+		return tx.Mfa.ReplaceRecoveryCodes(ctx, userId, hashes)
+	})
+	if err != nil {
+		return s.errorHandler.HandleConfirmEnrollmentError(err, method, "ENABLE_MFA_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	logSuccess("Enabled two-factor authentication", zap.Int("user_id", userId))
+
+	return recovery, nil
+}
+
+// Disable needs a current code or a recovery code, so a stolen access token
+// alone cannot turn the second factor off.
+func (s *mfaService) Disable(ctx context.Context, userId int, code string) (bool, *response.ErrorResponse) {
+	const method = "DisableMfa"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("user_id", userId))
+
+	defer func() {
+		end(status)
+	}()
+
+	required, err := requiresMfa(ctx, s.role, s.policy, userId)
+	if err != nil {
+		return s.errorHandler.HandleDisableError(err, method, "FIND_ROLES_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	if required {
+		return s.errorHandler.HandleDisableError(mfa.ErrEnrollmentRequired, method, "MFA_REQUIRED_BY_ROLE", span, &status, zap.Int("user_id", userId))
+	}
+
+	factor, err := s.mfa.FindByUserId(ctx, userId)
+	if err != nil {
+		return s.errorHandler.HandleDisableError(err, method, "FIND_SECRET_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	if factor.Enabled {
+		ok, err := verifyMfaCode(ctx, s.mfa, factor, code)
+		if err != nil {
+			return s.errorHandler.HandleDisableError(err, method, "VERIFY_MFA_CODE_ERR", span, &status, zap.Int("user_id", userId))
+		}
+
+		if !ok {
+			return s.errorHandler.HandleDisableError(mfa.ErrInvalidCode, method, "INVALID_MFA_CODE", span, &status, zap.Int("user_id", userId))
+		}
+	}
+
+	if err := s.mfa.DeleteFactor(ctx, userId); err != nil {
+		return s.errorHandler.HandleDisableError(err, method, "DELETE_SECRET_ERR", span, &status, zap.Int("user_id", userId))
+	}
+
+	logSuccess("Disabled two-factor authentication", zap.Int("user_id", userId))
+
+	return true, nil
+}
+
+// verifyMfaCode accepts a TOTP code, which cannot be used twice, or an unused
+// recovery code, which is spent.
+func verifyMfaCode(ctx context.Context, repo repository.MfaRepository, factor *mfa.Factor, code string) (bool, error) {
+	if step, ok := mfa.Validate(factor.Secret, code, time.Now()); ok {
+		return repo.RecordStep(ctx, factor.UserID, step)
+	}
+
+	return repo.UseRecoveryCode(ctx, factor.UserID, mfa.HashRecoveryCode(code))
+}
+
+func requiresMfa(ctx context.Context, repo repository.RoleRepository, policy mfa.Policy, userId int) (bool, error) {
+	if policy.Empty() {
+		return false, nil
+	}
+
+	roles, err := repo.FindByUserId(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = role.Name
+	}
+
+	return policy.Requires(names), nil
+}
+
+func (s *mfaService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
+	context.Context,
+	trace.Span,
+	func(string),
+	string,
+	func(string, ...zap.Field),
+) {
+	start := time.Now()
+	status := "success"
+
+	ctx, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+
+	s.logger.Info("Start: " + method)
+
+	end := func(status string) {
+		s.recordMetrics(method, status, start)
+		code := codes.Ok
+		if status != "success" {
+			code = codes.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess := func(msg string, fields ...zap.Field) {
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	return ctx, span, end, status, logSuccess
+}
+
+func (s *mfaService) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
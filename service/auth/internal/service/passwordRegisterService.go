@@ -11,7 +11,6 @@ import (
 	mencache "github.com/MamangRust/monolith-point-of-sale-auth/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/repository"
 	emails "github.com/MamangRust/monolith-point-of-sale-pkg/email"
-	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/randomstring"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -24,6 +23,8 @@ import (
 	"go.uber.org/zap"
 )
 
+var errRollbackPasswordReset = errors.New("password reset transaction rolled back")
+
 type passwordResetService struct {
 	errorhandler      errorhandler.PasswordResetErrorHandler
 	errorRandomString errorhandler.RandomStringErrorHandler
@@ -31,11 +32,13 @@ type passwordResetService struct {
 	errorPassword     errorhandler.PasswordErrorHandler
 	errorKafka        errorhandler.KafkaErrorHandler
 	mencache          mencache.PasswordResetCache
+	loginCache        mencache.LoginCache
 	trace             trace.Tracer
-	kafka             *kafka.Kafka
 	logger            logger.LoggerInterface
 	user              repository.UserRepository
 	resetToken        repository.ResetTokenRepository
+	unitOfWork        repository.UnitOfWork
+	outbox            repository.OutboxRepository
 	requestCounter    *prometheus.CounterVec
 	requestDuration   *prometheus.HistogramVec
 }
@@ -47,7 +50,8 @@ func NewPasswordResetService(
 	errorPassword errorhandler.PasswordErrorHandler,
 	errorKafka errorhandler.KafkaErrorHandler,
 	mencache mencache.PasswordResetCache,
-	kafka *kafka.Kafka, logger logger.LoggerInterface, user repository.UserRepository, resetToken repository.ResetTokenRepository) *passwordResetService {
+	loginCache mencache.LoginCache,
+	logger logger.LoggerInterface, user repository.UserRepository, resetToken repository.ResetTokenRepository, unitOfWork repository.UnitOfWork, outbox repository.OutboxRepository) *passwordResetService {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "password_reset_service_requests_total",
@@ -74,11 +78,13 @@ func NewPasswordResetService(
 		errorMarshal:      errorMarshal,
 		errorKafka:        errorKafka,
 		mencache:          mencache,
-		kafka:             kafka,
+		loginCache:        loginCache,
 		trace:             otel.Tracer("password-reset-service"),
 		user:              user,
 		logger:            logger,
 		resetToken:        resetToken,
+		unitOfWork:        unitOfWork,
+		outbox:            outbox,
 		requestCounter:    requestCounter,
 		requestDuration:   requestDuration,
 	}
@@ -105,17 +111,6 @@ func (s *passwordResetService) ForgotPassword(ctx context.Context, email string)
 		return s.errorRandomString.HandleRandomStringErrorForgotPassword(err, method, "FORGOT_PASSWORD_ERR", span, &status, zap.String("email", email), zap.Error(err))
 	}
 
-	_, err = s.resetToken.CreateResetToken(ctx, &requests.CreateResetTokenRequest{
-		UserID:     res.ID,
-		ResetToken: random,
-		ExpiredAt:  time.Now().Add(24 * time.Hour).Format("2006-01-02 15:04:05"),
-	})
-	if err != nil {
-		return s.errorhandler.HandleCreateResetTokenError(err, method, "FORGOT_PASSWORD_ERR", span, &status, zap.String("email", email), zap.Error(err))
-	}
-
-	s.mencache.SetResetTokenCache(ctx, random, res.ID, 5*time.Minute)
-
 	htmlBody := emails.GenerateEmailHTML(map[string]string{
 		"Title":   "Reset Your Password",
 		"Message": "Click the button below to reset your password.",
@@ -134,11 +129,35 @@ func (s *passwordResetService) ForgotPassword(ctx context.Context, email string)
 		return s.errorMarshal.HandleMarsalForgotPassword(err, method, "FORGOT_PASSWORD_ERR", span, &status, zap.Error(err))
 	}
 
-	err = s.kafka.SendMessage("email-service-topic-auth-forgot-password", strconv.Itoa(res.ID), payloadBytes)
+	var errResp *response.ErrorResponse
+
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		_, err := tx.ResetToken.CreateResetToken(ctx, &requests.CreateResetTokenRequest{
+			UserID:     res.ID,
+			ResetToken: random,
+			ExpiredAt:  time.Now().Add(24 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+		if err != nil {
+			_, errResp = s.errorhandler.HandleCreateResetTokenError(err, method, "FORGOT_PASSWORD_ERR", span, &status, zap.String("email", email), zap.Error(err))
+			return errRollbackPasswordReset
+		}
+
+		if err := tx.Outbox.Enqueue(ctx, "email-service-topic-auth-forgot-password", strconv.Itoa(res.ID), payloadBytes); err != nil {
+			_, errResp = s.errorKafka.HandleSendEmailForgotPassword(err, method, "FORGOT_PASSWORD_ERR", span, &status, zap.Error(err))
+			return errRollbackPasswordReset
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return false, errResp
+	}
 	if err != nil {
-		return s.errorKafka.HandleSendEmailForgotPassword(err, method, "FORGOT_PASSWORD_ERR", span, &status, zap.Error(err))
+		return s.errorhandler.HandleCreateResetTokenError(err, method, "FORGOT_PASSWORD_ERR", span, &status, zap.String("email", email), zap.Error(err))
 	}
 
+	s.mencache.SetResetTokenCache(ctx, random, res.ID, 5*time.Minute)
+
 	logSuccess("Successfully sent password reset email", zap.String("email", email))
 
 	return true, nil
@@ -172,7 +191,7 @@ func (s *passwordResetService) ResetPassword(ctx context.Context, req *requests.
 		return s.errorPassword.HandlePasswordNotMatchError(err, method, "RESET_PASSWORD_ERR", span, &status, zap.String("reset_token", req.ResetToken))
 	}
 
-	_, err := s.user.UpdateUserPassword(ctx, userID, req.Password)
+	user, err := s.user.UpdateUserPassword(ctx, userID, req.Password)
 	if err != nil {
 		return s.errorhandler.HandleUpdatePasswordError(err, method, "RESET_PASSWORD_ERR", span, &status, zap.String("reset_token", req.ResetToken))
 	}
@@ -180,6 +199,8 @@ func (s *passwordResetService) ResetPassword(ctx context.Context, req *requests.
 	_ = s.resetToken.DeleteResetToken(ctx, userID)
 	s.mencache.DeleteResetTokenCache(ctx, req.ResetToken)
 
+	s.unlockAccount(ctx, user.Email)
+
 	logSuccess("Successfully reset password", zap.String("reset_token", req.ResetToken))
 
 	return true, nil
@@ -199,13 +220,6 @@ func (s *passwordResetService) VerifyCode(ctx context.Context, code string) (boo
 		return s.errorhandler.HandleVerifyCodeError(err, method, "VERIFY_CODE_ERR", span, &status, zap.String("code", code))
 	}
 
-	_, err = s.user.UpdateUserIsVerified(ctx, res.ID, true)
-	if err != nil {
-		return s.errorhandler.HandleUpdateVerifiedError(err, method, "VERIFY_CODE_ERR", span, &status, zap.Int("user.id", res.ID))
-	}
-
-	s.mencache.DeleteVerificationCodeCache(ctx, res.Email)
-
 	htmlBody := emails.GenerateEmailHTML(map[string]string{
 		"Title":   "Verification Success",
 		"Message": "Your account has been successfully verified. Click the button below to view or manage your card.",
@@ -218,16 +232,53 @@ func (s *passwordResetService) VerifyCode(ctx context.Context, code string) (boo
 		return s.errorMarshal.HandleMarshalVerifyCode(err, method, "SEND_EMAIL_VERIFY_CODE_ERR", span, &status, zap.Error(err))
 	}
 
-	err = s.kafka.SendMessage("email-service-topic-auth-verify-code-success", strconv.Itoa(res.ID), payloadBytes)
+	var errResp *response.ErrorResponse
+
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		if _, err := tx.User.UpdateUserIsVerified(ctx, res.ID, true); err != nil {
+			_, errResp = s.errorhandler.HandleUpdateVerifiedError(err, method, "VERIFY_CODE_ERR", span, &status, zap.Int("user.id", res.ID))
+			return errRollbackPasswordReset
+		}
+
+		if err := tx.Outbox.Enqueue(ctx, "email-service-topic-auth-verify-code-success", strconv.Itoa(res.ID), payloadBytes); err != nil {
+			_, errResp = s.errorKafka.HandleSendEmailVerifyCode(err, method, "SEND_EMAIL_VERIFY_CODE_ERR", span, &status, zap.Error(err))
+			return errRollbackPasswordReset
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return false, errResp
+	}
 	if err != nil {
-		return s.errorKafka.HandleSendEmailVerifyCode(err, method, "SEND_EMAIL_VERIFY_CODE_ERR", span, &status, zap.Error(err))
+		return s.errorhandler.HandleUpdateVerifiedError(err, method, "VERIFY_CODE_ERR", span, &status, zap.Int("user.id", res.ID))
 	}
 
+	s.mencache.DeleteVerificationCodeCache(ctx, res.Email)
+
 	logSuccess("Successfully verify code", zap.String("code", code))
 
 	return true, nil
 }
 
+// unlockAccount lifts a login lockout, since resetting the password proves
+// the caller owns the account.
+func (s *passwordResetService) unlockAccount(ctx context.Context, address string) {
+	key := loginKey(address)
+
+	if !s.loginCache.UnlockAccount(ctx, key) {
+		return
+	}
+
+	payload, err := lockoutEmail(address, false, 0)
+	if err == nil {
+		err = s.outbox.Enqueue(ctx, topicAccountUnlocked, key, payload)
+	}
+	if err != nil {
+		s.logger.Error("Failed to enqueue account unlocked email", zap.String("email", key), zap.Error(err))
+	}
+}
+
 func (s *passwordResetService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
 	context.Context,
 	trace.Span,
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strconv"
 	"time"
 
@@ -11,9 +12,9 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/repository"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/email"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/hash"
-	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/randomstring"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	response_service "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/service"
@@ -25,6 +26,12 @@ import (
 	"go.uber.org/zap"
 )
 
+var errRollbackRegister = errors.New("register transaction rolled back")
+
+// defaultRoleName is the role a self-registered user starts with: they
+// run their own merchant. Admins are only ever granted by another admin.
+const defaultRoleName = "ROLE_MERCHANT"
+
 type registerService struct {
 	errohandler       errorhandler.RegisterErrorHandler
 	errorPassword     errorhandler.PasswordErrorHandler
@@ -35,9 +42,8 @@ type registerService struct {
 	trace             trace.Tracer
 	user              repository.UserRepository
 	role              repository.RoleRepository
-	userRole          repository.UserRoleRepository
+	unitOfWork        repository.UnitOfWork
 	hash              hash.HashPassword
-	kafka             *kafka.Kafka
 	logger            logger.LoggerInterface
 	mapping           response_service.UserResponseMapper
 	requestCounter    *prometheus.CounterVec
@@ -51,7 +57,7 @@ func NewRegisterService(
 	errorMarshal errorhandler.MarshalErrorHandler,
 	errorKafka errorhandler.KafkaErrorHandler,
 	mencache mencache.RegisterCache,
-	user repository.UserRepository, role repository.RoleRepository, userRole repository.UserRoleRepository, hash hash.HashPassword, kafka *kafka.Kafka, logger logger.LoggerInterface, mapping response_service.UserResponseMapper) *registerService {
+	user repository.UserRepository, role repository.RoleRepository, unitOfWork repository.UnitOfWork, hash hash.HashPassword, logger logger.LoggerInterface, mapping response_service.UserResponseMapper) *registerService {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "register_service_requests_total",
@@ -81,9 +87,8 @@ func NewRegisterService(
 		trace:             otel.Tracer("register-service"),
 		user:              user,
 		role:              role,
-		userRole:          userRole,
+		unitOfWork:        unitOfWork,
 		hash:              hash,
-		kafka:             kafka,
 		logger:            logger,
 		mapping:           mapping,
 		requestCounter:    requestCounter,
@@ -112,8 +117,6 @@ func (s *registerService) Register(ctx context.Context, request *requests.Regist
 	}
 	request.Password = passwordHash
 
-	const defaultRoleName = "Admin Access 1"
-
 	role, err := s.role.FindByName(ctx, defaultRoleName)
 
 	if err != nil || role == nil {
@@ -129,11 +132,6 @@ func (s *registerService) Register(ctx context.Context, request *requests.Regist
 	request.VerifiedCode = random
 	request.IsVerified = false
 
-	newUser, err := s.user.CreateUser(ctx, request)
-	if err != nil {
-		return s.errohandler.HandleCreateUserError(err, "Register", "REGISTER_ERR", span, &status, zap.Error(err))
-	}
-
 	htmlBody := email.GenerateEmailHTML(map[string]string{
 		"Title":   "Welcome to SanEdge",
 		"Message": "Your account has been successfully created.",
@@ -152,17 +150,39 @@ func (s *registerService) Register(ctx context.Context, request *requests.Regist
 		return s.errorMarshal.HandleMarshalRegisterError(err, "Register", "MARSHAL_ERR", span, &status, zap.Error(err))
 	}
 
-	err = s.kafka.SendMessage("email-service-topic-auth-register", strconv.Itoa(newUser.ID), payloadBytes)
-	if err != nil {
-		return s.errorKafka.HandleSendEmailRegister(err, "Register", "SEND_EMAIL_ERR", span, &status, zap.Error(err))
-	}
+	var (
+		newUser *record.UserRecord
+		errResp *response.ErrorResponse
+	)
+
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		newUser, err = tx.User.CreateUser(ctx, request)
+		if err != nil {
+			_, errResp = s.errohandler.HandleCreateUserError(err, "Register", "REGISTER_ERR", span, &status, zap.Error(err))
+			return errRollbackRegister
+		}
+
+		_, err = tx.UserRole.AssignRoleToUser(ctx, &requests.CreateUserRoleRequest{
+			UserId: newUser.ID,
+			RoleId: role.ID,
+		})
+		if err != nil {
+			_, errResp = s.errohandler.HandleAssignRoleError(err, "Register", "ASSIGN_ROLE_ERR", span, &status, zap.Error(err))
+			return errRollbackRegister
+		}
 
-	_, err = s.userRole.AssignRoleToUser(ctx, &requests.CreateUserRoleRequest{
-		UserId: newUser.ID,
-		RoleId: role.ID,
+		if err := tx.Outbox.Enqueue(ctx, "email-service-topic-auth-register", strconv.Itoa(newUser.ID), payloadBytes); err != nil {
+			_, errResp = s.errorKafka.HandleSendEmailRegister(err, "Register", "SEND_EMAIL_ERR", span, &status, zap.Error(err))
+			return errRollbackRegister
+		}
+
+		return nil
 	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return s.errohandler.HandleAssignRoleError(err, "Register", "ASSIGN_ROLE_ERR", span, &status, zap.Error(err))
+		return s.errohandler.HandleCreateUserError(err, "Register", "REGISTER_ERR", span, &status, zap.Error(err))
 	}
 
 	s.mencache.SetVerificationCodeCache(ctx, request.Email, random, 15*time.Minute)
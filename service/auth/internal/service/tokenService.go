@@ -2,15 +2,14 @@ package service
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 	"time"
 
 	"github.com/MamangRust/monolith-point-of-sale-auth/internal/repository"
-	"github.com/MamangRust/monolith-point-of-sale-pkg/auth"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/session"
+	"github.com/MamangRust/monolith-point-of-sale-auth/internal/token"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	traceunic "github.com/MamangRust/monolith-point-of-sale-pkg/trace_unic"
-	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -21,7 +20,8 @@ import (
 
 type tokenService struct {
 	refreshToken    repository.RefreshTokenRepository
-	token           auth.TokenManager
+	unitOfWork      repository.UnitOfWork
+	token           token.TokenManager
 	logger          logger.LoggerInterface
 	trace           trace.Tracer
 	requestCounter  *prometheus.CounterVec
@@ -29,29 +29,41 @@ type tokenService struct {
 }
 
 func NewTokenService(
-	refreshToken repository.RefreshTokenRepository, token auth.TokenManager, logger logger.LoggerInterface) *tokenService {
-	prometheus.MustRegister(prometheus.NewCounterVec(
+	refreshToken repository.RefreshTokenRepository, unitOfWork repository.UnitOfWork, token token.TokenManager, logger logger.LoggerInterface) *tokenService {
+	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "token_service_requests_total",
 			Help: "Total number of auth requests",
 		},
 		[]string{"method", "status"},
-	))
+	)
 
-	prometheus.MustRegister(prometheus.NewHistogramVec(
+	requestDuration := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "token_service_request_duration_seconds",
 			Help:    "Duration of auth requests",
 			Buckets: prometheus.DefBuckets,
 		},
 		[]string{"method", "status"},
-	))
+	)
 
-	return &tokenService{trace: otel.Tracer("token-service"), refreshToken: refreshToken, token: token, logger: logger}
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return &tokenService{
+		trace:           otel.Tracer("token-service"),
+		refreshToken:    refreshToken,
+		unitOfWork:      unitOfWork,
+		token:           token,
+		logger:          logger,
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
 }
 
-func (s *tokenService) createAccessToken(ctx context.Context, id int) (string, error) {
-	const method = "createAccessToken"
+// openSession starts a new session for the device in ctx and issues its
+// first token pair. Other sessions of the user are left alone.
+func (s *tokenService) openSession(ctx context.Context, id int) (*response.TokenResponse, error) {
+	const method = "openSession"
 
 	ctx, end, logSuccess, status, logError := s.startTracingAndLogging(ctx, method, attribute.Int("user.id", id))
 
@@ -59,74 +71,94 @@ func (s *tokenService) createAccessToken(ctx context.Context, id int) (string, e
 		end(status)
 	}()
 
-	res, err := s.token.GenerateToken(id, "access")
+	client := session.FromContext(ctx)
+	expiresAt := time.Now().Add(session.RefreshTTL)
+
+	var tokens *response.TokenResponse
+
+	err := s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		sess, err := tx.RefreshToken.CreateSession(ctx, id, client, expiresAt)
+		if err != nil {
+			return err
+		}
+
+		tokens, err = s.issue(id, sess.ID)
+		if err != nil {
+			return err
+		}
+
+		return tx.RefreshToken.CreateSessionRefreshToken(ctx, sess.ID, id, tokens.RefreshToken, expiresAt)
+	})
 	if err != nil {
 		status = "error"
-		traceId := traceunic.GenerateTraceID("ACCESS_TOKEN_FAILED")
+		traceId := traceunic.GenerateTraceID("OPEN_SESSION_ERR")
 
-		logError(traceId, "Failed to create access token", err,
-			zap.Int("userID", id),
-			zap.Error(err),
-		)
+		logError(traceId, "Failed to open session", err, zap.Int("userID", id), zap.Error(err))
 
-		return "", err
+		return nil, err
 	}
 
-	logSuccess("Created access token",
+	logSuccess("Opened session",
 		zap.Int("userID", id),
+		zap.String("device", client.DeviceName),
 	)
 
-	return res, nil
+	return tokens, nil
 }
 
-func (s *tokenService) createRefreshToken(ctx context.Context, id int) (string, error) {
-	const method = "createRefreshToken"
+// rotateSession exchanges the session's current refresh token for a new
+// token pair. It returns session.ErrTokenReused when oldToken was already
+// exchanged.
+func (s *tokenService) rotateSession(ctx context.Context, sessionId int, id int, oldToken string) (*response.TokenResponse, error) {
+	const method = "rotateSession"
 
-	ctx, end, logSuccess, status, logError := s.startTracingAndLogging(ctx, method, attribute.Int("user.id", id))
+	ctx, end, logSuccess, status, logError := s.startTracingAndLogging(ctx, method, attribute.Int("user.id", id), attribute.Int("session.id", sessionId))
 
 	defer func() {
 		end(status)
 	}()
 
-	res, err := s.token.GenerateToken(id, "refresh")
-	if err != nil {
-		status = "error"
-		traceId := traceunic.GenerateTraceID("REFRESH_TOKEN_FAILED")
+	client := session.FromContext(ctx)
+	expiresAt := time.Now().Add(session.RefreshTTL)
 
-		logError(traceId, "Failed to create refresh token", err, zap.Int("user.id", id), zap.Error(err))
-		return "", err
+	tokens, err := s.issue(id, sessionId)
+	if err == nil {
+		err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+			return tx.RefreshToken.RotateRefreshToken(ctx, sessionId, id, oldToken, tokens.RefreshToken, expiresAt, client)
+		})
 	}
-
-	if err := s.refreshToken.DeleteRefreshTokenByUserId(ctx, id); err != nil && !errors.Is(err, sql.ErrNoRows) {
+	if err != nil {
 		status = "error"
+		traceId := traceunic.GenerateTraceID("ROTATE_SESSION_ERR")
 
-		traceId := traceunic.GenerateTraceID("DELETE_REFRESH_TOKEN_ERR")
+		logError(traceId, "Failed to rotate session", err, zap.Int("userID", id), zap.Int("sessionID", sessionId), zap.Error(err))
 
-		logError(traceId, "Failed to delete existing refresh token", err, zap.Int("userID", id), zap.Error(err))
-
-		return "", err
+		return nil, err
 	}
 
-	_, err = s.refreshToken.CreateRefreshToken(ctx, &requests.CreateRefreshToken{
-		Token:     res,
-		UserId:    id,
-		ExpiresAt: time.Now().Add(24 * time.Hour).Format("2006-01-02 15:04:05"),
-	})
-	if err != nil {
-		status = "error"
-
-		traceId := traceunic.GenerateTraceID("CREATE_REFRESH_TOKEN_ERR")
+	logSuccess("Rotated session",
+		zap.Int("userID", id),
+		zap.Int("sessionID", sessionId),
+	)
 
-		logError(traceId, "Failed to create refresh token", err, zap.Int("userID", id), zap.Error(err))
+	return tokens, nil
+}
 
-		return "", err
+func (s *tokenService) issue(id int, sessionId int) (*response.TokenResponse, error) {
+	accessToken, err := s.token.GenerateSessionToken(id, sessionId, "access")
+	if err != nil {
+		return nil, err
 	}
 
-	logSuccess("Created refresh token",
-		zap.Int("userID", id),
-	)
+	refreshToken, err := s.token.GenerateSessionToken(id, sessionId, "refresh")
+	if err != nil {
+		return nil, err
+	}
 
-	return res, nil
+	return &response.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
 }
 
 func (s *tokenService) startTracingAndLogging(
@@ -0,0 +1,55 @@
+package session
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys set by the API gateway on login and refresh calls.
+const (
+	MetadataDeviceName = "x-device-name"
+	MetadataClientIP   = "x-client-ip"
+	MetadataUserAgent  = "x-client-user-agent"
+)
+
+const (
+	maxDeviceName = 100
+	maxIPAddress  = 45
+	maxUserAgent  = 512
+)
+
+// Client describes the device a session was opened from.
+type Client struct {
+	DeviceName string
+	IPAddress  string
+	UserAgent  string
+}
+
+func FromContext(ctx context.Context) Client {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Client{}
+	}
+
+	return Client{
+		DeviceName: first(md, MetadataDeviceName, maxDeviceName),
+		IPAddress:  first(md, MetadataClientIP, maxIPAddress),
+		UserAgent:  first(md, MetadataUserAgent, maxUserAgent),
+	}
+}
+
+func first(md metadata.MD, key string, limit int) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	value := values[0]
+	if len(value) > limit {
+		value = strings.ToValidUTF8(value[:limit], "")
+	}
+
+	return value
+}
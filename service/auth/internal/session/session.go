@@ -0,0 +1,43 @@
+package session
+
+import (
+	"errors"
+	"time"
+)
+
+// RefreshTTL is how long a session stays open without being refreshed.
+const RefreshTTL = 24 * time.Hour
+
+// Reasons stored when a session is closed.
+const (
+	ReasonLogout  = "logout"
+	ReasonRevoked = "revoked"
+	ReasonReuse   = "reuse"
+)
+
+var (
+	ErrNotFound     = errors.New("session not found")
+	ErrTokenReused  = errors.New("refresh token has already been used")
+	ErrTokenUnknown = errors.New("refresh token does not belong to a session")
+)
+
+// Session is one login on one device. Every refresh token it hands out
+// replaces the previous one; together they form the session's family.
+type Session struct {
+	ID         int
+	UserID     int
+	DeviceName string
+	IPAddress  string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+}
+
+// Token is what a presented refresh token says about its session.
+type Token struct {
+	SessionID int
+	UserID    int
+	Rotated   bool
+	Revoked   bool
+}
@@ -0,0 +1,133 @@
+package token
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/auth"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TTL is the lifetime of every token the manager issues.
+const TTL = 12 * time.Hour
+
+var ErrInvalidClaims = errors.New("token is missing required claims")
+
+type Claims struct {
+	ID        string
+	Subject   string
+	SessionID int
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+type TokenManager interface {
+	auth.TokenManager
+	GenerateSessionToken(userId int, sessionId int, audience string) (string, error)
+	Parse(tokenString string) (*Claims, error)
+}
+
+// sessionClaims adds the session a token was issued for, so revoking the
+// session revokes its access tokens too.
+type sessionClaims struct {
+	jwt.RegisteredClaims
+	SessionID int `json:"sid,omitempty"`
+}
+
+// Manager issues the same HS256 tokens as auth.Manager but stamps each one
+// with a jti and iat, so a single token or every token of a user issued
+// before a point in time can be revoked.
+type Manager struct {
+	secretKey []byte
+}
+
+func NewManager(secretKey string) (*Manager, error) {
+	if secretKey == "" {
+		return nil, errors.New("empty secret key")
+	}
+
+	return &Manager{secretKey: []byte(secretKey)}, nil
+}
+
+func (m *Manager) GenerateToken(userId int, audience string) (string, error) {
+	return m.GenerateSessionToken(userId, 0, audience)
+}
+
+func (m *Manager) GenerateSessionToken(userId int, sessionId int, audience string) (string, error) {
+	id, err := newID()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, sessionClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        id,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(TTL)),
+			Subject:   strconv.Itoa(userId),
+			Audience:  []string{audience},
+		},
+		SessionID: sessionId,
+	})
+
+	return token.SignedString(m.secretKey)
+}
+
+func (m *Manager) ValidateToken(tokenString string) (string, error) {
+	claims, err := m.Parse(tokenString)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.Subject, nil
+}
+
+func (m *Manager) Parse(tokenString string) (*Claims, error) {
+	var parsed sessionClaims
+
+	_, err := jwt.ParseWithClaims(tokenString, &parsed, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return m.secretKey, nil
+	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, auth.ErrTokenExpired
+		}
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	if parsed.Subject == "" || parsed.ExpiresAt == nil {
+		return nil, ErrInvalidClaims
+	}
+
+	claims := &Claims{
+		ID:        parsed.ID,
+		Subject:   parsed.Subject,
+		SessionID: parsed.SessionID,
+		ExpiresAt: parsed.ExpiresAt.Time,
+	}
+
+	if parsed.IssuedAt != nil {
+		claims.IssuedAt = parsed.IssuedAt.Time
+	}
+
+	return claims, nil
+}
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -15,9 +15,14 @@ import (
 	mencache "github.com/MamangRust/monolith-point-of-sale-cashier/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/repository"
 	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/service"
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	otel_pkg "github.com/MamangRust/monolith-point-of-sale-pkg/otel"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
@@ -28,6 +33,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -44,11 +50,14 @@ func init() {
 }
 
 type Server struct {
-	Logger   logger.LoggerInterface
-	DB       *db.Queries
-	Services *service.Service
-	Handlers *handler.Handler
-	Ctx      context.Context
+	Logger       logger.LoggerInterface
+	DB           *db.Queries
+	Repositories *repository.Repositories
+	Services     *service.Service
+	Handlers     *handler.Handler
+	Relay        *outbox.Relay
+	Conn         *audit.DB
+	Ctx          context.Context
 }
 
 func NewServer(ctx context.Context) (*Server, func(context.Context) error, error) {
@@ -62,13 +71,14 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 	}
 	flag.Parse()
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 
 	shutdownTracerProvider, err := otel_pkg.InitTracerProvider("Cashier-service", ctx)
 	if err != nil {
@@ -100,6 +110,8 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Logger: logger,
 	})
 
+	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
+
 	errorhandler := errorhandler.NewErrorHandler(logger)
 
 	services := service.NewService(&service.Deps{
@@ -114,12 +126,17 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Service: services,
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, myKafka, logger)
+
 	return &Server{
-		Logger:   logger,
-		DB:       DB,
-		Services: services,
-		Handlers: handlers,
-		Ctx:      ctx,
+		Logger:       logger,
+		DB:           DB,
+		Repositories: repositories,
+		Services:     services,
+		Handlers:     handlers,
+		Relay:        relay,
+		Conn:         conn,
+		Ctx:          ctx,
 	}, shutdownTracerProvider, nil
 }
 
@@ -148,6 +165,49 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			tenant.Middleware(s.Repositories.Tenant, s.Logger),
+			tenant.Guard(
+				pb.CashierService_FindMonthlyTotalSales_FullMethodName,
+				pb.CashierService_FindYearlyTotalSales_FullMethodName,
+				pb.CashierService_FindMonthSales_FullMethodName,
+				pb.CashierService_FindYearSales_FullMethodName,
+				pb.CashierService_RestoreAllCashier_FullMethodName,
+				pb.CashierService_DeleteAllCashierPermanent_FullMethodName,
+			),
+			tenant.Owned(s.Repositories.CashierQuery.FindMerchantIdByCashier, map[string]tenant.RowID{
+				pb.CashierService_FindById_FullMethodName:                  tenant.ByID,
+				pb.CashierService_TrashedCashier_FullMethodName:            tenant.ByID,
+				pb.CashierService_RestoreCashier_FullMethodName:            tenant.ByID,
+				pb.CashierService_DeleteCashierPermanent_FullMethodName:    tenant.ByID,
+				pb.CashierService_UpdateCashier_FullMethodName:             cashierID,
+				pb.CashierService_FindMonthlyTotalSalesById_FullMethodName: cashierID,
+				pb.CashierService_FindYearlyTotalSalesById_FullMethodName:  cashierID,
+				pb.CashierService_FindMonthSalesById_FullMethodName:        cashierID,
+				pb.CashierService_FindYearSalesById_FullMethodName:         cashierID,
+				pb.CashierService_FindShiftSalesById_FullMethodName:        cashierID,
+				pb.CashierService_OpenShift_FullMethodName:                 cashierID,
+				pb.CashierService_FindOpenShift_FullMethodName:             cashierID,
+			}),
+			tenant.Owned(s.Repositories.Shift.FindMerchantIdByShift, map[string]tenant.RowID{
+				pb.CashierService_RecordShiftMovement_FullMethodName: shiftID,
+				pb.CashierService_CloseShift_FullMethodName:          shiftID,
+				pb.CashierService_FindShiftReport_FullMethodName:     shiftID,
+			}),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "cashier",
+				RequestID: middleware.RequestID,
+				Actions: map[string]string{
+					pb.CashierService_OpenShift_FullMethodName:           "open_shift",
+					pb.CashierService_RecordShiftMovement_FullMethodName: "record_shift_movement",
+					pb.CashierService_CloseShift_FullMethodName:          "close_shift",
+				},
+				Snapshots: map[string]audit.Snapshot{
+					"pb.CashierService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Cashier.FindById(ctx, &pb.FindByIdCashierRequest{Id: int32(id)})
+					},
+				},
+			}, s.Logger),
+			bulk.Middleware(s.Logger),
 		),
 	)
 
@@ -158,6 +218,8 @@ func (s *Server) Run() {
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
@@ -179,3 +241,23 @@ func (s *Server) Run() {
 
 	wg.Wait()
 }
+
+// cashierID reads the cashier addressed by stats and update requests.
+func cashierID(req interface{}) (int, bool) {
+	r, ok := req.(interface{ GetCashierId() int32 })
+	if !ok {
+		return 0, false
+	}
+
+	return int(r.GetCashierId()), true
+}
+
+// shiftID reads the shift addressed by drawer requests.
+func shiftID(req interface{}) (int, bool) {
+	r, ok := req.(interface{ GetShiftId() int32 })
+	if !ok {
+		return 0, false
+	}
+
+	return int(r.GetShiftId()), true
+}
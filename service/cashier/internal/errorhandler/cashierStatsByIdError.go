@@ -1,6 +1,7 @@
 package errorhandler
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/cashier_errors"
@@ -31,3 +32,7 @@ func (c *cashierStatsByIdError) HandleMonthlySalesByIdError(err error, method, t
 func (c *cashierStatsByIdError) HandleYearlySalesByIdError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*response.CashierResponseYearSales, *response.ErrorResponse) {
 	return handleErrorRepository[[]*response.CashierResponseYearSales](c.logger, err, method, tracePrefix, span, status, cashier_errors.ErrFailedFindYearlyTotalSalesById, fields...)
 }
+
+func (c *cashierStatsByIdError) HandleShiftSalesByIdError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*shift.ShiftSales, *response.ErrorResponse) {
+	return handleErrorRepository[[]*shift.ShiftSales](c.logger, err, method, tracePrefix, span, status, shiftErrorResponse(err, ErrFailedFindShiftSales), fields...)
+}
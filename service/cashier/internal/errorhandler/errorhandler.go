@@ -8,6 +8,7 @@ type ErrorHandler struct {
 	CashierStatsError           CashierStatsError
 	CashierStatsByIdError       CashierStatsByIdError
 	CashierStatsByMerchantError CashierStatsByMerchantError
+	ShiftError                  ShiftError
 }
 
 func NewErrorHandler(logger logger.LoggerInterface) *ErrorHandler {
@@ -17,5 +18,6 @@ func NewErrorHandler(logger logger.LoggerInterface) *ErrorHandler {
 		CashierStatsError:           NewCashierStatsError(logger),
 		CashierStatsByIdError:       NewcashierStatsByIdError(logger),
 		CashierStatsByMerchantError: NewcashierStatsByMerchantError(logger),
+		ShiftError:                  NewShiftError(logger),
 	}
 }
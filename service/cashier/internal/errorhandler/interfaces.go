@@ -1,6 +1,7 @@
 package errorhandler
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -55,6 +56,7 @@ type CashierStatsByIdError interface {
 	HandleYearlyTotalSalesByIdError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*response.CashierResponseYearTotalSales, *response.ErrorResponse)
 	HandleMonthlySalesByIdError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*response.CashierResponseMonthSales, *response.ErrorResponse)
 	HandleYearlySalesByIdError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*response.CashierResponseYearSales, *response.ErrorResponse)
+	HandleShiftSalesByIdError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*shift.ShiftSales, *response.ErrorResponse)
 }
 
 type CashierStatsByMerchantError interface {
@@ -63,3 +65,11 @@ type CashierStatsByMerchantError interface {
 	HandleMonthlySalesByMerchantError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*response.CashierResponseMonthSales, *response.ErrorResponse)
 	HandleYearlySalesByMerchantError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*response.CashierResponseYearSales, *response.ErrorResponse)
 }
+
+type ShiftError interface {
+	HandleOpenShiftError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.Shift, *response.ErrorResponse)
+	HandleFindShiftError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.Shift, *response.ErrorResponse)
+	HandleRecordMovementError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.Movement, *response.ErrorResponse)
+	HandleCloseShiftError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.ZReport, *response.ErrorResponse)
+	HandleReportError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.ZReport, *response.ErrorResponse)
+}
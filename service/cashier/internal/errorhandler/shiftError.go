@@ -0,0 +1,85 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrShiftNotFound         = response.NewErrorResponse("Shift not found", http.StatusNotFound)
+	ErrShiftCashierNotFound  = response.NewErrorResponse("Cashier not found", http.StatusNotFound)
+	ErrShiftAlreadyOpen      = response.NewErrorResponse("Cashier already has an open shift", http.StatusConflict)
+	ErrShiftClosed           = response.NewErrorResponse("Shift is already closed", http.StatusConflict)
+	ErrShiftInvalidAmount    = response.NewErrorResponse("Cash amounts must not be negative", http.StatusBadRequest)
+	ErrShiftInvalidMovement  = response.NewErrorResponse("Movement must be a positive pay_in or pay_out", http.StatusBadRequest)
+	ErrShiftReasonRequired   = response.NewErrorResponse("Movement reason is required", http.StatusBadRequest)
+	ErrShiftOperatorRequired = response.NewErrorResponse("Shift operator is required", http.StatusBadRequest)
+	ErrShiftInvalidPeriod    = response.NewErrorResponse("Invalid year or month", http.StatusBadRequest)
+	ErrFailedOpenShift       = response.NewErrorResponse("Failed to open shift", http.StatusInternalServerError)
+	ErrFailedCloseShift      = response.NewErrorResponse("Failed to close shift", http.StatusInternalServerError)
+	ErrFailedRecordMovement  = response.NewErrorResponse("Failed to record cash movement", http.StatusInternalServerError)
+	ErrFailedFindShift       = response.NewErrorResponse("Failed to find shift", http.StatusInternalServerError)
+	ErrFailedFindShiftReport = response.NewErrorResponse("Failed to build shift report", http.StatusInternalServerError)
+	ErrFailedFindShiftSales  = response.NewErrorResponse("Failed to find shift sales", http.StatusInternalServerError)
+)
+
+type shiftError struct {
+	logger logger.LoggerInterface
+}
+
+func NewShiftError(logger logger.LoggerInterface) *shiftError {
+	return &shiftError{
+		logger: logger,
+	}
+}
+
+func (e *shiftError) HandleOpenShiftError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.Shift, *response.ErrorResponse) {
+	return handleErrorRepository[*shift.Shift](e.logger, err, method, tracePrefix, span, status, shiftErrorResponse(err, ErrFailedOpenShift), fields...)
+}
+
+func (e *shiftError) HandleFindShiftError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.Shift, *response.ErrorResponse) {
+	return handleErrorRepository[*shift.Shift](e.logger, err, method, tracePrefix, span, status, shiftErrorResponse(err, ErrFailedFindShift), fields...)
+}
+
+func (e *shiftError) HandleRecordMovementError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.Movement, *response.ErrorResponse) {
+	return handleErrorRepository[*shift.Movement](e.logger, err, method, tracePrefix, span, status, shiftErrorResponse(err, ErrFailedRecordMovement), fields...)
+}
+
+func (e *shiftError) HandleCloseShiftError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.ZReport, *response.ErrorResponse) {
+	return handleErrorRepository[*shift.ZReport](e.logger, err, method, tracePrefix, span, status, shiftErrorResponse(err, ErrFailedCloseShift), fields...)
+}
+
+func (e *shiftError) HandleReportError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*shift.ZReport, *response.ErrorResponse) {
+	return handleErrorRepository[*shift.ZReport](e.logger, err, method, tracePrefix, span, status, shiftErrorResponse(err, ErrFailedFindShiftReport), fields...)
+}
+
+func shiftErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, shift.ErrNotFound):
+		return ErrShiftNotFound
+	case errors.Is(err, shift.ErrCashierNotFound):
+		return ErrShiftCashierNotFound
+	case errors.Is(err, shift.ErrAlreadyOpen):
+		return ErrShiftAlreadyOpen
+	case errors.Is(err, shift.ErrClosed):
+		return ErrShiftClosed
+	case errors.Is(err, shift.ErrInvalidAmount):
+		return ErrShiftInvalidAmount
+	case errors.Is(err, shift.ErrInvalidMovement):
+		return ErrShiftInvalidMovement
+	case errors.Is(err, shift.ErrReasonRequired):
+		return ErrShiftReasonRequired
+	case errors.Is(err, shift.ErrOperatorRequired):
+		return ErrShiftOperatorRequired
+	case errors.Is(err, shift.ErrInvalidPeriod):
+		return ErrShiftInvalidPeriod
+	default:
+		return fallback
+	}
+}
@@ -20,6 +20,7 @@ type cashierHandleGrpc struct {
 	cashierStats           service.CashierStatsService
 	cashierStatsById       service.CashierStatsByIdService
 	cashierStatsByMerchant service.CashierStatsByMerchant
+	shift                  service.ShiftService
 	mapping                protomapper.CashierProtoMapper
 }
 
@@ -32,6 +33,7 @@ func NewCashierHandleGrpc(
 		cashierStats:           service.CashierStats,
 		cashierStatsById:       service.CashierStatsById,
 		cashierStatsByMerchant: service.CashierStatsByMerchant,
+		shift:                  service.Shift,
 		mapping:                protomapper.NewCashierProtoMapper(),
 	}
 }
@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/cashier_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func (s *cashierHandleGrpc) OpenShift(ctx context.Context, req *pb.OpenShiftRequest) (*pb.ApiResponseShift, error) {
+	cashierID := int(req.GetCashierId())
+
+	if cashierID == 0 {
+		return nil, cashier_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.shift.OpenShift(ctx, &shift.OpenRequest{
+		CashierID:    cashierID,
+		OpeningFloat: int(req.GetOpeningFloat()),
+		OperatorID:   int(req.GetOperatorId()),
+	})
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseShift{
+		Status:  "success",
+		Message: "Successfully opened shift",
+		Data:    toProtoShift(res),
+	}, nil
+}
+
+func (s *cashierHandleGrpc) FindOpenShift(ctx context.Context, req *pb.FindOpenShiftRequest) (*pb.ApiResponseShift, error) {
+	cashierID := int(req.GetCashierId())
+
+	if cashierID == 0 {
+		return nil, cashier_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.shift.FindOpenShift(ctx, cashierID)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseShift{
+		Status:  "success",
+		Message: "Successfully fetched open shift",
+		Data:    toProtoShift(res),
+	}, nil
+}
+
+func (s *cashierHandleGrpc) RecordShiftMovement(ctx context.Context, req *pb.RecordShiftMovementRequest) (*pb.ApiResponseShiftMovement, error) {
+	shiftID := int(req.GetShiftId())
+
+	if shiftID == 0 {
+		return nil, cashier_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.shift.RecordMovement(ctx, &shift.MovementRequest{
+		ShiftID:    shiftID,
+		Kind:       shift.MovementKind(req.GetKind()),
+		Amount:     int(req.GetAmount()),
+		Reason:     req.GetReason(),
+		OperatorID: int(req.GetOperatorId()),
+	})
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseShiftMovement{
+		Status:  "success",
+		Message: "Successfully recorded cash movement",
+		Data:    toProtoShiftMovement(res),
+	}, nil
+}
+
+func (s *cashierHandleGrpc) CloseShift(ctx context.Context, req *pb.CloseShiftRequest) (*pb.ApiResponseShiftReport, error) {
+	shiftID := int(req.GetShiftId())
+
+	if shiftID == 0 {
+		return nil, cashier_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.shift.CloseShift(ctx, &shift.CloseRequest{
+		ShiftID:     shiftID,
+		CountedCash: int(req.GetCountedCash()),
+		OperatorID:  int(req.GetOperatorId()),
+		Note:        req.GetNote(),
+	})
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseShiftReport{
+		Status:  "success",
+		Message: "Successfully closed shift",
+		Data:    toProtoShiftReport(res),
+	}, nil
+}
+
+func (s *cashierHandleGrpc) FindShiftReport(ctx context.Context, req *pb.FindShiftRequest) (*pb.ApiResponseShiftReport, error) {
+	shiftID := int(req.GetShiftId())
+
+	if shiftID == 0 {
+		return nil, cashier_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.shift.FindReport(ctx, shiftID)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseShiftReport{
+		Status:  "success",
+		Message: "Successfully fetched shift report",
+		Data:    toProtoShiftReport(res),
+	}, nil
+}
+
+func (s *cashierHandleGrpc) FindShiftSalesById(ctx context.Context, req *pb.FindYearMonthTotalSalesById) (*pb.ApiResponseShiftSales, error) {
+	cashierID := int(req.GetCashierId())
+
+	if cashierID == 0 {
+		return nil, cashier_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.cashierStatsById.FindShiftSalesById(ctx, &requests.MonthTotalSalesCashier{
+		CashierID: cashierID,
+		Year:      int(req.GetYear()),
+		Month:     int(req.GetMonth()),
+	})
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	data := make([]*pb.ShiftSalesByShiftResponse, len(res))
+	for i, row := range res {
+		data[i] = &pb.ShiftSalesByShiftResponse{
+			Shift: toProtoShift(row.Shift),
+			Sales: toProtoShiftSales(row.Sales),
+		}
+	}
+
+	return &pb.ApiResponseShiftSales{
+		Status:  "success",
+		Message: "Successfully fetched shift sales",
+		Data:    data,
+	}, nil
+}
+
+func toProtoShift(s *shift.Shift) *pb.ShiftResponse {
+	res := &pb.ShiftResponse{
+		Id:           int32(s.ID),
+		CashierId:    int32(s.CashierID),
+		MerchantId:   int32(s.MerchantID),
+		OpeningFloat: int32(s.OpeningFloat),
+		OpenedBy:     int32(s.OpenedBy),
+		OpenedAt:     s.OpenedAt.Format(time.RFC3339),
+		Note:         s.Note,
+		Drawer:       toProtoShiftDrawer(s.Drawer),
+	}
+
+	if s.ClosedBy != nil {
+		res.ClosedBy = wrapperspb.Int32(int32(*s.ClosedBy))
+	}
+	if s.ClosedAt != nil {
+		res.ClosedAt = wrapperspb.String(s.ClosedAt.Format(time.RFC3339))
+	}
+
+	return res
+}
+
+func toProtoShiftDrawer(d *shift.Drawer) *pb.ShiftDrawerResponse {
+	if d == nil {
+		return nil
+	}
+
+	res := &pb.ShiftDrawerResponse{
+		OpeningFloat: int32(d.OpeningFloat),
+		CashSales:    int32(d.CashSales),
+		CashRefunds:  int32(d.CashRefunds),
+		PayIns:       int32(d.PayIns),
+		PayOuts:      int32(d.PayOuts),
+		ExpectedCash: int32(d.ExpectedCash),
+	}
+
+	if d.CountedCash != nil {
+		res.CountedCash = wrapperspb.Int32(int32(*d.CountedCash))
+	}
+	if d.Variance != nil {
+		res.Variance = wrapperspb.Int32(int32(*d.Variance))
+	}
+
+	return res
+}
+
+func toProtoShiftMovement(m *shift.Movement) *pb.ShiftMovementResponse {
+	return &pb.ShiftMovementResponse{
+		Id:         int32(m.ID),
+		ShiftId:    int32(m.ShiftID),
+		Kind:       string(m.Kind),
+		Amount:     int32(m.Amount),
+		Reason:     m.Reason,
+		OperatorId: int32(m.OperatorID),
+		CreatedAt:  m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toProtoShiftSales(s *shift.Sales) *pb.ShiftSalesResponse {
+	if s == nil {
+		return nil
+	}
+
+	methods := make([]*pb.ShiftMethodSalesResponse, len(s.Methods))
+	for i, m := range s.Methods {
+		methods[i] = &pb.ShiftMethodSalesResponse{
+			Method:       m.Method,
+			Transactions: int32(m.Transactions),
+			Amount:       int32(m.Amount),
+		}
+	}
+
+	return &pb.ShiftSalesResponse{
+		Transactions: int32(s.Transactions),
+		Gross:        int32(s.Gross),
+		Refunds:      int32(s.Refunds),
+		CashRefunds:  int32(s.CashRefunds),
+		Net:          int32(s.Net()),
+		Methods:      methods,
+	}
+}
+
+func toProtoShiftReport(r *shift.ZReport) *pb.ShiftReportResponse {
+	movements := make([]*pb.ShiftMovementResponse, len(r.Movements))
+	for i, m := range r.Movements {
+		movements[i] = toProtoShiftMovement(m)
+	}
+
+	return &pb.ShiftReportResponse{
+		Shift:     toProtoShift(r.Shift),
+		Sales:     toProtoShiftSales(r.Sales),
+		Drawer:    toProtoShiftDrawer(r.Drawer),
+		Movements: movements,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
@@ -14,6 +15,8 @@ const (
 
 	cashierStatsMonthSalesByIdCacheKey = "cashier:stats:month:%d:id:%d"
 	cashierStatsYearSalesByIdCacheKey  = "cashier:stats:year:%d:id:%d"
+
+	cashierStatsShiftSalesByIdCacheKey = "cashier:stats:shift:month:%d:year:%d:id:%d"
 )
 
 type cashierStatsByIdCache struct {
@@ -91,3 +94,20 @@ func (s *cashierStatsByIdCache) SetYearlyCashierByIdCache(ctx context.Context, r
 	key := fmt.Sprintf(cashierStatsYearSalesByIdCacheKey, req.Year, req.CashierID)
 	SetToCache(ctx, s.store, key, &res, ttlDefault)
 }
+
+func (s *cashierStatsByIdCache) GetShiftSalesByIdCache(ctx context.Context, req *requests.MonthTotalSalesCashier) ([]*shift.ShiftSales, bool) {
+	key := fmt.Sprintf(cashierStatsShiftSalesByIdCacheKey, req.Month, req.Year, req.CashierID)
+	result, found := GetFromCache[[]*shift.ShiftSales](ctx, s.store, key)
+	if !found || result == nil {
+		return nil, false
+	}
+	return *result, true
+}
+
+func (s *cashierStatsByIdCache) SetShiftSalesByIdCache(ctx context.Context, req *requests.MonthTotalSalesCashier, res []*shift.ShiftSales) {
+	if res == nil {
+		return
+	}
+	key := fmt.Sprintf(cashierStatsShiftSalesByIdCacheKey, req.Month, req.Year, req.CashierID)
+	SetToCache(ctx, s.store, key, &res, ttlDefault)
+}
@@ -3,6 +3,7 @@ package mencache
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
@@ -54,6 +55,9 @@ type CashierStatsByIdCache interface {
 
 	GetYearlyCashierByIdCache(ctx context.Context, req *requests.YearCashierId) ([]*response.CashierResponseYearSales, bool)
 	SetYearlyCashierByIdCache(ctx context.Context, req *requests.YearCashierId, res []*response.CashierResponseYearSales)
+
+	GetShiftSalesByIdCache(ctx context.Context, req *requests.MonthTotalSalesCashier) ([]*shift.ShiftSales, bool)
+	SetShiftSalesByIdCache(ctx context.Context, req *requests.MonthTotalSalesCashier, res []*shift.ShiftSales)
 }
 
 type CashierStatsByMerchantCache interface {
@@ -0,0 +1,6 @@
+package repository
+
+import "github.com/MamangRust/monolith-point-of-sale-common/bulk"
+
+// Tables the DeleteAll and RestoreAll calls of this service run on.
+var bulkCashiers = bulk.Table{Name: "cashiers", ID: "cashier_id", Merchant: "merchant_id = $1"}
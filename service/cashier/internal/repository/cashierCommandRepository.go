@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -12,12 +13,14 @@ import (
 
 type cashierCommandRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.CashierRecordMapping
 }
 
-func NewCashierCommandRepository(db *db.Queries, mapping recordmapper.CashierRecordMapping) *cashierCommandRepository {
+func NewCashierCommandRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.CashierRecordMapping) *cashierCommandRepository {
 	return &cashierCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -84,21 +87,25 @@ func (r *cashierCommandRepository) DeleteCashierPermanent(ctx context.Context, c
 }
 
 func (r *cashierCommandRepository) RestoreAllCashier(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllCashiers(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkCashiers, bulk.Restore)
 
 	if err != nil {
 		return false, cashier_errors.ErrRestoreAllCashiers
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *cashierCommandRepository) DeleteAllCashierPermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentCashiers(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkCashiers, bulk.Delete)
 
 	if err != nil {
 		return false, cashier_errors.ErrDeleteAllCashiersPermanent
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
@@ -3,21 +3,56 @@ package repository
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/cashier_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/lib/pq"
 )
 
+// Merchant-scoped variants of GetCashiers / GetCashiersTrashed used for
+// callers that are restricted to their own merchants.
+const getCashiersByMerchantIDs = `-- name: GetCashiersByMerchantIDs :many
+SELECT
+    cashier_id, merchant_id, user_id, name, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM cashiers
+WHERE deleted_at IS NULL
+  AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%')
+  AND merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+const getCashiersTrashedByMerchantIDs = `-- name: GetCashiersTrashedByMerchantIDs :many
+SELECT
+    cashier_id, merchant_id, user_id, name, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM cashiers
+WHERE deleted_at IS NOT NULL
+  AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%')
+  AND merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+// getCashierOwner finds the merchant of a cashier, trashed or not.
+const getCashierOwner = `-- name: GetCashierOwner :one
+SELECT merchant_id FROM cashiers WHERE cashier_id = $1
+`
+
 type cashierQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.CashierRecordMapping
 }
 
-func NewCashierQueryRepository(db *db.Queries, mapping recordmapper.CashierRecordMapping) *cashierQueryRepository {
+func NewCashierQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.CashierRecordMapping) *cashierQueryRepository {
 	return &cashierQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -25,6 +60,15 @@ func NewCashierQueryRepository(db *db.Queries, mapping recordmapper.CashierRecor
 func (r *cashierQueryRepository) FindAllCashiers(ctx context.Context, req *requests.FindAllCashiers) ([]*record.CashierRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getCashiersByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, cashier_errors.ErrFindAllCashiers
+		}
+
+		return r.mapping.ToCashiersRecordPagination(res), totalCount(res), nil
+	}
+
 	reqDb := db.GetCashiersParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -51,6 +95,20 @@ func (r *cashierQueryRepository) FindAllCashiers(ctx context.Context, req *reque
 func (r *cashierQueryRepository) FindByActive(ctx context.Context, req *requests.FindAllCashiers) ([]*record.CashierRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getCashiersByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, cashier_errors.ErrFindActiveCashiers
+		}
+
+		active := make([]*db.GetCashiersActiveRow, len(res))
+		for i, row := range res {
+			active[i] = (*db.GetCashiersActiveRow)(row)
+		}
+
+		return r.mapping.ToCashiersRecordActivePagination(active), totalCount(res), nil
+	}
+
 	reqDb := db.GetCashiersActiveParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -76,6 +134,20 @@ func (r *cashierQueryRepository) FindByActive(ctx context.Context, req *requests
 func (r *cashierQueryRepository) FindByTrashed(ctx context.Context, req *requests.FindAllCashiers) ([]*record.CashierRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getCashiersTrashedByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, cashier_errors.ErrFindTrashedCashiers
+		}
+
+		trashed := make([]*db.GetCashiersTrashedRow, len(res))
+		for i, row := range res {
+			trashed[i] = (*db.GetCashiersTrashedRow)(row)
+		}
+
+		return r.mapping.ToCashiersRecordTrashedPagination(trashed), totalCount(res), nil
+	}
+
 	reqDb := db.GetCashiersTrashedParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -131,5 +203,64 @@ func (r *cashierQueryRepository) FindById(ctx context.Context, cashier_id int) (
 		return nil, cashier_errors.ErrFindCashierById
 	}
 
+	if !tenant.Allows(ctx, int(res.MerchantID)) {
+		return nil, cashier_errors.ErrFindCashierById
+	}
+
 	return r.mapping.ToCashierRecord(res), nil
 }
+
+// FindMerchantIdByCashier returns the merchant a cashier works for,
+// including trashed cashiers.
+func (r *cashierQueryRepository) FindMerchantIdByCashier(ctx context.Context, cashier_id int) (int, error) {
+	var merchantID int
+
+	if err := r.conn.QueryRowContext(ctx, getCashierOwner, cashier_id).Scan(&merchantID); err != nil {
+		return 0, cashier_errors.ErrFindCashierById
+	}
+
+	return merchantID, nil
+}
+
+func (r *cashierQueryRepository) findByMerchantIDs(ctx context.Context, query string, req *requests.FindAllCashiers, scope *tenant.Scope) ([]*db.GetCashiersRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	rows, err := r.conn.QueryContext(ctx, query, req.Search, req.PageSize, offset, pq.Array(scope.Int64MerchantIDs()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*db.GetCashiersRow
+	for rows.Next() {
+		var i db.GetCashiersRow
+		if err := rows.Scan(
+			&i.CashierID,
+			&i.MerchantID,
+			&i.UserID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func totalCount(rows []*db.GetCashiersRow) *int {
+	total := 0
+	if len(rows) > 0 {
+		total = int(rows[0].TotalCount)
+	}
+
+	return &total
+}
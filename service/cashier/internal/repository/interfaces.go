@@ -2,7 +2,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 )
@@ -45,6 +48,7 @@ type CashierQueryRepository interface {
 	FindByActive(ctx context.Context, req *requests.FindAllCashiers) ([]*record.CashierRecord, *int, error)
 	FindByTrashed(ctx context.Context, req *requests.FindAllCashiers) ([]*record.CashierRecord, *int, error)
 	FindByMerchant(ctx context.Context, req *requests.FindAllCashierMerchant) ([]*record.CashierRecord, *int, error)
+	FindMerchantIdByCashier(ctx context.Context, cashier_id int) (int, error)
 }
 
 type CashierCommandRepository interface {
@@ -56,3 +60,28 @@ type CashierCommandRepository interface {
 	RestoreAllCashier(ctx context.Context) (bool, error)
 	DeleteAllCashierPermanent(ctx context.Context) (bool, error)
 }
+
+type ShiftRepository interface {
+	OpenShift(ctx context.Context, req *shift.OpenRequest) (*shift.Shift, error)
+	FindById(ctx context.Context, shift_id int) (*shift.Shift, error)
+	FindMerchantIdByShift(ctx context.Context, shift_id int) (int, error)
+	FindOpenByCashier(ctx context.Context, cashier_id int) (*shift.Shift, error)
+	FindByCashier(ctx context.Context, cashier_id int, from, to time.Time) ([]*shift.Shift, error)
+	FindMovements(ctx context.Context, shift_id int) ([]*shift.Movement, error)
+	FindSales(ctx context.Context, shift_ids []int) (map[int]*shift.Sales, error)
+	AddMovement(ctx context.Context, req *shift.MovementRequest) (*shift.Movement, error)
+	CloseShift(ctx context.Context, req *shift.CloseRequest) (*shift.ZReport, error)
+}
+
+type TenantRepository interface {
+	FindMerchantIDsByUser(ctx context.Context, userID int) ([]int, error)
+}
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
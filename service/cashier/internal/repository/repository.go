@@ -1,10 +1,16 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "cashier"
+
 type Repositories struct {
 	UserQuery              UserQueryRepository
 	MerchantQuery          MerchantQueryRepository
@@ -13,9 +19,12 @@ type Repositories struct {
 	CashierStats           CashierStatsRepository
 	CashierStatsByMerchant CashierStatByMerchantRepository
 	CashierStatsById       CashierStatByIdRepository
+	Shift                  ShiftRepository
+	Tenant                 TenantRepository
+	Outbox                 OutboxRepository
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	mapperUser := recordmapper.NewUserRecordMapper()
 	mapperMerchant := recordmapper.NewMerchantRecordMapper()
 	mapperCashier := recordmapper.NewCashierRecordMapper()
@@ -23,10 +32,13 @@ func NewRepositories(DB *db.Queries) *Repositories {
 	return &Repositories{
 		UserQuery:              NewUserQueryRepository(DB, mapperUser),
 		MerchantQuery:          NewMerchantQueryRepository(DB, mapperMerchant),
-		CashierQuery:           NewCashierQueryRepository(DB, mapperCashier),
-		CashierCommand:         NewCashierCommandRepository(DB, mapperCashier),
+		CashierQuery:           NewCashierQueryRepository(DB, conn, mapperCashier),
+		CashierCommand:         NewCashierCommandRepository(DB, conn, mapperCashier),
 		CashierStats:           NewCashierStatsRepository(DB, mapperCashier),
 		CashierStatsByMerchant: NewCashierStatsByMerchantRepository(DB, mapperCashier),
 		CashierStatsById:       NewCashierStatsByIdRepository(DB, mapperCashier),
+		Shift:                  NewShiftRepository(conn),
+		Tenant:                 tenant.NewRepository(conn),
+		Outbox:                 outbox.NewRepository(conn, outboxSource),
 	}
 }
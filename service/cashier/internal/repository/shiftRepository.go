@@ -0,0 +1,509 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrFindShift          = errors.New("failed to find cashier shift")
+	ErrOpenShift          = errors.New("failed to open cashier shift")
+	ErrCloseShift         = errors.New("failed to close cashier shift")
+	ErrCreateMovement     = errors.New("failed to record cash movement")
+	ErrFindShiftMovements = errors.New("failed to find cash movements")
+	ErrFindShiftSales     = errors.New("failed to find shift sales")
+)
+
+const createShift = `-- name: CreateShift :one
+INSERT INTO cashier_shifts (cashier_id, merchant_id, opening_float, opened_by)
+SELECT cashier_id, merchant_id, $2, $3
+FROM cashiers
+WHERE cashier_id = $1
+    AND deleted_at IS NULL
+RETURNING shift_id, cashier_id, merchant_id, opening_float, opened_by, opened_at, closed_by, closed_at, cash_sales, cash_refunds, pay_ins, pay_outs, expected_cash, counted_cash, variance, note
+`
+
+const getShift = `-- name: GetShift :one
+SELECT shift_id, cashier_id, merchant_id, opening_float, opened_by, opened_at, closed_by, closed_at, cash_sales, cash_refunds, pay_ins, pay_outs, expected_cash, counted_cash, variance, note
+FROM cashier_shifts
+WHERE shift_id = $1
+`
+
+const lockShift = `-- name: LockShift :one
+SELECT shift_id, cashier_id, merchant_id, opening_float, opened_by, opened_at, closed_by, closed_at, cash_sales, cash_refunds, pay_ins, pay_outs, expected_cash, counted_cash, variance, note
+FROM cashier_shifts
+WHERE shift_id = $1
+FOR UPDATE
+`
+
+const getOpenShiftByCashier = `-- name: GetOpenShiftByCashier :one
+SELECT shift_id, cashier_id, merchant_id, opening_float, opened_by, opened_at, closed_by, closed_at, cash_sales, cash_refunds, pay_ins, pay_outs, expected_cash, counted_cash, variance, note
+FROM cashier_shifts
+WHERE cashier_id = $1
+    AND closed_at IS NULL
+`
+
+const getShiftsByCashier = `-- name: GetShiftsByCashier :many
+SELECT shift_id, cashier_id, merchant_id, opening_float, opened_by, opened_at, closed_by, closed_at, cash_sales, cash_refunds, pay_ins, pay_outs, expected_cash, counted_cash, variance, note
+FROM cashier_shifts
+WHERE cashier_id = $1
+    AND opened_at >= $2
+    AND opened_at < $3
+ORDER BY opened_at
+`
+
+// A closed shift keeps the close time as the end of its window, which is
+// also the value current_timestamp takes inside the closing transaction.
+const closeShift = `-- name: CloseShift :one
+UPDATE cashier_shifts
+SET closed_by = $2,
+    closed_at = current_timestamp,
+    cash_sales = $3,
+    cash_refunds = $4,
+    pay_ins = $5,
+    pay_outs = $6,
+    expected_cash = $7,
+    counted_cash = $8,
+    variance = $9,
+    note = $10
+WHERE shift_id = $1
+    AND closed_at IS NULL
+RETURNING shift_id, cashier_id, merchant_id, opening_float, opened_by, opened_at, closed_by, closed_at, cash_sales, cash_refunds, pay_ins, pay_outs, expected_cash, counted_cash, variance, note
+`
+
+const createShiftMovement = `-- name: CreateShiftMovement :one
+INSERT INTO cashier_shift_movements (shift_id, kind, amount, reason, operator_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING movement_id, shift_id, kind, amount, reason, operator_id, created_at
+`
+
+const getShiftMovements = `-- name: GetShiftMovements :many
+SELECT movement_id, shift_id, kind, amount, reason, operator_id, created_at
+FROM cashier_shift_movements
+WHERE shift_id = $1
+ORDER BY created_at, movement_id
+`
+
+// Transactions have no cashier of their own; they belong to the cashier who
+// rang up the order. Only settled transactions count as sales, refunds are
+// reported separately.
+const getShiftSalesTotals = `-- name: GetShiftSalesTotals :many
+SELECT cs.shift_id, COUNT(t.transaction_id)::int, COALESCE(SUM(t.amount), 0)::int
+FROM cashier_shifts cs
+JOIN orders o ON o.cashier_id = cs.cashier_id
+JOIN transactions t ON t.order_id = o.order_id
+WHERE cs.shift_id = ANY($1::INT[])
+    AND t.deleted_at IS NULL
+    AND t.payment_status IN ('success', 'partially_refunded', 'refunded', 'voided')
+    AND t.created_at >= cs.opened_at
+    AND t.created_at < COALESCE(cs.closed_at, current_timestamp)
+GROUP BY cs.shift_id
+`
+
+const getShiftSalesByMethod = `-- name: GetShiftSalesByMethod :many
+SELECT cs.shift_id, tt.method, COUNT(DISTINCT t.transaction_id)::int, COALESCE(SUM(tt.amount), 0)::int
+FROM cashier_shifts cs
+JOIN orders o ON o.cashier_id = cs.cashier_id
+JOIN transactions t ON t.order_id = o.order_id
+JOIN transaction_tenders tt ON tt.transaction_id = t.transaction_id
+WHERE cs.shift_id = ANY($1::INT[])
+    AND t.deleted_at IS NULL
+    AND t.payment_status IN ('success', 'partially_refunded', 'refunded', 'voided')
+    AND t.created_at >= cs.opened_at
+    AND t.created_at < COALESCE(cs.closed_at, current_timestamp)
+GROUP BY cs.shift_id, tt.method
+ORDER BY cs.shift_id, tt.method
+`
+
+// Refunds come out of the drawer of the cashier who gave them, whichever
+// shift the sale was made in. Only the part a transaction was paid in cash
+// is handed back as cash.
+const getShiftRefunds = `-- name: GetShiftRefunds :many
+SELECT cs.shift_id, COALESCE(SUM(r.amount), 0)::int, COALESCE(SUM(LEAST(r.amount, COALESCE(cash.amount, 0))), 0)::int
+FROM cashier_shifts cs
+JOIN cashiers c ON c.cashier_id = cs.cashier_id
+JOIN transaction_refunds r ON r.operator_id = c.user_id
+LEFT JOIN LATERAL (
+    SELECT SUM(tt.amount) AS amount
+    FROM transaction_tenders tt
+    WHERE tt.transaction_id = r.transaction_id
+        AND tt.method = 'cash'
+) cash ON TRUE
+WHERE cs.shift_id = ANY($1::INT[])
+    AND r.created_at >= cs.opened_at
+    AND r.created_at < COALESCE(cs.closed_at, current_timestamp)
+GROUP BY cs.shift_id
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+type shiftRepository struct {
+	conn *audit.DB
+}
+
+func NewShiftRepository(conn *audit.DB) *shiftRepository {
+	return &shiftRepository{
+		conn: conn,
+	}
+}
+
+func (r *shiftRepository) OpenShift(ctx context.Context, req *shift.OpenRequest) (*shift.Shift, error) {
+	s, err := scanShift(r.conn.QueryRowContext(ctx, createShift, req.CashierID, req.OpeningFloat, req.OperatorID))
+	if err != nil {
+		var pqErr *pq.Error
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, shift.ErrCashierNotFound
+		case errors.As(err, &pqErr) && pqErr.Code == "23505":
+			return nil, shift.ErrAlreadyOpen
+		default:
+			return nil, ErrOpenShift
+		}
+	}
+
+	return s, nil
+}
+
+func (r *shiftRepository) FindById(ctx context.Context, shift_id int) (*shift.Shift, error) {
+	s, err := scanShift(r.conn.QueryRowContext(ctx, getShift, shift_id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, shift.ErrNotFound
+		}
+		return nil, ErrFindShift
+	}
+
+	return s, nil
+}
+
+// FindMerchantIdByShift returns the merchant whose drawer the shift is on.
+func (r *shiftRepository) FindMerchantIdByShift(ctx context.Context, shift_id int) (int, error) {
+	s, err := r.FindById(ctx, shift_id)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.MerchantID, nil
+}
+
+func (r *shiftRepository) FindOpenByCashier(ctx context.Context, cashier_id int) (*shift.Shift, error) {
+	s, err := scanShift(r.conn.QueryRowContext(ctx, getOpenShiftByCashier, cashier_id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, shift.ErrNotFound
+		}
+		return nil, ErrFindShift
+	}
+
+	return s, nil
+}
+
+// FindByCashier lists the shifts the cashier opened in [from, to).
+func (r *shiftRepository) FindByCashier(ctx context.Context, cashier_id int, from, to time.Time) ([]*shift.Shift, error) {
+	rows, err := r.conn.QueryContext(ctx, getShiftsByCashier, cashier_id, from, to)
+	if err != nil {
+		return nil, ErrFindShift
+	}
+	defer rows.Close()
+
+	var shifts []*shift.Shift
+	for rows.Next() {
+		s, err := scanShift(rows)
+		if err != nil {
+			return nil, ErrFindShift
+		}
+		shifts = append(shifts, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrFindShift
+	}
+
+	return shifts, nil
+}
+
+func (r *shiftRepository) FindMovements(ctx context.Context, shift_id int) ([]*shift.Movement, error) {
+	movements, err := findMovements(ctx, r.conn, shift_id)
+	if err != nil {
+		return nil, ErrFindShiftMovements
+	}
+
+	return movements, nil
+}
+
+// FindSales returns the sales of each shift, keyed by shift id. Shifts
+// without any sales get an empty entry.
+func (r *shiftRepository) FindSales(ctx context.Context, shift_ids []int) (map[int]*shift.Sales, error) {
+	sales, err := findSales(ctx, r.conn, shift_ids)
+	if err != nil {
+		return nil, ErrFindShiftSales
+	}
+
+	return sales, nil
+}
+
+// AddMovement fails with shift.ErrClosed once the shift is closed; the lock
+// keeps a movement from slipping in while the shift is being closed.
+func (r *shiftRepository) AddMovement(ctx context.Context, req *shift.MovementRequest) (*shift.Movement, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, ErrCreateMovement
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := lockOpenShift(ctx, tx, req.ShiftID); err != nil {
+		return nil, err
+	}
+
+	m, err := scanMovement(tx.QueryRowContext(ctx, createShiftMovement, req.ShiftID, string(req.Kind), req.Amount, req.Reason, req.OperatorID))
+	if err != nil {
+		return nil, ErrCreateMovement
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, ErrCreateMovement
+	}
+
+	return m, nil
+}
+
+// CloseShift reconciles the drawer against the counted cash and closes the
+// shift, returning its Z-report.
+func (r *shiftRepository) CloseShift(ctx context.Context, req *shift.CloseRequest) (*shift.ZReport, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, ErrCloseShift
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	open, err := lockOpenShift(ctx, tx, req.ShiftID)
+	if err != nil {
+		return nil, err
+	}
+
+	sales, err := findSales(ctx, tx, []int{open.ID})
+	if err != nil {
+		return nil, ErrCloseShift
+	}
+
+	movements, err := findMovements(ctx, tx, open.ID)
+	if err != nil {
+		return nil, ErrCloseShift
+	}
+
+	drawer := shift.NewDrawer(open.OpeningFloat, sales[open.ID], movements)
+	drawer.Count(req.CountedCash)
+
+	closed, err := scanShift(tx.QueryRowContext(ctx, closeShift,
+		open.ID,
+		req.OperatorID,
+		drawer.CashSales,
+		drawer.CashRefunds,
+		drawer.PayIns,
+		drawer.PayOuts,
+		drawer.ExpectedCash,
+		*drawer.CountedCash,
+		*drawer.Variance,
+		req.Note,
+	))
+	if err != nil {
+		return nil, ErrCloseShift
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, ErrCloseShift
+	}
+
+	return &shift.ZReport{
+		Shift:     closed,
+		Sales:     sales[open.ID],
+		Drawer:    closed.Drawer,
+		Movements: movements,
+	}, nil
+}
+
+func lockOpenShift(ctx context.Context, conn db.DBTX, shift_id int) (*shift.Shift, error) {
+	s, err := scanShift(conn.QueryRowContext(ctx, lockShift, shift_id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, shift.ErrNotFound
+		}
+		return nil, ErrFindShift
+	}
+
+	if !s.Open() {
+		return nil, shift.ErrClosed
+	}
+
+	return s, nil
+}
+
+func findMovements(ctx context.Context, conn db.DBTX, shift_id int) ([]*shift.Movement, error) {
+	rows, err := conn.QueryContext(ctx, getShiftMovements, shift_id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	movements := []*shift.Movement{}
+	for rows.Next() {
+		m, err := scanMovement(rows)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, m)
+	}
+
+	return movements, rows.Err()
+}
+
+func findSales(ctx context.Context, conn db.DBTX, shift_ids []int) (map[int]*shift.Sales, error) {
+	ids := make([]int64, len(shift_ids))
+	sales := make(map[int]*shift.Sales, len(shift_ids))
+	for i, id := range shift_ids {
+		ids[i] = int64(id)
+		sales[id] = &shift.Sales{Methods: []*shift.MethodSales{}}
+	}
+
+	err := eachRow(ctx, conn, getShiftSalesTotals, ids, func(row rowScanner) error {
+		var id, transactions, gross int
+		if err := row.Scan(&id, &transactions, &gross); err != nil {
+			return err
+		}
+		if s, ok := sales[id]; ok {
+			s.Transactions, s.Gross = transactions, gross
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = eachRow(ctx, conn, getShiftSalesByMethod, ids, func(row rowScanner) error {
+		var id int
+		m := &shift.MethodSales{}
+		if err := row.Scan(&id, &m.Method, &m.Transactions, &m.Amount); err != nil {
+			return err
+		}
+		if s, ok := sales[id]; ok {
+			s.Methods = append(s.Methods, m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = eachRow(ctx, conn, getShiftRefunds, ids, func(row rowScanner) error {
+		var id, refunds, cashRefunds int
+		if err := row.Scan(&id, &refunds, &cashRefunds); err != nil {
+			return err
+		}
+		if s, ok := sales[id]; ok {
+			s.Refunds, s.CashRefunds = refunds, cashRefunds
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sales, nil
+}
+
+func eachRow(ctx context.Context, conn db.DBTX, query string, ids []int64, fn func(rowScanner) error) error {
+	rows, err := conn.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func scanShift(row rowScanner) (*shift.Shift, error) {
+	var (
+		s                                       shift.Shift
+		closedBy                                sql.NullInt32
+		closedAt                                sql.NullTime
+		cashSales, cashRefunds, payIns, payOuts sql.NullInt32
+		expected, counted, variance             sql.NullInt32
+	)
+
+	if err := row.Scan(
+		&s.ID,
+		&s.CashierID,
+		&s.MerchantID,
+		&s.OpeningFloat,
+		&s.OpenedBy,
+		&s.OpenedAt,
+		&closedBy,
+		&closedAt,
+		&cashSales,
+		&cashRefunds,
+		&payIns,
+		&payOuts,
+		&expected,
+		&counted,
+		&variance,
+		&s.Note,
+	); err != nil {
+		return nil, err
+	}
+
+	if closedAt.Valid {
+		by := int(closedBy.Int32)
+		at := closedAt.Time
+		countedCash := int(counted.Int32)
+		varianceCash := int(variance.Int32)
+
+		s.ClosedBy = &by
+		s.ClosedAt = &at
+		s.Drawer = &shift.Drawer{
+			OpeningFloat: s.OpeningFloat,
+			CashSales:    int(cashSales.Int32),
+			CashRefunds:  int(cashRefunds.Int32),
+			PayIns:       int(payIns.Int32),
+			PayOuts:      int(payOuts.Int32),
+			ExpectedCash: int(expected.Int32),
+			CountedCash:  &countedCash,
+			Variance:     &varianceCash,
+		}
+	}
+
+	return &s, nil
+}
+
+func scanMovement(row rowScanner) (*shift.Movement, error) {
+	var (
+		m    shift.Movement
+		kind string
+	)
+
+	if err := row.Scan(&m.ID, &m.ShiftID, &kind, &m.Amount, &m.Reason, &m.OperatorID, &m.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	m.Kind = shift.MovementKind(kind)
+
+	return &m, nil
+}
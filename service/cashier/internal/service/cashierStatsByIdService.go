@@ -7,6 +7,7 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/errorhandler"
 	mencache "github.com/MamangRust/monolith-point-of-sale-cashier/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
@@ -24,6 +25,7 @@ type cashierStatsByIdService struct {
 	errorhandler    errorhandler.CashierStatsByIdError
 	trace           trace.Tracer
 	cashierStats    repository.CashierStatByIdRepository
+	shifts          repository.ShiftRepository
 	logger          logger.LoggerInterface
 	mapping         response_service.CashierResponseMapper
 	requestCounter  *prometheus.CounterVec
@@ -34,6 +36,7 @@ func NewCashierStatsByIdService(
 	mencache mencache.CashierStatsByIdCache,
 	errorhandler errorhandler.CashierStatsByIdError,
 	cashierStats repository.CashierStatByIdRepository,
+	shifts repository.ShiftRepository,
 	logger logger.LoggerInterface, mapping response_service.CashierResponseMapper,
 ) *cashierStatsByIdService {
 	requestCounter := prometheus.NewCounterVec(
@@ -60,6 +63,7 @@ func NewCashierStatsByIdService(
 		errorhandler:    errorhandler,
 		trace:           otel.Tracer("cashier-stats-by-id-service"),
 		cashierStats:    cashierStats,
+		shifts:          shifts,
 		logger:          logger,
 		mapping:         mapping,
 		requestCounter:  requestCounter,
@@ -199,6 +203,60 @@ func (s *cashierStatsByIdService) FindYearlyCashierById(ctx context.Context, req
 	return so, nil
 }
 
+// FindShiftSalesById breaks the cashier's sales down by the shifts they
+// opened in the month. A shift still open shows its sales so far.
+func (s *cashierStatsByIdService) FindShiftSalesById(ctx context.Context, req *requests.MonthTotalSalesCashier) ([]*shift.ShiftSales, *response.ErrorResponse) {
+	const method = "FindShiftSalesById"
+
+	year := req.Year
+	month := req.Month
+	cashier_id := req.CashierID
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("year", year), attribute.Int("month", month), attribute.Int("cashier.id", cashier_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	if year <= 0 || month < 1 || month > 12 {
+		return s.errorhandler.HandleShiftSalesByIdError(shift.ErrInvalidPeriod, method, "FAILED_FIND_SHIFT_SALES_BY_ID", span, &status, zap.Int("year", year), zap.Int("month", month))
+	}
+
+	if data, found := s.mencache.GetShiftSalesByIdCache(ctx, req); found {
+		logSuccess("Successfully fetched shift sales by ID from cache", zap.Int("year", year), zap.Int("month", month), zap.Int("cashier_id", cashier_id))
+
+		return data, nil
+	}
+
+	from := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+
+	shifts, err := s.shifts.FindByCashier(ctx, cashier_id, from, from.AddDate(0, 1, 0))
+	if err != nil {
+		return s.errorhandler.HandleShiftSalesByIdError(err, method, "FAILED_FIND_SHIFT_SALES_BY_ID", span, &status, zap.Error(err))
+	}
+
+	ids := make([]int, len(shifts))
+	for i, sh := range shifts {
+		ids[i] = sh.ID
+	}
+
+	sales, err := s.shifts.FindSales(ctx, ids)
+	if err != nil {
+		return s.errorhandler.HandleShiftSalesByIdError(err, method, "FAILED_FIND_SHIFT_SALES_BY_ID", span, &status, zap.Error(err))
+	}
+
+	so := make([]*shift.ShiftSales, len(shifts))
+	for i, sh := range shifts {
+		so[i] = &shift.ShiftSales{Shift: sh, Sales: sales[sh.ID]}
+	}
+
+	s.mencache.SetShiftSalesByIdCache(ctx, req, so)
+
+	logSuccess("Successfully fetched shift sales by ID", zap.Int("year", year), zap.Int("month", month), zap.Int("cashier_id", cashier_id))
+
+	return so, nil
+}
+
 func (s *cashierStatsByIdService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
 	context.Context,
 	trace.Span,
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
@@ -20,6 +21,7 @@ type CashierStatsByIdService interface {
 	FindYearlyTotalSalesById(ctx context.Context, req *requests.YearTotalSalesCashier) ([]*response.CashierResponseYearTotalSales, *response.ErrorResponse)
 	FindMonthlyCashierById(ctx context.Context, req *requests.MonthCashierId) ([]*response.CashierResponseMonthSales, *response.ErrorResponse)
 	FindYearlyCashierById(ctx context.Context, req *requests.YearCashierId) ([]*response.CashierResponseYearSales, *response.ErrorResponse)
+	FindShiftSalesById(ctx context.Context, req *requests.MonthTotalSalesCashier) ([]*shift.ShiftSales, *response.ErrorResponse)
 }
 
 type CashierStatsByMerchant interface {
@@ -47,3 +49,11 @@ type CashierCommandService interface {
 	RestoreAllCashier(ctx context.Context) (bool, *response.ErrorResponse)
 	DeleteAllCashierPermanent(ctx context.Context) (bool, *response.ErrorResponse)
 }
+
+type ShiftService interface {
+	OpenShift(ctx context.Context, req *shift.OpenRequest) (*shift.Shift, *response.ErrorResponse)
+	FindOpenShift(ctx context.Context, cashier_id int) (*shift.Shift, *response.ErrorResponse)
+	RecordMovement(ctx context.Context, req *shift.MovementRequest) (*shift.Movement, *response.ErrorResponse)
+	CloseShift(ctx context.Context, req *shift.CloseRequest) (*shift.ZReport, *response.ErrorResponse)
+	FindReport(ctx context.Context, shift_id int) (*shift.ZReport, *response.ErrorResponse)
+}
@@ -16,6 +16,7 @@ type Service struct {
 	CashierStats           CashierStatsService
 	CashierStatsById       CashierStatsByIdService
 	CashierStatsByMerchant CashierStatsByMerchant
+	Shift                  ShiftService
 }
 
 type Deps struct {
@@ -33,7 +34,8 @@ func NewService(deps *Deps) *Service {
 		CashierQuery:           NewCashierQueryService(deps.ErrorHandler.CashierQueryError, deps.Mencache.CashierQueryCache, deps.Repositoriees.CashierQuery, deps.Logger, mapper),
 		CashierCommand:         NewCashierCommandService(deps.Mencache.CashierCommandCache, deps.ErrorHandler.CashierCommandError, deps.Repositoriees.MerchantQuery, deps.Repositoriees.UserQuery, deps.Repositoriees.CashierCommand, mapper, deps.Logger),
 		CashierStats:           NewCashierStatsService(deps.Mencache.CashierStatsCache, deps.ErrorHandler.CashierStatsError, deps.Repositoriees.CashierStats, deps.Logger, mapper),
-		CashierStatsById:       NewCashierStatsByIdService(deps.Mencache.CashierStatsByIdCache, deps.ErrorHandler.CashierStatsByIdError, deps.Repositoriees.CashierStatsById, deps.Logger, mapper),
+		CashierStatsById:       NewCashierStatsByIdService(deps.Mencache.CashierStatsByIdCache, deps.ErrorHandler.CashierStatsByIdError, deps.Repositoriees.CashierStatsById, deps.Repositoriees.Shift, deps.Logger, mapper),
 		CashierStatsByMerchant: NewCashierStatsByMerchantService(deps.Mencache.CashierStatsByMerchantCache, deps.ErrorHandler.CashierStatsByMerchantError, deps.Repositoriees.CashierStatsByMerchant, deps.Logger, mapper),
+		Shift:                  NewShiftService(deps.ErrorHandler.ShiftError, deps.Repositoriees.Shift, deps.Logger),
 	}
 }
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-cashier/internal/shift"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type shiftService struct {
+	errorhandler    errorhandler.ShiftError
+	trace           trace.Tracer
+	shifts          repository.ShiftRepository
+	logger          logger.LoggerInterface
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func NewShiftService(
+	errorhandler errorhandler.ShiftError,
+	shifts repository.ShiftRepository,
+	logger logger.LoggerInterface,
+) *shiftService {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "shift_service_requests_total",
+			Help: "Total number of requests to the ShiftService",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "shift_service_request_duration_seconds",
+			Help:    "Histogram of request durations for the ShiftService",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return &shiftService{
+		errorhandler:    errorhandler,
+		trace:           otel.Tracer("shift-service"),
+		shifts:          shifts,
+		logger:          logger,
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+}
+
+// OpenShift starts a shift on the cashier's drawer with the cash put in it
+// as float. A cashier can have only one shift open.
+func (s *shiftService) OpenShift(ctx context.Context, req *shift.OpenRequest) (*shift.Shift, *response.ErrorResponse) {
+	const method = "OpenShift"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("cashier.id", req.CashierID), attribute.Int("operator.id", req.OperatorID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if err := req.Validate(); err != nil {
+		return s.errorhandler.HandleOpenShiftError(err, method, "FAILED_OPEN_SHIFT", span, &status, zap.Int("cashier.id", req.CashierID))
+	}
+
+	res, err := s.shifts.OpenShift(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleOpenShiftError(err, method, "FAILED_OPEN_SHIFT", span, &status, zap.Error(err))
+	}
+
+	s.audit("open", res.ID, req.OperatorID, zap.Int("cashier.id", res.CashierID), zap.Int("opening_float", res.OpeningFloat))
+
+	logSuccess("Successfully opened shift", zap.Int("shift.id", res.ID), zap.Int("cashier.id", res.CashierID))
+
+	return res, nil
+}
+
+func (s *shiftService) FindOpenShift(ctx context.Context, cashier_id int) (*shift.Shift, *response.ErrorResponse) {
+	const method = "FindOpenShift"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("cashier.id", cashier_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.shifts.FindOpenByCashier(ctx, cashier_id)
+	if err != nil {
+		return s.errorhandler.HandleFindShiftError(err, method, "FAILED_FIND_OPEN_SHIFT", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched open shift", zap.Int("shift.id", res.ID), zap.Int("cashier.id", cashier_id))
+
+	return res, nil
+}
+
+// RecordMovement records a pay-in or pay-out on an open shift.
+func (s *shiftService) RecordMovement(ctx context.Context, req *shift.MovementRequest) (*shift.Movement, *response.ErrorResponse) {
+	const method = "RecordMovement"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("shift.id", req.ShiftID), attribute.String("kind", string(req.Kind)))
+
+	defer func() {
+		end(status)
+	}()
+
+	if err := req.Validate(); err != nil {
+		return s.errorhandler.HandleRecordMovementError(err, method, "FAILED_RECORD_MOVEMENT", span, &status, zap.Int("shift.id", req.ShiftID))
+	}
+
+	res, err := s.shifts.AddMovement(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleRecordMovementError(err, method, "FAILED_RECORD_MOVEMENT", span, &status, zap.Error(err))
+	}
+
+	s.audit(string(res.Kind), res.ShiftID, req.OperatorID, zap.Int("amount", res.Amount), zap.String("reason", res.Reason))
+
+	logSuccess("Successfully recorded cash movement", zap.Int("shift.id", res.ShiftID), zap.Int("movement.id", res.ID))
+
+	return res, nil
+}
+
+// CloseShift counts the drawer and closes the shift. The returned Z-report
+// carries the expected cash and the over/short variance.
+func (s *shiftService) CloseShift(ctx context.Context, req *shift.CloseRequest) (*shift.ZReport, *response.ErrorResponse) {
+	const method = "CloseShift"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("shift.id", req.ShiftID), attribute.Int("operator.id", req.OperatorID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if err := req.Validate(); err != nil {
+		return s.errorhandler.HandleCloseShiftError(err, method, "FAILED_CLOSE_SHIFT", span, &status, zap.Int("shift.id", req.ShiftID))
+	}
+
+	res, err := s.shifts.CloseShift(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleCloseShiftError(err, method, "FAILED_CLOSE_SHIFT", span, &status, zap.Error(err))
+	}
+
+	s.audit("close", res.Shift.ID, req.OperatorID,
+		zap.Int("expected_cash", res.Drawer.ExpectedCash),
+		zap.Int("counted_cash", *res.Drawer.CountedCash),
+		zap.Int("variance", *res.Drawer.Variance),
+	)
+
+	logSuccess("Successfully closed shift", zap.Int("shift.id", res.Shift.ID), zap.Int("variance", *res.Drawer.Variance))
+
+	return res, nil
+}
+
+// FindReport returns the Z-report of a closed shift, or the running totals
+// of an open one.
+func (s *shiftService) FindReport(ctx context.Context, shift_id int) (*shift.ZReport, *response.ErrorResponse) {
+	const method = "FindReport"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("shift.id", shift_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	sh, err := s.shifts.FindById(ctx, shift_id)
+	if err != nil {
+		return s.errorhandler.HandleReportError(err, method, "FAILED_FIND_SHIFT_REPORT", span, &status, zap.Error(err))
+	}
+
+	sales, err := s.shifts.FindSales(ctx, []int{sh.ID})
+	if err != nil {
+		return s.errorhandler.HandleReportError(err, method, "FAILED_FIND_SHIFT_REPORT", span, &status, zap.Error(err))
+	}
+
+	movements, err := s.shifts.FindMovements(ctx, sh.ID)
+	if err != nil {
+		return s.errorhandler.HandleReportError(err, method, "FAILED_FIND_SHIFT_REPORT", span, &status, zap.Error(err))
+	}
+
+	// The drawer of a closed shift is what was reconciled at close, even if
+	// its transactions changed since.
+	drawer := sh.Drawer
+	if drawer == nil {
+		drawer = shift.NewDrawer(sh.OpeningFloat, sales[sh.ID], movements)
+	}
+
+	logSuccess("Successfully built shift report", zap.Int("shift.id", sh.ID))
+
+	return &shift.ZReport{
+		Shift:     sh,
+		Sales:     sales[sh.ID],
+		Drawer:    drawer,
+		Movements: movements,
+	}, nil
+}
+
+// audit logs cash handling at info level, so every change to a drawer can be
+// traced back to who made it.
+func (s *shiftService) audit(action string, shiftId int, operatorId int, fields ...zap.Field) {
+	s.logger.Info("Audit: cash drawer changed", append([]zap.Field{
+		zap.String("action", action),
+		zap.Int("shift.id", shiftId),
+		zap.Int("operator.id", operatorId),
+	}, fields...)...)
+}
+
+func (s *shiftService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
+	context.Context,
+	trace.Span,
+	func(string),
+	string,
+	func(string, ...zap.Field),
+) {
+	start := time.Now()
+	status := "success"
+
+	ctx, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+
+	s.logger.Debug("Start: " + method)
+
+	end := func(status string) {
+		s.recordMetrics(method, status, start)
+		code := codes.Ok
+		if status != "success" {
+			code = codes.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess := func(msg string, fields ...zap.Field) {
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	return ctx, span, end, status, logSuccess
+}
+
+func (s *shiftService) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
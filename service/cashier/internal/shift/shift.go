@@ -0,0 +1,206 @@
+package shift
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type MovementKind string
+
+const (
+	PayIn  MovementKind = "pay_in"
+	PayOut MovementKind = "pay_out"
+)
+
+// MethodCash is the tender method that ends up in the drawer.
+const MethodCash = "cash"
+
+var (
+	ErrNotFound         = errors.New("shift not found")
+	ErrCashierNotFound  = errors.New("cashier not found")
+	ErrAlreadyOpen      = errors.New("cashier already has an open shift")
+	ErrClosed           = errors.New("shift is already closed")
+	ErrInvalidAmount    = errors.New("cash amounts must not be negative")
+	ErrInvalidMovement  = errors.New("movement must be a positive pay_in or pay_out")
+	ErrReasonRequired   = errors.New("movement reason is required")
+	ErrOperatorRequired = errors.New("shift operator is required")
+	ErrInvalidPeriod    = errors.New("invalid year or month")
+)
+
+type OpenRequest struct {
+	CashierID    int
+	OpeningFloat int
+	OperatorID   int
+}
+
+type CloseRequest struct {
+	ShiftID     int
+	CountedCash int
+	OperatorID  int
+	Note        string
+}
+
+// MovementRequest puts cash into the drawer (pay_in) or takes it out
+// (pay_out) for anything that is not a sale, e.g. change or a supplier paid
+// from the till.
+type MovementRequest struct {
+	ShiftID    int
+	Kind       MovementKind
+	Amount     int
+	Reason     string
+	OperatorID int
+}
+
+func (r *OpenRequest) Validate() error {
+	if r.OperatorID <= 0 {
+		return ErrOperatorRequired
+	}
+	if r.OpeningFloat < 0 {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+func (r *CloseRequest) Validate() error {
+	if r.OperatorID <= 0 {
+		return ErrOperatorRequired
+	}
+	if r.CountedCash < 0 {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+func (r *MovementRequest) Validate() error {
+	if r.OperatorID <= 0 {
+		return ErrOperatorRequired
+	}
+	if (r.Kind != PayIn && r.Kind != PayOut) || r.Amount <= 0 {
+		return ErrInvalidMovement
+	}
+	if strings.TrimSpace(r.Reason) == "" {
+		return ErrReasonRequired
+	}
+
+	return nil
+}
+
+// Shift is one cashier's stint on a drawer. Drawer is filled in when the
+// shift is closed and never changes afterwards.
+type Shift struct {
+	ID           int        `json:"id"`
+	CashierID    int        `json:"cashier_id"`
+	MerchantID   int        `json:"merchant_id"`
+	OpeningFloat int        `json:"opening_float"`
+	OpenedBy     int        `json:"opened_by"`
+	OpenedAt     time.Time  `json:"opened_at"`
+	ClosedBy     *int       `json:"closed_by,omitempty"`
+	ClosedAt     *time.Time `json:"closed_at,omitempty"`
+	Note         string     `json:"note,omitempty"`
+	Drawer       *Drawer    `json:"drawer,omitempty"`
+}
+
+func (s *Shift) Open() bool {
+	return s.ClosedAt == nil
+}
+
+type Movement struct {
+	ID         int          `json:"id"`
+	ShiftID    int          `json:"shift_id"`
+	Kind       MovementKind `json:"kind"`
+	Amount     int          `json:"amount"`
+	Reason     string       `json:"reason"`
+	OperatorID int          `json:"operator_id"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// Drawer reconciles the cash a drawer should hold with what was counted.
+// CashSales is net of change given; CashRefunds is cash handed back.
+type Drawer struct {
+	OpeningFloat int  `json:"opening_float"`
+	CashSales    int  `json:"cash_sales"`
+	CashRefunds  int  `json:"cash_refunds"`
+	PayIns       int  `json:"pay_ins"`
+	PayOuts      int  `json:"pay_outs"`
+	ExpectedCash int  `json:"expected_cash"`
+	CountedCash  *int `json:"counted_cash,omitempty"`
+	Variance     *int `json:"variance,omitempty"`
+}
+
+func NewDrawer(openingFloat int, sales *Sales, movements []*Movement) *Drawer {
+	d := &Drawer{
+		OpeningFloat: openingFloat,
+		CashSales:    sales.Cash(),
+		CashRefunds:  sales.CashRefunds,
+	}
+
+	for _, m := range movements {
+		switch m.Kind {
+		case PayIn:
+			d.PayIns += m.Amount
+		case PayOut:
+			d.PayOuts += m.Amount
+		}
+	}
+
+	d.ExpectedCash = d.OpeningFloat + d.CashSales - d.CashRefunds + d.PayIns - d.PayOuts
+
+	return d
+}
+
+// Count records the cash counted at close; a negative variance means the
+// drawer is short.
+func (d *Drawer) Count(counted int) {
+	variance := counted - d.ExpectedCash
+
+	d.CountedCash = &counted
+	d.Variance = &variance
+}
+
+type MethodSales struct {
+	Method       string `json:"method"`
+	Transactions int    `json:"transactions"`
+	Amount       int    `json:"amount"`
+}
+
+// Sales covers the settled transactions rung up by the cashier during the
+// shift, and the refunds the cashier handed out in that time.
+type Sales struct {
+	Transactions int            `json:"transactions"`
+	Gross        int            `json:"gross"`
+	Refunds      int            `json:"refunds"`
+	CashRefunds  int            `json:"cash_refunds"`
+	Methods      []*MethodSales `json:"methods"`
+}
+
+func (s *Sales) Net() int {
+	return s.Gross - s.Refunds
+}
+
+func (s *Sales) Cash() int {
+	for _, m := range s.Methods {
+		if m.Method == MethodCash {
+			return m.Amount
+		}
+	}
+
+	return 0
+}
+
+// ZReport is the end of shift report. For a shift that is still open it is
+// a running total and the drawer has not been counted.
+type ZReport struct {
+	Shift     *Shift      `json:"shift"`
+	Sales     *Sales      `json:"sales"`
+	Drawer    *Drawer     `json:"drawer"`
+	Movements []*Movement `json:"movements"`
+}
+
+// ShiftSales is one row of a cashier's per-shift sales breakdown.
+type ShiftSales struct {
+	Shift *Shift `json:"shift"`
+	Sales *Sales `json:"sales"`
+}
@@ -15,9 +15,14 @@ import (
 	mencache "github.com/MamangRust/monolith-point-of-sale-category/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-category/internal/repository"
 	"github.com/MamangRust/monolith-point-of-sale-category/internal/service"
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	otel_pkg "github.com/MamangRust/monolith-point-of-sale-pkg/otel"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
@@ -28,6 +33,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -44,11 +50,14 @@ func init() {
 }
 
 type Server struct {
-	Logger   logger.LoggerInterface
-	DB       *db.Queries
-	Services *service.Service
-	Handlers *handler.Handler
-	Ctx      context.Context
+	Logger       logger.LoggerInterface
+	DB           *db.Queries
+	Repositories *repository.Repositories
+	Services     *service.Service
+	Handlers     *handler.Handler
+	Relay        *outbox.Relay
+	Conn         *audit.DB
+	Ctx          context.Context
 }
 
 func NewServer(ctx context.Context) (*Server, func(context.Context) error, error) {
@@ -64,15 +73,16 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 
 	flag.Parse()
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
 
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 
 	shutdownTracerProvider, err := otel_pkg.InitTracerProvider("Category-service", ctx)
 
@@ -106,6 +116,8 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Logger: logger,
 	})
 
+	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
+
 	errorhandler := errorhandler.NewErrorHandler(logger)
 
 	services := service.NewService(&service.Deps{
@@ -120,12 +132,17 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Service: services,
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, myKafka, logger)
+
 	return &Server{
-		Logger:   logger,
-		DB:       DB,
-		Services: services,
-		Handlers: handlers,
-		Ctx:      ctx,
+		Logger:       logger,
+		DB:           DB,
+		Repositories: repositories,
+		Services:     services,
+		Handlers:     handlers,
+		Relay:        relay,
+		Conn:         conn,
+		Ctx:          ctx,
 	}, shutdownTracerProvider, nil
 }
 
@@ -154,6 +171,29 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			tenant.Middleware(s.Repositories.Tenant, s.Logger),
+			tenant.Guard(
+				pb.CategoryService_FindMonthlyTotalPrices_FullMethodName,
+				pb.CategoryService_FindYearlyTotalPrices_FullMethodName,
+				pb.CategoryService_FindMonthPrice_FullMethodName,
+				pb.CategoryService_FindYearPrice_FullMethodName,
+				pb.CategoryService_FindMonthlyTotalPricesById_FullMethodName,
+				pb.CategoryService_FindYearlyTotalPricesById_FullMethodName,
+				pb.CategoryService_FindMonthPriceById_FullMethodName,
+				pb.CategoryService_FindYearPriceById_FullMethodName,
+				pb.CategoryService_RestoreAllCategory_FullMethodName,
+				pb.CategoryService_DeleteAllCategoryPermanent_FullMethodName,
+			),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "category",
+				RequestID: middleware.RequestID,
+				Snapshots: map[string]audit.Snapshot{
+					"pb.CategoryService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Category.FindById(ctx, &pb.FindByIdCategoryRequest{Id: int32(id)})
+					},
+				},
+			}, s.Logger),
+			bulk.Middleware(s.Logger),
 		),
 	)
 
@@ -164,6 +204,8 @@ func (s *Server) Run() {
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
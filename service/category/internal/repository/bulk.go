@@ -0,0 +1,6 @@
+package repository
+
+import "github.com/MamangRust/monolith-point-of-sale-common/bulk"
+
+// Tables the DeleteAll and RestoreAll calls of this service run on.
+var bulkCategories = bulk.Table{Name: "categories", ID: "category_id"}
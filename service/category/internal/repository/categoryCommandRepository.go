@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -13,12 +14,14 @@ import (
 
 type categoryCommandRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.CategoryRecordMapper
 }
 
-func NewCategoryCommandRepository(db *db.Queries, mapping recordmapper.CategoryRecordMapper) *categoryCommandRepository {
+func NewCategoryCommandRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.CategoryRecordMapper) *categoryCommandRepository {
 	return &categoryCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -98,19 +101,25 @@ func (r *categoryCommandRepository) DeleteCategoryPermanently(ctx context.Contex
 }
 
 func (r *categoryCommandRepository) RestoreAllCategories(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllCategories(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkCategories, bulk.Restore)
 
 	if err != nil {
 		return false, category_errors.ErrRestoreAllCategories
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *categoryCommandRepository) DeleteAllPermanentCategories(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentCategories(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkCategories, bulk.Delete)
 
 	if err != nil {
 		return false, category_errors.ErrDeleteAllPermanentCategories
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
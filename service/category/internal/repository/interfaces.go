@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 )
@@ -52,3 +54,16 @@ type CategoryCommandRepository interface {
 	RestoreAllCategories(ctx context.Context) (bool, error)
 	DeleteAllPermanentCategories(ctx context.Context) (bool, error)
 }
+
+type TenantRepository interface {
+	FindMerchantIDsByUser(ctx context.Context, userID int) ([]int, error)
+}
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
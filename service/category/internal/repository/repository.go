@@ -1,26 +1,36 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "category"
+
 type Repositories struct {
 	CategoryQuery           CategoryQueryRepository
 	CategoryCommand         CategoryCommandRepository
 	CategoryStats           CategoryStatsRepository
 	CategoryStatsById       CategoryStatsByIdRepository
 	CategoryStatsByMerchant CategoryStatsByMerchantRepository
+	Tenant                  TenantRepository
+	Outbox                  OutboxRepository
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	categoryMapper := recordmapper.NewCategoryRecordMapper()
 
 	return &Repositories{
 		CategoryQuery:           NewCategoryQueryRepository(DB, categoryMapper),
-		CategoryCommand:         NewCategoryCommandRepository(DB, categoryMapper),
+		CategoryCommand:         NewCategoryCommandRepository(DB, conn, categoryMapper),
 		CategoryStats:           NewCategoryStatsRepository(DB, categoryMapper),
 		CategoryStatsById:       NewCategoryStatsByIdRepository(DB, categoryMapper),
 		CategoryStatsByMerchant: NewCategoryStatsByMerchantRepository(DB, categoryMapper),
+		Tenant:                  tenant.NewRepository(conn),
+		Outbox:                  outbox.NewRepository(conn, outboxSource),
 	}
 }
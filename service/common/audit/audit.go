@@ -0,0 +1,388 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Topic is consumed by the audit service, which keeps every event in an
+// append-only table. Keep the event format in sync with it.
+const Topic = "audit-service-topic-events"
+
+const (
+	metadataUserID           = "x-user-id"
+	metadataApiKeyMerchantID = "x-api-key-merchant-id"
+
+	// Scope of DeleteAll and RestoreAll calls, see bulk.Middleware.
+	metadataBulkPrefix = "x-bulk-"
+	metadataBulkDryRun = "x-bulk-dry-run"
+)
+
+// Fields whose values never leave the service in an audit event.
+var redacted = regexp.MustCompile(`password|token|secret`)
+
+type Event struct {
+	EventID          string          `json:"event_id"`
+	Service          string          `json:"service"`
+	Method           string          `json:"method"`
+	Action           string          `json:"action"`
+	Entity           string          `json:"entity"`
+	TargetIDs        []int64         `json:"target_ids"`
+	ActorID          int             `json:"actor_id,omitempty"`
+	ApiKeyMerchantID int             `json:"api_key_merchant_id,omitempty"`
+	RequestID        string          `json:"request_id,omitempty"`
+	Request          json.RawMessage `json:"request,omitempty"`
+	Before           json.RawMessage `json:"before,omitempty"`
+	After            json.RawMessage `json:"after,omitempty"`
+	Code             string          `json:"code"`
+	Error            string          `json:"error,omitempty"`
+	OccurredAt       time.Time       `json:"occurred_at"`
+}
+
+// Snapshot loads an entity by id, so the audit event can carry its state
+// from before the call.
+type Snapshot func(ctx context.Context, id int) (proto.Message, error)
+
+type Config struct {
+	// Service tags the events and is the outbox source they are enqueued
+	// under, so it must match the source of the service's relay.
+	Service string
+
+	// Snapshots are keyed by the full gRPC service name, e.g.
+	// pb.CashierService.
+	Snapshots map[string]Snapshot
+
+	// Actions classifies RPCs whose name doesn't start with a verb action
+	// knows, keyed by full method name.
+	Actions map[string]string
+
+	// Entities overrides the entity derived from the gRPC service name.
+	Entities map[string]string
+
+	// Redact names further fields whose values never leave the service,
+	// e.g. one-time codes.
+	Redact []string
+
+	RequestID func(ctx context.Context) string
+}
+
+// Interceptor records every create, update, trash, restore and delete call,
+// and the calls in cfg.Actions, whether they succeeded or not.
+//
+// A mutating call runs in a transaction that every query made through conn
+// joins. Its event is enqueued in the outbox in that transaction, so the
+// call's writes and its event are committed together or not at all. A call
+// that fails is rolled back and its event enqueued on its own.
+func Interceptor(conn *DB, cfg Config, logger logger.LoggerInterface) grpc.UnaryServerInterceptor {
+	hidden := make(map[protoreflect.Name]bool, len(cfg.Redact))
+	for _, name := range cfg.Redact {
+		hidden[protoreflect.Name(name)] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		serviceName, method := splitFullMethod(info.FullMethod)
+
+		act, ok := cfg.Actions[info.FullMethod]
+		if !ok {
+			act = action(method)
+		}
+		if act == "" {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		// A dry run changes nothing.
+		if values := md.Get(metadataBulkDryRun); len(values) > 0 && values[0] == "true" {
+			return handler(ctx, req)
+		}
+
+		ent, ok := cfg.Entities[serviceName]
+		if !ok {
+			ent = entity(serviceName)
+		}
+
+		event := &Event{
+			EventID:    newEventID(),
+			Service:    cfg.Service,
+			Method:     info.FullMethod,
+			Action:     act,
+			Entity:     ent,
+			OccurredAt: time.Now().UTC(),
+		}
+		if cfg.RequestID != nil {
+			event.RequestID = cfg.RequestID(ctx)
+		}
+		event.ActorID, event.ApiKeyMerchantID = actor(md)
+
+		reqMsg, _ := req.(proto.Message)
+		if act == "delete_all" || act == "restore_all" {
+			event.Request = bulkScope(md)
+		} else if reqMsg != nil {
+			event.Request = marshal(reqMsg, hidden)
+
+			if id, ok := targetID(reqMsg, event.Entity); ok {
+				event.TargetIDs = []int64{id}
+			}
+		}
+
+		if snapshot, ok := cfg.Snapshots[serviceName]; ok && len(event.TargetIDs) == 1 && act != "create" {
+			if before, err := snapshot(ctx, int(event.TargetIDs[0])); err == nil {
+				event.Before = marshal(unwrap(before), hidden)
+			}
+		}
+
+		tx, err := conn.DB.BeginTx(ctx, nil)
+		if err != nil {
+			logger.Error("Failed to begin audited call", zap.String("method", info.FullMethod), zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to begin database transaction")
+		}
+
+		defer func() {
+			if p := recover(); p != nil {
+				_ = tx.Rollback()
+				panic(p)
+			}
+		}()
+
+		resp, err := handler(withCallTx(ctx, &callTx{tx: tx}), req)
+
+		event.Code = status.Code(err).String()
+		if err != nil {
+			_ = tx.Rollback()
+
+			event.Error = status.Convert(err).Message()
+
+			// The caller's deadline may be what failed the call; the event
+			// is still owed.
+			if enqueueErr := enqueue(context.WithoutCancel(ctx), conn.DB, cfg.Service, event); enqueueErr != nil {
+				logger.Error("Failed to enqueue audit event", zap.String("event_id", event.EventID), zap.Error(enqueueErr))
+			}
+
+			return resp, err
+		}
+
+		if respMsg, ok := resp.(proto.Message); ok && act != "delete" && act != "delete_all" {
+			after := unwrap(respMsg)
+			event.After = marshal(after, hidden)
+
+			if len(event.TargetIDs) == 0 && act == "create" {
+				if id, ok := targetID(after, event.Entity); ok {
+					event.TargetIDs = []int64{id}
+				}
+			}
+		}
+
+		if err := enqueue(ctx, tx, cfg.Service, event); err != nil {
+			_ = tx.Rollback()
+			logger.Error("Failed to enqueue audit event", zap.String("event_id", event.EventID), zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to record audit event")
+		}
+
+		if err := tx.Commit(); err != nil {
+			logger.Error("Failed to commit audited call", zap.String("event_id", event.EventID), zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to commit database transaction")
+		}
+
+		return resp, nil
+	}
+}
+
+func enqueue(ctx context.Context, conn db.DBTX, source string, event *Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return outbox.NewRepository(conn, source).Enqueue(ctx, Topic, event.Entity, payload)
+}
+
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+
+	return "", fullMethod
+}
+
+// action classifies an RPC by its name; reads return "".
+func action(method string) string {
+	switch {
+	case strings.HasPrefix(method, "DeleteAll"):
+		return "delete_all"
+	case strings.HasPrefix(method, "RestoreAll"):
+		return "restore_all"
+	case strings.HasPrefix(method, "Delete"):
+		return "delete"
+	case strings.HasPrefix(method, "Restore"):
+		return "restore"
+	case strings.HasPrefix(method, "Trash"):
+		return "trash"
+	case strings.HasPrefix(method, "Update"):
+		return "update"
+	case strings.HasPrefix(method, "Create"):
+		return "create"
+	default:
+		return ""
+	}
+}
+
+// entity turns pb.MerchantDocumentService into merchant_document.
+func entity(serviceName string) string {
+	if i := strings.LastIndex(serviceName, "."); i >= 0 {
+		serviceName = serviceName[i+1:]
+	}
+	serviceName = strings.TrimSuffix(serviceName, "Service")
+
+	var b strings.Builder
+	for i, r := range serviceName {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+func actor(md metadata.MD) (int, int) {
+	first := func(key string) int {
+		values := md.Get(key)
+		if len(values) == 0 {
+			return 0
+		}
+		id, _ := strconv.Atoi(values[0])
+		return id
+	}
+
+	return first(metadataUserID), first(metadataApiKeyMerchantID)
+}
+
+// bulkScope records the scope of a bulk call, whose request is empty,
+// e.g. {"merchant_id":"3","ids":"4,5"}.
+func bulkScope(md metadata.MD) json.RawMessage {
+	scope := map[string]string{}
+	for key, values := range md {
+		if strings.HasPrefix(key, metadataBulkPrefix) && len(values) > 0 {
+			scope[strings.ReplaceAll(strings.TrimPrefix(key, metadataBulkPrefix), "-", "_")] = values[0]
+		}
+	}
+
+	data, err := json.Marshal(scope)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// targetID finds the id of the entity a message is about: a field
+// named id, <entity>_id, or the last word of the entity followed by _id
+// (document_id for merchant_document).
+func targetID(msg proto.Message, entity string) (int64, bool) {
+	if msg == nil {
+		return 0, false
+	}
+
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	names := []string{"id", entity + "_id", entity[strings.LastIndex(entity, "_")+1:] + "_id"}
+
+	for _, name := range names {
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil || field.Cardinality() == protoreflect.Repeated {
+			continue
+		}
+
+		switch field.Kind() {
+		case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+			if id := msg.ProtoReflect().Get(field).Int(); id > 0 {
+				return id, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// unwrap unwraps the data field of an api response.
+func unwrap(msg proto.Message) proto.Message {
+	if msg == nil {
+		return nil
+	}
+
+	m := msg.ProtoReflect()
+
+	field := m.Descriptor().Fields().ByName("data")
+	if field == nil || field.Kind() != protoreflect.MessageKind || field.Cardinality() == protoreflect.Repeated {
+		return msg
+	}
+
+	if !m.Has(field) {
+		return nil
+	}
+
+	return m.Get(field).Message().Interface()
+}
+
+func marshal(msg proto.Message, hidden map[protoreflect.Name]bool) json.RawMessage {
+	if msg == nil {
+		return nil
+	}
+
+	clone := proto.Clone(msg)
+	redact(clone.ProtoReflect(), hidden)
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(clone)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func redact(m protoreflect.Message, hidden map[protoreflect.Name]bool) {
+	m.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case redacted.MatchString(string(field.Name())) || hidden[field.Name()]:
+			m.Clear(field)
+		case field.IsList() && field.Kind() == protoreflect.MessageKind:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				redact(list.Get(i).Message(), hidden)
+			}
+		case !field.IsList() && !field.IsMap() && field.Kind() == protoreflect.MessageKind:
+			redact(value.Message(), hidden)
+		}
+		return true
+	})
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
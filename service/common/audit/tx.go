@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+)
+
+// callTx is the transaction Interceptor opens for a mutating call. Every
+// query made through DB while handling the call runs in it, so the call's
+// writes and its audit event are committed together.
+type callTx struct {
+	tx         *sql.Tx
+	savepoints atomic.Int64
+}
+
+type callTxKey struct{}
+
+func withCallTx(ctx context.Context, tx *callTx) context.Context {
+	return context.WithValue(ctx, callTxKey{}, tx)
+}
+
+func callTxFromContext(ctx context.Context) (*callTx, bool) {
+	tx, ok := ctx.Value(callTxKey{}).(*callTx)
+	return tx, ok && tx != nil
+}
+
+// DB is the connection pool repositories use. Outside a mutating call it
+// behaves like *sql.DB; inside one, queries join the call's transaction.
+type DB struct {
+	*sql.DB
+}
+
+func NewDB(conn *sql.DB) *DB {
+	return &DB{DB: conn}
+}
+
+func (d *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx, ok := callTxFromContext(ctx); ok {
+		return tx.tx.ExecContext(ctx, query, args...)
+	}
+	return d.DB.ExecContext(ctx, query, args...)
+}
+
+func (d *DB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	if tx, ok := callTxFromContext(ctx); ok {
+		return tx.tx.PrepareContext(ctx, query)
+	}
+	return d.DB.PrepareContext(ctx, query)
+}
+
+func (d *DB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx, ok := callTxFromContext(ctx); ok {
+		return tx.tx.QueryContext(ctx, query, args...)
+	}
+	return d.DB.QueryContext(ctx, query, args...)
+}
+
+func (d *DB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx, ok := callTxFromContext(ctx); ok {
+		return tx.tx.QueryRowContext(ctx, query, args...)
+	}
+	return d.DB.QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx starts a transaction, or a savepoint in the call's transaction
+// when there is one, so rolling back undoes only the work since BeginTx.
+func (d *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	call, ok := callTxFromContext(ctx)
+	if !ok {
+		tx, err := d.DB.BeginTx(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &Tx{Tx: tx}, nil
+	}
+
+	savepoint := fmt.Sprintf("sp_%d", call.savepoints.Add(1))
+	if _, err := call.tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: call.tx, savepoint: savepoint}, nil
+}
+
+// Tx is a transaction begun with DB.BeginTx. Queries go through the
+// embedded *sql.Tx; pass it to db.Queries.WithTx.
+type Tx struct {
+	*sql.Tx
+	savepoint string
+	done      bool
+}
+
+func (t *Tx) Commit() error {
+	if t.savepoint == "" {
+		return t.Tx.Commit()
+	}
+
+	return t.finish("RELEASE SAVEPOINT " + t.savepoint)
+}
+
+func (t *Tx) Rollback() error {
+	if t.savepoint == "" {
+		return t.Tx.Rollback()
+	}
+
+	return t.finish("ROLLBACK TO SAVEPOINT " + t.savepoint)
+}
+
+// finish ends a savepoint once. Postgres aborts the whole transaction on a
+// failed statement, so a second Commit or Rollback must not reach it.
+func (t *Tx) finish(stmt string) error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+
+	_, err := t.Tx.Exec(stmt)
+	return err
+}
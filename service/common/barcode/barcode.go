@@ -0,0 +1,50 @@
+// Package barcode checks the retail barcodes printed on products.
+package barcode
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalid = errors.New("barcode must be a valid EAN-13, UPC-A or EAN-8 code")
+
+// Normalize checks a scanned code and brings it to the form barcodes are
+// stored and looked up in. Spaces and dashes are dropped, and a UPC-A code
+// gets the leading zero of its EAN-13 form, so both scan to the same
+// product.
+func Normalize(code string) (string, error) {
+	code = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, code)
+
+	switch len(code) {
+	case 8, 13:
+	case 12:
+		code = "0" + code
+	default:
+		return "", ErrInvalid
+	}
+
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		d := code[i]
+		if d < '0' || d > '9' {
+			return "", ErrInvalid
+		}
+
+		weight := 1
+		if (len(code)-2-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(d-'0') * weight
+	}
+
+	if check := code[len(code)-1]; check < '0' || check > '9' || int(check-'0') != (10-sum%10)%10 {
+		return "", ErrInvalid
+	}
+
+	return code, nil
+}
@@ -0,0 +1,39 @@
+package barcode
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    string
+		wantErr error
+	}{
+		{name: "valid EAN-13", code: "4006381333931", want: "4006381333931"},
+		{name: "valid EAN-8", code: "96385074", want: "96385074"},
+		{name: "UPC-A is padded to EAN-13", code: "036000291452", want: "0036000291452"},
+		{name: "padded UPC-A matches its EAN-13 form", code: "0036000291452", want: "0036000291452"},
+		{name: "spaces and dashes are dropped", code: "400-6381 333931", want: "4006381333931"},
+		{name: "another EAN-13", code: "5901234123457", want: "5901234123457"},
+		{name: "wrong EAN-13 check digit", code: "4006381333932", wantErr: ErrInvalid},
+		{name: "wrong EAN-8 check digit", code: "96385075", wantErr: ErrInvalid},
+		{name: "wrong UPC-A check digit", code: "036000291453", wantErr: ErrInvalid},
+		{name: "letters", code: "40063813339A1", wantErr: ErrInvalid},
+		{name: "letter as check digit", code: "400638133393X", wantErr: ErrInvalid},
+		{name: "too short", code: "1234567", wantErr: ErrInvalid},
+		{name: "too long", code: "40063813339310", wantErr: ErrInvalid},
+		{name: "empty", code: "", wantErr: ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.code)
+			if err != tt.wantErr {
+				t.Fatalf("Normalize(%q) error = %v, want %v", tt.code, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package bulk
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	metadataUserRoles = "x-user-roles"
+
+	roleAdmin = "ROLE_ADMIN"
+)
+
+// Middleware guards the DeleteAll and RestoreAll calls. They are limited to
+// admins, must carry a scope, and report the rows they touched in the
+// MetadataAffected header.
+func Middleware(logger logger.LoggerInterface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		if !strings.HasPrefix(method, "DeleteAll") && !strings.HasPrefix(method, "RestoreAll") {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		if !admin(md) {
+			return nil, status.Error(codes.PermissionDenied, "bulk operations are limited to admins")
+		}
+
+		scope, err := FromMetadata(md)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		resp, err := handler(WithScope(ctx, scope), req)
+		if err != nil {
+			return resp, err
+		}
+
+		if err := grpc.SetHeader(ctx, metadata.Pairs(MetadataAffected, strconv.FormatInt(scope.Affected(), 10))); err != nil {
+			logger.Error("Failed to set bulk affected header", zap.String("method", info.FullMethod), zap.Error(err))
+		}
+
+		return resp, nil
+	}
+}
+
+func admin(md metadata.MD) bool {
+	for _, value := range md.Get(metadataUserRoles) {
+		for _, role := range strings.Split(value, ",") {
+			if strings.TrimSpace(role) == roleAdmin {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,128 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys set by the API gateway on DeleteAll and RestoreAll calls,
+// whose requests carry no fields of their own.
+const (
+	MetadataMerchantID    = "x-bulk-merchant-id"
+	MetadataTrashedBefore = "x-bulk-trashed-before"
+	MetadataIDs           = "x-bulk-ids"
+	MetadataDryRun        = "x-bulk-dry-run"
+
+	// MetadataAffected is the response header with the number of rows the
+	// call changed, or would have changed on a dry run.
+	MetadataAffected = "x-bulk-affected"
+)
+
+// MaxIDs caps the ids of a single call.
+const MaxIDs = 1000
+
+var (
+	ErrScopeRequired = errors.New("bulk operations require a merchant_id, trashed_before or ids scope")
+	ErrInvalidScope  = errors.New("invalid bulk operation scope")
+)
+
+// Scope limits a DeleteAll or RestoreAll call to the trashed rows matching
+// every field that is set.
+type Scope struct {
+	MerchantID    int
+	TrashedBefore time.Time
+	IDs           []int
+	DryRun        bool
+
+	affected int64
+}
+
+type scopeKey struct{}
+
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+func FromContext(ctx context.Context) (*Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(*Scope)
+	return scope, ok && scope != nil
+}
+
+// FromMetadata reads the scope the gateway forwarded.
+func FromMetadata(md metadata.MD) (*Scope, error) {
+	scope := &Scope{}
+
+	if value := first(md, MetadataMerchantID); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil || id <= 0 {
+			return nil, ErrInvalidScope
+		}
+		scope.MerchantID = id
+	}
+
+	if value := first(md, MetadataTrashedBefore); value != "" {
+		before, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, ErrInvalidScope
+		}
+		scope.TrashedBefore = before
+	}
+
+	if value := first(md, MetadataIDs); value != "" {
+		parts := strings.Split(value, ",")
+		if len(parts) > MaxIDs {
+			return nil, ErrInvalidScope
+		}
+
+		for _, part := range parts {
+			id, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || id <= 0 {
+				return nil, ErrInvalidScope
+			}
+			scope.IDs = append(scope.IDs, id)
+		}
+	}
+
+	if scope.MerchantID == 0 && scope.TrashedBefore.IsZero() && len(scope.IDs) == 0 {
+		return nil, ErrScopeRequired
+	}
+
+	scope.DryRun = first(md, MetadataDryRun) == "true"
+
+	return scope, nil
+}
+
+// SetAffected records the rows of the entity the call is named after;
+// rows of dependent tables are not counted.
+func SetAffected(ctx context.Context, n int64) {
+	if scope, ok := FromContext(ctx); ok {
+		scope.affected = n
+	}
+}
+
+func (s *Scope) Affected() int64 {
+	return s.affected
+}
+
+func (s *Scope) Int64IDs() []int64 {
+	ids := make([]int64, len(s.IDs))
+	for i, id := range s.IDs {
+		ids[i] = int64(id)
+	}
+
+	return ids
+}
+
+func first(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(values[0])
+}
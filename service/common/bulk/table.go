@@ -0,0 +1,84 @@
+package bulk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/lib/pq"
+)
+
+// Table is a soft-deleted table DeleteAll and RestoreAll calls run on.
+type Table struct {
+	Name string
+	// ID is the column matched against the ids of the scope.
+	ID string
+	// Merchant is the condition on the merchant id in $1; empty when the
+	// rows do not belong to a merchant.
+	Merchant string
+}
+
+type Operation int
+
+const (
+	Restore Operation = iota
+	Delete
+)
+
+// Where returns the condition and arguments selecting the trashed rows in
+// the scope of ctx.
+func (t Table) Where(ctx context.Context) (string, []interface{}, *Scope, error) {
+	scope, ok := FromContext(ctx)
+	if !ok {
+		return "", nil, nil, ErrScopeRequired
+	}
+
+	merchant := t.Merchant
+	if merchant == "" {
+		if scope.MerchantID > 0 {
+			return "", nil, nil, ErrInvalidScope
+		}
+		merchant = "FALSE"
+	}
+
+	condition := fmt.Sprintf(`deleted_at IS NOT NULL
+AND ($1::INT IS NULL OR %s)
+AND ($2::TIMESTAMPTZ IS NULL OR deleted_at < $2)
+AND (cardinality($3::INT[]) = 0 OR %s = ANY($3::INT[]))`, merchant, t.ID)
+
+	args := []interface{}{
+		sql.NullInt32{Int32: int32(scope.MerchantID), Valid: scope.MerchantID > 0},
+		sql.NullTime{Time: scope.TrashedBefore, Valid: !scope.TrashedBefore.IsZero()},
+		pq.Array(scope.Int64IDs()),
+	}
+
+	return condition, args, scope, nil
+}
+
+// Apply restores or deletes the trashed rows of table in the scope of ctx
+// and returns how many it touched. A dry run only counts them.
+func Apply(ctx context.Context, conn db.DBTX, table Table, op Operation) (int64, error) {
+	condition, args, scope, err := table.Where(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if scope.DryRun {
+		var n int64
+		err := conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table.Name+" WHERE "+condition, args...).Scan(&n)
+		return n, err
+	}
+
+	query := "UPDATE " + table.Name + " SET deleted_at = NULL WHERE " + condition
+	if op == Delete {
+		query = "DELETE FROM " + table.Name + " WHERE " + condition
+	}
+
+	res, err := conn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
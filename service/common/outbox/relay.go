@@ -0,0 +1,192 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const (
+	pollInterval       = time.Second
+	purgeInterval      = time.Hour
+	batchSize          = 100
+	claimLease         = time.Minute
+	baseBackoff        = time.Second
+	maxBackoff         = 5 * time.Minute
+	deliveredRetention = 7 * 24 * time.Hour
+)
+
+// Store is the part of Repository the relay uses.
+type Store interface {
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
+
+type Publisher interface {
+	SendMessage(topic string, key string, value []byte) error
+}
+
+// Relay publishes the events services enqueue in outbox_events. An event
+// is marked delivered only after Kafka acknowledged it, so delivery is at
+// least once and consumers must tolerate duplicates.
+type Relay struct {
+	outbox    Store
+	publisher Publisher
+	logger    logger.LoggerInterface
+
+	published   *prometheus.CounterVec
+	failed      *prometheus.CounterVec
+	pending     prometheus.Gauge
+	lag         prometheus.Gauge
+	deliveryLag prometheus.Histogram
+}
+
+func NewRelay(outbox Store, publisher Publisher, logger logger.LoggerInterface) *Relay {
+	published := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "outbox_events_published_total",
+			Help: "Total number of outbox events published to Kafka",
+		},
+		[]string{"topic"},
+	)
+
+	failed := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "outbox_events_failed_total",
+			Help: "Total number of failed outbox publish attempts",
+		},
+		[]string{"topic"},
+	)
+
+	pending := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_events_pending",
+		Help: "Number of outbox events not yet delivered",
+	})
+
+	lag := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_lag_seconds",
+		Help: "Age of the oldest undelivered outbox event",
+	})
+
+	deliveryLag := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "outbox_delivery_lag_seconds",
+		Help:    "Histogram of the time between enqueueing and publishing an outbox event",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 14),
+	})
+
+	prometheus.MustRegister(published, failed, pending, lag, deliveryLag)
+
+	return &Relay{
+		outbox:      outbox,
+		publisher:   publisher,
+		logger:      logger,
+		published:   published,
+		failed:      failed,
+		pending:     pending,
+		lag:         lag,
+		deliveryLag: deliveryLag,
+	}
+}
+
+// Run polls the outbox until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+
+	purge := time.NewTicker(purgeInterval)
+	defer purge.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+			r.drain(ctx)
+			r.observeBacklog(ctx)
+		case <-purge.C:
+			if n, err := r.outbox.PurgeDelivered(ctx, deliveredRetention); err != nil {
+				r.logger.Error("failed to purge outbox events", zap.Error(err))
+			} else if n > 0 {
+				r.logger.Debug("purged delivered outbox events", zap.Int64("count", n))
+			}
+		}
+	}
+}
+
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		messages, err := r.outbox.Claim(ctx, batchSize, claimLease)
+		if err != nil {
+			r.logger.Error("failed to claim outbox events", zap.Error(err))
+			return
+		}
+
+		for _, msg := range messages {
+			r.publish(ctx, msg)
+		}
+
+		if len(messages) < batchSize {
+			return
+		}
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, msg *Message) {
+	start := time.Now()
+
+	if err := r.publisher.SendMessage(msg.Topic, msg.Key, msg.Payload); err != nil {
+		r.failed.WithLabelValues(msg.Topic).Inc()
+
+		retryIn := backoff(msg.Attempts)
+
+		r.logger.Error("failed to publish outbox event",
+			zap.Int64("outbox_event_id", msg.ID),
+			zap.String("topic", msg.Topic),
+			zap.Int("attempts", msg.Attempts),
+			zap.Duration("retry_in", retryIn),
+			zap.Error(err),
+		)
+
+		if err := r.outbox.MarkFailed(ctx, msg.ID, err, retryIn); err != nil {
+			r.logger.Error("failed to reschedule outbox event", zap.Int64("outbox_event_id", msg.ID), zap.Error(err))
+		}
+
+		return
+	}
+
+	// A failure here leaves the lease to expire, so the event is published
+	// again later rather than lost.
+	if err := r.outbox.MarkDelivered(ctx, msg.ID); err != nil {
+		r.logger.Error("failed to mark outbox event delivered", zap.Int64("outbox_event_id", msg.ID), zap.Error(err))
+		return
+	}
+
+	r.published.WithLabelValues(msg.Topic).Inc()
+	r.deliveryLag.Observe((msg.Age + time.Since(start)).Seconds())
+}
+
+func (r *Relay) observeBacklog(ctx context.Context) {
+	pending, age, err := r.outbox.Backlog(ctx)
+	if err != nil {
+		r.logger.Error("failed to read outbox backlog", zap.Error(err))
+		return
+	}
+
+	r.pending.Set(float64(pending))
+	r.lag.Set(age.Seconds())
+}
+
+func backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,163 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+)
+
+var (
+	ErrEnqueueOutboxEvent = errors.New("failed to enqueue outbox event")
+	ErrClaimOutboxEvents  = errors.New("failed to claim outbox events")
+	ErrUpdateOutboxEvent  = errors.New("failed to update outbox event")
+	ErrOutboxBacklog      = errors.New("failed to read outbox backlog")
+	ErrPurgeOutboxEvents  = errors.New("failed to purge delivered outbox events")
+)
+
+const insertOutboxEvent = `-- name: InsertOutboxEvent :exec
+INSERT INTO outbox_events (source, topic, message_key, payload)
+VALUES ($1, $2, $3, $4)
+`
+
+// Claimed rows are leased by pushing next_attempt_at past the lease, so a
+// relay that dies mid-batch only delays them instead of losing them.
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+UPDATE outbox_events
+SET attempts = attempts + 1,
+    next_attempt_at = now() + $3::float8 * interval '1 second'
+WHERE outbox_event_id IN (
+    SELECT outbox_event_id FROM outbox_events
+    WHERE source = $1 AND delivered_at IS NULL AND next_attempt_at <= now()
+    ORDER BY outbox_event_id
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING outbox_event_id, topic, message_key, payload, attempts,
+    EXTRACT(EPOCH FROM now() - created_at)::float8
+`
+
+const markOutboxEventDelivered = `-- name: MarkOutboxEventDelivered :exec
+UPDATE outbox_events
+SET delivered_at = now(), last_error = NULL
+WHERE outbox_event_id = $1
+`
+
+const markOutboxEventFailed = `-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET last_error = $2,
+    next_attempt_at = now() + $3::float8 * interval '1 second'
+WHERE outbox_event_id = $1
+`
+
+const getOutboxBacklog = `-- name: GetOutboxBacklog :one
+SELECT COUNT(*), COALESCE(EXTRACT(EPOCH FROM now() - MIN(created_at)), 0)::float8
+FROM outbox_events
+WHERE source = $1 AND delivered_at IS NULL
+`
+
+const purgeDeliveredOutboxEvents = `-- name: PurgeDeliveredOutboxEvents :execrows
+DELETE FROM outbox_events
+WHERE source = $1 AND delivered_at < now() - $2::float8 * interval '1 second'
+`
+
+type Message struct {
+	ID       int64
+	Topic    string
+	Key      string
+	Payload  []byte
+	Attempts int
+	Age      time.Duration
+}
+
+// Repository reads and writes the events of one source, the service whose
+// relay publishes them.
+type Repository struct {
+	conn   db.DBTX
+	source string
+}
+
+func NewRepository(conn db.DBTX, source string) *Repository {
+	return &Repository{
+		conn:   conn,
+		source: source,
+	}
+}
+
+// Enqueue stores an event for the relay. Pass a transaction-bound conn to
+// make the event commit or roll back together with the domain write.
+func (r *Repository) Enqueue(ctx context.Context, topic string, key string, payload []byte) error {
+	if _, err := r.conn.ExecContext(ctx, insertOutboxEvent, r.source, topic, key, payload); err != nil {
+		return ErrEnqueueOutboxEvent
+	}
+
+	return nil
+}
+
+func (r *Repository) Claim(ctx context.Context, limit int, lease time.Duration) ([]*Message, error) {
+	rows, err := r.conn.QueryContext(ctx, claimOutboxEvents, r.source, limit, lease.Seconds())
+	if err != nil {
+		return nil, ErrClaimOutboxEvents
+	}
+	defer rows.Close()
+
+	var messages []*Message
+	for rows.Next() {
+		var (
+			m   Message
+			age float64
+		)
+		if err := rows.Scan(&m.ID, &m.Topic, &m.Key, &m.Payload, &m.Attempts, &age); err != nil {
+			return nil, ErrClaimOutboxEvents
+		}
+		m.Age = time.Duration(age * float64(time.Second))
+		messages = append(messages, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrClaimOutboxEvents
+	}
+
+	return messages, nil
+}
+
+func (r *Repository) MarkDelivered(ctx context.Context, id int64) error {
+	if _, err := r.conn.ExecContext(ctx, markOutboxEventDelivered, id); err != nil {
+		return ErrUpdateOutboxEvent
+	}
+
+	return nil
+}
+
+func (r *Repository) MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error {
+	if _, err := r.conn.ExecContext(ctx, markOutboxEventFailed, id, cause.Error(), retryIn.Seconds()); err != nil {
+		return ErrUpdateOutboxEvent
+	}
+
+	return nil
+}
+
+// Backlog returns the number of undelivered events and the age of the
+// oldest one.
+func (r *Repository) Backlog(ctx context.Context) (int, time.Duration, error) {
+	var (
+		pending int
+		age     float64
+	)
+
+	if err := r.conn.QueryRowContext(ctx, getOutboxBacklog, r.source).Scan(&pending, &age); err != nil {
+		return 0, 0, ErrOutboxBacklog
+	}
+
+	return pending, time.Duration(age * float64(time.Second)), nil
+}
+
+func (r *Repository) PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error) {
+	res, err := r.conn.ExecContext(ctx, purgeDeliveredOutboxEvents, r.source, olderThan.Seconds())
+	if err != nil {
+		return 0, ErrPurgeOutboxEvents
+	}
+
+	return res.RowsAffected()
+}
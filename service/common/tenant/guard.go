@@ -0,0 +1,93 @@
+package tenant
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// merchantRequest is any request message with a merchant_id field.
+type merchantRequest interface {
+	GetMerchantId() int32
+}
+
+type idRequest interface {
+	GetId() int32
+}
+
+// OwnerResolver returns the merchant the row with id belongs to.
+type OwnerResolver func(ctx context.Context, id int) (int, error)
+
+// RowID reads the id of the row a request addresses.
+type RowID func(req interface{}) (int, bool)
+
+// ByID reads the id field of requests such as FindByIdCashierRequest.
+func ByID(req interface{}) (int, bool) {
+	r, ok := req.(idRequest)
+	if !ok {
+		return 0, false
+	}
+
+	return int(r.GetId()), true
+}
+
+// Guard keeps scoped callers to their merchants on calls the repositories
+// do not filter themselves. A request naming a merchant must name one of
+// theirs, and the methods in global, which read or change every merchant,
+// are left to admins. Methods are full gRPC names. It runs after Middleware.
+func Guard(global ...string) grpc.UnaryServerInterceptor {
+	adminOnly := make(map[string]struct{}, len(global))
+	for _, method := range global {
+		adminOnly[method] = struct{}{}
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := Restricted(ctx); !ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok := adminOnly[info.FullMethod]; ok {
+			return nil, status.Error(codes.PermissionDenied, "calls across merchants are limited to admins")
+		}
+
+		if r, ok := req.(merchantRequest); ok && r.GetMerchantId() != 0 && !Allows(ctx, int(r.GetMerchantId())) {
+			return nil, status.Error(codes.PermissionDenied, "merchant is outside the caller's scope")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Owned checks calls that address a single row by id, such as a cashier or
+// a merchant document, against the merchant the row belongs to. Rows the
+// resolver cannot find are left to the handler to report.
+func Owned(resolve OwnerResolver, methods map[string]RowID) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rowID, ok := methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if _, ok := Restricted(ctx); !ok {
+			return handler(ctx, req)
+		}
+
+		id, ok := rowID(req)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		merchantID, err := resolve(ctx, id)
+		if err != nil {
+			return handler(ctx, req)
+		}
+
+		if !Allows(ctx, merchantID) {
+			return nil, status.Error(codes.PermissionDenied, "merchant is outside the caller's scope")
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,48 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MerchantResolver interface {
+	FindMerchantIDsByUser(ctx context.Context, userID int) ([]int, error)
+}
+
+// Middleware turns the caller forwarded by the API gateway into a Scope.
+// Non-admin callers are limited to the merchants they own or work for, and
+// API key callers to the merchant of their key.
+func Middleware(resolver MerchantResolver, logger logger.LoggerInterface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if merchantID, ok := ApiKeyMerchant(ctx); ok {
+			return handler(WithScope(ctx, &Scope{MerchantIDs: []int{merchantID}}), req)
+		}
+
+		userID, roles, ok := Caller(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		scope := &Scope{
+			UserID: userID,
+			Admin:  HasRole(roles, RoleAdmin),
+		}
+
+		if !scope.Admin {
+			ids, err := resolver.FindMerchantIDsByUser(ctx, userID)
+			if err != nil {
+				logger.Error("Failed to resolve tenant scope", zap.Int("user_id", userID), zap.Error(err))
+				return nil, status.Error(codes.Internal, "failed to resolve tenant scope")
+			}
+
+			scope.MerchantIDs = ids
+		}
+
+		return handler(WithScope(ctx, scope), req)
+	}
+}
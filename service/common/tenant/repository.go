@@ -0,0 +1,50 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+)
+
+var ErrFindTenantMerchants = errors.New("failed to find merchants of user")
+
+const getMerchantIDsByUser = `-- name: GetMerchantIDsByUser :many
+SELECT merchant_id FROM merchants WHERE user_id = $1 AND deleted_at IS NULL
+UNION
+SELECT merchant_id FROM cashiers WHERE user_id = $1 AND deleted_at IS NULL
+`
+
+type repository struct {
+	conn db.DBTX
+}
+
+func NewRepository(conn db.DBTX) *repository {
+	return &repository{
+		conn: conn,
+	}
+}
+
+// FindMerchantIDsByUser returns the merchants a user owns or works for.
+func (r *repository) FindMerchantIDsByUser(ctx context.Context, userID int) ([]int, error) {
+	rows, err := r.conn.QueryContext(ctx, getMerchantIDsByUser, userID)
+	if err != nil {
+		return nil, ErrFindTenantMerchants
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, ErrFindTenantMerchants
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrFindTenantMerchants
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,134 @@
+package tenant
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys set by the API gateway for every authenticated call.
+const (
+	MetadataUserID    = "x-user-id"
+	MetadataUserRoles = "x-user-roles"
+
+	// MetadataApiKeyMerchantID is set instead of the user for calls made
+	// with an API key.
+	MetadataApiKeyMerchantID = "x-api-key-merchant-id"
+
+	RoleAdmin = "ROLE_ADMIN"
+)
+
+// Scope is the set of merchants a caller may see. Calls without a scope
+// come from inside the cluster and are not restricted.
+type Scope struct {
+	UserID      int
+	Admin       bool
+	MerchantIDs []int
+}
+
+type scopeKey struct{}
+
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+func FromContext(ctx context.Context) (*Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(*Scope)
+	return scope, ok && scope != nil
+}
+
+// Restricted returns the scope of a non-admin caller, or false when rows of
+// every merchant may be returned.
+func Restricted(ctx context.Context) (*Scope, bool) {
+	scope, ok := FromContext(ctx)
+	if !ok || scope.Admin {
+		return nil, false
+	}
+
+	return scope, true
+}
+
+// Allows reports whether rows of merchantID are visible in ctx.
+func Allows(ctx context.Context, merchantID int) bool {
+	scope, ok := Restricted(ctx)
+	if !ok {
+		return true
+	}
+
+	for _, id := range scope.MerchantIDs {
+		if id == merchantID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Scope) Int64MerchantIDs() []int64 {
+	ids := make([]int64, len(s.MerchantIDs))
+	for i, id := range s.MerchantIDs {
+		ids[i] = int64(id)
+	}
+
+	return ids
+}
+
+// Caller reads the gateway identity from the incoming metadata.
+func Caller(ctx context.Context) (userID int, roles []string, ok bool) {
+	md, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return 0, nil, false
+	}
+
+	values := md.Get(MetadataUserID)
+	if len(values) == 0 {
+		return 0, nil, false
+	}
+
+	userID, err := strconv.Atoi(values[0])
+	if err != nil || userID <= 0 {
+		return 0, nil, false
+	}
+
+	for _, value := range md.Get(MetadataUserRoles) {
+		for _, role := range strings.Split(value, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	return userID, roles, true
+}
+
+// ApiKeyMerchant returns the merchant an API key caller is limited to.
+func ApiKeyMerchant(ctx context.Context) (int, bool) {
+	md, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return 0, false
+	}
+
+	values := md.Get(MetadataApiKeyMerchantID)
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	merchantID, err := strconv.Atoi(values[0])
+	if err != nil || merchantID <= 0 {
+		return 0, false
+	}
+
+	return merchantID, true
+}
+
+func HasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -58,6 +58,8 @@ func main() {
 		"email-service-topic-auth-register",
 		"email-service-topic-auth-forgot-password",
 		"email-service-topic-auth-verify-code-success",
+		"email-service-topic-auth-account-locked",
+		"email-service-topic-auth-account-unlocked",
 		"email-service-topic-merchant-create",
 		"email-service-topic-merchant-update-status",
 		"email-service-topic-merchant-document-create",
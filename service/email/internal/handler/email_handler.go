@@ -7,6 +7,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/MamangRust/monolith-point-of-sale-email/internal/mailer"
 	"github.com/MamangRust/monolith-point-of-sale-email/internal/metrics"
+	"github.com/MamangRust/monolith-point-of-sale-email/internal/receipt"
 )
 
 type EmailHandler struct {
@@ -18,6 +19,12 @@ func (h *EmailHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil
 
 func (h *EmailHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for msg := range claim.Messages() {
+		if msg.Topic == receipt.Topic {
+			h.sendReceipt(msg.Value)
+			sess.MarkMessage(msg, "")
+			continue
+		}
+
 		var payload map[string]interface{}
 		if err := json.Unmarshal(msg.Value, &payload); err != nil {
 			log.Printf("Failed to unmarshal message: %v", err)
@@ -40,3 +47,26 @@ func (h *EmailHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sara
 	}
 	return nil
 }
+
+func (h *EmailHandler) sendReceipt(value []byte) {
+	var event receipt.Event
+	if err := json.Unmarshal(value, &event); err != nil {
+		log.Printf("Failed to unmarshal receipt: %v", err)
+		return
+	}
+
+	subject, body, err := receipt.Render(&event)
+	if err != nil {
+		log.Printf("Failed to render receipt: %v", err)
+		metrics.EmailFailed.Inc()
+		return
+	}
+
+	if err := h.Mailer.Send(event.Email, subject, body); err != nil {
+		log.Printf("Failed to send receipt: %v", err)
+		metrics.EmailFailed.Inc()
+		return
+	}
+
+	metrics.EmailSent.Inc()
+}
@@ -0,0 +1,153 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+)
+
+const Topic = "email-service-topic-transaction-create"
+
+// Event mirrors the receipt published by the transaction service.
+type Event struct {
+	Email         string   `json:"email"`
+	TransactionID int      `json:"transaction_id"`
+	OrderID       int      `json:"order_id"`
+	Merchant      Merchant `json:"merchant"`
+	Cashier       Cashier  `json:"cashier"`
+	Items         []Item   `json:"items"`
+	Subtotal      int      `json:"subtotal"`
+	Tax           int      `json:"tax"`
+	Total         int      `json:"total"`
+	Tenders       []Tender `json:"tenders"`
+	Change        int      `json:"change"`
+	PaidAt        string   `json:"paid_at"`
+}
+
+type Merchant struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Phone   string `json:"phone"`
+}
+
+type Cashier struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Item struct {
+	ProductID int    `json:"product_id"`
+	Name      string `json:"name"`
+	Quantity  int    `json:"quantity"`
+	Price     int    `json:"price"`
+	Discount  int    `json:"discount,omitempty"`
+	Total     int    `json:"total"`
+}
+
+type Tender struct {
+	Method   string `json:"method"`
+	Tendered int    `json:"tendered"`
+	Amount   int    `json:"amount"`
+}
+
+var receiptTemplate = template.Must(template.New("receipt").Funcs(template.FuncMap{
+	"money":  formatMoney,
+	"method": formatMethod,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="UTF-8">
+	<meta name="viewport" content="width=device-width, initial-scale=1.0">
+	<title>Receipt #{{.TransactionID}}</title>
+	<style>
+		body { font-family: 'Arial', sans-serif; background-color: #f9f9f9; margin: 0; padding: 0; color: #333; }
+		.container { max-width: 600px; margin: 20px auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 4px 8px rgba(0, 0, 0, 0.1); padding: 30px; font-size: 15px; }
+		.header { text-align: center; border-bottom: 1px dashed #ccc; padding-bottom: 15px; }
+		.header h1 { font-size: 24px; margin: 0 0 5px; }
+		.meta { color: #666; font-size: 13px; margin: 15px 0; }
+		table { width: 100%; border-collapse: collapse; }
+		th, td { padding: 6px 0; text-align: left; }
+		td.num, th.num { text-align: right; }
+		.totals td { border-top: 1px dashed #ccc; }
+		.grand td { font-weight: bold; font-size: 17px; }
+		.footer { text-align: center; color: #888; font-size: 12px; margin-top: 25px; }
+	</style>
+</head>
+<body>
+	<div class="container">
+		<div class="header">
+			<h1>{{.Merchant.Name}}</h1>
+			{{if .Merchant.Address}}<div>{{.Merchant.Address}}</div>{{end}}
+			{{if .Merchant.Phone}}<div>{{.Merchant.Phone}}</div>{{end}}
+		</div>
+		<div class="meta">
+			Receipt #{{.TransactionID}} &middot; Order #{{.OrderID}}<br>
+			{{.PaidAt}} &middot; Cashier: {{.Cashier.Name}}
+		</div>
+		<table>
+			<tr><th>Item</th><th class="num">Qty</th><th class="num">Price</th><th class="num">Total</th></tr>
+			{{range .Items}}
+			<tr><td>{{.Name}}</td><td class="num">{{.Quantity}}</td><td class="num">{{money .Price}}</td><td class="num">{{money .Total}}</td></tr>
+			{{if .Discount}}<tr><td colspan="3">Discount</td><td class="num">-{{money .Discount}}</td></tr>{{end}}
+			{{end}}
+			<tr class="totals"><td colspan="3">Subtotal</td><td class="num">{{money .Subtotal}}</td></tr>
+			<tr><td colspan="3">Tax</td><td class="num">{{money .Tax}}</td></tr>
+			<tr class="grand"><td colspan="3">Total</td><td class="num">{{money .Total}}</td></tr>
+			{{range .Tenders}}
+			<tr><td colspan="3">{{method .Method}}</td><td class="num">{{money .Tendered}}</td></tr>
+			{{end}}
+			{{if .Change}}<tr><td colspan="3">Change</td><td class="num">{{money .Change}}</td></tr>{{end}}
+		</table>
+		<div class="footer">Thank you for shopping at {{.Merchant.Name}}.</div>
+	</div>
+</body>
+</html>`))
+
+// Render returns the subject and HTML body of the receipt email.
+func Render(event *Event) (string, string, error) {
+	var buf bytes.Buffer
+	if err := receiptTemplate.Execute(&buf, event); err != nil {
+		return "", "", err
+	}
+
+	subject := fmt.Sprintf("Your receipt from %s (#%d)", event.Merchant.Name, event.TransactionID)
+
+	return subject, buf.String(), nil
+}
+
+// formatMoney groups thousands with dots, e.g. 125000 -> 125.000.
+func formatMoney(amount int) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	digits := strconv.Itoa(amount)
+	var out strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			out.WriteByte('.')
+		}
+		out.WriteRune(d)
+	}
+
+	return sign + out.String()
+}
+
+func formatMethod(method string) string {
+	switch method {
+	case "cash":
+		return "Cash"
+	case "card":
+		return "Card"
+	case "e_wallet":
+		return "E-Wallet"
+	case "voucher":
+		return "Voucher"
+	}
+
+	return method
+}
@@ -3,8 +3,10 @@ module github.com/MamangRust/monolith-point-of-sale-merchant
 go 1.23.4
 
 require (
+	github.com/MamangRust/monolith-point-of-sale-common v0.0.0
 	github.com/MamangRust/monolith-point-of-sale-pkg v1.0.7
-	github.com/MamangRust/monolith-point-of-sale-shared v1.0.8
+	github.com/MamangRust/monolith-point-of-sale-shared v1.0.9
+	github.com/lib/pq v1.10.9
 	github.com/prometheus/client_golang v1.22.0
 	github.com/redis/go-redis/v9 v9.10.0
 	github.com/spf13/viper v1.20.1
@@ -49,7 +51,6 @@ require (
 	github.com/labstack/echo/v4 v4.13.4 // indirect
 	github.com/labstack/gommon v0.4.2 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
-	github.com/lib/pq v1.10.9 // indirect
 	github.com/mattn/go-colorable v0.1.14 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
@@ -82,3 +83,7 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250519155744-55703ea1f237 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/MamangRust/monolith-point-of-sale-common => ../common
+
+replace github.com/MamangRust/monolith-point-of-sale-shared => ../shared
@@ -9,6 +9,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	"github.com/MamangRust/monolith-point-of-sale-merchant/internal/errorhandler"
 	"github.com/MamangRust/monolith-point-of-sale-merchant/internal/handler"
 	"github.com/MamangRust/monolith-point-of-sale-merchant/internal/middleware"
@@ -29,6 +33,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -45,11 +50,14 @@ func init() {
 }
 
 type Server struct {
-	Logger   logger.LoggerInterface
-	DB       *db.Queries
-	Services *service.Service
-	Handlers *handler.Handler
-	Ctx      context.Context
+	Logger       logger.LoggerInterface
+	DB           *db.Queries
+	Repositories *repository.Repositories
+	Services     *service.Service
+	Handlers     *handler.Handler
+	Relay        *outbox.Relay
+	Conn         *audit.DB
+	Ctx          context.Context
 }
 
 func NewServer(ctx context.Context) (*Server, func(context.Context) error, error) {
@@ -65,15 +73,16 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		logger.Fatal("Failed to load .env file", zap.Error(err))
 	}
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
 
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 
 	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
 
@@ -115,21 +124,25 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 	services := service.NewService(&service.Deps{
 		Mencache:     mencache,
 		ErrorHander:  errorhandler,
-		Kafka:        myKafka,
 		Repositories: repositories,
 		Logger:       logger,
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, myKafka, logger)
+
 	handlers := handler.NewHandler(&handler.Deps{
 		Service: services,
 	})
 
 	return &Server{
-		Logger:   logger,
-		DB:       DB,
-		Services: services,
-		Handlers: handlers,
-		Ctx:      ctx,
+		Logger:       logger,
+		DB:           DB,
+		Repositories: repositories,
+		Services:     services,
+		Handlers:     handlers,
+		Relay:        relay,
+		Conn:         conn,
+		Ctx:          ctx,
 	}, shutdownTracerProvider, nil
 }
 
@@ -158,6 +171,39 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			tenant.Middleware(s.Repositories.Tenant, s.Logger),
+			tenant.Guard(
+				pb.MerchantService_RestoreAllMerchant_FullMethodName,
+				pb.MerchantService_DeleteAllMerchantPermanent_FullMethodName,
+				pb.MerchantDocumentService_RestoreAll_FullMethodName,
+				pb.MerchantDocumentService_DeleteAllPermanent_FullMethodName,
+			),
+			tenant.Owned(merchantOwner, map[string]tenant.RowID{
+				pb.MerchantService_TrashedMerchant_FullMethodName:         tenant.ByID,
+				pb.MerchantService_RestoreMerchant_FullMethodName:         tenant.ByID,
+				pb.MerchantService_DeleteMerchantPermanent_FullMethodName: tenant.ByID,
+			}),
+			tenant.Owned(s.Repositories.MerchantDocumentQuery.FindMerchantIdByDocument, map[string]tenant.RowID{
+				pb.MerchantDocumentService_FindById_FullMethodName:        documentID,
+				pb.MerchantDocumentService_Update_FullMethodName:          documentID,
+				pb.MerchantDocumentService_UpdateStatus_FullMethodName:    documentID,
+				pb.MerchantDocumentService_Trashed_FullMethodName:         documentID,
+				pb.MerchantDocumentService_Restore_FullMethodName:         documentID,
+				pb.MerchantDocumentService_DeletePermanent_FullMethodName: documentID,
+			}),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "merchant",
+				RequestID: middleware.RequestID,
+				Snapshots: map[string]audit.Snapshot{
+					"pb.MerchantService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Merchant.FindById(ctx, &pb.FindByIdMerchantRequest{Id: int32(id)})
+					},
+					"pb.MerchantDocumentService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.MerchantDocument.FindById(ctx, &pb.FindMerchantDocumentByIdRequest{DocumentId: int32(id)})
+					},
+				},
+			}, s.Logger),
+			bulk.Middleware(s.Logger),
 		),
 	)
 
@@ -169,6 +215,8 @@ func (s *Server) Run() {
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
@@ -190,3 +238,19 @@ func (s *Server) Run() {
 
 	wg.Wait()
 }
+
+// merchantOwner resolves a merchant to itself, so merchant commands are
+// checked like rows of that merchant.
+func merchantOwner(ctx context.Context, id int) (int, error) {
+	return id, nil
+}
+
+// documentID reads the document addressed by merchant document requests.
+func documentID(req interface{}) (int, bool) {
+	r, ok := req.(interface{ GetDocumentId() int32 })
+	if !ok {
+		return 0, false
+	}
+
+	return int(r.GetDocumentId()), true
+}
@@ -0,0 +1,9 @@
+package repository
+
+import "github.com/MamangRust/monolith-point-of-sale-common/bulk"
+
+// Tables the DeleteAll and RestoreAll calls of this service run on.
+var (
+	bulkMerchants         = bulk.Table{Name: "merchants", ID: "merchant_id", Merchant: "merchant_id = $1"}
+	bulkMerchantDocuments = bulk.Table{Name: "merchant_documents", ID: "document_id", Merchant: "merchant_id = $1"}
+)
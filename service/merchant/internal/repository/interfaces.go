@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"time"
 
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -12,6 +14,7 @@ type MerchantDocumentQueryRepository interface {
 	FindById(ctx context.Context, id int) (*record.MerchantDocumentRecord, error)
 	FindByActive(ctx context.Context, req *requests.FindAllMerchantDocuments) ([]*record.MerchantDocumentRecord, *int, error)
 	FindByTrashed(ctx context.Context, req *requests.FindAllMerchantDocuments) ([]*record.MerchantDocumentRecord, *int, error)
+	FindMerchantIdByDocument(ctx context.Context, documentID int) (int, error)
 }
 
 type MerchantDocumentCommandRepository interface {
@@ -46,3 +49,20 @@ type MerchantCommandRepository interface {
 type UserQueryRepository interface {
 	FindById(ctx context.Context, userID int) (*record.UserRecord, error)
 }
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
+
+type UnitOfWork interface {
+	WithTx(ctx context.Context, fn func(tx *TxRepositories) error) error
+}
+
+type TenantRepository interface {
+	FindMerchantIDsByUser(ctx context.Context, userID int) ([]int, error)
+}
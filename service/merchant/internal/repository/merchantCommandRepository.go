@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -13,12 +14,14 @@ import (
 
 type merchantCommandRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.MerchantRecordMapping
 }
 
-func NewMerchantCommandRepository(db *db.Queries, mapping recordmapper.MerchantRecordMapping) *merchantCommandRepository {
+func NewMerchantCommandRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.MerchantRecordMapping) *merchantCommandRepository {
 	return &merchantCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -109,19 +112,25 @@ func (r *merchantCommandRepository) DeleteMerchantPermanent(ctx context.Context,
 }
 
 func (r *merchantCommandRepository) RestoreAllMerchant(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllMerchants(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkMerchants, bulk.Restore)
 
 	if err != nil {
 		return false, merchant_errors.ErrRestoreAllMerchant
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *merchantCommandRepository) DeleteAllMerchantPermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentMerchants(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkMerchants, bulk.Delete)
 
 	if err != nil {
 		return false, merchant_errors.ErrDeleteAllMerchantPermanent
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
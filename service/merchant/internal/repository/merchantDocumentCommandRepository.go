@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -13,12 +14,14 @@ import (
 
 type merchantDocumentCommandRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.MerchantDocumentMapping
 }
 
-func NewMerchantDocumentCommandRepository(db *db.Queries, mapping recordmapper.MerchantDocumentMapping) *merchantDocumentCommandRepository {
+func NewMerchantDocumentCommandRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.MerchantDocumentMapping) *merchantDocumentCommandRepository {
 	return &merchantDocumentCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -100,19 +103,23 @@ func (r *merchantDocumentCommandRepository) DeleteMerchantDocumentPermanent(ctx
 }
 
 func (r *merchantDocumentCommandRepository) RestoreAllMerchantDocument(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllMerchantDocuments(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkMerchantDocuments, bulk.Restore)
 	if err != nil {
 		return false, merchantdocument_errors.ErrRestoreAllMerchantDocumentsFailed
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *merchantDocumentCommandRepository) DeleteAllMerchantDocumentPermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentMerchantDocuments(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkMerchantDocuments, bulk.Delete)
 	if err != nil {
 		return false, merchantdocument_errors.ErrDeleteAllMerchantDocumentsPermanentFailed
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
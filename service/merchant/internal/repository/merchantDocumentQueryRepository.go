@@ -3,21 +3,77 @@ package repository
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	merchantdocument_errors "github.com/MamangRust/monolith-point-of-sale-shared/errors/merchant_document_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/lib/pq"
 )
 
+// Merchant-scoped variants of the document listings used for callers that
+// are restricted to their own merchants.
+const getMerchantDocumentsByMerchantIDs = `-- name: GetMerchantDocumentsByMerchantIDs :many
+SELECT document_id, merchant_id, document_type, document_url, status, note, uploaded_at, created_at, updated_at, deleted_at, COUNT(*) OVER() AS total_count
+FROM merchant_documents
+WHERE deleted_at IS NULL
+  AND (
+    $1::TEXT IS NULL OR
+    document_type ILIKE '%' || $1 || '%' OR
+    status ILIKE '%' || $1 || '%' OR
+    note ILIKE '%' || $1 || '%'
+  )
+  AND merchant_id = ANY($4::INT[])
+ORDER BY document_id
+LIMIT $2 OFFSET $3
+`
+
+const getActiveMerchantDocumentsByMerchantIDs = `-- name: GetActiveMerchantDocumentsByMerchantIDs :many
+SELECT document_id, merchant_id, document_type, document_url, status, note, uploaded_at, created_at, updated_at, deleted_at, COUNT(*) OVER() AS total_count
+FROM merchant_documents
+WHERE deleted_at IS NULL AND status != 'deleted'
+  AND (
+    $1::TEXT IS NULL OR
+    document_type ILIKE '%' || $1 || '%' OR
+    status ILIKE '%' || $1 || '%' OR
+    note ILIKE '%' || $1 || '%'
+  )
+  AND merchant_id = ANY($4::INT[])
+ORDER BY document_id
+LIMIT $2 OFFSET $3
+`
+
+const getTrashedMerchantDocumentsByMerchantIDs = `-- name: GetTrashedMerchantDocumentsByMerchantIDs :many
+SELECT document_id, merchant_id, document_type, document_url, status, note, uploaded_at, created_at, updated_at, deleted_at, COUNT(*) OVER() AS total_count
+FROM merchant_documents
+WHERE deleted_at IS NOT NULL
+  AND (
+    $1::TEXT IS NULL OR
+    document_type ILIKE '%' || $1 || '%' OR
+    status ILIKE '%' || $1 || '%' OR
+    note ILIKE '%' || $1 || '%'
+  )
+  AND merchant_id = ANY($4::INT[])
+ORDER BY document_id
+LIMIT $2 OFFSET $3
+`
+
+// getMerchantDocumentOwner finds the merchant of a document, trashed or not.
+const getMerchantDocumentOwner = `-- name: GetMerchantDocumentOwner :one
+SELECT merchant_id FROM merchant_documents WHERE document_id = $1
+`
+
 type merchantDocumentQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.MerchantDocumentMapping
 }
 
-func NewMerchantDocumentQueryRepository(db *db.Queries, mapping recordmapper.MerchantDocumentMapping) *merchantDocumentQueryRepository {
+func NewMerchantDocumentQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.MerchantDocumentMapping) *merchantDocumentQueryRepository {
 	return &merchantDocumentQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -25,6 +81,15 @@ func NewMerchantDocumentQueryRepository(db *db.Queries, mapping recordmapper.Mer
 func (r *merchantDocumentQueryRepository) FindAllDocuments(ctx context.Context, req *requests.FindAllMerchantDocuments) ([]*record.MerchantDocumentRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getMerchantDocumentsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, merchantdocument_errors.ErrFindAllMerchantDocumentsFailed
+		}
+
+		return r.mapping.ToMerchantDocumentsRecord(res), documentTotalCount(res), nil
+	}
+
 	params := db.GetMerchantDocumentsParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -47,6 +112,20 @@ func (r *merchantDocumentQueryRepository) FindAllDocuments(ctx context.Context,
 func (r *merchantDocumentQueryRepository) FindByActive(ctx context.Context, req *requests.FindAllMerchantDocuments) ([]*record.MerchantDocumentRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getActiveMerchantDocumentsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, merchantdocument_errors.ErrFindActiveMerchantDocumentsFailed
+		}
+
+		active := make([]*db.GetActiveMerchantDocumentsRow, len(res))
+		for i, row := range res {
+			active[i] = (*db.GetActiveMerchantDocumentsRow)(row)
+		}
+
+		return r.mapping.ToMerchantDocumentsActiveRecord(active), documentTotalCount(res), nil
+	}
+
 	params := db.GetActiveMerchantDocumentsParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -69,6 +148,20 @@ func (r *merchantDocumentQueryRepository) FindByActive(ctx context.Context, req
 func (r *merchantDocumentQueryRepository) FindByTrashed(ctx context.Context, req *requests.FindAllMerchantDocuments) ([]*record.MerchantDocumentRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getTrashedMerchantDocumentsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, merchantdocument_errors.ErrFindTrashedMerchantDocumentsFailed
+		}
+
+		trashed := make([]*db.GetTrashedMerchantDocumentsRow, len(res))
+		for i, row := range res {
+			trashed[i] = (*db.GetTrashedMerchantDocumentsRow)(row)
+		}
+
+		return r.mapping.ToMerchantDocumentsTrashedRecord(trashed), documentTotalCount(res), nil
+	}
+
 	params := db.GetTrashedMerchantDocumentsParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -93,5 +186,68 @@ func (r *merchantDocumentQueryRepository) FindById(ctx context.Context, id int)
 	if err != nil {
 		return nil, merchantdocument_errors.ErrFindMerchantDocumentByIdFailed
 	}
+
+	if !tenant.Allows(ctx, int(doc.MerchantID)) {
+		return nil, merchantdocument_errors.ErrFindMerchantDocumentByIdFailed
+	}
+
 	return r.mapping.ToGetMerchantDocument(doc), nil
 }
+
+// FindMerchantIdByDocument returns the merchant a document belongs to,
+// including trashed documents.
+func (r *merchantDocumentQueryRepository) FindMerchantIdByDocument(ctx context.Context, documentID int) (int, error) {
+	var merchantID int
+
+	if err := r.conn.QueryRowContext(ctx, getMerchantDocumentOwner, documentID).Scan(&merchantID); err != nil {
+		return 0, merchantdocument_errors.ErrFindMerchantDocumentByIdFailed
+	}
+
+	return merchantID, nil
+}
+
+func (r *merchantDocumentQueryRepository) findByMerchantIDs(ctx context.Context, query string, req *requests.FindAllMerchantDocuments, scope *tenant.Scope) ([]*db.GetMerchantDocumentsRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	rows, err := r.conn.QueryContext(ctx, query, req.Search, req.PageSize, offset, pq.Array(scope.Int64MerchantIDs()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*db.GetMerchantDocumentsRow
+	for rows.Next() {
+		var i db.GetMerchantDocumentsRow
+		if err := rows.Scan(
+			&i.DocumentID,
+			&i.MerchantID,
+			&i.DocumentType,
+			&i.DocumentUrl,
+			&i.Status,
+			&i.Note,
+			&i.UploadedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func documentTotalCount(rows []*db.GetMerchantDocumentsRow) *int {
+	total := 0
+	if len(rows) > 0 {
+		total = int(rows[0].TotalCount)
+	}
+
+	return &total
+}
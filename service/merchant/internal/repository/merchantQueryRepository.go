@@ -3,21 +3,51 @@ package repository
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/merchant_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/lib/pq"
 )
 
+// Merchant-scoped variants of GetMerchants / GetMerchantsTrashed used for
+// callers that are restricted to their own merchants.
+const getMerchantsByMerchantIDs = `-- name: GetMerchantsByMerchantIDs :many
+SELECT
+    merchant_id, user_id, name, description, address, contact_email, contact_phone, status, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM merchants
+WHERE deleted_at IS NULL
+AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR contact_email ILIKE '%' || $1 || '%')
+AND merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+const getMerchantsTrashedByMerchantIDs = `-- name: GetMerchantsTrashedByMerchantIDs :many
+SELECT
+    merchant_id, user_id, name, description, address, contact_email, contact_phone, status, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM merchants
+WHERE deleted_at IS NOT NULL
+AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR contact_email ILIKE '%' || $1 || '%')
+AND merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
 type merchantQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.MerchantRecordMapping
 }
 
-func NewMerchantQueryRepository(db *db.Queries, mapping recordmapper.MerchantRecordMapping) *merchantQueryRepository {
+func NewMerchantQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.MerchantRecordMapping) *merchantQueryRepository {
 	return &merchantQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -25,6 +55,15 @@ func NewMerchantQueryRepository(db *db.Queries, mapping recordmapper.MerchantRec
 func (r *merchantQueryRepository) FindAllMerchants(ctx context.Context, req *requests.FindAllMerchants) ([]*record.MerchantRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getMerchantsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, merchant_errors.ErrFindAllMerchants
+		}
+
+		return r.mapping.ToMerchantsRecordPagination(res), totalCount(res), nil
+	}
+
 	reqDb := db.GetMerchantsParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -51,6 +90,20 @@ func (r *merchantQueryRepository) FindAllMerchants(ctx context.Context, req *req
 func (r *merchantQueryRepository) FindByActive(ctx context.Context, req *requests.FindAllMerchants) ([]*record.MerchantRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getMerchantsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, merchant_errors.ErrFindByActive
+		}
+
+		active := make([]*db.GetMerchantsActiveRow, len(res))
+		for i, row := range res {
+			active[i] = (*db.GetMerchantsActiveRow)(row)
+		}
+
+		return r.mapping.ToMerchantsRecordActivePagination(active), totalCount(res), nil
+	}
+
 	reqDb := db.GetMerchantsActiveParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -77,6 +130,20 @@ func (r *merchantQueryRepository) FindByActive(ctx context.Context, req *request
 func (r *merchantQueryRepository) FindByTrashed(ctx context.Context, req *requests.FindAllMerchants) ([]*record.MerchantRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getMerchantsTrashedByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, merchant_errors.ErrFindByTrashed
+		}
+
+		trashed := make([]*db.GetMerchantsTrashedRow, len(res))
+		for i, row := range res {
+			trashed[i] = (*db.GetMerchantsTrashedRow)(row)
+		}
+
+		return r.mapping.ToMerchantsRecordTrashedPagination(trashed), totalCount(res), nil
+	}
+
 	reqDb := db.GetMerchantsTrashedParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -107,5 +174,56 @@ func (r *merchantQueryRepository) FindById(ctx context.Context, user_id int) (*r
 		return nil, merchant_errors.ErrFindById
 	}
 
+	if !tenant.Allows(ctx, int(res.MerchantID)) {
+		return nil, merchant_errors.ErrFindById
+	}
+
 	return r.mapping.ToMerchantRecord(res), nil
 }
+
+func (r *merchantQueryRepository) findByMerchantIDs(ctx context.Context, query string, req *requests.FindAllMerchants, scope *tenant.Scope) ([]*db.GetMerchantsRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	rows, err := r.conn.QueryContext(ctx, query, req.Search, req.PageSize, offset, pq.Array(scope.Int64MerchantIDs()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*db.GetMerchantsRow
+	for rows.Next() {
+		var i db.GetMerchantsRow
+		if err := rows.Scan(
+			&i.MerchantID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.Address,
+			&i.ContactEmail,
+			&i.ContactPhone,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func totalCount(rows []*db.GetMerchantsRow) *int {
+	total := 0
+	if len(rows) > 0 {
+		total = int(rows[0].TotalCount)
+	}
+
+	return &total
+}
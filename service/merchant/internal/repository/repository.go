@@ -1,28 +1,40 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "merchant"
+
 type Repositories struct {
 	MerchantQuery           MerchantQueryRepository
 	MerchantCommand         MerchantCommandRepository
 	MerchantDocumentCommand MerchantDocumentCommandRepository
 	MerchantDocumentQuery   MerchantDocumentQueryRepository
 	UserQuery               UserQueryRepository
+	Outbox                  OutboxRepository
+	UnitOfWork              UnitOfWork
+	Tenant                  TenantRepository
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	mapper := recordmapper.NewMerchantRecordMapper()
 	mapperDocument := recordmapper.NewMerchantDocumentRecordMapper()
 	mapperUser := recordmapper.NewUserRecordMapper()
 
 	return &Repositories{
-		MerchantQuery:           NewMerchantQueryRepository(DB, mapper),
-		MerchantCommand:         NewMerchantCommandRepository(DB, mapper),
-		MerchantDocumentCommand: NewMerchantDocumentCommandRepository(DB, mapperDocument),
-		MerchantDocumentQuery:   NewMerchantDocumentQueryRepository(DB, mapperDocument),
+		MerchantQuery:           NewMerchantQueryRepository(DB, conn, mapper),
+		MerchantCommand:         NewMerchantCommandRepository(DB, conn, mapper),
+		MerchantDocumentCommand: NewMerchantDocumentCommandRepository(DB, conn, mapperDocument),
+		MerchantDocumentQuery:   NewMerchantDocumentQueryRepository(DB, conn, mapperDocument),
 		UserQuery:               NewUserQueryRepository(DB, mapperUser),
+		Outbox:                  outbox.NewRepository(conn, outboxSource),
+		UnitOfWork:              NewUnitOfWork(conn, DB, mapper, mapperDocument),
+		Tenant:                  tenant.NewRepository(conn),
 	}
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,8 +12,8 @@ import (
 	mencache "github.com/MamangRust/monolith-point-of-sale-merchant/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-merchant/internal/repository"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/email"
-	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/merchant_errors"
@@ -26,8 +27,10 @@ import (
 	"go.uber.org/zap"
 )
 
+var errRollbackMerchant = errors.New("merchant transaction rolled back")
+
 type merchantCommandService struct {
-	kafka                     *kafka.Kafka
+	unitOfWork                repository.UnitOfWork
 	errorHandler              errorhandler.MerchantCommandErrorHandler
 	mencache                  mencache.MerchantCommandCache
 	trace                     trace.Tracer
@@ -40,7 +43,7 @@ type merchantCommandService struct {
 	requestDuration           *prometheus.HistogramVec
 }
 
-func NewMerchantCommandService(kafka *kafka.Kafka,
+func NewMerchantCommandService(unitOfWork repository.UnitOfWork,
 	errorHandler errorhandler.MerchantCommandErrorHandler,
 	mencache mencache.MerchantCommandCache,
 	userRepository repository.UserQueryRepository,
@@ -66,7 +69,7 @@ func NewMerchantCommandService(kafka *kafka.Kafka,
 	prometheus.MustRegister(requestCounter, requestDuration)
 
 	return &merchantCommandService{
-		kafka:                     kafka,
+		unitOfWork:                unitOfWork,
 		errorHandler:              errorHandler,
 		mencache:                  mencache,
 		trace:                     otel.Tracer("merchant-command-service"),
@@ -95,12 +98,6 @@ func (s *merchantCommandService) CreateMerchant(ctx context.Context, request *re
 		return errorhandler.HandleRepositorySingleError[*response.MerchantResponse](s.logger, err, method, "FAILED_FIND_USER_BY_ID", span, &status, user_errors.ErrUserNotFoundRes, zap.Int("user.id", request.UserID))
 	}
 
-	res, err := s.merchantCommandRepository.CreateMerchant(ctx, request)
-
-	if err != nil {
-		return s.errorHandler.HandleCreateMerchantError(err, method, "FAILED_CREATE_MERCHANT", span, &status, zap.Int("user.id", request.UserID))
-	}
-
 	htmlBody := email.GenerateEmailHTML(map[string]string{
 		"Title":   "Welcome to SanEdge Merchant Portal",
 		"Message": "Your merchant account has been created successfully. To continue, please upload the required documents for verification. Once completed, our team will review and activate your account.",
@@ -119,9 +116,30 @@ func (s *merchantCommandService) CreateMerchant(ctx context.Context, request *re
 		return errorhandler.HandleErrorJSONMarshal[*response.MerchantResponse](s.logger, err, method, "FAILED_MARSHAL_EMAIL_PAYLOAD", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("user.id", user.ID))
 	}
 
-	err = s.kafka.SendMessage("email-service-topic-merchant-created", strconv.Itoa(res.ID), payloadBytes)
+	var (
+		res     *record.MerchantRecord
+		errResp *response.ErrorResponse
+	)
+
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		res, err = tx.MerchantCommand.CreateMerchant(ctx, request)
+		if err != nil {
+			_, errResp = s.errorHandler.HandleCreateMerchantError(err, method, "FAILED_CREATE_MERCHANT", span, &status, zap.Int("user.id", request.UserID))
+			return errRollbackMerchant
+		}
+
+		if err := tx.Outbox.Enqueue(ctx, "email-service-topic-merchant-created", strconv.Itoa(res.ID), payloadBytes); err != nil {
+			_, errResp = errorhandler.HandleErrorKafkaSend[*response.MerchantResponse](s.logger, err, method, "FAILED_SEND_EMAIL", span, &status, merchant_errors.ErrFailedSendEmail, zap.Error(err))
+			return errRollbackMerchant
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return errorhandler.HandleErrorKafkaSend[*response.MerchantResponse](s.logger, err, method, "FAILED_SEND_EMAIL", span, &status, merchant_errors.ErrFailedSendEmail, zap.Error(err))
+		return s.errorHandler.HandleCreateMerchantError(err, method, "FAILED_COMMIT_CREATE_MERCHANT", span, &status, zap.Int("user.id", request.UserID))
 	}
 
 	so := s.mapping.ToMerchantResponse(res)
@@ -176,12 +194,6 @@ func (s *merchantCommandService) UpdateMerchantStatus(ctx context.Context, reque
 		return errorhandler.HandleRepositorySingleError[*response.MerchantResponse](s.logger, err, method, "FAILED_FIND_USER_BY_ID", span, &status, user_errors.ErrUserNotFoundRes, zap.Int("user.id", merchant.UserID))
 	}
 
-	res, err := s.merchantCommandRepository.UpdateMerchantStatus(ctx, request)
-
-	if err != nil {
-		return s.errorHandler.HandleUpdateMerchantStatusError(err, method, "FAILED_UPDATE_MERCHANT_STATUS", span, &status, zap.Int("merchant.id", *request.MerchantID))
-	}
-
 	statusReq := request.Status
 	subject := ""
 	message := ""
@@ -198,31 +210,58 @@ func (s *merchantCommandService) UpdateMerchantStatus(ctx context.Context, reque
 	case "rejected":
 		subject = "Merchant Account Rejected"
 		message = "We're sorry to inform you that your merchant account has been <b>rejected</b>. Please contact support or review your submissions."
-	default:
-		return nil, nil
 	}
 
-	htmlBody := email.GenerateEmailHTML(map[string]string{
-		"Title":   subject,
-		"Message": message,
-		"Button":  buttonLabel,
-		"Link":    link,
-	})
+	var payloadBytes []byte
 
-	emailPayload := map[string]any{
-		"email":   user.Email,
-		"subject": subject,
-		"body":    htmlBody,
-	}
+	if subject != "" {
+		htmlBody := email.GenerateEmailHTML(map[string]string{
+			"Title":   subject,
+			"Message": message,
+			"Button":  buttonLabel,
+			"Link":    link,
+		})
 
-	payloadBytes, err := json.Marshal(emailPayload)
-	if err != nil {
-		return errorhandler.HandleErrorJSONMarshal[*response.MerchantResponse](s.logger, err, method, "FAILED_MARSHAL_EMAIL_PAYLOAD", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant.id", *request.MerchantID))
+		emailPayload := map[string]any{
+			"email":   user.Email,
+			"subject": subject,
+			"body":    htmlBody,
+		}
+
+		payloadBytes, err = json.Marshal(emailPayload)
+		if err != nil {
+			return errorhandler.HandleErrorJSONMarshal[*response.MerchantResponse](s.logger, err, method, "FAILED_MARSHAL_EMAIL_PAYLOAD", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant.id", *request.MerchantID))
+		}
 	}
 
-	err = s.kafka.SendMessage("email-service-topic-merchant-update-status", strconv.Itoa(*request.MerchantID), payloadBytes)
+	var (
+		res     *record.MerchantRecord
+		errResp *response.ErrorResponse
+	)
+
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		res, err = tx.MerchantCommand.UpdateMerchantStatus(ctx, request)
+		if err != nil {
+			_, errResp = s.errorHandler.HandleUpdateMerchantStatusError(err, method, "FAILED_UPDATE_MERCHANT_STATUS", span, &status, zap.Int("merchant.id", *request.MerchantID))
+			return errRollbackMerchant
+		}
+
+		if payloadBytes == nil {
+			return nil
+		}
+
+		if err := tx.Outbox.Enqueue(ctx, "email-service-topic-merchant-update-status", strconv.Itoa(*request.MerchantID), payloadBytes); err != nil {
+			_, errResp = errorhandler.HandleErrorKafkaSend[*response.MerchantResponse](s.logger, err, method, "FAILED_SEND_EMAIL", span, &status, merchant_errors.ErrFailedSendEmail, zap.Error(err))
+			return errRollbackMerchant
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return errorhandler.HandleErrorKafkaSend[*response.MerchantResponse](s.logger, err, method, "FAILED_SEND_EMAIL", span, &status, merchant_errors.ErrFailedSendEmail, zap.Error(err))
+		return s.errorHandler.HandleUpdateMerchantStatusError(err, method, "FAILED_COMMIT_UPDATE_MERCHANT_STATUS", span, &status, zap.Int("merchant.id", *request.MerchantID))
 	}
 
 	so := s.mapping.ToMerchantResponse(res)
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,8 +12,8 @@ import (
 	mencache "github.com/MamangRust/monolith-point-of-sale-merchant/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-merchant/internal/repository"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/email"
-	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/merchant_errors"
@@ -26,8 +27,10 @@ import (
 	"go.uber.org/zap"
 )
 
+var errRollbackMerchantDocument = errors.New("merchant document transaction rolled back")
+
 type merchantDocumentCommandService struct {
-	kafka                             *kafka.Kafka
+	unitOfWork                        repository.UnitOfWork
 	mencache                          mencache.MerchantDocumentCommandCache
 	errorMerchantDocumentCommand      errorhandler.MerchantDocumentCommandErrorHandler
 	trace                             trace.Tracer
@@ -41,7 +44,7 @@ type merchantDocumentCommandService struct {
 }
 
 func NewMerchantDocumentCommandService(
-	kafka *kafka.Kafka,
+	unitOfWork repository.UnitOfWork,
 	mencache mencache.MerchantDocumentCommandCache,
 	errorMerchantDocumentCommand errorhandler.MerchantDocumentCommandErrorHandler,
 	merchantDocumentCommandRepository repository.MerchantDocumentCommandRepository,
@@ -64,7 +67,7 @@ func NewMerchantDocumentCommandService(
 	prometheus.MustRegister(requestCounter, requestDuration)
 
 	return &merchantDocumentCommandService{
-		kafka:                             kafka,
+		unitOfWork:                        unitOfWork,
 		mencache:                          mencache,
 		errorMerchantDocumentCommand:      errorMerchantDocumentCommand,
 		trace:                             otel.Tracer("merchant-document-command-service"),
@@ -99,12 +102,6 @@ func (s *merchantDocumentCommandService) CreateMerchantDocument(ctx context.Cont
 		return errorhandler.HandleRepositorySingleError[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_FIND_USER_BY_ID", span, &status, user_errors.ErrUserNotFoundRes, zap.Int("user.id", merchant.UserID))
 	}
 
-	merchantDocument, err := s.merchantDocumentCommandRepository.CreateMerchantDocument(ctx, request)
-
-	if err != nil {
-		return s.errorMerchantDocumentCommand.HandleCreateMerchantDocumentError(err, method, "FAILED_CREATE_MERCHANT_DOCUMENT", span, &status, zap.Int("merchant.id", request.MerchantID))
-	}
-
 	htmlBody := email.GenerateEmailHTML(map[string]string{
 		"Title":   "Welcome to SanEdge Merchant Portal",
 		"Message": "Thank you for registering your merchant account. Your account is currently <b>inactive</b> and under initial review. To proceed, please upload all required documents for verification. Once your documents are submitted, our team will review them and activate your account accordingly.",
@@ -123,9 +120,30 @@ func (s *merchantDocumentCommandService) CreateMerchantDocument(ctx context.Cont
 		return errorhandler.HandleErrorJSONMarshal[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_MARSHAL_EMAIL_PAYLOAD", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant.id", request.MerchantID))
 	}
 
-	err = s.kafka.SendMessage("email-service-topic-merchant-created", strconv.Itoa(merchantDocument.ID), payloadBytes)
+	var (
+		merchantDocument *record.MerchantDocumentRecord
+		errResp          *response.ErrorResponse
+	)
+
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		merchantDocument, err = tx.MerchantDocumentCommand.CreateMerchantDocument(ctx, request)
+		if err != nil {
+			_, errResp = s.errorMerchantDocumentCommand.HandleCreateMerchantDocumentError(err, method, "FAILED_CREATE_MERCHANT_DOCUMENT", span, &status, zap.Int("merchant.id", request.MerchantID))
+			return errRollbackMerchantDocument
+		}
+
+		if err := tx.Outbox.Enqueue(ctx, "email-service-topic-merchant-created", strconv.Itoa(merchantDocument.ID), payloadBytes); err != nil {
+			_, errResp = errorhandler.HandleErrorKafkaSend[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_SEND_EMAIL", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant_id", request.MerchantID))
+			return errRollbackMerchantDocument
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return errorhandler.HandleErrorKafkaSend[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_SEND_EMAIL", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant_id", request.MerchantID))
+		return s.errorMerchantDocumentCommand.HandleCreateMerchantDocumentError(err, method, "FAILED_COMMIT_CREATE_MERCHANT_DOCUMENT", span, &status, zap.Int("merchant.id", request.MerchantID))
 	}
 
 	so := s.mapping.ToMerchantDocumentResponse(merchantDocument)
@@ -179,11 +197,6 @@ func (s *merchantDocumentCommandService) UpdateMerchantDocumentStatus(ctx contex
 		return errorhandler.HandleRepositorySingleError[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_FIND_USER", span, &status, user_errors.ErrUserNotFoundRes, zap.Int("user.id", merchant.UserID))
 	}
 
-	merchantDocument, err := s.merchantDocumentCommandRepository.UpdateMerchantDocumentStatus(ctx, request)
-	if err != nil {
-		return s.errorMerchantDocumentCommand.HandleUpdateMerchantDocumentStatusError(err, method, "FAILED_UPDATE_MERCHANT_DOCUMENT_STATUS", span, &status, zap.Int("merchantDocument.id", *request.DocumentID))
-	}
-
 	statusReq := request.Status
 	note := request.Note
 	subject := ""
@@ -205,35 +218,62 @@ func (s *merchantDocumentCommandService) UpdateMerchantDocumentStatus(ctx contex
 		subject = "Merchant Document Status: Rejected"
 		message = "Unfortunately, your merchant documents were rejected. Please review the feedback below and re-upload the necessary documents."
 		buttonLabel = "Re-upload Documents"
-	default:
-		return nil, nil
 	}
 
-	if note != "" {
-		message += fmt.Sprintf(`<br><br><b>Reviewer Note:</b><br><i>%s</i>`, note)
-	}
+	var payloadBytes []byte
 
-	htmlBody := email.GenerateEmailHTML(map[string]string{
-		"Title":   subject,
-		"Message": message,
-		"Button":  buttonLabel,
-		"Link":    link,
-	})
+	if subject != "" {
+		if note != "" {
+			message += fmt.Sprintf(`<br><br><b>Reviewer Note:</b><br><i>%s</i>`, note)
+		}
 
-	emailPayload := map[string]any{
-		"email":   user.Email,
-		"subject": subject,
-		"body":    htmlBody,
-	}
+		htmlBody := email.GenerateEmailHTML(map[string]string{
+			"Title":   subject,
+			"Message": message,
+			"Button":  buttonLabel,
+			"Link":    link,
+		})
+
+		emailPayload := map[string]any{
+			"email":   user.Email,
+			"subject": subject,
+			"body":    htmlBody,
+		}
 
-	payloadBytes, err := json.Marshal(emailPayload)
-	if err != nil {
-		return errorhandler.HandleErrorJSONMarshal[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_MARSHAL_EMAIL_PAYLOAD", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant.id", request.MerchantID))
+		payloadBytes, err = json.Marshal(emailPayload)
+		if err != nil {
+			return errorhandler.HandleErrorJSONMarshal[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_MARSHAL_EMAIL_PAYLOAD", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant.id", request.MerchantID))
+		}
 	}
 
-	err = s.kafka.SendMessage("email-service-topic-merchant-document-update-status", strconv.Itoa(request.MerchantID), payloadBytes)
+	var (
+		merchantDocument *record.MerchantDocumentRecord
+		errResp          *response.ErrorResponse
+	)
+
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		merchantDocument, err = tx.MerchantDocumentCommand.UpdateMerchantDocumentStatus(ctx, request)
+		if err != nil {
+			_, errResp = s.errorMerchantDocumentCommand.HandleUpdateMerchantDocumentStatusError(err, method, "FAILED_UPDATE_MERCHANT_DOCUMENT_STATUS", span, &status, zap.Int("merchantDocument.id", *request.DocumentID))
+			return errRollbackMerchantDocument
+		}
+
+		if payloadBytes == nil {
+			return nil
+		}
+
+		if err := tx.Outbox.Enqueue(ctx, "email-service-topic-merchant-document-update-status", strconv.Itoa(request.MerchantID), payloadBytes); err != nil {
+			_, errResp = errorhandler.HandleErrorKafkaSend[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_SEND_EMAIL", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant.id", request.MerchantID))
+			return errRollbackMerchantDocument
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return errorhandler.HandleErrorKafkaSend[*response.MerchantDocumentResponse](s.logger, err, method, "FAILED_SEND_EMAIL", span, &status, merchant_errors.ErrFailedSendEmail, zap.Int("merchant.id", request.MerchantID))
+		return s.errorMerchantDocumentCommand.HandleUpdateMerchantDocumentStatusError(err, method, "FAILED_COMMIT_UPDATE_MERCHANT_DOCUMENT_STATUS", span, &status, zap.Int("merchantDocument.id", *request.DocumentID))
 	}
 
 	so := s.mapping.ToMerchantDocumentResponse(merchantDocument)
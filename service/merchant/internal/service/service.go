@@ -4,7 +4,6 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-merchant/internal/errorhandler"
 	mencache "github.com/MamangRust/monolith-point-of-sale-merchant/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-merchant/internal/repository"
-	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	response_service "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/service"
 )
@@ -17,7 +16,6 @@ type Service struct {
 }
 
 type Deps struct {
-	Kafka        *kafka.Kafka
 	Repositories *repository.Repositories
 	ErrorHander  *errorhandler.ErrorHandler
 	Mencache     *mencache.Mencache
@@ -30,8 +28,8 @@ func NewService(deps *Deps) *Service {
 
 	return &Service{
 		MerchantQuery:           NewMerchantQueryService(deps.ErrorHander.MerchantQueryError, deps.Mencache.MerchantQueryCache, deps.Repositories.MerchantQuery, deps.Logger, merchantMapper),
-		MerchantCommand:         NewMerchantCommandService(deps.Kafka, deps.ErrorHander.MerchantCommandError, deps.Mencache.MerchantCommandCache, deps.Repositories.UserQuery, deps.Repositories.MerchantQuery, deps.Repositories.MerchantCommand, deps.Logger, merchantMapper),
-		MerchantDocumentCommand: NewMerchantDocumentCommandService(deps.Kafka, deps.Mencache.MerchantDocumentCommandCache, deps.ErrorHander.MerchantDocumentCommandError, deps.Repositories.MerchantDocumentCommand, deps.Repositories.MerchantQuery, deps.Repositories.UserQuery, deps.Logger, merchantDocument),
+		MerchantCommand:         NewMerchantCommandService(deps.Repositories.UnitOfWork, deps.ErrorHander.MerchantCommandError, deps.Mencache.MerchantCommandCache, deps.Repositories.UserQuery, deps.Repositories.MerchantQuery, deps.Repositories.MerchantCommand, deps.Logger, merchantMapper),
+		MerchantDocumentCommand: NewMerchantDocumentCommandService(deps.Repositories.UnitOfWork, deps.Mencache.MerchantDocumentCommandCache, deps.ErrorHander.MerchantDocumentCommandError, deps.Repositories.MerchantDocumentCommand, deps.Repositories.MerchantQuery, deps.Repositories.UserQuery, deps.Logger, merchantDocument),
 		MerchantDocumentQuery:   NewMerchantDocumentQueryService(deps.ErrorHander.MerchantDocumentQueryError, deps.Mencache.MerchantDocumentQueryCache, deps.Repositories.MerchantDocumentQuery, deps.Logger, merchantDocument),
 	}
 }
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "products"
+    ADD CONSTRAINT chk_products_count_in_stock_non_negative CHECK (count_in_stock >= 0);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "products"
+    DROP CONSTRAINT IF EXISTS chk_products_count_in_stock_non_negative;
+
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "merchant_tax_profiles" (
+    "merchant_id" INT PRIMARY KEY REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "rate_bps" INT NOT NULL CHECK ("rate_bps" >= 0),
+    "mode" VARCHAR(20) NOT NULL CHECK ("mode" IN ('exclusive', 'inclusive')),
+    "rounding" VARCHAR(20) NOT NULL CHECK ("rounding" IN ('half_up', 'down', 'up')),
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE TABLE "merchant_tax_category_rates" (
+    "merchant_id" INT NOT NULL REFERENCES "merchant_tax_profiles" ("merchant_id") ON DELETE CASCADE,
+    "category_id" INT NOT NULL REFERENCES "categories" ("category_id") ON DELETE CASCADE,
+    "rate_bps" INT NOT NULL CHECK ("rate_bps" >= 0),
+    PRIMARY KEY ("merchant_id", "category_id")
+);
+
+ALTER TABLE "transactions"
+    ADD COLUMN "subtotal_amount" INT,
+    ADD COLUMN "tax_amount" INT;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "transactions"
+    DROP COLUMN IF EXISTS "tax_amount",
+    DROP COLUMN IF EXISTS "subtotal_amount";
+
+DROP TABLE IF EXISTS "merchant_tax_category_rates";
+
+DROP TABLE IF EXISTS "merchant_tax_profiles";
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "transaction_tenders" (
+    "transaction_tender_id" SERIAL PRIMARY KEY,
+    "transaction_id" INT NOT NULL REFERENCES "transactions" ("transaction_id") ON DELETE CASCADE,
+    "method" VARCHAR(50) NOT NULL,
+    "tendered" INT NOT NULL CHECK ("tendered" > 0),
+    "amount" INT NOT NULL CHECK ("amount" >= 0),
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_transaction_tenders_transaction_id ON transaction_tenders (transaction_id);
+
+CREATE INDEX idx_transaction_tenders_method ON transaction_tenders (method);
+
+INSERT INTO "transaction_tenders" ("transaction_id", "method", "tendered", "amount", "created_at")
+SELECT
+    transaction_id,
+    payment_method,
+    amount + COALESCE(change_amount, 0),
+    amount,
+    created_at
+FROM transactions
+WHERE amount > 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "transaction_tenders";
+
+-- +goose StatementEnd
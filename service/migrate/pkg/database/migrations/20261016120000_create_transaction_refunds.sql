@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "transaction_refunds" (
+    "transaction_refund_id" SERIAL PRIMARY KEY,
+    "transaction_id" INT NOT NULL REFERENCES "transactions" ("transaction_id") ON DELETE CASCADE,
+    "kind" VARCHAR(10) NOT NULL CHECK ("kind" IN ('refund', 'void')),
+    "amount" INT NOT NULL CHECK ("amount" >= 0),
+    "reason" TEXT NOT NULL,
+    "operator_id" INT NOT NULL,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_transaction_refunds_transaction_id ON transaction_refunds (transaction_id);
+
+CREATE INDEX idx_transaction_refunds_created_at ON transaction_refunds (created_at);
+
+CREATE TABLE "transaction_refund_items" (
+    "transaction_refund_item_id" SERIAL PRIMARY KEY,
+    "transaction_refund_id" INT NOT NULL REFERENCES "transaction_refunds" ("transaction_refund_id") ON DELETE CASCADE,
+    "order_item_id" INT NOT NULL,
+    "product_id" INT NOT NULL,
+    "quantity" INT NOT NULL CHECK ("quantity" > 0),
+    "amount" INT NOT NULL CHECK ("amount" >= 0)
+);
+
+CREATE INDEX idx_transaction_refund_items_refund_id ON transaction_refund_items (transaction_refund_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "transaction_refund_items";
+
+DROP TABLE IF EXISTS "transaction_refunds";
+
+-- +goose StatementEnd
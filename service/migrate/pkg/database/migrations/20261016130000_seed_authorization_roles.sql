@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO "roles" ("role_name")
+VALUES ('ROLE_ADMIN'), ('ROLE_MERCHANT'), ('ROLE_CASHIER')
+ON CONFLICT ("role_name") DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM "roles"
+WHERE "role_name" IN ('ROLE_ADMIN', 'ROLE_MERCHANT', 'ROLE_CASHIER');
+
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "outbox_events" (
+    "outbox_event_id" BIGSERIAL PRIMARY KEY,
+    "source" VARCHAR(50) NOT NULL,
+    "topic" VARCHAR(255) NOT NULL,
+    "message_key" VARCHAR(255) NOT NULL,
+    "payload" BYTEA NOT NULL,
+    "attempts" INT NOT NULL DEFAULT 0,
+    "last_error" TEXT,
+    "next_attempt_at" timestamp NOT NULL DEFAULT current_timestamp,
+    "created_at" timestamp NOT NULL DEFAULT current_timestamp,
+    "delivered_at" timestamp
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events (source, next_attempt_at)
+WHERE
+    delivered_at IS NULL;
+
+CREATE INDEX idx_outbox_events_delivered_at ON outbox_events (delivered_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "outbox_events";
+
+-- +goose StatementEnd
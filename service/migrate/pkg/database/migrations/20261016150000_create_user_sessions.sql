@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "user_sessions" (
+    "session_id" SERIAL PRIMARY KEY,
+    "user_id" INT NOT NULL REFERENCES "users" ("user_id") ON DELETE CASCADE,
+    "device_name" VARCHAR(100) NOT NULL DEFAULT '',
+    "ip_address" VARCHAR(45) NOT NULL DEFAULT '',
+    "user_agent" TEXT NOT NULL DEFAULT '',
+    "created_at" timestamp DEFAULT current_timestamp,
+    "last_used_at" timestamp DEFAULT current_timestamp,
+    "expires_at" timestamp NOT NULL,
+    "revoked_at" timestamp DEFAULT NULL,
+    "revoked_reason" VARCHAR(20) DEFAULT NULL CHECK ("revoked_reason" IN ('logout', 'revoked', 'reuse'))
+);
+
+CREATE INDEX idx_user_sessions_user_id ON user_sessions (user_id) WHERE revoked_at IS NULL;
+
+-- Rotated refresh tokens are soft-deleted rather than removed, so a token
+-- presented again after rotation is recognised as reuse of its session.
+ALTER TABLE "refresh_tokens"
+    ADD COLUMN "session_id" INT REFERENCES "user_sessions" ("session_id") ON DELETE CASCADE;
+
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens (session_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_refresh_tokens_session_id;
+
+ALTER TABLE "refresh_tokens" DROP COLUMN IF EXISTS "session_id";
+
+DROP INDEX IF EXISTS idx_user_sessions_user_id;
+
+DROP TABLE IF EXISTS "user_sessions";
+
+-- +goose StatementEnd
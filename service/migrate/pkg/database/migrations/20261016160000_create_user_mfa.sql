@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "user_mfa" (
+    "user_id" INT PRIMARY KEY REFERENCES "users" ("user_id") ON DELETE CASCADE,
+    "secret" VARCHAR(64) NOT NULL,
+    "enabled_at" timestamp DEFAULT NULL,
+    "last_used_step" BIGINT NOT NULL DEFAULT 0,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+-- Only the SHA-256 of each recovery code is kept.
+CREATE TABLE "user_mfa_recovery_codes" (
+    "recovery_code_id" SERIAL PRIMARY KEY,
+    "user_id" INT NOT NULL REFERENCES "users" ("user_id") ON DELETE CASCADE,
+    "code_hash" CHAR(64) NOT NULL,
+    "used_at" timestamp DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX idx_user_mfa_recovery_codes_user_hash ON user_mfa_recovery_codes (user_id, code_hash);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_user_mfa_recovery_codes_user_hash;
+
+DROP TABLE IF EXISTS "user_mfa_recovery_codes";
+
+DROP TABLE IF EXISTS "user_mfa";
+
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "permissions" (
+    "permission_id" SERIAL PRIMARY KEY,
+    "name" VARCHAR(100) NOT NULL UNIQUE,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE TABLE "role_permissions" (
+    "role_id" INT NOT NULL REFERENCES "roles" ("role_id") ON DELETE CASCADE,
+    "permission_id" INT NOT NULL REFERENCES "permissions" ("permission_id") ON DELETE CASCADE,
+    "created_at" timestamp DEFAULT current_timestamp,
+    PRIMARY KEY ("role_id", "permission_id")
+);
+
+CREATE INDEX idx_role_permissions_permission_id ON role_permissions (permission_id);
+
+INSERT INTO "permissions" ("name", "description")
+SELECT resource || ':' || action, initcap(action) || ' ' || resource || ' records'
+FROM unnest(ARRAY['user', 'role', 'merchant', 'cashier', 'category', 'product', 'order', 'transaction']) AS resource
+CROSS JOIN unnest(ARRAY['read', 'create', 'update', 'delete']) AS action
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "permissions" ("name", "description")
+VALUES ('transaction:refund', 'Refund transaction records')
+ON CONFLICT ("name") DO NOTHING;
+
+-- Administrators get everything; merchants run their own stores and
+-- cashiers work the till.
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON
+    r.role_name = 'ROLE_ADMIN'
+    OR (r.role_name = 'ROLE_MERCHANT' AND (
+        p.name IN ('merchant:read', 'merchant:update', 'category:read', 'transaction:refund')
+        OR split_part(p.name, ':', 1) IN ('cashier', 'product', 'order', 'transaction')
+    ))
+    OR (r.role_name = 'ROLE_CASHIER' AND p.name IN (
+        'merchant:read', 'category:read', 'product:read',
+        'order:read', 'order:create', 'order:update',
+        'transaction:read', 'transaction:create'
+    ))
+WHERE r.role_name IN ('ROLE_ADMIN', 'ROLE_MERCHANT', 'ROLE_CASHIER')
+ON CONFLICT DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_role_permissions_permission_id;
+
+DROP TABLE IF EXISTS "role_permissions";
+
+DROP TABLE IF EXISTS "permissions";
+
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "audit_logs" (
+    "audit_log_id" BIGSERIAL PRIMARY KEY,
+    "event_id" VARCHAR(64) NOT NULL UNIQUE,
+    "service" VARCHAR(50) NOT NULL,
+    "method" VARCHAR(200) NOT NULL,
+    "action" VARCHAR(20) NOT NULL,
+    "entity" VARCHAR(50) NOT NULL,
+    "target_ids" BIGINT[] NOT NULL DEFAULT '{}',
+    "actor_id" INT,
+    "api_key_merchant_id" INT,
+    "request_id" VARCHAR(100) NOT NULL DEFAULT '',
+    "request" JSONB,
+    "before" JSONB,
+    "after" JSONB,
+    "code" VARCHAR(30) NOT NULL,
+    "error" TEXT NOT NULL DEFAULT '',
+    "occurred_at" timestamp NOT NULL,
+    "recorded_at" timestamp NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_audit_logs_occurred_at ON audit_logs (occurred_at);
+CREATE INDEX idx_audit_logs_actor_id ON audit_logs (actor_id, occurred_at) WHERE actor_id IS NOT NULL;
+CREATE INDEX idx_audit_logs_entity ON audit_logs (entity, occurred_at);
+CREATE INDEX idx_audit_logs_target_ids ON audit_logs USING GIN (target_ids);
+
+-- The audit trail is append-only: rows can be added but never changed or
+-- removed, not even by the service that writes them.
+CREATE FUNCTION audit_logs_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_logs_append_only
+    BEFORE UPDATE OR DELETE ON audit_logs
+    FOR EACH ROW EXECUTE FUNCTION audit_logs_append_only();
+
+CREATE TRIGGER trg_audit_logs_no_truncate
+    BEFORE TRUNCATE ON audit_logs
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_logs_append_only();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_audit_logs_no_truncate ON audit_logs;
+
+DROP TRIGGER IF EXISTS trg_audit_logs_append_only ON audit_logs;
+
+DROP FUNCTION IF EXISTS audit_logs_append_only();
+
+DROP TABLE IF EXISTS "audit_logs";
+
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "cashier_shifts" (
+    "shift_id" SERIAL PRIMARY KEY,
+    "cashier_id" INT NOT NULL REFERENCES "cashiers" ("cashier_id") ON DELETE CASCADE,
+    "merchant_id" INT NOT NULL,
+    "opening_float" INT NOT NULL CHECK ("opening_float" >= 0),
+    "opened_by" INT NOT NULL,
+    "opened_at" timestamp NOT NULL DEFAULT current_timestamp,
+    "closed_by" INT,
+    "closed_at" timestamp,
+    "cash_sales" INT,
+    "cash_refunds" INT,
+    "pay_ins" INT,
+    "pay_outs" INT,
+    "expected_cash" INT,
+    "counted_cash" INT CHECK ("counted_cash" >= 0),
+    "variance" INT,
+    "note" TEXT NOT NULL DEFAULT ''
+);
+
+-- A cashier works one drawer at a time.
+CREATE UNIQUE INDEX uq_cashier_shifts_open ON cashier_shifts (cashier_id) WHERE closed_at IS NULL;
+
+CREATE INDEX idx_cashier_shifts_cashier_opened_at ON cashier_shifts (cashier_id, opened_at);
+
+CREATE TABLE "cashier_shift_movements" (
+    "movement_id" SERIAL PRIMARY KEY,
+    "shift_id" INT NOT NULL REFERENCES "cashier_shifts" ("shift_id") ON DELETE CASCADE,
+    "kind" VARCHAR(10) NOT NULL CHECK ("kind" IN ('pay_in', 'pay_out')),
+    "amount" INT NOT NULL CHECK ("amount" > 0),
+    "reason" TEXT NOT NULL,
+    "operator_id" INT NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_cashier_shift_movements_shift_id ON cashier_shift_movements (shift_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "cashier_shift_movements";
+
+DROP TABLE IF EXISTS "cashier_shifts";
+
+-- +goose StatementEnd
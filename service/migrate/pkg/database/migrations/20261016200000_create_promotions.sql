@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "promotions" (
+    "promotion_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "name" VARCHAR(255) NOT NULL,
+    "kind" VARCHAR(20) NOT NULL CHECK ("kind" IN ('percent_off', 'amount_off', 'buy_x_get_y', 'fixed_price')),
+    "value" INT NOT NULL DEFAULT 0 CHECK ("value" >= 0),
+    "buy_quantity" INT NOT NULL DEFAULT 0 CHECK ("buy_quantity" >= 0),
+    "get_quantity" INT NOT NULL DEFAULT 0 CHECK ("get_quantity" >= 0),
+    "product_id" INT REFERENCES "products" ("product_id") ON DELETE CASCADE,
+    "category_id" INT REFERENCES "categories" ("category_id") ON DELETE CASCADE,
+    "coupon_code" VARCHAR(50),
+    "starts_at" timestamp NOT NULL DEFAULT current_timestamp,
+    "ends_at" timestamp,
+    "daily_start" INT NOT NULL DEFAULT 0 CHECK ("daily_start" BETWEEN 0 AND 1439),
+    "daily_end" INT NOT NULL DEFAULT 0 CHECK ("daily_end" BETWEEN 0 AND 1439),
+    "priority" INT NOT NULL DEFAULT 0,
+    "stackable" BOOLEAN NOT NULL DEFAULT FALSE,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    "deleted_at" timestamp DEFAULT NULL
+);
+
+CREATE INDEX idx_promotions_merchant_id ON promotions (merchant_id) WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX uq_promotions_coupon_code ON promotions (merchant_id, UPPER(coupon_code))
+    WHERE coupon_code IS NOT NULL AND deleted_at IS NULL;
+
+-- The discounts an order item was sold with. The promotion's name is kept so
+-- receipts still read right after the promotion is gone.
+CREATE TABLE "order_item_discounts" (
+    "order_item_discount_id" SERIAL PRIMARY KEY,
+    "order_item_id" INT NOT NULL REFERENCES "order_items" ("order_item_id") ON DELETE CASCADE,
+    "promotion_id" INT REFERENCES "promotions" ("promotion_id") ON DELETE SET NULL,
+    "promotion_name" VARCHAR(255) NOT NULL,
+    "amount" INT NOT NULL CHECK ("amount" > 0),
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_order_item_discounts_order_item_id ON order_item_discounts (order_item_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "order_item_discounts";
+
+DROP TABLE IF EXISTS "promotions";
+
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "customers" (
+    "customer_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "name" VARCHAR(255) NOT NULL,
+    "email" VARCHAR(255),
+    "phone" VARCHAR(20),
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    "deleted_at" timestamp DEFAULT NULL,
+    CHECK ("email" IS NOT NULL OR "phone" IS NOT NULL)
+);
+
+CREATE INDEX idx_customers_merchant_id ON customers (merchant_id);
+
+-- A phone number or email identifies one live customer of a merchant; a
+-- trashed customer must be restored rather than registered again.
+CREATE UNIQUE INDEX uq_customers_phone ON customers (merchant_id, phone)
+    WHERE phone IS NOT NULL AND deleted_at IS NULL;
+
+CREATE UNIQUE INDEX uq_customers_email ON customers (merchant_id, LOWER(email))
+    WHERE email IS NOT NULL AND deleted_at IS NULL;
+
+ALTER TABLE "orders"
+    ADD COLUMN "customer_id" INT REFERENCES "customers" ("customer_id") ON DELETE SET NULL;
+
+CREATE INDEX idx_orders_customer_id ON orders (customer_id) WHERE customer_id IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "customer_id";
+
+DROP TABLE IF EXISTS "customers";
+
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A merchant's loyalty rule: one point for every spend_per_point spent, each
+-- point worth point_value when redeemed and valid for expiry_days (0 keeps
+-- points forever).
+CREATE TABLE "loyalty_programs" (
+    "merchant_id" INT PRIMARY KEY REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "spend_per_point" INT NOT NULL CHECK ("spend_per_point" > 0),
+    "point_value" INT NOT NULL CHECK ("point_value" > 0),
+    "expiry_days" INT NOT NULL DEFAULT 0 CHECK ("expiry_days" >= 0),
+    "active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+-- The points ledger. Balances are the sum of a customer's entries; entries
+-- are never changed, mistakes are corrected by appending.
+CREATE TABLE "loyalty_entries" (
+    "loyalty_entry_id" BIGSERIAL PRIMARY KEY,
+    "customer_id" INT NOT NULL REFERENCES "customers" ("customer_id") ON DELETE CASCADE,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "transaction_id" INT,
+    "kind" VARCHAR(10) NOT NULL CHECK ("kind" IN ('earn', 'redeem', 'adjust', 'refund', 'trash', 'restore', 'expire')),
+    "points" INT NOT NULL CHECK ("points" <> 0),
+    "expires_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_loyalty_entries_customer ON loyalty_entries (customer_id, merchant_id, created_at);
+
+CREATE INDEX idx_loyalty_entries_transaction_id ON loyalty_entries (transaction_id) WHERE transaction_id IS NOT NULL;
+
+CREATE INDEX idx_loyalty_entries_expires_at ON loyalty_entries (expires_at) WHERE kind = 'earn';
+
+-- Only the cascade from a deleted customer or merchant may remove entries.
+CREATE FUNCTION loyalty_entries_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND pg_trigger_depth() > 1 THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'loyalty_entries is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_loyalty_entries_append_only
+    BEFORE UPDATE OR DELETE ON loyalty_entries
+    FOR EACH ROW EXECUTE FUNCTION loyalty_entries_append_only();
+
+CREATE TRIGGER trg_loyalty_entries_no_truncate
+    BEFORE TRUNCATE ON loyalty_entries
+    FOR EACH STATEMENT EXECUTE FUNCTION loyalty_entries_append_only();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_loyalty_entries_no_truncate ON loyalty_entries;
+
+DROP TRIGGER IF EXISTS trg_loyalty_entries_append_only ON loyalty_entries;
+
+DROP TABLE IF EXISTS "loyalty_entries";
+
+DROP FUNCTION IF EXISTS loyalty_entries_append_only();
+
+DROP TABLE IF EXISTS "loyalty_programs";
+
+-- +goose StatementEnd
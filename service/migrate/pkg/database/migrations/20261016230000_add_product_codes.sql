@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Barcodes were unique across all merchants, so two shops could not stock
+-- the same article. A barcode or SKU now identifies one live product of a
+-- merchant; a trashed product must be restored rather than added again.
+ALTER TABLE "products" DROP CONSTRAINT IF EXISTS "products_barcode_key";
+
+ALTER TABLE "products" ADD COLUMN "sku" VARCHAR(64);
+
+CREATE UNIQUE INDEX uq_products_barcode ON products (merchant_id, barcode)
+    WHERE barcode IS NOT NULL AND deleted_at IS NULL;
+
+CREATE UNIQUE INDEX uq_products_sku ON products (merchant_id, sku)
+    WHERE sku IS NOT NULL AND deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uq_products_sku;
+
+DROP INDEX IF EXISTS uq_products_barcode;
+
+ALTER TABLE "products" DROP COLUMN IF EXISTS "sku";
+
+ALTER TABLE "products" ADD CONSTRAINT "products_barcode_key" UNIQUE ("barcode");
+
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The tenders backfilled from transactions kept whatever payment_method the
+-- client had sent. Map them onto the tender methods the service parses so
+-- per-method stats do not split "Cash", "cash" and "tunai" apart.
+UPDATE "transaction_tenders"
+SET "method" = CASE normalized
+        WHEN 'cash' THEN 'cash'
+        WHEN 'tunai' THEN 'cash'
+        WHEN 'card' THEN 'card'
+        WHEN 'credit_card' THEN 'card'
+        WHEN 'debit_card' THEN 'card'
+        WHEN 'credit' THEN 'card'
+        WHEN 'debit' THEN 'card'
+        WHEN 'e_wallet' THEN 'e_wallet'
+        WHEN 'ewallet' THEN 'e_wallet'
+        WHEN 'wallet' THEN 'e_wallet'
+        WHEN 'qris' THEN 'e_wallet'
+        WHEN 'gopay' THEN 'e_wallet'
+        WHEN 'ovo' THEN 'e_wallet'
+        WHEN 'dana' THEN 'e_wallet'
+        WHEN 'shopeepay' THEN 'e_wallet'
+        WHEN 'voucher' THEN 'voucher'
+        WHEN 'gift_card' THEN 'voucher'
+        WHEN 'coupon' THEN 'voucher'
+        WHEN 'loyalty_points' THEN 'loyalty_points'
+        WHEN 'loyalty' THEN 'loyalty_points'
+        WHEN 'points' THEN 'loyalty_points'
+        ELSE 'other'
+    END
+FROM (
+    SELECT
+        transaction_tender_id,
+        TRANSLATE(LOWER(TRIM("method")), '- ', '__') AS normalized
+    FROM transaction_tenders
+) AS m
+WHERE m.transaction_tender_id = transaction_tenders.transaction_tender_id;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+-- Single-tender transactions still carry the method the client sent.
+UPDATE "transaction_tenders" tt
+SET "method" = t.payment_method
+FROM transactions t
+WHERE t.transaction_id = tt.transaction_id
+  AND t.payment_method <> 'split';
+
+-- +goose StatementEnd
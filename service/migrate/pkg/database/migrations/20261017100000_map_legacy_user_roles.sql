@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Authorization only knows ROLE_ADMIN, ROLE_MERCHANT and ROLE_CASHIER, so
+-- users still holding the free-form roles of before would be locked out.
+--
+-- "Admin Access 1" was handed to every registered user regardless of what
+-- they did; they become merchants, as registration makes them now. Other
+-- legacy roles map by the word in their name.
+INSERT INTO "user_roles" ("user_id", "role_id", "created_at", "updated_at")
+SELECT DISTINCT ur.user_id, target.role_id, current_timestamp, current_timestamp
+FROM user_roles ur
+JOIN roles legacy ON legacy.role_id = ur.role_id
+JOIN roles target ON target.role_name = CASE
+        WHEN legacy.role_name = 'Admin Access 1' THEN 'ROLE_MERCHANT'
+        WHEN legacy.role_name ILIKE '%admin%' THEN 'ROLE_ADMIN'
+        WHEN legacy.role_name ILIKE '%merchant%' THEN 'ROLE_MERCHANT'
+        WHEN legacy.role_name ILIKE '%cashier%' THEN 'ROLE_CASHIER'
+    END
+WHERE ur.deleted_at IS NULL
+  AND legacy.role_name NOT IN ('ROLE_ADMIN', 'ROLE_MERCHANT', 'ROLE_CASHIER')
+  AND NOT EXISTS (
+      SELECT 1 FROM user_roles existing
+      WHERE existing.user_id = ur.user_id
+        AND existing.role_id = target.role_id
+        AND existing.deleted_at IS NULL
+  );
+
+-- With nobody left able to grant roles, the oldest active user becomes the
+-- admin.
+INSERT INTO "user_roles" ("user_id", "role_id", "created_at", "updated_at")
+SELECT u.user_id, r.role_id, current_timestamp, current_timestamp
+FROM users u
+JOIN roles r ON r.role_name = 'ROLE_ADMIN'
+WHERE u.deleted_at IS NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM user_roles ur
+      WHERE ur.role_id = r.role_id
+        AND ur.deleted_at IS NULL
+  )
+ORDER BY u.user_id
+LIMIT 1;
+
+-- +goose StatementEnd
+-- +goose Down
+-- The assignments are indistinguishable from ones made since and are kept.
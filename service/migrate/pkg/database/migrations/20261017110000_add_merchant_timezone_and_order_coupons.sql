@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Promotions with a time of day, e.g. a happy hour, run on the merchant's
+-- clock. Names are IANA zones such as 'Asia/Jakarta'.
+ALTER TABLE "merchants" ADD COLUMN "timezone" VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+-- The coupon codes an order was priced with, so updating the order keeps
+-- the discounts they unlocked.
+ALTER TABLE "orders" ADD COLUMN "coupon_codes" TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "coupon_codes";
+
+ALTER TABLE "merchants" DROP COLUMN IF EXISTS "timezone";
+
+-- +goose StatementEnd
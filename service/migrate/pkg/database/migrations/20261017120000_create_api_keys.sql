@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- API keys used to live only in the gateway's Redis and were lost with it.
+-- Postgres is the record now; Redis caches keys by hash for authentication.
+CREATE TABLE "api_keys" (
+    "api_key_id" VARCHAR(32) PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL,
+    "prefix" VARCHAR(32) NOT NULL,
+    "key_hash" VARCHAR(64) NOT NULL UNIQUE,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "scopes" TEXT[] NOT NULL DEFAULT '{}',
+    "created_by" INT NOT NULL DEFAULT 0,
+    "created_at" timestamp NOT NULL DEFAULT current_timestamp,
+    "expires_at" timestamp DEFAULT NULL,
+    "rotated_from" VARCHAR(32) REFERENCES "api_keys" ("api_key_id") ON DELETE SET NULL,
+    "last_used_at" timestamp DEFAULT NULL
+);
+
+CREATE INDEX idx_api_keys_merchant_id ON api_keys (merchant_id);
+
+CREATE INDEX idx_api_keys_rotated_from ON api_keys (rotated_from) WHERE rotated_from IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "api_keys";
+
+-- +goose StatementEnd
@@ -0,0 +1,108 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Gateway routes are guarded by permission rather than by role. Fill in the
+-- permissions the routes need beyond the first seed, so every route and API
+-- key scope uses the same resource:action names:
+--   read, create, update          the obvious ones
+--   delete                        move to the trash and restore from it
+--   purge                         delete permanently
+--   refund                        refund or void a transaction
+--   merchant:approve              change the status of a merchant or its documents
+--   report:read                   statistics across all merchants
+--   bulk:restore, bulk:purge      restore or purge every trashed record
+INSERT INTO "permissions" ("name", "description")
+SELECT resource || ':' || action, initcap(action) || ' ' || replace(resource, '_', ' ') || ' records'
+FROM unnest(ARRAY['customer', 'promotion', 'merchant_document', 'shift', 'api_key']) AS resource
+CROSS JOIN unnest(ARRAY['read', 'create', 'update', 'delete']) AS action
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "permissions" ("name", "description")
+SELECT resource || ':purge', 'Permanently delete ' || replace(resource, '_', ' ') || ' records'
+FROM unnest(ARRAY['user', 'role', 'merchant', 'cashier', 'category', 'product', 'order', 'transaction', 'customer', 'merchant_document']) AS resource
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "permissions" ("name", "description")
+VALUES
+    ('loyalty:read', 'Read loyalty programs and balances'),
+    ('loyalty:update', 'Update loyalty programs'),
+    ('audit:read', 'Read the audit log'),
+    ('merchant:approve', 'Change the status of merchants and their documents'),
+    ('report:read', 'Read statistics across all merchants'),
+    ('bulk:restore', 'Restore every trashed record of a resource'),
+    ('bulk:purge', 'Permanently delete every trashed record of a resource')
+ON CONFLICT ("name") DO NOTHING;
+
+-- Grant what the routes allowed each role before they checked permissions.
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON
+    r.role_name = 'ROLE_ADMIN'
+    OR (r.role_name = 'ROLE_MERCHANT' AND p.name IN (
+        'merchant:create',
+        'category:create', 'category:update', 'category:delete', 'category:purge',
+        'cashier:purge', 'product:purge',
+        'customer:read', 'customer:create', 'customer:update', 'customer:delete', 'customer:purge',
+        'promotion:read', 'promotion:create', 'promotion:delete',
+        'loyalty:read', 'loyalty:update',
+        'shift:read', 'shift:create', 'shift:update',
+        'merchant_document:read', 'merchant_document:create', 'merchant_document:update'
+    ))
+    OR (r.role_name = 'ROLE_CASHIER' AND p.name IN (
+        'cashier:read', 'transaction:update',
+        'customer:read', 'customer:create', 'customer:update',
+        'promotion:read', 'loyalty:read',
+        'shift:read', 'shift:create', 'shift:update'
+    ))
+WHERE r.role_name IN ('ROLE_ADMIN', 'ROLE_MERCHANT', 'ROLE_CASHIER')
+ON CONFLICT DO NOTHING;
+
+-- API key scopes used to say write for every change; spell it out.
+UPDATE "api_keys"
+SET "scopes" = ARRAY(
+    SELECT DISTINCT scope
+    FROM unnest("scopes") AS s (name)
+    CROSS JOIN LATERAL unnest(
+        CASE
+            WHEN s.name LIKE '%:write' THEN ARRAY[
+                replace(s.name, ':write', ':create'),
+                replace(s.name, ':write', ':update'),
+                replace(s.name, ':write', ':delete')
+            ]
+            ELSE ARRAY[s.name]
+        END
+    ) AS scope
+)
+WHERE EXISTS (SELECT 1 FROM unnest("scopes") AS s (name) WHERE s.name LIKE '%:write');
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+UPDATE "api_keys"
+SET "scopes" = ARRAY(
+    SELECT DISTINCT CASE
+        WHEN name LIKE '%:create' OR name LIKE '%:update' OR name LIKE '%:delete'
+            THEN split_part(name, ':', 1) || ':write'
+        ELSE name
+    END
+    FROM unnest("scopes") AS name
+    WHERE name <> 'transaction:refund'
+);
+
+DELETE FROM "role_permissions" rp
+USING roles r, permissions p
+WHERE rp.role_id = r.role_id
+  AND rp.permission_id = p.permission_id
+  AND (
+      (r.role_name = 'ROLE_MERCHANT' AND p.name IN (
+          'merchant:create', 'category:create', 'category:update', 'category:delete'
+      ))
+      OR (r.role_name = 'ROLE_CASHIER' AND p.name IN ('cashier:read', 'transaction:update'))
+  );
+
+DELETE FROM "permissions"
+WHERE "name" LIKE '%:purge'
+   OR split_part("name", ':', 1) IN ('customer', 'promotion', 'merchant_document', 'shift', 'api_key', 'loyalty', 'audit', 'report', 'bulk')
+   OR "name" = 'merchant:approve';
+
+-- +goose StatementEnd
@@ -9,6 +9,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	"github.com/MamangRust/monolith-point-of-sale-order/internal/errorhandler"
 	"github.com/MamangRust/monolith-point-of-sale-order/internal/handler"
 	"github.com/MamangRust/monolith-point-of-sale-order/internal/middleware"
@@ -18,6 +22,7 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	otel_pkg "github.com/MamangRust/monolith-point-of-sale-pkg/otel"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
@@ -28,6 +33,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -44,11 +50,14 @@ func init() {
 }
 
 type Server struct {
-	Logger   logger.LoggerInterface
-	DB       *db.Queries
-	Services *service.Service
-	Handlers *handler.Handler
-	Ctx      context.Context
+	Logger       logger.LoggerInterface
+	DB           *db.Queries
+	Repositories *repository.Repositories
+	Services     *service.Service
+	Handlers     *handler.Handler
+	Relay        *outbox.Relay
+	Conn         *audit.DB
+	Ctx          context.Context
 }
 
 func NewServer(ctx context.Context) (*Server, func(context.Context) error, error) {
@@ -64,15 +73,16 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 
 	flag.Parse()
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
 
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 
 	shutdownTracerProvider, err := otel_pkg.InitTracerProvider("Order-service", ctx)
 
@@ -106,6 +116,8 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Logger: logger,
 	})
 
+	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
+
 	errorhandler := errorhandler.NewErrorHandler(logger)
 
 	services := service.NewService(&service.Deps{
@@ -120,12 +132,17 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Service: services,
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, myKafka, logger)
+
 	return &Server{
-		Logger:   logger,
-		DB:       DB,
-		Services: services,
-		Handlers: handlers,
-		Ctx:      ctx,
+		Logger:       logger,
+		DB:           DB,
+		Repositories: repositories,
+		Services:     services,
+		Handlers:     handlers,
+		Relay:        relay,
+		Conn:         conn,
+		Ctx:          ctx,
 	}, shutdownTracerProvider, nil
 }
 
@@ -154,16 +171,57 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			tenant.Middleware(s.Repositories.Tenant, s.Logger),
+			tenant.Guard(
+				pb.OrderService_FindMonthlyTotalRevenue_FullMethodName,
+				pb.OrderService_FindYearlyTotalRevenue_FullMethodName,
+				pb.OrderService_FindMonthlyRevenue_FullMethodName,
+				pb.OrderService_FindYearlyRevenue_FullMethodName,
+				pb.OrderService_RestoreAllOrder_FullMethodName,
+				pb.OrderService_DeleteAllOrderPermanent_FullMethodName,
+			),
+			tenant.Owned(s.Repositories.OrderQuery.FindMerchantIdByOrder, map[string]tenant.RowID{
+				pb.OrderService_FindMonthlyTotalRevenueById_FullMethodName: orderID,
+				pb.OrderService_FindYearlyTotalRevenueById_FullMethodName:  orderID,
+				pb.OrderService_Update_FullMethodName:                      orderID,
+				pb.OrderService_TrashedOrder_FullMethodName:                tenant.ByID,
+				pb.OrderService_RestoreOrder_FullMethodName:                tenant.ByID,
+				pb.OrderService_DeleteOrderPermanent_FullMethodName:        tenant.ByID,
+				pb.PromotionService_FindOrderDiscounts_FullMethodName:      orderID,
+			}),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "order",
+				RequestID: middleware.RequestID,
+				Snapshots: map[string]audit.Snapshot{
+					"pb.OrderService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Order.FindById(ctx, &pb.FindByIdOrderRequest{Id: int32(id)})
+					},
+					"pb.PromotionService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Promotion.FindById(ctx, &pb.FindByIdPromotionRequest{Id: int32(id)})
+					},
+					"pb.CustomerService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Customer.FindById(ctx, &pb.FindByIdCustomerRequest{Id: int32(id)})
+					},
+				},
+				Actions: map[string]string{
+					pb.CustomerService_AttachOrder_FullMethodName: "attach_order",
+				},
+			}, s.Logger),
+			bulk.Middleware(s.Logger),
 		),
 	)
 
 	pb.RegisterOrderServiceServer(grpcServer, s.Handlers.Order)
+	pb.RegisterPromotionServiceServer(grpcServer, s.Handlers.Promotion)
+	pb.RegisterCustomerServiceServer(grpcServer, s.Handlers.Customer)
 
 	metricsServer := http.NewServeMux()
 	metricsServer.Handle("/metrics", promhttp.Handler())
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
@@ -185,3 +243,13 @@ func (s *Server) Run() {
 
 	wg.Wait()
 }
+
+// orderID reads the order addressed by stats, update and discount requests.
+func orderID(req interface{}) (int, bool) {
+	r, ok := req.(interface{ GetOrderId() int32 })
+	if !ok {
+		return 0, false
+	}
+
+	return int(r.GetOrderId()), true
+}
@@ -0,0 +1,51 @@
+package barcode
+
+import (
+	"errors"
+
+	commonbarcode "github.com/MamangRust/monolith-point-of-sale-common/barcode"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
+)
+
+var (
+	ErrInvalidQuantity = errors.New("scanned quantity must be a positive number")
+	ErrNotFound        = errors.New("no product of the merchant has this barcode")
+)
+
+// Line is a scanned barcode and how many of the product were scanned.
+type Line struct {
+	Barcode  string
+	Quantity int
+}
+
+// Lines turns the items scanned with an order into one line per barcode,
+// with the quantities of repeated scans added up.
+func Lines(items []requests.ScannedOrderItemRequest) ([]Line, error) {
+	var lines []Line
+	index := make(map[string]int)
+
+	for _, item := range items {
+		quantity := item.Quantity
+		if quantity < 0 {
+			return nil, ErrInvalidQuantity
+		}
+		if quantity == 0 {
+			quantity = 1
+		}
+
+		code, err := commonbarcode.Normalize(item.Barcode)
+		if err != nil {
+			return nil, err
+		}
+
+		if i, ok := index[code]; ok {
+			lines[i].Quantity += quantity
+			continue
+		}
+
+		index[code] = len(lines)
+		lines = append(lines, Line{Barcode: code, Quantity: quantity})
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,166 @@
+package customer
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotFound        = errors.New("customer not found")
+	ErrInvalidID       = errors.New("invalid customer id")
+	ErrNameRequired    = errors.New("customer name is required")
+	ErrContactRequired = errors.New("customer needs an email or a phone number")
+	ErrInvalidEmail    = errors.New("invalid customer email")
+	ErrInvalidPhone    = errors.New("invalid customer phone number")
+	ErrContactTaken    = errors.New("email or phone number is already used by another customer")
+	ErrOrderMerchant   = errors.New("customer belongs to another merchant than the order")
+)
+
+type Customer struct {
+	ID         int        `json:"id"`
+	MerchantID int        `json:"merchant_id"`
+	Name       string     `json:"name"`
+	Email      string     `json:"email,omitempty"`
+	Phone      string     `json:"phone,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+}
+
+type CreateRequest struct {
+	MerchantID int    `json:"merchant_id"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	Phone      string `json:"phone"`
+}
+
+// Normalize trims the request and brings the email and phone number to the
+// form they are stored and searched in.
+func (r *CreateRequest) Normalize() {
+	r.Name = strings.TrimSpace(r.Name)
+	r.Email = NormalizeEmail(r.Email)
+	r.Phone = NormalizePhone(r.Phone)
+}
+
+func (r *CreateRequest) Validate() error {
+	return validate(r.Name, r.Email, r.Phone)
+}
+
+type UpdateRequest struct {
+	CustomerID int    `json:"customer_id"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	Phone      string `json:"phone"`
+}
+
+func (r *UpdateRequest) Normalize() {
+	r.Name = strings.TrimSpace(r.Name)
+	r.Email = NormalizeEmail(r.Email)
+	r.Phone = NormalizePhone(r.Phone)
+}
+
+func (r *UpdateRequest) Validate() error {
+	return validate(r.Name, r.Email, r.Phone)
+}
+
+func validate(name, email, phone string) error {
+	if name == "" {
+		return ErrNameRequired
+	}
+	if email == "" && phone == "" {
+		return ErrContactRequired
+	}
+
+	if email != "" {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Address != email {
+			return ErrInvalidEmail
+		}
+	}
+
+	if phone != "" {
+		digits := strings.TrimPrefix(phone, "+")
+		if len(digits) < 6 || len(digits) > 15 {
+			return ErrInvalidPhone
+		}
+		for _, r := range digits {
+			if r < '0' || r > '9' {
+				return ErrInvalidPhone
+			}
+		}
+	}
+
+	return nil
+}
+
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone drops the spaces, dashes, dots and brackets people type
+// between digits, keeping a leading plus.
+func NormalizePhone(phone string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(phone))
+}
+
+// FindAllRequest lists a merchant's customers. Search matches the name,
+// email or phone number.
+type FindAllRequest struct {
+	MerchantID int
+	Search     string
+	Page       int
+	PageSize   int
+}
+
+type PurchasesRequest struct {
+	CustomerID int
+	Page       int
+	PageSize   int
+}
+
+// Purchase is one order of a customer with how it was paid. Orders that were
+// never paid have no transaction.
+type Purchase struct {
+	OrderID       int       `json:"order_id"`
+	MerchantID    int       `json:"merchant_id"`
+	CashierID     int       `json:"cashier_id"`
+	TotalPrice    int       `json:"total_price"`
+	TransactionID int       `json:"transaction_id,omitempty"`
+	PaymentStatus string    `json:"payment_status,omitempty"`
+	Paid          int       `json:"paid"`
+	Refunded      int       `json:"refunded"`
+	OrderedAt     time.Time `json:"ordered_at"`
+}
+
+// Stats sums up a customer's paid orders. A visit is a day with at least one
+// paid order.
+type Stats struct {
+	CustomerID        int        `json:"customer_id"`
+	Orders            int        `json:"orders"`
+	Visits            int        `json:"visits"`
+	LifetimeSpend     int        `json:"lifetime_spend"`
+	Refunded          int        `json:"refunded"`
+	AverageOrder      int        `json:"average_order"`
+	FirstVisit        *time.Time `json:"first_visit,omitempty"`
+	LastVisit         *time.Time `json:"last_visit,omitempty"`
+	DaysBetweenVisits float64    `json:"days_between_visits"`
+}
+
+// Derive fills in the averages from the totals.
+func (s *Stats) Derive() {
+	if s.Orders > 0 {
+		s.AverageOrder = s.LifetimeSpend / s.Orders
+	}
+
+	if s.Visits > 1 && s.FirstVisit != nil && s.LastVisit != nil {
+		s.DaysBetweenVisits = s.LastVisit.Sub(*s.FirstVisit).Hours() / 24 / float64(s.Visits-1)
+	}
+}
@@ -0,0 +1,30 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	commonbarcode "github.com/MamangRust/monolith-point-of-sale-common/barcode"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/barcode"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+)
+
+var (
+	ErrInvalidBarcode         = response.NewErrorResponse("Barcode must be a valid EAN-13, UPC-A or EAN-8 code", http.StatusBadRequest)
+	ErrInvalidScannedQuantity = response.NewErrorResponse("Scanned quantity must be a positive number", http.StatusBadRequest)
+	ErrBarcodeNotFound        = response.NewErrorResponse("No product of the merchant has this barcode", http.StatusNotFound)
+	ErrFailedResolveBarcode   = response.NewErrorResponse("Failed to find product by barcode", http.StatusInternalServerError)
+)
+
+func BarcodeErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, commonbarcode.ErrInvalid):
+		return ErrInvalidBarcode
+	case errors.Is(err, barcode.ErrInvalidQuantity):
+		return ErrInvalidScannedQuantity
+	case errors.Is(err, barcode.ErrNotFound):
+		return ErrBarcodeNotFound
+	default:
+		return fallback
+	}
+}
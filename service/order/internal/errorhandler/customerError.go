@@ -0,0 +1,108 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/customer"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrCustomerNotFound            = response.NewErrorResponse("Customer not found", http.StatusNotFound)
+	ErrInvalidCustomerId           = response.NewErrorResponse("Invalid customer ID", http.StatusBadRequest)
+	ErrCustomerNameRequired        = response.NewErrorResponse("Customer name is required", http.StatusBadRequest)
+	ErrCustomerContactRequired     = response.NewErrorResponse("Customer needs an email or a phone number", http.StatusBadRequest)
+	ErrInvalidCustomerEmail        = response.NewErrorResponse("Invalid customer email", http.StatusBadRequest)
+	ErrInvalidCustomerPhone        = response.NewErrorResponse("Invalid customer phone number", http.StatusBadRequest)
+	ErrCustomerContactTaken        = response.NewErrorResponse("Email or phone number is already used by another customer", http.StatusConflict)
+	ErrCustomerOrderMerchant       = response.NewErrorResponse("Customer belongs to another merchant than the order", http.StatusBadRequest)
+	ErrFailedCreateCustomer        = response.NewErrorResponse("Failed to create customer", http.StatusInternalServerError)
+	ErrFailedFindCustomer          = response.NewErrorResponse("Failed to fetch customer", http.StatusInternalServerError)
+	ErrFailedFindCustomers         = response.NewErrorResponse("Failed to fetch customers", http.StatusInternalServerError)
+	ErrFailedUpdateCustomer        = response.NewErrorResponse("Failed to update customer", http.StatusInternalServerError)
+	ErrFailedTrashCustomer         = response.NewErrorResponse("Failed to move customer to trash", http.StatusInternalServerError)
+	ErrFailedRestoreCustomer       = response.NewErrorResponse("Failed to restore customer", http.StatusInternalServerError)
+	ErrFailedDeleteCustomer        = response.NewErrorResponse("Failed to permanently delete customer", http.StatusInternalServerError)
+	ErrFailedAttachCustomer        = response.NewErrorResponse("Failed to attach customer to order", http.StatusInternalServerError)
+	ErrFailedFindCustomerPurchases = response.NewErrorResponse("Failed to fetch customer purchases", http.StatusInternalServerError)
+	ErrFailedFindCustomerStats     = response.NewErrorResponse("Failed to fetch customer stats", http.StatusInternalServerError)
+)
+
+type customerError struct {
+	logger logger.LoggerInterface
+}
+
+func NewCustomerError(logger logger.LoggerInterface) *customerError {
+	return &customerError{
+		logger: logger,
+	}
+}
+
+func (e *customerError) HandleCreateCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse) {
+	return handleErrorRepository[*customer.Customer](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedCreateCustomer), fields...)
+}
+
+func (e *customerError) HandleFindCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse) {
+	return handleErrorRepository[*customer.Customer](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedFindCustomer), fields...)
+}
+
+func (e *customerError) HandleFindCustomersError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*customer.Customer, *int, *response.ErrorResponse) {
+	return handleErrorPagination[[]*customer.Customer](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedFindCustomers), fields...)
+}
+
+func (e *customerError) HandleUpdateCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse) {
+	return handleErrorRepository[*customer.Customer](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedUpdateCustomer), fields...)
+}
+
+func (e *customerError) HandleTrashCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse) {
+	return handleErrorRepository[*customer.Customer](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedTrashCustomer), fields...)
+}
+
+func (e *customerError) HandleRestoreCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse) {
+	return handleErrorRepository[*customer.Customer](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedRestoreCustomer), fields...)
+}
+
+func (e *customerError) HandleDeleteCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse) {
+	return handleErrorRepository[bool](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedDeleteCustomer), fields...)
+}
+
+func (e *customerError) HandleAttachCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse) {
+	return handleErrorRepository[bool](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedAttachCustomer), fields...)
+}
+
+func (e *customerError) HandleFindPurchasesError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*customer.Purchase, *int, *response.ErrorResponse) {
+	return handleErrorPagination[[]*customer.Purchase](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedFindCustomerPurchases), fields...)
+}
+
+func (e *customerError) HandleFindStatsError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Stats, *response.ErrorResponse) {
+	return handleErrorRepository[*customer.Stats](e.logger, err, method, tracePrefix, span, status, CustomerErrorResponse(err, ErrFailedFindCustomerStats), fields...)
+}
+
+// CustomerErrorResponse maps a customer error to its response, or to
+// fallback when err is not one. Orders use it for the customer they carry.
+func CustomerErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, customer.ErrNotFound):
+		return ErrCustomerNotFound
+	case errors.Is(err, customer.ErrInvalidID):
+		return ErrInvalidCustomerId
+	case errors.Is(err, customer.ErrNameRequired):
+		return ErrCustomerNameRequired
+	case errors.Is(err, customer.ErrContactRequired):
+		return ErrCustomerContactRequired
+	case errors.Is(err, customer.ErrInvalidEmail):
+		return ErrInvalidCustomerEmail
+	case errors.Is(err, customer.ErrInvalidPhone):
+		return ErrInvalidCustomerPhone
+	case errors.Is(err, customer.ErrContactTaken):
+		return ErrCustomerContactTaken
+	case errors.Is(err, customer.ErrOrderMerchant):
+		return ErrCustomerOrderMerchant
+	default:
+		return fallback
+	}
+}
@@ -7,6 +7,8 @@ type ErrorHandler struct {
 	OrderQueryError      OrderQueryError
 	OrderStats           OrderStatsError
 	OrderStatsByMerchant OrderStatsByMerchantError
+	PromotionError       PromotionError
+	CustomerError        CustomerError
 }
 
 func NewErrorHandler(logger logger.LoggerInterface) *ErrorHandler {
@@ -15,5 +17,7 @@ func NewErrorHandler(logger logger.LoggerInterface) *ErrorHandler {
 		OrderQueryError:      NewOrderQueryError(logger),
 		OrderStats:           NewOrderStatsError(logger),
 		OrderStatsByMerchant: NewOrderStatsByMerchantError(logger),
+		PromotionError:       NewPromotionError(logger),
+		CustomerError:        NewCustomerError(logger),
 	}
 }
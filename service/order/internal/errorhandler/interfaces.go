@@ -1,6 +1,8 @@
 package errorhandler
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/customer"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/promotion"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -65,3 +67,24 @@ type OrderCommandError interface {
 	HandleRestoreAllOrderError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse)
 	HandleDeleteAllOrderError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse)
 }
+
+type PromotionError interface {
+	HandleCreatePromotionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*promotion.Promotion, *response.ErrorResponse)
+	HandleFindPromotionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*promotion.Promotion, *response.ErrorResponse)
+	HandleFindPromotionsError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*promotion.Promotion, *response.ErrorResponse)
+	HandleTrashPromotionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse)
+	HandleFindOrderDiscountsError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]promotion.Discount, *response.ErrorResponse)
+}
+
+type CustomerError interface {
+	HandleCreateCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse)
+	HandleFindCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse)
+	HandleFindCustomersError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*customer.Customer, *int, *response.ErrorResponse)
+	HandleUpdateCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse)
+	HandleTrashCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse)
+	HandleRestoreCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Customer, *response.ErrorResponse)
+	HandleDeleteCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse)
+	HandleAttachCustomerError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse)
+	HandleFindPurchasesError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*customer.Purchase, *int, *response.ErrorResponse)
+	HandleFindStatsError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*customer.Stats, *response.ErrorResponse)
+}
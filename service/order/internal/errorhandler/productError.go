@@ -0,0 +1,19 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+)
+
+var ErrProductNotInMerchant = response.NewErrorResponse("Product does not belong to the merchant of the order", http.StatusBadRequest)
+
+func ProductErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	if errors.Is(err, repository.ErrProductNotInMerchant) {
+		return ErrProductNotInMerchant
+	}
+
+	return fallback
+}
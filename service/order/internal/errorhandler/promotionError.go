@@ -0,0 +1,71 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/promotion"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPromotionNotFound       = response.NewErrorResponse("Promotion not found", http.StatusNotFound)
+	ErrInvalidPromotionRule    = response.NewErrorResponse("Invalid promotion rule", http.StatusBadRequest)
+	ErrInvalidPromotionWindow  = response.NewErrorResponse("Invalid promotion validity window", http.StatusBadRequest)
+	ErrPromotionNameRequired   = response.NewErrorResponse("Promotion name is required", http.StatusBadRequest)
+	ErrPromotionCouponTaken    = response.NewErrorResponse("Coupon code is already used by another promotion", http.StatusConflict)
+	ErrFailedCreatePromotion   = response.NewErrorResponse("Failed to create promotion", http.StatusInternalServerError)
+	ErrFailedFindPromotions    = response.NewErrorResponse("Failed to fetch promotions", http.StatusInternalServerError)
+	ErrFailedTrashPromotion    = response.NewErrorResponse("Failed to trash promotion", http.StatusInternalServerError)
+	ErrFailedFindOrderDiscount = response.NewErrorResponse("Failed to fetch order discounts", http.StatusInternalServerError)
+)
+
+type promotionError struct {
+	logger logger.LoggerInterface
+}
+
+func NewPromotionError(logger logger.LoggerInterface) *promotionError {
+	return &promotionError{
+		logger: logger,
+	}
+}
+
+func (e *promotionError) HandleCreatePromotionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*promotion.Promotion, *response.ErrorResponse) {
+	return handleErrorRepository[*promotion.Promotion](e.logger, err, method, tracePrefix, span, status, promotionErrorResponse(err, ErrFailedCreatePromotion), fields...)
+}
+
+func (e *promotionError) HandleFindPromotionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*promotion.Promotion, *response.ErrorResponse) {
+	return handleErrorRepository[*promotion.Promotion](e.logger, err, method, tracePrefix, span, status, promotionErrorResponse(err, ErrFailedFindPromotions), fields...)
+}
+
+func (e *promotionError) HandleFindPromotionsError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*promotion.Promotion, *response.ErrorResponse) {
+	return handleErrorRepository[[]*promotion.Promotion](e.logger, err, method, tracePrefix, span, status, promotionErrorResponse(err, ErrFailedFindPromotions), fields...)
+}
+
+func (e *promotionError) HandleTrashPromotionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse) {
+	return handleErrorRepository[bool](e.logger, err, method, tracePrefix, span, status, promotionErrorResponse(err, ErrFailedTrashPromotion), fields...)
+}
+
+func (e *promotionError) HandleFindOrderDiscountsError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]promotion.Discount, *response.ErrorResponse) {
+	return handleErrorRepository[[]promotion.Discount](e.logger, err, method, tracePrefix, span, status, promotionErrorResponse(err, ErrFailedFindOrderDiscount), fields...)
+}
+
+func promotionErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, promotion.ErrNotFound):
+		return ErrPromotionNotFound
+	case errors.Is(err, promotion.ErrInvalidRule):
+		return ErrInvalidPromotionRule
+	case errors.Is(err, promotion.ErrInvalidWindow):
+		return ErrInvalidPromotionWindow
+	case errors.Is(err, promotion.ErrNameRequired):
+		return ErrPromotionNameRequired
+	case errors.Is(err, promotion.ErrCouponTaken):
+		return ErrPromotionCouponTaken
+	default:
+		return fallback
+	}
+}
@@ -0,0 +1,334 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/customer"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/service"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/customer_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type customerHandleGrpc struct {
+	pb.UnimplementedCustomerServiceServer
+	customer service.CustomerService
+}
+
+func NewCustomerHandleGrpc(service *service.Service) *customerHandleGrpc {
+	return &customerHandleGrpc{
+		customer: service.Customer,
+	}
+}
+
+func (s *customerHandleGrpc) FindById(ctx context.Context, req *pb.FindByIdCustomerRequest) (*pb.ApiResponseCustomer, error) {
+	id := int(req.GetId())
+
+	if id <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.customer.FindById(ctx, id)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCustomer{
+		Status:  "success",
+		Message: "Successfully fetched customer",
+		Data:    toProtoCustomer(res),
+	}, nil
+}
+
+func (s *customerHandleGrpc) FindByContact(ctx context.Context, req *pb.FindCustomerByContactRequest) (*pb.ApiResponseCustomer, error) {
+	merchantID := int(req.GetMerchantId())
+
+	if merchantID <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidMerchantId
+	}
+
+	if req.GetPhone() == "" && req.GetEmail() == "" {
+		return nil, customer_errors.ErrGrpcContactRequired
+	}
+
+	res, err := s.customer.FindByContact(ctx, merchantID, req.GetPhone(), req.GetEmail())
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCustomer{
+		Status:  "success",
+		Message: "Successfully fetched customer",
+		Data:    toProtoCustomer(res),
+	}, nil
+}
+
+func (s *customerHandleGrpc) FindByMerchant(ctx context.Context, req *pb.FindAllCustomerRequest) (*pb.ApiResponsePaginationCustomer, error) {
+	return s.findPage(ctx, req, s.customer.FindByMerchant, "Successfully fetched customers")
+}
+
+func (s *customerHandleGrpc) FindTrashedByMerchant(ctx context.Context, req *pb.FindAllCustomerRequest) (*pb.ApiResponsePaginationCustomer, error) {
+	return s.findPage(ctx, req, s.customer.FindTrashedByMerchant, "Successfully fetched trashed customers")
+}
+
+func (s *customerHandleGrpc) findPage(
+	ctx context.Context,
+	req *pb.FindAllCustomerRequest,
+	find func(context.Context, *customer.FindAllRequest) ([]*customer.Customer, *int, *response.ErrorResponse),
+	message string,
+) (*pb.ApiResponsePaginationCustomer, error) {
+	merchantID := int(req.GetMerchantId())
+
+	if merchantID <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidMerchantId
+	}
+
+	reqService := &customer.FindAllRequest{
+		MerchantID: merchantID,
+		Search:     req.GetSearch(),
+		Page:       int(req.GetPage()),
+		PageSize:   int(req.GetPageSize()),
+	}
+
+	res, total, err := find(ctx, reqService)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	data := make([]*pb.CustomerResponse, len(res))
+	for i, c := range res {
+		data[i] = toProtoCustomer(c)
+	}
+
+	return &pb.ApiResponsePaginationCustomer{
+		Status:     "success",
+		Message:    message,
+		Data:       data,
+		Pagination: paginationMeta(reqService.Page, reqService.PageSize, *total),
+	}, nil
+}
+
+func (s *customerHandleGrpc) FindPurchases(ctx context.Context, req *pb.FindCustomerPurchasesRequest) (*pb.ApiResponsePaginationCustomerPurchase, error) {
+	customerID := int(req.GetCustomerId())
+
+	if customerID <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidId
+	}
+
+	reqService := &customer.PurchasesRequest{
+		CustomerID: customerID,
+		Page:       int(req.GetPage()),
+		PageSize:   int(req.GetPageSize()),
+	}
+
+	res, total, err := s.customer.FindPurchases(ctx, reqService)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	data := make([]*pb.CustomerPurchaseResponse, len(res))
+	for i, p := range res {
+		data[i] = &pb.CustomerPurchaseResponse{
+			OrderId:       int32(p.OrderID),
+			MerchantId:    int32(p.MerchantID),
+			CashierId:     int32(p.CashierID),
+			TotalPrice:    int32(p.TotalPrice),
+			TransactionId: int32(p.TransactionID),
+			PaymentStatus: p.PaymentStatus,
+			Paid:          int32(p.Paid),
+			Refunded:      int32(p.Refunded),
+			OrderedAt:     p.OrderedAt.Format(time.RFC3339),
+		}
+	}
+
+	return &pb.ApiResponsePaginationCustomerPurchase{
+		Status:     "success",
+		Message:    "Successfully fetched customer purchases",
+		Data:       data,
+		Pagination: paginationMeta(reqService.Page, reqService.PageSize, *total),
+	}, nil
+}
+
+func (s *customerHandleGrpc) FindStats(ctx context.Context, req *pb.FindByIdCustomerRequest) (*pb.ApiResponseCustomerStats, error) {
+	id := int(req.GetId())
+
+	if id <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.customer.FindStats(ctx, id)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	stats := &pb.CustomerStatsResponse{
+		CustomerId:        int32(res.CustomerID),
+		Orders:            int32(res.Orders),
+		Visits:            int32(res.Visits),
+		LifetimeSpend:     int32(res.LifetimeSpend),
+		Refunded:          int32(res.Refunded),
+		AverageOrder:      int32(res.AverageOrder),
+		DaysBetweenVisits: res.DaysBetweenVisits,
+	}
+
+	if res.FirstVisit != nil {
+		stats.FirstVisit = wrapperspb.String(res.FirstVisit.Format(time.RFC3339))
+	}
+	if res.LastVisit != nil {
+		stats.LastVisit = wrapperspb.String(res.LastVisit.Format(time.RFC3339))
+	}
+
+	return &pb.ApiResponseCustomerStats{
+		Status:  "success",
+		Message: "Successfully fetched customer stats",
+		Data:    stats,
+	}, nil
+}
+
+func (s *customerHandleGrpc) Create(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.ApiResponseCustomer, error) {
+	if req.GetMerchantId() <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidMerchantId
+	}
+
+	res, err := s.customer.CreateCustomer(ctx, &customer.CreateRequest{
+		MerchantID: int(req.GetMerchantId()),
+		Name:       req.GetName(),
+		Email:      req.GetEmail(),
+		Phone:      req.GetPhone(),
+	})
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCustomer{
+		Status:  "success",
+		Message: "Successfully created customer",
+		Data:    toProtoCustomer(res),
+	}, nil
+}
+
+func (s *customerHandleGrpc) Update(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.ApiResponseCustomer, error) {
+	if req.GetCustomerId() <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.customer.UpdateCustomer(ctx, &customer.UpdateRequest{
+		CustomerID: int(req.GetCustomerId()),
+		Name:       req.GetName(),
+		Email:      req.GetEmail(),
+		Phone:      req.GetPhone(),
+	})
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCustomer{
+		Status:  "success",
+		Message: "Successfully updated customer",
+		Data:    toProtoCustomer(res),
+	}, nil
+}
+
+func (s *customerHandleGrpc) AttachOrder(ctx context.Context, req *pb.AttachCustomerOrderRequest) (*pb.ApiResponseCustomerDelete, error) {
+	if req.GetCustomerId() <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidId
+	}
+
+	if req.GetOrderId() <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidOrderId
+	}
+
+	if _, err := s.customer.AttachOrder(ctx, int(req.GetCustomerId()), int(req.GetOrderId())); err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCustomerDelete{
+		Status:  "success",
+		Message: "Successfully attached customer to order",
+	}, nil
+}
+
+func (s *customerHandleGrpc) TrashedCustomer(ctx context.Context, req *pb.FindByIdCustomerRequest) (*pb.ApiResponseCustomer, error) {
+	id := int(req.GetId())
+
+	if id <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.customer.TrashCustomer(ctx, id)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCustomer{
+		Status:  "success",
+		Message: "Successfully trashed customer",
+		Data:    toProtoCustomer(res),
+	}, nil
+}
+
+func (s *customerHandleGrpc) RestoreCustomer(ctx context.Context, req *pb.FindByIdCustomerRequest) (*pb.ApiResponseCustomer, error) {
+	id := int(req.GetId())
+
+	if id <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.customer.RestoreCustomer(ctx, id)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCustomer{
+		Status:  "success",
+		Message: "Successfully restored customer",
+		Data:    toProtoCustomer(res),
+	}, nil
+}
+
+func (s *customerHandleGrpc) DeleteCustomerPermanent(ctx context.Context, req *pb.FindByIdCustomerRequest) (*pb.ApiResponseCustomerDelete, error) {
+	id := int(req.GetId())
+
+	if id <= 0 {
+		return nil, customer_errors.ErrGrpcFailedInvalidId
+	}
+
+	if _, err := s.customer.DeleteCustomerPermanent(ctx, id); err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCustomerDelete{
+		Status:  "success",
+		Message: "Successfully deleted customer permanently",
+	}, nil
+}
+
+func toProtoCustomer(c *customer.Customer) *pb.CustomerResponse {
+	res := &pb.CustomerResponse{
+		Id:         int32(c.ID),
+		MerchantId: int32(c.MerchantID),
+		Name:       c.Name,
+		Email:      c.Email,
+		Phone:      c.Phone,
+		CreatedAt:  c.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  c.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if c.DeletedAt != nil {
+		res.DeletedAt = wrapperspb.String(c.DeletedAt.Format(time.RFC3339))
+	}
+
+	return res
+}
+
+func paginationMeta(page, pageSize, totalRecords int) *pb.PaginationMeta {
+	return &pb.PaginationMeta{
+		CurrentPage:  int32(page),
+		PageSize:     int32(pageSize),
+		TotalPages:   int32(math.Ceil(float64(totalRecords) / float64(pageSize))),
+		TotalRecords: int32(totalRecords),
+	}
+}
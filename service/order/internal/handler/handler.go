@@ -7,11 +7,15 @@ type Deps struct {
 }
 
 type Handler struct {
-	Order OrderHandleGrpc
+	Order     OrderHandleGrpc
+	Promotion PromotionHandleGrpc
+	Customer  CustomerHandleGrpc
 }
 
 func NewHandler(deps *Deps) *Handler {
 	return &Handler{
-		Order: NewOrderHandleGrpc(deps.Service),
+		Order:     NewOrderHandleGrpc(deps.Service),
+		Promotion: NewPromotionHandleGrpc(deps.Service),
+		Customer:  NewCustomerHandleGrpc(deps.Service),
 	}
 }
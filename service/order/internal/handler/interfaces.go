@@ -5,3 +5,11 @@ import "github.com/MamangRust/monolith-point-of-sale-shared/pb"
 type OrderHandleGrpc interface {
 	pb.OrderServiceServer
 }
+
+type PromotionHandleGrpc interface {
+	pb.PromotionServiceServer
+}
+
+type CustomerHandleGrpc interface {
+	pb.CustomerServiceServer
+}
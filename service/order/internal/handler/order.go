@@ -351,8 +351,10 @@ func (s *orderHandleGrpc) FindYearlyRevenueByMerchant(ctx context.Context, reque
 
 func (s *orderHandleGrpc) Create(ctx context.Context, request *pb.CreateOrderRequest) (*pb.ApiResponseOrder, error) {
 	req := &requests.CreateOrderRequest{
-		MerchantID: int(request.GetMerchantId()),
-		CashierID:  int(request.GetCashierId()),
+		MerchantID:  int(request.GetMerchantId()),
+		CashierID:   int(request.GetCashierId()),
+		CouponCodes: request.GetCouponCodes(),
+		CustomerID:  int(request.GetCustomerId()),
 	}
 
 	for _, item := range request.GetItems() {
@@ -362,6 +364,13 @@ func (s *orderHandleGrpc) Create(ctx context.Context, request *pb.CreateOrderReq
 		})
 	}
 
+	for _, item := range request.GetBarcodes() {
+		req.Barcodes = append(req.Barcodes, requests.ScannedOrderItemRequest{
+			Barcode:  item.GetBarcode(),
+			Quantity: int(item.GetQuantity()),
+		})
+	}
+
 	if err := req.Validate(); err != nil {
 		return nil, order_errors.ErrGrpcValidateCreateOrder
 	}
@@ -384,7 +393,9 @@ func (s *orderHandleGrpc) Update(ctx context.Context, request *pb.UpdateOrderReq
 	}
 
 	req := &requests.UpdateOrderRequest{
-		OrderID: &id,
+		OrderID:     &id,
+		CouponCodes: request.GetCouponCodes(),
+		CustomerID:  int(request.GetCustomerId()),
 	}
 
 	for _, item := range request.GetItems() {
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/promotion"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/service"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/promotion_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type promotionHandleGrpc struct {
+	pb.UnimplementedPromotionServiceServer
+	promotion service.PromotionService
+}
+
+func NewPromotionHandleGrpc(service *service.Service) *promotionHandleGrpc {
+	return &promotionHandleGrpc{
+		promotion: service.Promotion,
+	}
+}
+
+func (s *promotionHandleGrpc) Create(ctx context.Context, req *pb.CreatePromotionRequest) (*pb.ApiResponsePromotion, error) {
+	if req.GetMerchantId() <= 0 {
+		return nil, promotion_errors.ErrGrpcFailedInvalidMerchantId
+	}
+
+	p := &promotion.Promotion{
+		MerchantID:  int(req.GetMerchantId()),
+		Name:        req.GetName(),
+		Kind:        promotion.Kind(req.GetKind()),
+		Value:       int(req.GetValue()),
+		BuyQuantity: int(req.GetBuyQuantity()),
+		GetQuantity: int(req.GetGetQuantity()),
+		ProductID:   int(req.GetProductId()),
+		CategoryID:  int(req.GetCategoryId()),
+		CouponCode:  req.GetCouponCode(),
+		DailyStart:  int(req.GetDailyStart()),
+		DailyEnd:    int(req.GetDailyEnd()),
+		Priority:    int(req.GetPriority()),
+		Stackable:   req.GetStackable(),
+	}
+
+	if v := req.GetStartsAt(); v != "" {
+		startsAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, promotion_errors.ErrGrpcInvalidPromotionTime
+		}
+		p.StartsAt = startsAt
+	}
+
+	if v := req.GetEndsAt(); v != "" {
+		endsAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, promotion_errors.ErrGrpcInvalidPromotionTime
+		}
+		p.EndsAt = &endsAt
+	}
+
+	res, err := s.promotion.CreatePromotion(ctx, p)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponsePromotion{
+		Status:  "success",
+		Message: "Successfully created promotion",
+		Data:    toProtoPromotion(res),
+	}, nil
+}
+
+func (s *promotionHandleGrpc) FindById(ctx context.Context, req *pb.FindByIdPromotionRequest) (*pb.ApiResponsePromotion, error) {
+	id := int(req.GetId())
+
+	if id <= 0 {
+		return nil, promotion_errors.ErrGrpcFailedInvalidId
+	}
+
+	res, err := s.promotion.FindById(ctx, id)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponsePromotion{
+		Status:  "success",
+		Message: "Successfully fetched promotion",
+		Data:    toProtoPromotion(res),
+	}, nil
+}
+
+func (s *promotionHandleGrpc) FindByMerchant(ctx context.Context, req *pb.FindPromotionsByMerchantRequest) (*pb.ApiResponsesPromotion, error) {
+	merchantID := int(req.GetMerchantId())
+
+	if merchantID <= 0 {
+		return nil, promotion_errors.ErrGrpcFailedInvalidMerchantId
+	}
+
+	res, err := s.promotion.FindByMerchant(ctx, merchantID)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	data := make([]*pb.PromotionResponse, len(res))
+	for i, p := range res {
+		data[i] = toProtoPromotion(p)
+	}
+
+	return &pb.ApiResponsesPromotion{
+		Status:  "success",
+		Message: "Successfully fetched promotions",
+		Data:    data,
+	}, nil
+}
+
+func (s *promotionHandleGrpc) Trashed(ctx context.Context, req *pb.FindByIdPromotionRequest) (*pb.ApiResponsePromotionDelete, error) {
+	id := int(req.GetId())
+
+	if id <= 0 {
+		return nil, promotion_errors.ErrGrpcFailedInvalidId
+	}
+
+	if _, err := s.promotion.TrashPromotion(ctx, id); err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponsePromotionDelete{
+		Status:  "success",
+		Message: "Successfully trashed promotion",
+	}, nil
+}
+
+func (s *promotionHandleGrpc) FindOrderDiscounts(ctx context.Context, req *pb.FindOrderDiscountsRequest) (*pb.ApiResponseOrderDiscounts, error) {
+	orderID := int(req.GetOrderId())
+
+	if orderID <= 0 {
+		return nil, promotion_errors.ErrGrpcFailedInvalidOrderId
+	}
+
+	res, err := s.promotion.FindOrderDiscounts(ctx, orderID)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	data := make([]*pb.OrderDiscountResponse, len(res))
+	for i, d := range res {
+		data[i] = &pb.OrderDiscountResponse{
+			OrderItemId:   int32(d.OrderItemID),
+			PromotionId:   int32(d.PromotionID),
+			PromotionName: d.PromotionName,
+			Amount:        int32(d.Amount),
+		}
+	}
+
+	return &pb.ApiResponseOrderDiscounts{
+		Status:  "success",
+		Message: "Successfully fetched order discounts",
+		Data:    data,
+	}, nil
+}
+
+func toProtoPromotion(p *promotion.Promotion) *pb.PromotionResponse {
+	res := &pb.PromotionResponse{
+		Id:          int32(p.ID),
+		MerchantId:  int32(p.MerchantID),
+		Name:        p.Name,
+		Kind:        string(p.Kind),
+		Value:       int32(p.Value),
+		BuyQuantity: int32(p.BuyQuantity),
+		GetQuantity: int32(p.GetQuantity),
+		ProductId:   int32(p.ProductID),
+		CategoryId:  int32(p.CategoryID),
+		CouponCode:  p.CouponCode,
+		StartsAt:    p.StartsAt.Format(time.RFC3339),
+		DailyStart:  int32(p.DailyStart),
+		DailyEnd:    int32(p.DailyEnd),
+		Priority:    int32(p.Priority),
+		Stackable:   p.Stackable,
+	}
+
+	if p.EndsAt != nil {
+		res.EndsAt = wrapperspb.String(p.EndsAt.Format(time.RFC3339))
+	}
+
+	return res
+}
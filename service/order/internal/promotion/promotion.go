@@ -0,0 +1,331 @@
+package promotion
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	// Merchants name their time zone; the service image has no zoneinfo.
+	_ "time/tzdata"
+)
+
+type Kind string
+
+const (
+	// KindPercentOff takes Value percent off the matching items.
+	KindPercentOff Kind = "percent_off"
+	// KindAmountOff takes Value off the matching items as a whole, spread
+	// over them by price.
+	KindAmountOff Kind = "amount_off"
+	// KindBuyXGetY gives GetQuantity of every BuyQuantity+GetQuantity
+	// matching units for free, the cheapest ones first.
+	KindBuyXGetY Kind = "buy_x_get_y"
+	// KindFixedPrice sells the matching units at Value each, e.g. a happy
+	// hour price.
+	KindFixedPrice Kind = "fixed_price"
+)
+
+const minutesPerDay = 24 * 60
+
+var (
+	ErrNotFound      = errors.New("promotion not found")
+	ErrInvalidRule   = errors.New("invalid promotion rule")
+	ErrInvalidWindow = errors.New("invalid promotion validity window")
+	ErrNameRequired  = errors.New("promotion name is required")
+	ErrCouponTaken   = errors.New("coupon code is already used by another promotion")
+)
+
+// Promotion is a discount rule of one merchant. A rule without a product or
+// category applies to every item; a rule with a coupon code only applies
+// when the code is presented.
+type Promotion struct {
+	ID          int        `json:"id"`
+	MerchantID  int        `json:"merchant_id"`
+	Name        string     `json:"name"`
+	Kind        Kind       `json:"kind"`
+	Value       int        `json:"value"`
+	BuyQuantity int        `json:"buy_quantity,omitempty"`
+	GetQuantity int        `json:"get_quantity,omitempty"`
+	ProductID   int        `json:"product_id,omitempty"`
+	CategoryID  int        `json:"category_id,omitempty"`
+	CouponCode  string     `json:"coupon_code,omitempty"`
+	StartsAt    time.Time  `json:"starts_at"`
+	EndsAt      *time.Time `json:"ends_at,omitempty"`
+	// DailyStart and DailyEnd limit the rule to a time of day, in minutes
+	// after midnight of the merchant's time zone. Equal values mean all day;
+	// a start after the end runs past midnight.
+	DailyStart int `json:"daily_start"`
+	DailyEnd   int `json:"daily_end"`
+	// Rules are applied from the highest priority down. A rule that is not
+	// stackable only applies to items no other rule has discounted, and no
+	// rule applies after it.
+	Priority  int  `json:"priority"`
+	Stackable bool `json:"stackable"`
+}
+
+func (p *Promotion) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return ErrNameRequired
+	}
+
+	switch p.Kind {
+	case KindPercentOff:
+		if p.Value <= 0 || p.Value > 100 {
+			return ErrInvalidRule
+		}
+	case KindAmountOff:
+		if p.Value <= 0 {
+			return ErrInvalidRule
+		}
+	case KindFixedPrice:
+		if p.Value < 0 {
+			return ErrInvalidRule
+		}
+	case KindBuyXGetY:
+		if p.BuyQuantity <= 0 || p.GetQuantity <= 0 {
+			return ErrInvalidRule
+		}
+	default:
+		return ErrInvalidRule
+	}
+
+	if p.ProductID < 0 || p.CategoryID < 0 {
+		return ErrInvalidRule
+	}
+
+	if p.EndsAt != nil && !p.EndsAt.After(p.StartsAt) {
+		return ErrInvalidWindow
+	}
+	if p.DailyStart < 0 || p.DailyStart >= minutesPerDay || p.DailyEnd < 0 || p.DailyEnd >= minutesPerDay {
+		return ErrInvalidWindow
+	}
+
+	return nil
+}
+
+// ActiveAt reports whether the rule is in its validity window at now. The
+// time of day is read in now's location, which must be the merchant's.
+func (p *Promotion) ActiveAt(now time.Time) bool {
+	if now.Before(p.StartsAt) || (p.EndsAt != nil && !now.Before(*p.EndsAt)) {
+		return false
+	}
+
+	if p.DailyStart == p.DailyEnd {
+		return true
+	}
+
+	minute := now.Hour()*60 + now.Minute()
+	if p.DailyStart < p.DailyEnd {
+		return minute >= p.DailyStart && minute < p.DailyEnd
+	}
+
+	return minute >= p.DailyStart || minute < p.DailyEnd
+}
+
+func (p *Promotion) matches(line Line) bool {
+	if p.ProductID > 0 && p.ProductID != line.ProductID {
+		return false
+	}
+	if p.CategoryID > 0 && p.CategoryID != line.CategoryID {
+		return false
+	}
+
+	return true
+}
+
+// Line is an order item as the promotions see it.
+type Line struct {
+	OrderItemID int
+	ProductID   int
+	CategoryID  int
+	Price       int
+	Quantity    int
+}
+
+// Discount is what one promotion took off one order item.
+type Discount struct {
+	OrderItemID   int    `json:"order_item_id"`
+	PromotionID   int    `json:"promotion_id"`
+	PromotionName string `json:"promotion_name"`
+	Amount        int    `json:"amount"`
+}
+
+// Result is an order priced with its promotions.
+type Result struct {
+	Gross     int
+	Discount  int
+	Total     int
+	Discounts []Discount
+	// Coupons are the presented codes that unlocked a discount, as the
+	// promotions spell them.
+	Coupons []string
+}
+
+// Evaluate applies the promotions that are active at now in the merchant's
+// time zone loc and whose coupon, if any, is among codes. Every discount is
+// taken from what is left of the item after the promotions before it, so an
+// item never goes below zero.
+func Evaluate(promotions []*Promotion, lines []Line, codes []string, now time.Time, loc *time.Location) *Result {
+	now = now.In(loc)
+
+	presented := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		presented[strings.ToUpper(strings.TrimSpace(code))] = true
+	}
+
+	rules := make([]*Promotion, 0, len(promotions))
+	for _, p := range promotions {
+		if p.ActiveAt(now) && (p.CouponCode == "" || presented[strings.ToUpper(p.CouponCode)]) {
+			rules = append(rules, p)
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Priority != rules[j].Priority {
+			return rules[i].Priority > rules[j].Priority
+		}
+		return rules[i].ID < rules[j].ID
+	})
+
+	res := &Result{Discounts: []Discount{}, Coupons: []string{}}
+	unlocked := map[string]bool{}
+	remaining := make([]int, len(lines))
+	discounted := make([]bool, len(lines))
+	locked := make([]bool, len(lines))
+
+	for i, line := range lines {
+		remaining[i] = line.Price * line.Quantity
+		res.Gross += remaining[i]
+	}
+
+	for _, p := range rules {
+		eligible := []int{}
+		for i, line := range lines {
+			if p.matches(line) && !locked[i] && remaining[i] > 0 && (p.Stackable || !discounted[i]) {
+				eligible = append(eligible, i)
+			}
+		}
+
+		for i, amount := range p.discounts(lines, remaining, eligible) {
+			if amount > remaining[i] {
+				amount = remaining[i]
+			}
+			if amount <= 0 {
+				continue
+			}
+
+			remaining[i] -= amount
+			discounted[i] = true
+			locked[i] = locked[i] || !p.Stackable
+
+			res.Discount += amount
+			res.Discounts = append(res.Discounts, Discount{
+				OrderItemID:   lines[i].OrderItemID,
+				PromotionID:   p.ID,
+				PromotionName: p.Name,
+				Amount:        amount,
+			})
+
+			if code := strings.ToUpper(p.CouponCode); code != "" && !unlocked[code] {
+				unlocked[code] = true
+				res.Coupons = append(res.Coupons, p.CouponCode)
+			}
+		}
+	}
+
+	res.Total = res.Gross - res.Discount
+
+	return res
+}
+
+// discounts returns the amount the rule takes off each line, indexed like
+// lines; lines that are not eligible get nothing.
+func (p *Promotion) discounts(lines []Line, remaining []int, eligible []int) []int {
+	amounts := make([]int, len(lines))
+	if len(eligible) == 0 {
+		return amounts
+	}
+
+	switch p.Kind {
+	case KindPercentOff:
+		for _, i := range eligible {
+			amounts[i] = remaining[i] * p.Value / 100
+		}
+
+	case KindAmountOff:
+		total := 0
+		for _, i := range eligible {
+			total += remaining[i]
+		}
+
+		off := min(p.Value, total)
+		left := off
+		for n, i := range eligible {
+			share := off * remaining[i] / total
+			if n == len(eligible)-1 {
+				share = left
+			}
+			amounts[i] = share
+			left -= share
+		}
+
+	case KindFixedPrice:
+		for _, i := range eligible {
+			if fixed := p.Value * lines[i].Quantity; remaining[i] > fixed {
+				amounts[i] = remaining[i] - fixed
+			}
+		}
+
+	case KindBuyXGetY:
+		units := 0
+		for _, i := range eligible {
+			units += lines[i].Quantity
+		}
+
+		free := units / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+
+		cheapest := append([]int(nil), eligible...)
+		sort.SliceStable(cheapest, func(a, b int) bool {
+			return remaining[cheapest[a]]/lines[cheapest[a]].Quantity < remaining[cheapest[b]]/lines[cheapest[b]].Quantity
+		})
+
+		for _, i := range cheapest {
+			if free == 0 {
+				break
+			}
+
+			n := min(free, lines[i].Quantity)
+			amounts[i] = remaining[i] * n / lines[i].Quantity
+			free -= n
+		}
+	}
+
+	return amounts
+}
+
+// Location resolves a merchant's time zone. A zone that is unset or unknown
+// falls back to UTC, so a bad setting never blocks an order.
+func Location(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// CouponCodes trims the codes presented with an order and drops the blank
+// ones.
+func CouponCodes(presented []string) []string {
+	var codes []string
+	for _, code := range presented {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	return codes
+}
@@ -0,0 +1,246 @@
+package promotion
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEvaluate(t *testing.T) {
+	const drinks, snacks = 1, 2
+
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 10:30 UTC is 17:30 in Jakarta.
+	now := time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+
+	coffee := Line{OrderItemID: 1, ProductID: 10, CategoryID: drinks, Price: 1000, Quantity: 2}
+	chips := Line{OrderItemID: 2, ProductID: 20, CategoryID: snacks, Price: 500, Quantity: 1}
+
+	tests := []struct {
+		name       string
+		promotions []*Promotion
+		lines      []Line
+		codes      []string
+		loc        *time.Location
+		want       Result
+	}{
+		{
+			name:       "percent off one category",
+			promotions: []*Promotion{{ID: 1, Name: "drinks 10%", Kind: KindPercentOff, Value: 10, CategoryID: drinks}},
+			lines:      []Line{coffee, chips},
+			want: Result{Gross: 2500, Discount: 200, Total: 2300, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 1, PromotionID: 1, PromotionName: "drinks 10%", Amount: 200},
+			}},
+		},
+		{
+			name:       "amount off is spread over the items by price",
+			promotions: []*Promotion{{ID: 1, Name: "300 off", Kind: KindAmountOff, Value: 300}},
+			lines:      []Line{coffee, {OrderItemID: 2, ProductID: 20, CategoryID: snacks, Price: 500, Quantity: 2}},
+			want: Result{Gross: 3000, Discount: 300, Total: 2700, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 1, PromotionID: 1, PromotionName: "300 off", Amount: 200},
+				{OrderItemID: 2, PromotionID: 1, PromotionName: "300 off", Amount: 100},
+			}},
+		},
+		{
+			name:       "amount off never takes an item below zero",
+			promotions: []*Promotion{{ID: 1, Name: "5000 off", Kind: KindAmountOff, Value: 5000}},
+			lines:      []Line{chips},
+			want: Result{Gross: 500, Discount: 500, Total: 0, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 2, PromotionID: 1, PromotionName: "5000 off", Amount: 500},
+			}},
+		},
+		{
+			name:       "buy two get one gives the cheapest unit",
+			promotions: []*Promotion{{ID: 1, Name: "3 for 2", Kind: KindBuyXGetY, BuyQuantity: 2, GetQuantity: 1}},
+			lines:      []Line{coffee, chips},
+			want: Result{Gross: 2500, Discount: 500, Total: 2000, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 2, PromotionID: 1, PromotionName: "3 for 2", Amount: 500},
+			}},
+		},
+		{
+			name: "happy hour runs on the merchant's clock",
+			promotions: []*Promotion{{
+				ID: 1, Name: "happy hour", Kind: KindFixedPrice, Value: 700, ProductID: 10,
+				DailyStart: 17 * 60, DailyEnd: 19 * 60,
+			}},
+			lines: []Line{coffee},
+			loc:   jakarta,
+			want: Result{Gross: 2000, Discount: 600, Total: 1400, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 1, PromotionID: 1, PromotionName: "happy hour", Amount: 600},
+			}},
+		},
+		{
+			name: "happy hour is over in UTC",
+			promotions: []*Promotion{{
+				ID: 1, Name: "happy hour", Kind: KindFixedPrice, Value: 700, ProductID: 10,
+				DailyStart: 17 * 60, DailyEnd: 19 * 60,
+			}},
+			lines: []Line{coffee},
+			loc:   time.UTC,
+			want:  Result{Gross: 2000, Discount: 0, Total: 2000, Coupons: []string{}, Discounts: []Discount{}},
+		},
+		{
+			name: "daily window past midnight",
+			promotions: []*Promotion{{
+				ID: 1, Name: "late night", Kind: KindPercentOff, Value: 50,
+				DailyStart: 17 * 60, DailyEnd: 2 * 60,
+			}},
+			lines: []Line{chips},
+			want: Result{Gross: 500, Discount: 250, Total: 250, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 2, PromotionID: 1, PromotionName: "late night", Amount: 250},
+			}},
+		},
+		{
+			name: "ended promotions do not apply",
+			promotions: []*Promotion{{
+				ID: 1, Name: "last week", Kind: KindPercentOff, Value: 10,
+				StartsAt: yesterday.Add(-7 * 24 * time.Hour), EndsAt: &yesterday,
+			}},
+			lines: []Line{chips},
+			want:  Result{Gross: 500, Discount: 0, Total: 500, Coupons: []string{}, Discounts: []Discount{}},
+		},
+		{
+			name:       "coupon applies when presented in any case",
+			promotions: []*Promotion{{ID: 1, Name: "coupon", Kind: KindPercentOff, Value: 10, CouponCode: "SAVE10"}},
+			lines:      []Line{chips},
+			codes:      []string{" save10 ", "UNKNOWN"},
+			want: Result{Gross: 500, Discount: 50, Total: 450, Coupons: []string{"SAVE10"}, Discounts: []Discount{
+				{OrderItemID: 2, PromotionID: 1, PromotionName: "coupon", Amount: 50},
+			}},
+		},
+		{
+			name:       "coupon does not apply without its code",
+			promotions: []*Promotion{{ID: 1, Name: "coupon", Kind: KindPercentOff, Value: 10, CouponCode: "SAVE10"}},
+			lines:      []Line{chips},
+			want:       Result{Gross: 500, Discount: 0, Total: 500, Coupons: []string{}, Discounts: []Discount{}},
+		},
+		{
+			name: "coupon that unlocks nothing is not kept",
+			promotions: []*Promotion{{
+				ID: 1, Name: "drinks coupon", Kind: KindPercentOff, Value: 10, CategoryID: drinks, CouponCode: "DRINKS",
+			}},
+			lines: []Line{chips},
+			codes: []string{"DRINKS"},
+			want:  Result{Gross: 500, Discount: 0, Total: 500, Coupons: []string{}, Discounts: []Discount{}},
+		},
+		{
+			name: "stackable promotions compound by priority",
+			promotions: []*Promotion{
+				{ID: 1, Name: "low", Kind: KindPercentOff, Value: 10, Priority: 1, Stackable: true},
+				{ID: 2, Name: "high", Kind: KindPercentOff, Value: 10, Priority: 2, Stackable: true},
+			},
+			lines: []Line{{OrderItemID: 1, ProductID: 10, Price: 1000, Quantity: 1}},
+			want: Result{Gross: 1000, Discount: 190, Total: 810, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 1, PromotionID: 2, PromotionName: "high", Amount: 100},
+				{OrderItemID: 1, PromotionID: 1, PromotionName: "low", Amount: 90},
+			}},
+		},
+		{
+			name: "a promotion that does not stack locks the item",
+			promotions: []*Promotion{
+				{ID: 1, Name: "half off", Kind: KindPercentOff, Value: 50, Priority: 2},
+				{ID: 2, Name: "extra", Kind: KindPercentOff, Value: 10, Priority: 1, Stackable: true},
+			},
+			lines: []Line{{OrderItemID: 1, ProductID: 10, Price: 1000, Quantity: 1}},
+			want: Result{Gross: 1000, Discount: 500, Total: 500, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 1, PromotionID: 1, PromotionName: "half off", Amount: 500},
+			}},
+		},
+		{
+			name: "a promotion that does not stack skips discounted items",
+			promotions: []*Promotion{
+				{ID: 1, Name: "first", Kind: KindPercentOff, Value: 10, Priority: 2, Stackable: true},
+				{ID: 2, Name: "half off", Kind: KindPercentOff, Value: 50, Priority: 1},
+			},
+			lines: []Line{{OrderItemID: 1, ProductID: 10, Price: 1000, Quantity: 1}},
+			want: Result{Gross: 1000, Discount: 100, Total: 900, Coupons: []string{}, Discounts: []Discount{
+				{OrderItemID: 1, PromotionID: 1, PromotionName: "first", Amount: 100},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := tt.loc
+			if loc == nil {
+				loc = jakarta
+			}
+
+			got := Evaluate(tt.promotions, tt.lines, tt.codes, now, loc)
+
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("Evaluate() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPromotionValidate(t *testing.T) {
+	start := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		promotion Promotion
+		want      error
+	}{
+		{
+			name:      "valid percent off",
+			promotion: Promotion{Name: "sale", Kind: KindPercentOff, Value: 10},
+		},
+		{
+			name:      "name is required",
+			promotion: Promotion{Name: " ", Kind: KindPercentOff, Value: 10},
+			want:      ErrNameRequired,
+		},
+		{
+			name:      "percent off above 100",
+			promotion: Promotion{Name: "sale", Kind: KindPercentOff, Value: 101},
+			want:      ErrInvalidRule,
+		},
+		{
+			name:      "buy x get y needs both quantities",
+			promotion: Promotion{Name: "sale", Kind: KindBuyXGetY, BuyQuantity: 2},
+			want:      ErrInvalidRule,
+		},
+		{
+			name:      "unknown kind",
+			promotion: Promotion{Name: "sale", Kind: "free_lunch", Value: 1},
+			want:      ErrInvalidRule,
+		},
+		{
+			name:      "end before start",
+			promotion: Promotion{Name: "sale", Kind: KindAmountOff, Value: 100, StartsAt: start, EndsAt: &start},
+			want:      ErrInvalidWindow,
+		},
+		{
+			name:      "daily window out of range",
+			promotion: Promotion{Name: "sale", Kind: KindAmountOff, Value: 100, DailyEnd: minutesPerDay},
+			want:      ErrInvalidWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.promotion.Validate(); err != tt.want {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocation(t *testing.T) {
+	if got := Location("Asia/Jakarta").String(); got != "Asia/Jakarta" {
+		t.Errorf("Location(Asia/Jakarta) = %s", got)
+	}
+
+	for _, name := range []string{"", "Not/AZone"} {
+		if got := Location(name); got != time.UTC {
+			t.Errorf("Location(%q) = %s, want UTC", name, got)
+		}
+	}
+}
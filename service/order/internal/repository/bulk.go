@@ -0,0 +1,9 @@
+package repository
+
+import "github.com/MamangRust/monolith-point-of-sale-common/bulk"
+
+// Tables the DeleteAll and RestoreAll calls of this service run on.
+var (
+	bulkOrders     = bulk.Table{Name: "orders", ID: "order_id", Merchant: "merchant_id = $1"}
+	bulkOrderItems = bulk.Table{Name: "order_items", ID: "order_id", Merchant: "order_id IN (SELECT order_id FROM orders WHERE merchant_id = $1)"}
+)
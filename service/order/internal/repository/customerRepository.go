@@ -0,0 +1,466 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/customer"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrFindCustomer         = errors.New("failed to find customer")
+	ErrCreateCustomer       = errors.New("failed to create customer")
+	ErrUpdateCustomer       = errors.New("failed to update customer")
+	ErrTrashCustomer        = errors.New("failed to trash customer")
+	ErrRestoreCustomer      = errors.New("failed to restore customer")
+	ErrDeleteCustomer       = errors.New("failed to permanently delete customer")
+	ErrAttachCustomer       = errors.New("failed to attach customer to order")
+	ErrFindCustomerPurchase = errors.New("failed to find customer purchases")
+	ErrFindCustomerStats    = errors.New("failed to find customer stats")
+)
+
+const createCustomer = `-- name: CreateCustomer :one
+INSERT INTO customers (merchant_id, name, email, phone)
+VALUES ($1, $2, $3, $4)
+RETURNING customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at
+`
+
+const getCustomer = `-- name: GetCustomer :one
+SELECT customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at
+FROM customers
+WHERE customer_id = $1
+    AND deleted_at IS NULL
+`
+
+const getTrashedCustomer = `-- name: GetTrashedCustomer :one
+SELECT customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at
+FROM customers
+WHERE customer_id = $1
+    AND deleted_at IS NOT NULL
+`
+
+const getCustomerByContact = `-- name: GetCustomerByContact :one
+SELECT customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at
+FROM customers
+WHERE merchant_id = $1
+    AND deleted_at IS NULL
+    AND (($2::TEXT <> '' AND phone = $2) OR ($3::TEXT <> '' AND LOWER(email) = $3))
+ORDER BY customer_id
+LIMIT 1
+`
+
+const getCustomersByMerchant = `-- name: GetCustomersByMerchant :many
+SELECT customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM customers
+WHERE merchant_id = $1
+    AND deleted_at IS NULL
+    AND ($2::TEXT = '' OR name ILIKE '%' || $2 || '%' OR email ILIKE '%' || $2 || '%' OR phone ILIKE '%' || $2 || '%')
+ORDER BY name, customer_id
+LIMIT $3 OFFSET $4
+`
+
+const getCustomersTrashedByMerchant = `-- name: GetCustomersTrashedByMerchant :many
+SELECT customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM customers
+WHERE merchant_id = $1
+    AND deleted_at IS NOT NULL
+    AND ($2::TEXT = '' OR name ILIKE '%' || $2 || '%' OR email ILIKE '%' || $2 || '%' OR phone ILIKE '%' || $2 || '%')
+ORDER BY deleted_at DESC, customer_id
+LIMIT $3 OFFSET $4
+`
+
+const updateCustomer = `-- name: UpdateCustomer :one
+UPDATE customers
+SET name = $2,
+    email = $3,
+    phone = $4,
+    updated_at = current_timestamp
+WHERE customer_id = $1
+    AND deleted_at IS NULL
+RETURNING customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at
+`
+
+const trashCustomer = `-- name: TrashCustomer :one
+UPDATE customers
+SET deleted_at = current_timestamp
+WHERE customer_id = $1
+    AND deleted_at IS NULL
+RETURNING customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at
+`
+
+const restoreCustomer = `-- name: RestoreCustomer :one
+UPDATE customers
+SET deleted_at = NULL
+WHERE customer_id = $1
+    AND deleted_at IS NOT NULL
+RETURNING customer_id, merchant_id, name, email, phone, created_at, updated_at, deleted_at
+`
+
+const deleteCustomerPermanent = `-- name: DeleteCustomerPermanent :execrows
+DELETE FROM customers
+WHERE customer_id = $1
+    AND deleted_at IS NOT NULL
+`
+
+const attachOrderCustomer = `-- name: AttachOrderCustomer :execrows
+UPDATE orders
+SET customer_id = $2,
+    updated_at = current_timestamp
+WHERE order_id = $1
+    AND deleted_at IS NULL
+`
+
+// A purchase is paid by the latest live transaction of its order.
+const getCustomerPurchases = `-- name: GetCustomerPurchases :many
+SELECT
+    o.order_id, o.merchant_id, o.cashier_id, o.total_price,
+    COALESCE(t.transaction_id, 0), COALESCE(t.payment_status, ''),
+    CASE WHEN t.payment_status IN ('success', 'partially_refunded', 'refunded') THEN t.amount ELSE 0 END,
+    COALESCE((SELECT SUM(r.amount) FROM transaction_refunds r WHERE r.transaction_id = t.transaction_id), 0)::int,
+    o.created_at,
+    COUNT(*) OVER() AS total_count
+FROM orders o
+LEFT JOIN LATERAL (
+    SELECT transaction_id, payment_status, amount
+    FROM transactions
+    WHERE order_id = o.order_id
+        AND deleted_at IS NULL
+    ORDER BY created_at DESC
+    LIMIT 1
+) t ON TRUE
+WHERE o.customer_id = $1
+    AND o.deleted_at IS NULL
+ORDER BY o.created_at DESC, o.order_id DESC
+LIMIT $2 OFFSET $3
+`
+
+const getCustomerStats = `-- name: GetCustomerStats :one
+SELECT
+    COUNT(*)::int,
+    COUNT(DISTINCT o.created_at::date)::int,
+    COALESCE(SUM(t.amount), 0)::int,
+    COALESCE(SUM(r.amount), 0)::int,
+    MIN(o.created_at),
+    MAX(o.created_at)
+FROM orders o
+JOIN transactions t ON t.order_id = o.order_id
+    AND t.deleted_at IS NULL
+    AND t.payment_status IN ('success', 'partially_refunded', 'refunded')
+LEFT JOIN LATERAL (
+    SELECT SUM(amount) AS amount
+    FROM transaction_refunds
+    WHERE transaction_id = t.transaction_id
+) r ON TRUE
+WHERE o.customer_id = $1
+    AND o.deleted_at IS NULL
+`
+
+type customerRepository struct {
+	conn db.DBTX
+}
+
+func NewCustomerRepository(conn db.DBTX) *customerRepository {
+	return &customerRepository{
+		conn: conn,
+	}
+}
+
+func (r *customerRepository) CreateCustomer(ctx context.Context, req *customer.CreateRequest) (*customer.Customer, error) {
+	if !tenant.Allows(ctx, req.MerchantID) {
+		return nil, customer.ErrNotFound
+	}
+
+	res, err := scanCustomer(r.conn.QueryRowContext(ctx, createCustomer,
+		req.MerchantID,
+		req.Name,
+		nullString(req.Email),
+		nullString(req.Phone),
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, customer.ErrContactTaken
+		}
+		return nil, ErrCreateCustomer
+	}
+
+	return res, nil
+}
+
+func (r *customerRepository) FindById(ctx context.Context, customer_id int) (*customer.Customer, error) {
+	return r.findOne(ctx, getCustomer, customer_id)
+}
+
+func (r *customerRepository) FindTrashedById(ctx context.Context, customer_id int) (*customer.Customer, error) {
+	return r.findOne(ctx, getTrashedCustomer, customer_id)
+}
+
+func (r *customerRepository) FindByContact(ctx context.Context, merchant_id int, phone string, email string) (*customer.Customer, error) {
+	if !tenant.Allows(ctx, merchant_id) {
+		return nil, customer.ErrNotFound
+	}
+
+	res, err := scanCustomer(r.conn.QueryRowContext(ctx, getCustomerByContact, merchant_id, phone, email))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customer.ErrNotFound
+		}
+		return nil, ErrFindCustomer
+	}
+
+	return res, nil
+}
+
+func (r *customerRepository) FindByMerchant(ctx context.Context, req *customer.FindAllRequest) ([]*customer.Customer, *int, error) {
+	return r.findPage(ctx, getCustomersByMerchant, req)
+}
+
+func (r *customerRepository) FindTrashedByMerchant(ctx context.Context, req *customer.FindAllRequest) ([]*customer.Customer, *int, error) {
+	return r.findPage(ctx, getCustomersTrashedByMerchant, req)
+}
+
+func (r *customerRepository) UpdateCustomer(ctx context.Context, req *customer.UpdateRequest) (*customer.Customer, error) {
+	res, err := scanCustomer(r.conn.QueryRowContext(ctx, updateCustomer,
+		req.CustomerID,
+		req.Name,
+		nullString(req.Email),
+		nullString(req.Phone),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customer.ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return nil, customer.ErrContactTaken
+		}
+		return nil, ErrUpdateCustomer
+	}
+
+	return res, nil
+}
+
+func (r *customerRepository) TrashCustomer(ctx context.Context, customer_id int) (*customer.Customer, error) {
+	res, err := scanCustomer(r.conn.QueryRowContext(ctx, trashCustomer, customer_id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customer.ErrNotFound
+		}
+		return nil, ErrTrashCustomer
+	}
+
+	return res, nil
+}
+
+func (r *customerRepository) RestoreCustomer(ctx context.Context, customer_id int) (*customer.Customer, error) {
+	res, err := scanCustomer(r.conn.QueryRowContext(ctx, restoreCustomer, customer_id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customer.ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return nil, customer.ErrContactTaken
+		}
+		return nil, ErrRestoreCustomer
+	}
+
+	return res, nil
+}
+
+// DeleteCustomerPermanent removes a trashed customer. Their orders stay and
+// lose the link.
+func (r *customerRepository) DeleteCustomerPermanent(ctx context.Context, customer_id int) (bool, error) {
+	res, err := r.conn.ExecContext(ctx, deleteCustomerPermanent, customer_id)
+	if err != nil {
+		return false, ErrDeleteCustomer
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrDeleteCustomer
+	}
+	if rows == 0 {
+		return false, customer.ErrNotFound
+	}
+
+	return true, nil
+}
+
+func (r *customerRepository) AttachOrder(ctx context.Context, order_id int, customer_id int) error {
+	res, err := r.conn.ExecContext(ctx, attachOrderCustomer, order_id, customer_id)
+	if err != nil {
+		return ErrAttachCustomer
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return ErrAttachCustomer
+	}
+	if rows == 0 {
+		return ErrAttachCustomer
+	}
+
+	return nil
+}
+
+func (r *customerRepository) FindPurchases(ctx context.Context, req *customer.PurchasesRequest) ([]*customer.Purchase, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	rows, err := r.conn.QueryContext(ctx, getCustomerPurchases, req.CustomerID, req.PageSize, offset)
+	if err != nil {
+		return nil, nil, ErrFindCustomerPurchase
+	}
+	defer rows.Close()
+
+	purchases := []*customer.Purchase{}
+	total := 0
+	for rows.Next() {
+		var (
+			p         customer.Purchase
+			orderedAt sql.NullTime
+		)
+
+		if err := rows.Scan(
+			&p.OrderID,
+			&p.MerchantID,
+			&p.CashierID,
+			&p.TotalPrice,
+			&p.TransactionID,
+			&p.PaymentStatus,
+			&p.Paid,
+			&p.Refunded,
+			&orderedAt,
+			&total,
+		); err != nil {
+			return nil, nil, ErrFindCustomerPurchase
+		}
+
+		p.OrderedAt = orderedAt.Time
+		purchases = append(purchases, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, ErrFindCustomerPurchase
+	}
+
+	return purchases, &total, nil
+}
+
+func (r *customerRepository) FindStats(ctx context.Context, customer_id int) (*customer.Stats, error) {
+	var (
+		stats       = customer.Stats{CustomerID: customer_id}
+		paid        int
+		first, last sql.NullTime
+	)
+
+	err := r.conn.QueryRowContext(ctx, getCustomerStats, customer_id).Scan(
+		&stats.Orders,
+		&stats.Visits,
+		&paid,
+		&stats.Refunded,
+		&first,
+		&last,
+	)
+	if err != nil {
+		return nil, ErrFindCustomerStats
+	}
+
+	stats.LifetimeSpend = paid - stats.Refunded
+	if first.Valid {
+		stats.FirstVisit = &first.Time
+	}
+	if last.Valid {
+		stats.LastVisit = &last.Time
+	}
+
+	stats.Derive()
+
+	return &stats, nil
+}
+
+// findOne loads a customer the caller may see.
+func (r *customerRepository) findOne(ctx context.Context, query string, customer_id int) (*customer.Customer, error) {
+	res, err := scanCustomer(r.conn.QueryRowContext(ctx, query, customer_id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, customer.ErrNotFound
+		}
+		return nil, ErrFindCustomer
+	}
+
+	if !tenant.Allows(ctx, res.MerchantID) {
+		return nil, customer.ErrNotFound
+	}
+
+	return res, nil
+}
+
+func (r *customerRepository) findPage(ctx context.Context, query string, req *customer.FindAllRequest) ([]*customer.Customer, *int, error) {
+	if !tenant.Allows(ctx, req.MerchantID) {
+		return nil, nil, customer.ErrNotFound
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+
+	rows, err := r.conn.QueryContext(ctx, query, req.MerchantID, req.Search, req.PageSize, offset)
+	if err != nil {
+		return nil, nil, ErrFindCustomer
+	}
+	defer rows.Close()
+
+	customers := []*customer.Customer{}
+	total := 0
+	for rows.Next() {
+		c, err := scanCustomer(rows, &total)
+		if err != nil {
+			return nil, nil, ErrFindCustomer
+		}
+		customers = append(customers, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, ErrFindCustomer
+	}
+
+	return customers, &total, nil
+}
+
+// scanCustomer scans a customer followed by any extra columns of the query,
+// such as its total count.
+func scanCustomer(row interface{ Scan(dest ...any) error }, extra ...any) (*customer.Customer, error) {
+	var (
+		c                    customer.Customer
+		email, phone         sql.NullString
+		createdAt, updatedAt sql.NullTime
+		deletedAt            sql.NullTime
+	)
+
+	dest := []any{&c.ID, &c.MerchantID, &c.Name, &email, &phone, &createdAt, &updatedAt, &deletedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	c.Email = email.String
+	c.Phone = phone.String
+	c.CreatedAt = createdAt.Time
+	c.UpdatedAt = updatedAt.Time
+
+	if deletedAt.Valid {
+		c.DeletedAt = &deletedAt.Time
+	}
+
+	return &c, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
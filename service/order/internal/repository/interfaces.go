@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/customer"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/promotion"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 )
@@ -27,6 +31,7 @@ type OrderQueryRepository interface {
 	FindByTrashed(ctx context.Context, req *requests.FindAllOrders) ([]*record.OrderRecord, *int, error)
 	FindByMerchant(ctx context.Context, req *requests.FindAllOrderMerchant) ([]*record.OrderRecord, *int, error)
 	FindById(ctx context.Context, orderID int) (*record.OrderRecord, error)
+	FindMerchantIdByOrder(ctx context.Context, orderID int) (int, error)
 }
 
 type OrderCommandRepository interface {
@@ -48,16 +53,56 @@ type MerchantQueryRepository interface {
 }
 
 type ProductQueryRepository interface {
-	FindById(ctx context.Context, product_id int) (*record.ProductRecord, error)
+	FindById(ctx context.Context, merchant_id int, product_id int) (*record.ProductRecord, error)
+	FindByBarcode(ctx context.Context, merchant_id int, code string) (*record.ProductRecord, error)
 }
 
 type ProductCommandRepository interface {
 	UpdateProductCountStock(ctx context.Context, productID int, stock int) (*record.ProductRecord, error)
+	DecrementProductCountStock(ctx context.Context, productID int, quantity int) (*record.ProductRecord, error)
+	IncrementProductCountStock(ctx context.Context, productID int, quantity int) (*record.ProductRecord, error)
 }
 
 type OrderItemQueryRepository interface {
 	FindOrderItemByOrder(ctx context.Context, orderID int) ([]*record.OrderItemRecord, error)
+	FindTrashedOrderItemByOrder(ctx context.Context, orderID int) ([]*record.OrderItemRecord, error)
+	FindTrashedOrderItems(ctx context.Context) ([]*record.OrderItemRecord, error)
 	CalculateTotalPrice(ctx context.Context, orderID int) (*int32, error)
+	FindRefundedQuantities(ctx context.Context, items []*record.OrderItemRecord) (map[int]int, error)
+}
+
+type PromotionRepository interface {
+	CreatePromotion(ctx context.Context, req *promotion.Promotion) (*promotion.Promotion, error)
+	FindById(ctx context.Context, promotion_id int) (*promotion.Promotion, error)
+	FindByMerchant(ctx context.Context, merchant_id int) ([]*promotion.Promotion, error)
+	FindActiveByMerchant(ctx context.Context, merchant_id int) ([]*promotion.Promotion, error)
+	TrashPromotion(ctx context.Context, promotion_id int) error
+	FindPricedItems(ctx context.Context, order_id int) ([]promotion.Line, error)
+	ReplaceDiscounts(ctx context.Context, order_id int, discounts []promotion.Discount) error
+	FindCouponCodes(ctx context.Context, order_id int) ([]string, error)
+	SaveCouponCodes(ctx context.Context, order_id int, codes []string) error
+	FindMerchantTimezone(ctx context.Context, merchant_id int) (string, error)
+	FindDiscountsByOrder(ctx context.Context, order_id int) ([]promotion.Discount, error)
+}
+
+type CustomerRepository interface {
+	CreateCustomer(ctx context.Context, req *customer.CreateRequest) (*customer.Customer, error)
+	FindById(ctx context.Context, customer_id int) (*customer.Customer, error)
+	FindTrashedById(ctx context.Context, customer_id int) (*customer.Customer, error)
+	FindByContact(ctx context.Context, merchant_id int, phone string, email string) (*customer.Customer, error)
+	FindByMerchant(ctx context.Context, req *customer.FindAllRequest) ([]*customer.Customer, *int, error)
+	FindTrashedByMerchant(ctx context.Context, req *customer.FindAllRequest) ([]*customer.Customer, *int, error)
+	UpdateCustomer(ctx context.Context, req *customer.UpdateRequest) (*customer.Customer, error)
+	TrashCustomer(ctx context.Context, customer_id int) (*customer.Customer, error)
+	RestoreCustomer(ctx context.Context, customer_id int) (*customer.Customer, error)
+	DeleteCustomerPermanent(ctx context.Context, customer_id int) (bool, error)
+	AttachOrder(ctx context.Context, order_id int, customer_id int) error
+	FindPurchases(ctx context.Context, req *customer.PurchasesRequest) ([]*customer.Purchase, *int, error)
+	FindStats(ctx context.Context, customer_id int) (*customer.Stats, error)
+}
+
+type UnitOfWork interface {
+	WithTx(ctx context.Context, fn func(tx *TxRepositories) error) error
 }
 
 type OrderItemCommandRepository interface {
@@ -69,3 +114,16 @@ type OrderItemCommandRepository interface {
 	RestoreAllOrderItem(ctx context.Context) (bool, error)
 	DeleteAllOrderPermanent(ctx context.Context) (bool, error)
 }
+
+type TenantRepository interface {
+	FindMerchantIDsByUser(ctx context.Context, userID int) ([]int, error)
+}
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/merchant_errors"
@@ -29,5 +30,9 @@ func (r *merchantQueryRepository) FindById(ctx context.Context, user_id int) (*r
 		return nil, merchant_errors.ErrFindById
 	}
 
+	if !tenant.Allows(ctx, int(res.MerchantID)) {
+		return nil, merchant_errors.ErrFindById
+	}
+
 	return r.mapping.ToMerchantRecord(res), nil
 }
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -12,12 +13,14 @@ import (
 
 type orderCommandRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.OrderRecordMapping
 }
 
-func NewOrderCommandRepository(db *db.Queries, mapping recordmapper.OrderRecordMapping) *orderCommandRepository {
+func NewOrderCommandRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.OrderRecordMapping) *orderCommandRepository {
 	return &orderCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -84,19 +87,25 @@ func (r *orderCommandRepository) DeleteOrderPermanent(ctx context.Context, user_
 }
 
 func (r *orderCommandRepository) RestoreAllOrder(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllOrders(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkOrders, bulk.Restore)
 
 	if err != nil {
 		return false, order_errors.ErrRestoreAllOrder
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *orderCommandRepository) DeleteAllOrderPermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentOrders(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkOrders, bulk.Delete)
 
 	if err != nil {
 		return false, order_errors.ErrDeleteAllOrderPermanent
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
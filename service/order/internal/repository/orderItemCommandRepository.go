@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
@@ -12,12 +13,14 @@ import (
 
 type orderItemCommandRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.OrderItemRecordMapping
 }
 
-func NewOrderItemCommandRepository(db *db.Queries, mapping recordmapper.OrderItemRecordMapping) *orderItemCommandRepository {
+func NewOrderItemCommandRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.OrderItemRecordMapping) *orderItemCommandRepository {
 	return &orderItemCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -82,7 +85,7 @@ func (r *orderItemCommandRepository) DeleteOrderItemPermanent(ctx context.Contex
 }
 
 func (r *orderItemCommandRepository) RestoreAllOrderItem(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllUsers(ctx)
+	_, err := bulk.Apply(ctx, r.conn, bulkOrderItems, bulk.Restore)
 
 	if err != nil {
 		return false, orderitem_errors.ErrRestoreAllOrderItem
@@ -91,7 +94,7 @@ func (r *orderItemCommandRepository) RestoreAllOrderItem(ctx context.Context) (b
 }
 
 func (r *orderItemCommandRepository) DeleteAllOrderPermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentOrders(ctx)
+	_, err := bulk.Apply(ctx, r.conn, bulkOrderItems, bulk.Delete)
 
 	if err != nil {
 		return false, orderitem_errors.ErrDeleteAllOrderPermanent
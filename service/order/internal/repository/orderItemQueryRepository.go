@@ -7,16 +7,40 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	orderitem_errors "github.com/MamangRust/monolith-point-of-sale-shared/errors/order_item_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/lib/pq"
 )
 
+const getTrashedOrderItemsByOrder = `-- name: GetTrashedOrderItemsByOrder :many
+SELECT order_item_id, order_id, product_id, quantity, price, created_at, updated_at, deleted_at
+FROM order_items
+WHERE order_id = $1
+  AND deleted_at IS NOT NULL
+`
+
+// Followed by the condition of a bulk scope.
+const getTrashedOrderItemsInScope = `-- name: GetTrashedOrderItemsInScope :many
+SELECT order_item_id, order_id, product_id, quantity, price, created_at, updated_at, deleted_at
+FROM order_items
+WHERE `
+
+// Refunds and voids give units back to stock when they are made.
+const getRefundedQuantities = `-- name: GetRefundedQuantities :many
+SELECT order_item_id, SUM(quantity)::int
+FROM transaction_refund_items
+WHERE order_item_id = ANY($1::INT[])
+GROUP BY order_item_id
+`
+
 type orderItemQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.OrderItemRecordMapping
 }
 
-func NewOrderItemQueryRepository(db *db.Queries, mapping recordmapper.OrderItemRecordMapping) *orderItemQueryRepository {
+func NewOrderItemQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.OrderItemRecordMapping) *orderItemQueryRepository {
 	return &orderItemQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -41,3 +65,95 @@ func (r *orderItemQueryRepository) FindOrderItemByOrder(ctx context.Context, ord
 
 	return r.mapping.ToOrderItemsRecord(res), nil
 }
+
+func (r *orderItemQueryRepository) FindTrashedOrderItemByOrder(ctx context.Context, order_id int) ([]*record.OrderItemRecord, error) {
+	res, err := r.queryOrderItems(ctx, getTrashedOrderItemsByOrder, int32(order_id))
+
+	if err != nil {
+		return nil, orderitem_errors.ErrFindOrderItemByOrder
+	}
+
+	return r.mapping.ToOrderItemsRecord(res), nil
+}
+
+// FindTrashedOrderItems returns the trashed items in the bulk scope of ctx.
+func (r *orderItemQueryRepository) FindTrashedOrderItems(ctx context.Context) ([]*record.OrderItemRecord, error) {
+	condition, args, _, err := bulkOrderItems.Where(ctx)
+	if err != nil {
+		return nil, orderitem_errors.ErrFindByTrashed
+	}
+
+	res, err := r.queryOrderItems(ctx, getTrashedOrderItemsInScope+condition, args...)
+
+	if err != nil {
+		return nil, orderitem_errors.ErrFindByTrashed
+	}
+
+	return r.mapping.ToOrderItemsRecord(res), nil
+}
+
+// FindRefundedQuantities returns, per order item, the units refunds have
+// already returned to stock.
+func (r *orderItemQueryRepository) FindRefundedQuantities(ctx context.Context, items []*record.OrderItemRecord) (map[int]int, error) {
+	refunded := make(map[int]int)
+	if len(items) == 0 {
+		return refunded, nil
+	}
+
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = int64(item.ID)
+	}
+
+	rows, err := r.conn.QueryContext(ctx, getRefundedQuantities, pq.Array(ids))
+	if err != nil {
+		return nil, orderitem_errors.ErrFindRefundedQuantities
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, quantity int
+		if err := rows.Scan(&id, &quantity); err != nil {
+			return nil, orderitem_errors.ErrFindRefundedQuantities
+		}
+		refunded[id] = quantity
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, orderitem_errors.ErrFindRefundedQuantities
+	}
+
+	return refunded, nil
+}
+
+func (r *orderItemQueryRepository) queryOrderItems(ctx context.Context, query string, args ...interface{}) ([]*db.OrderItem, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*db.OrderItem{}
+	for rows.Next() {
+		var i db.OrderItem
+		if err := rows.Scan(
+			&i.OrderItemID,
+			&i.OrderID,
+			&i.ProductID,
+			&i.Quantity,
+			&i.Price,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
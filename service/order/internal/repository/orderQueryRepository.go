@@ -3,21 +3,56 @@ package repository
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/order_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/lib/pq"
 )
 
+// Merchant-scoped variants of GetOrders / GetOrdersTrashed used for callers
+// that are restricted to their own merchants.
+const getOrdersByMerchantIDs = `-- name: GetOrdersByMerchantIDs :many
+SELECT
+    order_id, merchant_id, cashier_id, total_price, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM orders
+WHERE deleted_at IS NULL
+AND ($1::TEXT IS NULL OR order_id::TEXT ILIKE '%' || $1 || '%' OR total_price::TEXT ILIKE '%' || $1 || '%')
+AND merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+const getOrdersTrashedByMerchantIDs = `-- name: GetOrdersTrashedByMerchantIDs :many
+SELECT
+    order_id, merchant_id, cashier_id, total_price, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM orders
+WHERE deleted_at IS NOT NULL
+AND ($1::TEXT IS NULL OR order_id::TEXT ILIKE '%' || $1 || '%' OR total_price::TEXT ILIKE '%' || $1 || '%')
+AND merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+// getOrderOwner finds the merchant of an order, trashed or not.
+const getOrderOwner = `-- name: GetOrderOwner :one
+SELECT merchant_id FROM orders WHERE order_id = $1
+`
+
 type orderQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.OrderRecordMapping
 }
 
-func NewOrderQueryRepository(db *db.Queries, mapping recordmapper.OrderRecordMapping) *orderQueryRepository {
+func NewOrderQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.OrderRecordMapping) *orderQueryRepository {
 	return &orderQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -25,6 +60,15 @@ func NewOrderQueryRepository(db *db.Queries, mapping recordmapper.OrderRecordMap
 func (r *orderQueryRepository) FindAllOrders(ctx context.Context, req *requests.FindAllOrders) ([]*record.OrderRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getOrdersByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, order_errors.ErrFindAllOrders
+		}
+
+		return r.mapping.ToOrdersRecordPagination(res), totalCount(res), nil
+	}
+
 	reqDb := db.GetOrdersParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -51,6 +95,20 @@ func (r *orderQueryRepository) FindAllOrders(ctx context.Context, req *requests.
 func (r *orderQueryRepository) FindByActive(ctx context.Context, req *requests.FindAllOrders) ([]*record.OrderRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getOrdersByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, order_errors.ErrFindByActive
+		}
+
+		active := make([]*db.GetOrdersActiveRow, len(res))
+		for i, row := range res {
+			active[i] = (*db.GetOrdersActiveRow)(row)
+		}
+
+		return r.mapping.ToOrdersRecordActivePagination(active), totalCount(res), nil
+	}
+
 	reqDb := db.GetOrdersActiveParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -77,6 +135,20 @@ func (r *orderQueryRepository) FindByActive(ctx context.Context, req *requests.F
 func (r *orderQueryRepository) FindByTrashed(ctx context.Context, req *requests.FindAllOrders) ([]*record.OrderRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getOrdersTrashedByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, order_errors.ErrFindByTrashed
+		}
+
+		trashed := make([]*db.GetOrdersTrashedRow, len(res))
+		for i, row := range res {
+			trashed[i] = (*db.GetOrdersTrashedRow)(row)
+		}
+
+		return r.mapping.ToOrdersRecordTrashedPagination(trashed), totalCount(res), nil
+	}
+
 	reqDb := db.GetOrdersTrashedParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -103,6 +175,11 @@ func (r *orderQueryRepository) FindByTrashed(ctx context.Context, req *requests.
 func (r *orderQueryRepository) FindByMerchant(ctx context.Context, req *requests.FindAllOrderMerchant) ([]*record.OrderRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if !tenant.Allows(ctx, req.MerchantID) {
+		zero := 0
+		return []*record.OrderRecord{}, &zero, nil
+	}
+
 	reqDb := db.GetOrdersByMerchantParams{
 		Column1: req.Search,
 		Column4: int32(req.MerchantID),
@@ -134,5 +211,64 @@ func (r *orderQueryRepository) FindById(ctx context.Context, user_id int) (*reco
 		return nil, order_errors.ErrFindById
 	}
 
+	if !tenant.Allows(ctx, int(res.MerchantID)) {
+		return nil, order_errors.ErrFindById
+	}
+
 	return r.mapping.ToOrderRecord(res), nil
 }
+
+// FindMerchantIdByOrder returns the merchant an order belongs to, including
+// trashed orders.
+func (r *orderQueryRepository) FindMerchantIdByOrder(ctx context.Context, orderID int) (int, error) {
+	var merchantID int
+
+	if err := r.conn.QueryRowContext(ctx, getOrderOwner, orderID).Scan(&merchantID); err != nil {
+		return 0, order_errors.ErrFindById
+	}
+
+	return merchantID, nil
+}
+
+func (r *orderQueryRepository) findByMerchantIDs(ctx context.Context, query string, req *requests.FindAllOrders, scope *tenant.Scope) ([]*db.GetOrdersRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	rows, err := r.conn.QueryContext(ctx, query, req.Search, req.PageSize, offset, pq.Array(scope.Int64MerchantIDs()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*db.GetOrdersRow
+	for rows.Next() {
+		var i db.GetOrdersRow
+		if err := rows.Scan(
+			&i.OrderID,
+			&i.MerchantID,
+			&i.CashierID,
+			&i.TotalPrice,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func totalCount(rows []*db.GetOrdersRow) *int {
+	total := 0
+	if len(rows) > 0 {
+		total = int(rows[0].TotalCount)
+	}
+
+	return &total
+}
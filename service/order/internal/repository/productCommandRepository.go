@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
@@ -9,14 +11,36 @@ import (
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+var ErrInsufficientStock = errors.New("insufficient product stock")
+
+const decrementProductCountStock = `-- name: DecrementProductCountStock :one
+UPDATE products
+SET count_in_stock = count_in_stock - $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE product_id = $1
+    AND deleted_at IS NULL
+    AND count_in_stock >= $2
+RETURNING product_id, merchant_id, category_id, name, description, price, count_in_stock, brand, weight, slug_product, image_product, barcode, created_at, updated_at, deleted_at
+`
+
+const incrementProductCountStock = `-- name: IncrementProductCountStock :one
+UPDATE products
+SET count_in_stock = count_in_stock + $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE product_id = $1
+RETURNING product_id, merchant_id, category_id, name, description, price, count_in_stock, brand, weight, slug_product, image_product, barcode, created_at, updated_at, deleted_at
+`
+
 type productCommandRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.ProductRecordMapping
 }
 
-func NewProductCommandRepository(db *db.Queries, mapping recordmapper.ProductRecordMapping) *productCommandRepository {
+func NewProductCommandRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.ProductRecordMapping) *productCommandRepository {
 	return &productCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -33,3 +57,49 @@ func (r *productCommandRepository) UpdateProductCountStock(ctx context.Context,
 
 	return r.mapping.ToProductRecord(res), nil
 }
+
+func (r *productCommandRepository) DecrementProductCountStock(ctx context.Context, product_id int, quantity int) (*record.ProductRecord, error) {
+	res, err := scanProduct(r.conn.QueryRowContext(ctx, decrementProductCountStock, int32(product_id), int32(quantity)))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInsufficientStock
+	}
+
+	if err != nil {
+		return nil, product_errors.ErrUpdateProductCountStock
+	}
+
+	return r.mapping.ToProductRecord(res), nil
+}
+
+func (r *productCommandRepository) IncrementProductCountStock(ctx context.Context, product_id int, quantity int) (*record.ProductRecord, error) {
+	res, err := scanProduct(r.conn.QueryRowContext(ctx, incrementProductCountStock, int32(product_id), int32(quantity)))
+
+	if err != nil {
+		return nil, product_errors.ErrUpdateProductCountStock
+	}
+
+	return r.mapping.ToProductRecord(res), nil
+}
+
+func scanProduct(row *sql.Row) (*db.Product, error) {
+	var i db.Product
+	err := row.Scan(
+		&i.ProductID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.CountInStock,
+		&i.Brand,
+		&i.Weight,
+		&i.SlugProduct,
+		&i.ImageProduct,
+		&i.Barcode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return &i, err
+}
@@ -2,31 +2,90 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/barcode"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/product_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+const getProductByBarcode = `-- name: GetProductByBarcode :one
+SELECT product_id, merchant_id, category_id, name, description, price, count_in_stock, brand, weight, slug_product, image_product, barcode, created_at, updated_at, deleted_at
+FROM products
+WHERE merchant_id = $1
+    AND barcode = $2
+    AND deleted_at IS NULL
+`
+
+// ErrProductNotInMerchant is returned for a product another merchant sells.
+var ErrProductNotInMerchant = errors.New("product does not belong to the merchant")
+
 type productQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.ProductRecordMapping
 }
 
-func NewProductQueryRepository(db *db.Queries, mapping recordmapper.ProductRecordMapping) *productQueryRepository {
+func NewProductQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.ProductRecordMapping) *productQueryRepository {
 	return &productQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
 
-func (r *productQueryRepository) FindById(ctx context.Context, user_id int) (*record.ProductRecord, error) {
-	res, err := r.db.GetProductByID(ctx, int32(user_id))
+// FindById returns the product when merchant_id sells it, so an order can
+// never take stock or prices from another merchant.
+func (r *productQueryRepository) FindById(ctx context.Context, merchant_id int, product_id int) (*record.ProductRecord, error) {
+	res, err := r.db.GetProductByID(ctx, int32(product_id))
 
 	if err != nil {
 		return nil, product_errors.ErrFindById
 	}
 
+	if int(res.MerchantID) != merchant_id {
+		return nil, ErrProductNotInMerchant
+	}
+
 	return r.mapping.ToProductRecord(res), nil
 }
+
+// FindByBarcode returns the live product of the merchant with the given
+// normalized barcode.
+func (r *productQueryRepository) FindByBarcode(ctx context.Context, merchant_id int, code string) (*record.ProductRecord, error) {
+	if !tenant.Allows(ctx, merchant_id) {
+		return nil, barcode.ErrNotFound
+	}
+
+	var p db.Product
+
+	err := r.conn.QueryRowContext(ctx, getProductByBarcode, int32(merchant_id), code).Scan(
+		&p.ProductID,
+		&p.MerchantID,
+		&p.CategoryID,
+		&p.Name,
+		&p.Description,
+		&p.Price,
+		&p.CountInStock,
+		&p.Brand,
+		&p.Weight,
+		&p.SlugProduct,
+		&p.ImageProduct,
+		&p.Barcode,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+		&p.DeletedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, barcode.ErrNotFound
+	}
+	if err != nil {
+		return nil, product_errors.ErrFindById
+	}
+
+	return r.mapping.ToProductRecord(&p), nil
+}
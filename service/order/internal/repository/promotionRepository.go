@@ -0,0 +1,363 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/promotion"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrFindPromotion      = errors.New("failed to find promotion")
+	ErrCreatePromotion    = errors.New("failed to create promotion")
+	ErrTrashPromotion     = errors.New("failed to trash promotion")
+	ErrFindPricedItems    = errors.New("failed to find order items to price")
+	ErrFindOrderDiscounts = errors.New("failed to find order discounts")
+	ErrSaveOrderDiscounts = errors.New("failed to save order discounts")
+	ErrFindOrderCoupons   = errors.New("failed to find order coupon codes")
+	ErrSaveOrderCoupons   = errors.New("failed to save order coupon codes")
+	ErrFindTimezone       = errors.New("failed to find merchant time zone")
+)
+
+const createPromotion = `-- name: CreatePromotion :one
+INSERT INTO promotions (merchant_id, name, kind, value, buy_quantity, get_quantity, product_id, category_id, coupon_code, starts_at, ends_at, daily_start, daily_end, priority, stackable)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+RETURNING promotion_id, merchant_id, name, kind, value, buy_quantity, get_quantity, product_id, category_id, coupon_code, starts_at, ends_at, daily_start, daily_end, priority, stackable
+`
+
+const getPromotion = `-- name: GetPromotion :one
+SELECT promotion_id, merchant_id, name, kind, value, buy_quantity, get_quantity, product_id, category_id, coupon_code, starts_at, ends_at, daily_start, daily_end, priority, stackable
+FROM promotions
+WHERE promotion_id = $1
+    AND deleted_at IS NULL
+`
+
+const getPromotionsByMerchant = `-- name: GetPromotionsByMerchant :many
+SELECT promotion_id, merchant_id, name, kind, value, buy_quantity, get_quantity, product_id, category_id, coupon_code, starts_at, ends_at, daily_start, daily_end, priority, stackable
+FROM promotions
+WHERE merchant_id = $1
+    AND deleted_at IS NULL
+ORDER BY priority DESC, promotion_id
+`
+
+// The time of day is left to promotion.Evaluate.
+const getActivePromotionsByMerchant = `-- name: GetActivePromotionsByMerchant :many
+SELECT promotion_id, merchant_id, name, kind, value, buy_quantity, get_quantity, product_id, category_id, coupon_code, starts_at, ends_at, daily_start, daily_end, priority, stackable
+FROM promotions
+WHERE merchant_id = $1
+    AND deleted_at IS NULL
+    AND starts_at <= current_timestamp
+    AND (ends_at IS NULL OR ends_at > current_timestamp)
+ORDER BY priority DESC, promotion_id
+`
+
+const trashPromotion = `-- name: TrashPromotion :execrows
+UPDATE promotions
+SET deleted_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE promotion_id = $1
+    AND deleted_at IS NULL
+`
+
+const getPricedOrderItems = `-- name: GetPricedOrderItems :many
+SELECT oi.order_item_id, oi.product_id, p.category_id, oi.price, oi.quantity
+FROM order_items oi
+JOIN products p ON p.product_id = oi.product_id
+WHERE oi.order_id = $1
+    AND oi.deleted_at IS NULL
+ORDER BY oi.order_item_id
+`
+
+const deleteOrderDiscounts = `-- name: DeleteOrderDiscounts :exec
+DELETE FROM order_item_discounts d
+USING order_items oi
+WHERE oi.order_item_id = d.order_item_id
+    AND oi.order_id = $1
+`
+
+const createOrderItemDiscount = `-- name: CreateOrderItemDiscount :exec
+INSERT INTO order_item_discounts (order_item_id, promotion_id, promotion_name, amount)
+VALUES ($1, $2, $3, $4)
+`
+
+const getOrderDiscounts = `-- name: GetOrderDiscounts :many
+SELECT d.order_item_id, COALESCE(d.promotion_id, 0), d.promotion_name, d.amount
+FROM order_item_discounts d
+JOIN order_items oi ON oi.order_item_id = d.order_item_id
+WHERE oi.order_id = $1
+    AND oi.deleted_at IS NULL
+ORDER BY d.order_item_discount_id
+`
+
+const getOrderCouponCodes = `-- name: GetOrderCouponCodes :one
+SELECT coupon_codes
+FROM orders
+WHERE order_id = $1
+`
+
+const updateOrderCouponCodes = `-- name: UpdateOrderCouponCodes :exec
+UPDATE orders
+SET coupon_codes = $2
+WHERE order_id = $1
+`
+
+const getMerchantTimezone = `-- name: GetMerchantTimezone :one
+SELECT timezone
+FROM merchants
+WHERE merchant_id = $1
+`
+
+type promotionRepository struct {
+	conn db.DBTX
+}
+
+func NewPromotionRepository(conn db.DBTX) *promotionRepository {
+	return &promotionRepository{
+		conn: conn,
+	}
+}
+
+func (r *promotionRepository) CreatePromotion(ctx context.Context, req *promotion.Promotion) (*promotion.Promotion, error) {
+	if !tenant.Allows(ctx, req.MerchantID) {
+		return nil, promotion.ErrNotFound
+	}
+
+	var endsAt sql.NullTime
+	if req.EndsAt != nil {
+		endsAt = sql.NullTime{Time: *req.EndsAt, Valid: true}
+	}
+
+	res, err := scanPromotion(r.conn.QueryRowContext(ctx, createPromotion,
+		req.MerchantID,
+		req.Name,
+		string(req.Kind),
+		req.Value,
+		req.BuyQuantity,
+		req.GetQuantity,
+		sql.NullInt32{Int32: int32(req.ProductID), Valid: req.ProductID > 0},
+		sql.NullInt32{Int32: int32(req.CategoryID), Valid: req.CategoryID > 0},
+		sql.NullString{String: req.CouponCode, Valid: req.CouponCode != ""},
+		req.StartsAt,
+		endsAt,
+		req.DailyStart,
+		req.DailyEnd,
+		req.Priority,
+		req.Stackable,
+	))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, promotion.ErrCouponTaken
+		}
+		return nil, ErrCreatePromotion
+	}
+
+	return res, nil
+}
+
+func (r *promotionRepository) FindById(ctx context.Context, promotion_id int) (*promotion.Promotion, error) {
+	res, err := scanPromotion(r.conn.QueryRowContext(ctx, getPromotion, promotion_id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, promotion.ErrNotFound
+		}
+		return nil, ErrFindPromotion
+	}
+
+	if !tenant.Allows(ctx, res.MerchantID) {
+		return nil, promotion.ErrNotFound
+	}
+
+	return res, nil
+}
+
+func (r *promotionRepository) FindByMerchant(ctx context.Context, merchant_id int) ([]*promotion.Promotion, error) {
+	if !tenant.Allows(ctx, merchant_id) {
+		return nil, promotion.ErrNotFound
+	}
+
+	return r.findPromotions(ctx, getPromotionsByMerchant, merchant_id)
+}
+
+// FindActiveByMerchant is used to price orders whose merchant has already
+// been checked, so it does not look at the caller's scope.
+func (r *promotionRepository) FindActiveByMerchant(ctx context.Context, merchant_id int) ([]*promotion.Promotion, error) {
+	return r.findPromotions(ctx, getActivePromotionsByMerchant, merchant_id)
+}
+
+func (r *promotionRepository) TrashPromotion(ctx context.Context, promotion_id int) error {
+	res, err := r.conn.ExecContext(ctx, trashPromotion, promotion_id)
+	if err != nil {
+		return ErrTrashPromotion
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return ErrTrashPromotion
+	}
+	if rows == 0 {
+		return promotion.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *promotionRepository) FindPricedItems(ctx context.Context, order_id int) ([]promotion.Line, error) {
+	rows, err := r.conn.QueryContext(ctx, getPricedOrderItems, order_id)
+	if err != nil {
+		return nil, ErrFindPricedItems
+	}
+	defer rows.Close()
+
+	lines := []promotion.Line{}
+	for rows.Next() {
+		var line promotion.Line
+		if err := rows.Scan(&line.OrderItemID, &line.ProductID, &line.CategoryID, &line.Price, &line.Quantity); err != nil {
+			return nil, ErrFindPricedItems
+		}
+		lines = append(lines, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrFindPricedItems
+	}
+
+	return lines, nil
+}
+
+// ReplaceDiscounts swaps the discounts stored for the order's items for the
+// given ones.
+func (r *promotionRepository) ReplaceDiscounts(ctx context.Context, order_id int, discounts []promotion.Discount) error {
+	if _, err := r.conn.ExecContext(ctx, deleteOrderDiscounts, order_id); err != nil {
+		return ErrSaveOrderDiscounts
+	}
+
+	for _, d := range discounts {
+		if _, err := r.conn.ExecContext(ctx, createOrderItemDiscount, d.OrderItemID, d.PromotionID, d.PromotionName, d.Amount); err != nil {
+			return ErrSaveOrderDiscounts
+		}
+	}
+
+	return nil
+}
+
+// FindCouponCodes returns the coupon codes the order was last priced with.
+func (r *promotionRepository) FindCouponCodes(ctx context.Context, order_id int) ([]string, error) {
+	var codes []string
+
+	if err := r.conn.QueryRowContext(ctx, getOrderCouponCodes, order_id).Scan(pq.Array(&codes)); err != nil {
+		return nil, ErrFindOrderCoupons
+	}
+
+	return codes, nil
+}
+
+func (r *promotionRepository) SaveCouponCodes(ctx context.Context, order_id int, codes []string) error {
+	if _, err := r.conn.ExecContext(ctx, updateOrderCouponCodes, order_id, pq.Array(codes)); err != nil {
+		return ErrSaveOrderCoupons
+	}
+
+	return nil
+}
+
+func (r *promotionRepository) FindMerchantTimezone(ctx context.Context, merchant_id int) (string, error) {
+	var timezone string
+
+	if err := r.conn.QueryRowContext(ctx, getMerchantTimezone, merchant_id).Scan(&timezone); err != nil {
+		return "", ErrFindTimezone
+	}
+
+	return timezone, nil
+}
+
+func (r *promotionRepository) FindDiscountsByOrder(ctx context.Context, order_id int) ([]promotion.Discount, error) {
+	rows, err := r.conn.QueryContext(ctx, getOrderDiscounts, order_id)
+	if err != nil {
+		return nil, ErrFindOrderDiscounts
+	}
+	defer rows.Close()
+
+	discounts := []promotion.Discount{}
+	for rows.Next() {
+		var d promotion.Discount
+		if err := rows.Scan(&d.OrderItemID, &d.PromotionID, &d.PromotionName, &d.Amount); err != nil {
+			return nil, ErrFindOrderDiscounts
+		}
+		discounts = append(discounts, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrFindOrderDiscounts
+	}
+
+	return discounts, nil
+}
+
+func (r *promotionRepository) findPromotions(ctx context.Context, query string, merchant_id int) ([]*promotion.Promotion, error) {
+	rows, err := r.conn.QueryContext(ctx, query, merchant_id)
+	if err != nil {
+		return nil, ErrFindPromotion
+	}
+	defer rows.Close()
+
+	promotions := []*promotion.Promotion{}
+	for rows.Next() {
+		p, err := scanPromotion(rows)
+		if err != nil {
+			return nil, ErrFindPromotion
+		}
+		promotions = append(promotions, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrFindPromotion
+	}
+
+	return promotions, nil
+}
+
+func scanPromotion(row interface{ Scan(dest ...any) error }) (*promotion.Promotion, error) {
+	var (
+		p                     promotion.Promotion
+		kind                  string
+		productID, categoryID sql.NullInt32
+		couponCode            sql.NullString
+		endsAt                sql.NullTime
+	)
+
+	if err := row.Scan(
+		&p.ID,
+		&p.MerchantID,
+		&p.Name,
+		&kind,
+		&p.Value,
+		&p.BuyQuantity,
+		&p.GetQuantity,
+		&productID,
+		&categoryID,
+		&couponCode,
+		&p.StartsAt,
+		&endsAt,
+		&p.DailyStart,
+		&p.DailyEnd,
+		&p.Priority,
+		&p.Stackable,
+	); err != nil {
+		return nil, err
+	}
+
+	p.Kind = promotion.Kind(kind)
+	p.ProductID = int(productID.Int32)
+	p.CategoryID = int(categoryID.Int32)
+	p.CouponCode = couponCode.String
+
+	if endsAt.Valid {
+		p.EndsAt = &endsAt.Time
+	}
+
+	return &p, nil
+}
@@ -1,10 +1,16 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "order"
+
 type Repositories struct {
 	CashierQuery         CashierQueryRepository
 	MerchantQuery        MerchantQueryRepository
@@ -16,9 +22,14 @@ type Repositories struct {
 	OrderItemCommand     OrderItemCommandRepository
 	OrderStats           OrderStatsRepository
 	OrderStatsByMerchant OrderStatByMerchantRepository
+	UnitOfWork           UnitOfWork
+	Tenant               TenantRepository
+	Promotion            PromotionRepository
+	Customer             CustomerRepository
+	Outbox               OutboxRepository
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	mapperCashier := recordmapper.NewCashierRecordMapper()
 	mapperMerchant := recordmapper.NewMerchantRecordMapper()
 	mapperProduct := recordmapper.NewProductRecordMapper()
@@ -28,13 +39,18 @@ func NewRepositories(DB *db.Queries) *Repositories {
 	return &Repositories{
 		CashierQuery:         NewCashierQueryRepository(DB, mapperCashier),
 		MerchantQuery:        NewMerchantQueryRepository(DB, mapperMerchant),
-		ProductQuery:         NewProductQueryRepository(DB, mapperProduct),
-		ProductCommand:       NewProductCommandRepository(DB, mapperProduct),
-		OrderQuery:           NewOrderQueryRepository(DB, mapperOrder),
-		OrderCommand:         NewOrderCommandRepository(DB, mapperOrder),
-		OrderItemQuery:       NewOrderItemQueryRepository(DB, mapperOrderItem),
-		OrderItemCommand:     NewOrderItemCommandRepository(DB, mapperOrderItem),
+		ProductQuery:         NewProductQueryRepository(DB, conn, mapperProduct),
+		ProductCommand:       NewProductCommandRepository(DB, conn, mapperProduct),
+		OrderQuery:           NewOrderQueryRepository(DB, conn, mapperOrder),
+		OrderCommand:         NewOrderCommandRepository(DB, conn, mapperOrder),
+		OrderItemQuery:       NewOrderItemQueryRepository(DB, conn, mapperOrderItem),
+		OrderItemCommand:     NewOrderItemCommandRepository(DB, conn, mapperOrderItem),
 		OrderStats:           NewOrderStatsRepository(DB, mapperOrder),
 		OrderStatsByMerchant: NewOrderStatsByMerchantRepository(DB, mapperOrder),
+		UnitOfWork:           NewUnitOfWork(conn, DB, mapperProduct, mapperOrder, mapperOrderItem),
+		Tenant:               tenant.NewRepository(conn),
+		Promotion:            NewPromotionRepository(conn),
+		Customer:             NewCustomerRepository(conn),
+		Outbox:               outbox.NewRepository(conn, outboxSource),
 	}
 }
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+)
+
+var (
+	ErrBeginTransaction  = errors.New("failed to begin database transaction")
+	ErrCommitTransaction = errors.New("failed to commit database transaction")
+)
+
+type TxRepositories struct {
+	ProductQuery     ProductQueryRepository
+	ProductCommand   ProductCommandRepository
+	OrderCommand     OrderCommandRepository
+	OrderItemQuery   OrderItemQueryRepository
+	OrderItemCommand OrderItemCommandRepository
+	Promotion        PromotionRepository
+	Customer         CustomerRepository
+}
+
+type unitOfWork struct {
+	conn            *audit.DB
+	db              *db.Queries
+	mapperProduct   recordmapper.ProductRecordMapping
+	mapperOrder     recordmapper.OrderRecordMapping
+	mapperOrderItem recordmapper.OrderItemRecordMapping
+}
+
+func NewUnitOfWork(
+	conn *audit.DB,
+	db *db.Queries,
+	mapperProduct recordmapper.ProductRecordMapping,
+	mapperOrder recordmapper.OrderRecordMapping,
+	mapperOrderItem recordmapper.OrderItemRecordMapping,
+) *unitOfWork {
+	return &unitOfWork{
+		conn:            conn,
+		db:              db,
+		mapperProduct:   mapperProduct,
+		mapperOrder:     mapperOrder,
+		mapperOrderItem: mapperOrderItem,
+	}
+}
+
+// WithTx commits when fn returns nil and rolls back on error or panic.
+func (u *unitOfWork) WithTx(ctx context.Context, fn func(tx *TxRepositories) error) error {
+	tx, err := u.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBeginTransaction, err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	q := u.db.WithTx(tx.Tx)
+
+	repos := &TxRepositories{
+		ProductQuery:     NewProductQueryRepository(q, tx, u.mapperProduct),
+		ProductCommand:   NewProductCommandRepository(q, tx, u.mapperProduct),
+		OrderCommand:     NewOrderCommandRepository(q, tx, u.mapperOrder),
+		OrderItemQuery:   NewOrderItemQueryRepository(q, tx, u.mapperOrderItem),
+		OrderItemCommand: NewOrderItemCommandRepository(q, tx, u.mapperOrderItem),
+		Promotion:        NewPromotionRepository(tx),
+		Customer:         NewCustomerRepository(tx),
+	}
+
+	if err := fn(repos); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", ErrCommitTransaction, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,415 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/customer"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/merchant_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/order_errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type customerService struct {
+	errorhandler            errorhandler.CustomerError
+	trace                   trace.Tracer
+	customerRepository      repository.CustomerRepository
+	orderQueryRepository    repository.OrderQueryRepository
+	merchantQueryRepository repository.MerchantQueryRepository
+	logger                  logger.LoggerInterface
+	requestCounter          *prometheus.CounterVec
+	requestDuration         *prometheus.HistogramVec
+}
+
+func NewCustomerService(
+	errorhandler errorhandler.CustomerError,
+	customerRepository repository.CustomerRepository,
+	orderQueryRepository repository.OrderQueryRepository,
+	merchantQueryRepository repository.MerchantQueryRepository,
+	logger logger.LoggerInterface,
+) *customerService {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "customer_service_request_count",
+			Help: "Total number of requests to the CustomerService",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "customer_service_request_duration",
+			Help:    "Histogram of request durations for the CustomerService",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return &customerService{
+		errorhandler:            errorhandler,
+		trace:                   otel.Tracer("customer-service"),
+		customerRepository:      customerRepository,
+		orderQueryRepository:    orderQueryRepository,
+		merchantQueryRepository: merchantQueryRepository,
+		logger:                  logger,
+		requestCounter:          requestCounter,
+		requestDuration:         requestDuration,
+	}
+}
+
+func (s *customerService) CreateCustomer(ctx context.Context, req *customer.CreateRequest) (*customer.Customer, *response.ErrorResponse) {
+	const method = "CreateCustomer"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("merchant.id", req.MerchantID))
+
+	defer func() {
+		end(status)
+	}()
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		return s.errorhandler.HandleCreateCustomerError(err, method, "FAILED_INVALID_CUSTOMER", span, &status, zap.Int("merchant.id", req.MerchantID))
+	}
+
+	if _, err := s.merchantQueryRepository.FindById(ctx, req.MerchantID); err != nil {
+		return errorhandler.HandleRepositorySingleError[*customer.Customer](s.logger, err, method, "FAILED_FIND_MERCHANT_BY_ID", span, &status, merchant_errors.ErrFailedFindMerchantById, zap.Error(err))
+	}
+
+	res, err := s.customerRepository.CreateCustomer(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleCreateCustomerError(err, method, "FAILED_CREATE_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully created customer", zap.Int("customer.id", res.ID), zap.Int("merchant.id", res.MerchantID))
+
+	return res, nil
+}
+
+func (s *customerService) FindById(ctx context.Context, customerID int) (*customer.Customer, *response.ErrorResponse) {
+	const method = "FindById"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", customerID))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.customerRepository.FindById(ctx, customerID)
+	if err != nil {
+		return s.errorhandler.HandleFindCustomerError(err, method, "FAILED_FIND_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched customer", zap.Int("customer.id", customerID))
+
+	return res, nil
+}
+
+// FindByContact looks a customer up by the phone number or email given at
+// the till, the phone number winning when both match someone.
+func (s *customerService) FindByContact(ctx context.Context, merchantID int, phone string, email string) (*customer.Customer, *response.ErrorResponse) {
+	const method = "FindByContact"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("merchant.id", merchantID))
+
+	defer func() {
+		end(status)
+	}()
+
+	phone, email = customer.NormalizePhone(phone), customer.NormalizeEmail(email)
+	if phone == "" && email == "" {
+		return s.errorhandler.HandleFindCustomerError(customer.ErrContactRequired, method, "FAILED_INVALID_CONTACT", span, &status, zap.Int("merchant.id", merchantID))
+	}
+
+	if phone != "" {
+		res, err := s.customerRepository.FindByContact(ctx, merchantID, phone, "")
+		if err == nil {
+			logSuccess("Successfully fetched customer by phone", zap.Int("customer.id", res.ID))
+			return res, nil
+		}
+		if email == "" || !errors.Is(err, customer.ErrNotFound) {
+			return s.errorhandler.HandleFindCustomerError(err, method, "FAILED_FIND_CUSTOMER_BY_PHONE", span, &status, zap.Error(err))
+		}
+	}
+
+	res, err := s.customerRepository.FindByContact(ctx, merchantID, "", email)
+	if err != nil {
+		return s.errorhandler.HandleFindCustomerError(err, method, "FAILED_FIND_CUSTOMER_BY_EMAIL", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched customer by email", zap.Int("customer.id", res.ID))
+
+	return res, nil
+}
+
+func (s *customerService) FindByMerchant(ctx context.Context, req *customer.FindAllRequest) ([]*customer.Customer, *int, *response.ErrorResponse) {
+	return s.findPage(ctx, "FindByMerchant", req, s.customerRepository.FindByMerchant)
+}
+
+func (s *customerService) FindTrashedByMerchant(ctx context.Context, req *customer.FindAllRequest) ([]*customer.Customer, *int, *response.ErrorResponse) {
+	return s.findPage(ctx, "FindTrashedByMerchant", req, s.customerRepository.FindTrashedByMerchant)
+}
+
+func (s *customerService) findPage(ctx context.Context, method string, req *customer.FindAllRequest, find func(context.Context, *customer.FindAllRequest) ([]*customer.Customer, *int, error)) ([]*customer.Customer, *int, *response.ErrorResponse) {
+	req.Page, req.PageSize = s.normalizePagination(req.Page, req.PageSize)
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("merchant.id", req.MerchantID), attribute.Int("page", req.Page), attribute.Int("pageSize", req.PageSize), attribute.String("search", req.Search))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, total, err := find(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleFindCustomersError(err, method, "FAILED_FIND_CUSTOMERS", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched customers", zap.Int("merchant.id", req.MerchantID), zap.Int("page", req.Page), zap.Int("pageSize", req.PageSize))
+
+	return res, total, nil
+}
+
+func (s *customerService) UpdateCustomer(ctx context.Context, req *customer.UpdateRequest) (*customer.Customer, *response.ErrorResponse) {
+	const method = "UpdateCustomer"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", req.CustomerID))
+
+	defer func() {
+		end(status)
+	}()
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		return s.errorhandler.HandleUpdateCustomerError(err, method, "FAILED_INVALID_CUSTOMER", span, &status, zap.Int("customer.id", req.CustomerID))
+	}
+
+	if _, err := s.customerRepository.FindById(ctx, req.CustomerID); err != nil {
+		return s.errorhandler.HandleUpdateCustomerError(err, method, "FAILED_FIND_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	res, err := s.customerRepository.UpdateCustomer(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleUpdateCustomerError(err, method, "FAILED_UPDATE_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully updated customer", zap.Int("customer.id", res.ID))
+
+	return res, nil
+}
+
+func (s *customerService) TrashCustomer(ctx context.Context, customerID int) (*customer.Customer, *response.ErrorResponse) {
+	const method = "TrashCustomer"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", customerID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.customerRepository.FindById(ctx, customerID); err != nil {
+		return s.errorhandler.HandleTrashCustomerError(err, method, "FAILED_FIND_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	res, err := s.customerRepository.TrashCustomer(ctx, customerID)
+	if err != nil {
+		return s.errorhandler.HandleTrashCustomerError(err, method, "FAILED_TRASH_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully trashed customer", zap.Int("customer.id", customerID))
+
+	return res, nil
+}
+
+// RestoreCustomer brings a trashed customer back, unless their phone number
+// or email has been given to someone else in the meantime.
+func (s *customerService) RestoreCustomer(ctx context.Context, customerID int) (*customer.Customer, *response.ErrorResponse) {
+	const method = "RestoreCustomer"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", customerID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.customerRepository.FindTrashedById(ctx, customerID); err != nil {
+		return s.errorhandler.HandleRestoreCustomerError(err, method, "FAILED_FIND_TRASHED_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	res, err := s.customerRepository.RestoreCustomer(ctx, customerID)
+	if err != nil {
+		return s.errorhandler.HandleRestoreCustomerError(err, method, "FAILED_RESTORE_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully restored customer", zap.Int("customer.id", customerID))
+
+	return res, nil
+}
+
+func (s *customerService) DeleteCustomerPermanent(ctx context.Context, customerID int) (bool, *response.ErrorResponse) {
+	const method = "DeleteCustomerPermanent"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", customerID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.customerRepository.FindTrashedById(ctx, customerID); err != nil {
+		return s.errorhandler.HandleDeleteCustomerError(err, method, "FAILED_FIND_TRASHED_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	res, err := s.customerRepository.DeleteCustomerPermanent(ctx, customerID)
+	if err != nil {
+		return s.errorhandler.HandleDeleteCustomerError(err, method, "FAILED_DELETE_CUSTOMER_PERMANENT", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully permanently deleted customer", zap.Int("customer.id", customerID))
+
+	return res, nil
+}
+
+// AttachOrder records who an order was placed for, for orders created
+// without the customer metadata.
+func (s *customerService) AttachOrder(ctx context.Context, customerID int, orderID int) (bool, *response.ErrorResponse) {
+	const method = "AttachOrder"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", customerID), attribute.Int("order.id", orderID))
+
+	defer func() {
+		end(status)
+	}()
+
+	c, err := s.customerRepository.FindById(ctx, customerID)
+	if err != nil {
+		return s.errorhandler.HandleAttachCustomerError(err, method, "FAILED_FIND_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	order, err := s.orderQueryRepository.FindById(ctx, orderID)
+	if err != nil {
+		return errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_FIND_ORDER_BY_ID", span, &status, order_errors.ErrFailedFindOrderById, zap.Error(err))
+	}
+
+	if order.MerchantID != c.MerchantID {
+		return s.errorhandler.HandleAttachCustomerError(customer.ErrOrderMerchant, method, "FAILED_CUSTOMER_ORDER_MERCHANT", span, &status, zap.Int("merchant.id", order.MerchantID))
+	}
+
+	if err := s.customerRepository.AttachOrder(ctx, orderID, customerID); err != nil {
+		return s.errorhandler.HandleAttachCustomerError(err, method, "FAILED_ATTACH_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully attached customer to order", zap.Int("customer.id", customerID), zap.Int("order.id", orderID))
+
+	return true, nil
+}
+
+func (s *customerService) FindPurchases(ctx context.Context, req *customer.PurchasesRequest) ([]*customer.Purchase, *int, *response.ErrorResponse) {
+	const method = "FindPurchases"
+
+	req.Page, req.PageSize = s.normalizePagination(req.Page, req.PageSize)
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", req.CustomerID), attribute.Int("page", req.Page), attribute.Int("pageSize", req.PageSize))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.customerRepository.FindById(ctx, req.CustomerID); err != nil {
+		return s.errorhandler.HandleFindPurchasesError(err, method, "FAILED_FIND_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	res, total, err := s.customerRepository.FindPurchases(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleFindPurchasesError(err, method, "FAILED_FIND_CUSTOMER_PURCHASES", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched customer purchases", zap.Int("customer.id", req.CustomerID), zap.Int("page", req.Page), zap.Int("pageSize", req.PageSize))
+
+	return res, total, nil
+}
+
+func (s *customerService) FindStats(ctx context.Context, customerID int) (*customer.Stats, *response.ErrorResponse) {
+	const method = "FindStats"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", customerID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.customerRepository.FindById(ctx, customerID); err != nil {
+		return s.errorhandler.HandleFindStatsError(err, method, "FAILED_FIND_CUSTOMER", span, &status, zap.Error(err))
+	}
+
+	res, err := s.customerRepository.FindStats(ctx, customerID)
+	if err != nil {
+		return s.errorhandler.HandleFindStatsError(err, method, "FAILED_FIND_CUSTOMER_STATS", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched customer stats", zap.Int("customer.id", customerID))
+
+	return res, nil
+}
+
+func (s *customerService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
+	context.Context,
+	trace.Span,
+	func(string),
+	string,
+	func(string, ...zap.Field),
+) {
+	start := time.Now()
+	status := "success"
+
+	ctx, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+
+	s.logger.Debug("Start: " + method)
+
+	end := func(status string) {
+		s.recordMetrics(method, status, start)
+		code := codes.Ok
+		if status != "success" {
+			code = codes.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess := func(msg string, fields ...zap.Field) {
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	return ctx, span, end, status, logSuccess
+}
+
+func (s *customerService) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (s *customerService) normalizePagination(page, pageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	return page, pageSize
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/customer"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/promotion"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
@@ -40,3 +42,26 @@ type OrderCommandService interface {
 	RestoreAllOrder(ctx context.Context) (bool, *response.ErrorResponse)
 	DeleteAllOrderPermanent(ctx context.Context) (bool, *response.ErrorResponse)
 }
+
+type PromotionService interface {
+	CreatePromotion(ctx context.Context, req *promotion.Promotion) (*promotion.Promotion, *response.ErrorResponse)
+	FindById(ctx context.Context, promotionID int) (*promotion.Promotion, *response.ErrorResponse)
+	FindByMerchant(ctx context.Context, merchantID int) ([]*promotion.Promotion, *response.ErrorResponse)
+	TrashPromotion(ctx context.Context, promotionID int) (bool, *response.ErrorResponse)
+	FindOrderDiscounts(ctx context.Context, orderID int) ([]promotion.Discount, *response.ErrorResponse)
+}
+
+type CustomerService interface {
+	CreateCustomer(ctx context.Context, req *customer.CreateRequest) (*customer.Customer, *response.ErrorResponse)
+	FindById(ctx context.Context, customerID int) (*customer.Customer, *response.ErrorResponse)
+	FindByContact(ctx context.Context, merchantID int, phone string, email string) (*customer.Customer, *response.ErrorResponse)
+	FindByMerchant(ctx context.Context, req *customer.FindAllRequest) ([]*customer.Customer, *int, *response.ErrorResponse)
+	FindTrashedByMerchant(ctx context.Context, req *customer.FindAllRequest) ([]*customer.Customer, *int, *response.ErrorResponse)
+	UpdateCustomer(ctx context.Context, req *customer.UpdateRequest) (*customer.Customer, *response.ErrorResponse)
+	TrashCustomer(ctx context.Context, customerID int) (*customer.Customer, *response.ErrorResponse)
+	RestoreCustomer(ctx context.Context, customerID int) (*customer.Customer, *response.ErrorResponse)
+	DeleteCustomerPermanent(ctx context.Context, customerID int) (bool, *response.ErrorResponse)
+	AttachOrder(ctx context.Context, customerID int, orderID int) (bool, *response.ErrorResponse)
+	FindPurchases(ctx context.Context, req *customer.PurchasesRequest) ([]*customer.Purchase, *int, *response.ErrorResponse)
+	FindStats(ctx context.Context, customerID int) (*customer.Stats, *response.ErrorResponse)
+}
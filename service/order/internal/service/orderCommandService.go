@@ -2,13 +2,19 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/barcode"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/customer"
 	"github.com/MamangRust/monolith-point-of-sale-order/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/promotion"
 	mencache "github.com/MamangRust/monolith-point-of-sale-order/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-order/internal/repository"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/cashier_errors"
@@ -25,6 +31,11 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	errRollbackOrder = errors.New("order transaction rolled back")
+	errBulkDryRun    = errors.New("bulk dry run rolled back")
+)
+
 type orderCommandService struct {
 	errorhandler               errorhandler.OrderCommandError
 	mencache                   mencache.OrderCommandCache
@@ -37,6 +48,7 @@ type orderCommandService struct {
 	merchantQueryRepository    repository.MerchantQueryRepository
 	productQueryRepository     repository.ProductQueryRepository
 	productCommandRepository   repository.ProductCommandRepository
+	unitOfWork                 repository.UnitOfWork
 	logger                     logger.LoggerInterface
 	mapping                    response_service.OrderResponseMapper
 	requestCounter             *prometheus.CounterVec
@@ -54,6 +66,7 @@ func NewOrderCommandService(
 	productQueryRepository repository.ProductQueryRepository,
 	productCommandRepository repository.ProductCommandRepository,
 	merchantQueryRepository repository.MerchantQueryRepository,
+	unitOfWork repository.UnitOfWork,
 	logger logger.LoggerInterface,
 	mapping response_service.OrderResponseMapper,
 
@@ -89,6 +102,7 @@ func NewOrderCommandService(
 		merchantQueryRepository:    merchantQueryRepository,
 		productQueryRepository:     productQueryRepository,
 		productCommandRepository:   productCommandRepository,
+		unitOfWork:                 unitOfWork,
 		logger:                     logger,
 		mapping:                    mapping,
 		requestCounter:             requestCounter,
@@ -115,56 +129,87 @@ func (s *orderCommandService) CreateOrder(ctx context.Context, req *requests.Cre
 		return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_CASHIER_BY_ID", span, &status, cashier_errors.ErrFailedFindCashierById, zap.Error(err))
 	}
 
-	order, err := s.orderCommandRepository.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
-		MerchantID: req.MerchantID,
-		CashierID:  req.CashierID,
-	})
+	scanned, err := barcode.Lines(req.Barcodes)
 	if err != nil {
-		return s.errorhandler.HandleCreateOrderError(err, method, "FAILED_CREATE_ORDER", span, &status, zap.Error(err))
+		return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_INVALID_BARCODES", span, &status, errorhandler.BarcodeErrorResponse(err, errorhandler.ErrFailedResolveBarcode), zap.Error(err))
 	}
 
-	span.SetAttributes(attribute.Int("order.id", order.ID))
-
-	for _, item := range req.Items {
+	var (
+		order   *record.OrderRecord
+		errResp *response.ErrorResponse
+	)
 
-		product, err := s.productQueryRepository.FindById(ctx, item.ProductID)
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		created, err := tx.OrderCommand.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+			MerchantID: req.MerchantID,
+			CashierID:  req.CashierID,
+		})
 		if err != nil {
-			return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_PRODUCT_BY_ID", span, &status, product_errors.ErrFailedFindProductById, zap.Error(err))
+			_, errResp = s.errorhandler.HandleCreateOrderError(err, method, "FAILED_CREATE_ORDER", span, &status, zap.Error(err))
+			return errRollbackOrder
 		}
 
-		if product.CountInStock < item.Quantity {
-			return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_PRODUCT_BY_ID", span, &status, product_errors.ErrFailedFindProductById, zap.Error(err))
+		span.SetAttributes(attribute.Int("order.id", created.ID))
+
+		if resp := s.attachCustomer(ctx, tx, method, span, &status, created.ID, req.MerchantID, req.CustomerID); resp != nil {
+			errResp = resp
+			return errRollbackOrder
 		}
 
-		_, err = s.orderItemCommandRepository.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
-			OrderID:   order.ID,
-			ProductID: item.ProductID,
-			Quantity:  item.Quantity,
-			Price:     product.Price,
-		})
-		if err != nil {
-			return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_CREATE_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedCreateOrderItem, zap.Error(err))
+		items, resp := s.resolveBarcodes(ctx, tx, method, span, &status, req.MerchantID, scanned)
+		if resp != nil {
+			errResp = resp
+			return errRollbackOrder
 		}
 
-		product.CountInStock -= item.Quantity
-		_, err = s.productCommandRepository.UpdateProductCountStock(ctx, product.ID, product.CountInStock)
-		if err != nil {
-			return s.errorhandler.HandleErrorInvalidCountStockTemplate(err, method, "FAILED_UPDATE_PRODUCT_COUNT_STOCK", span, &status, product_errors.ErrFailedUpdateProduct, zap.Error(err))
+		ledger := newStockLedger(tx.ProductCommand)
+
+		for _, item := range append(req.Items, items...) {
+			product, err := tx.ProductQuery.FindById(ctx, req.MerchantID, item.ProductID)
+			if err != nil {
+				_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_PRODUCT_BY_ID", span, &status, errorhandler.ProductErrorResponse(err, product_errors.ErrFailedFindProductById), zap.Int("product.id", item.ProductID), zap.Error(err))
+				return errRollbackOrder
+			}
+
+			if err := ledger.Reserve(ctx, product.ID, item.Quantity); err != nil {
+				errResp = s.handleStockError(err, method, span, &status, product.ID, item.Quantity)
+				return errRollbackOrder
+			}
+
+			_, err = tx.OrderItemCommand.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+				OrderID:   created.ID,
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+				Price:     product.Price,
+			})
+			if err != nil {
+				_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_CREATE_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedCreateOrderItem, zap.Error(err))
+				return errRollbackOrder
+			}
 		}
 
-	}
+		totalPrice, resp := s.priceOrder(ctx, tx, method, span, &status, created.ID, req.MerchantID, req.CouponCodes)
+		if resp != nil {
+			errResp = resp
+			return errRollbackOrder
+		}
 
-	totalPrice, err := s.orderItemQueryRepository.CalculateTotalPrice(ctx, order.ID)
-	if err != nil {
-		return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_CALCULATE_TOTAL_PRICE", span, &status, orderitem_errors.ErrFailedCalculateTotal, zap.Error(err))
-	}
+		order, err = tx.OrderCommand.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
+			OrderID:    created.ID,
+			TotalPrice: totalPrice,
+		})
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_UPDATE_ORDER", span, &status, order_errors.ErrFailedUpdateOrder, zap.Error(err))
+			return errRollbackOrder
+		}
 
-	_, err = s.orderCommandRepository.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
-		OrderID:    order.ID,
-		TotalPrice: int(*totalPrice),
+		return nil
 	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_UPDATE_ORDER", span, &status, order_errors.ErrFailedUpdateOrder, zap.Error(err))
+		return s.errorhandler.HandleCreateOrderError(err, method, "FAILED_COMMIT_CREATE_ORDER", span, &status, zap.Error(err))
 	}
 
 	so := s.mapping.ToOrderResponse(order)
@@ -183,68 +228,73 @@ func (s *orderCommandService) UpdateOrder(ctx context.Context, req *requests.Upd
 		end(status)
 	}()
 
-	_, err := s.orderQueryRepository.FindById(ctx, *req.OrderID)
+	existingOrder, err := s.orderQueryRepository.FindById(ctx, *req.OrderID)
 	if err != nil {
 		return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_ORDER_BY_ID", span, &status, order_errors.ErrFailedFindOrderById, zap.Error(err))
 	}
 
-	for i, item := range req.Items {
-		_, itemSpan := s.trace.Start(ctx, fmt.Sprintf("ProcessItem-%d", i))
-		itemSpan.SetAttributes(
-			attribute.Int("item.product_id", item.ProductID),
-			attribute.Int("item.quantity", item.Quantity),
-		)
+	var (
+		res     *record.OrderRecord
+		errResp *response.ErrorResponse
+	)
 
-		product, err := s.productQueryRepository.FindById(ctx, item.ProductID)
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		existingItems, err := tx.OrderItemQuery.FindOrderItemByOrder(ctx, *req.OrderID)
 		if err != nil {
-			return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_PRODUCT_BY_ID", span, &status, product_errors.ErrFailedFindProductById, zap.Error(err))
+			_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_ORDER_ITEM_BY_ORDER", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
 		}
 
-		if item.OrderItemID > 0 {
-			_, err := s.orderItemCommandRepository.UpdateOrderItem(ctx, &requests.UpdateOrderItemRecordRequest{
-				OrderItemID: item.OrderItemID,
-				ProductID:   item.ProductID,
-				Quantity:    item.Quantity,
-				Price:       product.Price,
-			})
-			if err != nil {
-				return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_UPDATE_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedUpdateOrderItem, zap.Error(err))
-			}
-		} else {
-			if product.CountInStock < item.Quantity {
-				return s.errorhandler.HandleErrorInsufficientStockTemplate(err, method, "FAILED_INSUFFICIENT_STOCK", span, &status, order_errors.ErrFailedInvalidCountInStock, zap.Error(err))
-			}
+		if resp := s.attachCustomer(ctx, tx, method, span, &status, *req.OrderID, existingOrder.MerchantID, req.CustomerID); resp != nil {
+			errResp = resp
+			return errRollbackOrder
+		}
 
-			_, err := s.orderItemCommandRepository.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
-				OrderID:   *req.OrderID,
-				ProductID: item.ProductID,
-				Quantity:  item.Quantity,
-				Price:     product.Price,
-			})
-			if err != nil {
-				return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_CREATE_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedCreateOrderItem, zap.Error(err))
-			}
+		existing := make(map[int]*record.OrderItemRecord, len(existingItems))
+		for _, item := range existingItems {
+			existing[item.ID] = item
+		}
 
-			product.CountInStock -= item.Quantity
-			_, err = s.productCommandRepository.UpdateProductCountStock(ctx, product.ID, product.CountInStock)
-			if err != nil {
-				return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_UPDATE_PRODUCT_COUNT_STOCK", span, &status, product_errors.ErrFailedCountStock, zap.Error(err))
+		ledger := newStockLedger(tx.ProductCommand)
+
+		for i, item := range req.Items {
+			_, itemSpan := s.trace.Start(ctx, fmt.Sprintf("ProcessItem-%d", i))
+			itemSpan.SetAttributes(
+				attribute.Int("item.product_id", item.ProductID),
+				attribute.Int("item.quantity", item.Quantity),
+			)
+
+			if resp := s.updateOrderItem(ctx, tx, ledger, method, span, &status, *req.OrderID, existingOrder.MerchantID, existing, item); resp != nil {
+				itemSpan.End()
+				errResp = resp
+				return errRollbackOrder
 			}
+
+			itemSpan.End()
 		}
-		itemSpan.End()
-	}
 
-	totalPrice, err := s.orderItemQueryRepository.CalculateTotalPrice(ctx, *req.OrderID)
-	if err != nil {
-		return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_CALCULATE_TOTAL_PRICE", span, &status, orderitem_errors.ErrFailedCalculateTotal, zap.Error(err))
-	}
+		totalPrice, resp := s.priceOrder(ctx, tx, method, span, &status, *req.OrderID, existingOrder.MerchantID, req.CouponCodes)
+		if resp != nil {
+			errResp = resp
+			return errRollbackOrder
+		}
 
-	res, err := s.orderCommandRepository.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
-		OrderID:    *req.OrderID,
-		TotalPrice: int(*totalPrice),
+		res, err = tx.OrderCommand.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
+			OrderID:    *req.OrderID,
+			TotalPrice: totalPrice,
+		})
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_UPDATE_ORDER", span, &status, order_errors.ErrFailedUpdateOrder, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		return nil
 	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_UPDATE_ORDER", span, &status, order_errors.ErrFailedUpdateOrder, zap.Error(err))
+		return s.errorhandler.HandleUpdateOrderError(err, method, "FAILED_COMMIT_UPDATE_ORDER", span, &status, zap.Error(err))
 	}
 
 	so := s.mapping.ToOrderResponse(res)
@@ -256,6 +306,188 @@ func (s *orderCommandService) UpdateOrder(ctx context.Context, req *requests.Upd
 	return so, nil
 }
 
+func (s *orderCommandService) updateOrderItem(
+	ctx context.Context,
+	tx *repository.TxRepositories,
+	ledger *stockLedger,
+	method string,
+	span trace.Span,
+	status *string,
+	orderID int,
+	merchantID int,
+	existing map[int]*record.OrderItemRecord,
+	item requests.UpdateOrderItemRequest,
+) *response.ErrorResponse {
+	product, err := tx.ProductQuery.FindById(ctx, merchantID, item.ProductID)
+	if err != nil {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_PRODUCT_BY_ID", span, status, errorhandler.ProductErrorResponse(err, product_errors.ErrFailedFindProductById), zap.Int("product.id", item.ProductID), zap.Error(err))
+		return errResp
+	}
+
+	if item.OrderItemID > 0 {
+		current, ok := existing[item.OrderItemID]
+		if !ok || current.ProductID != item.ProductID {
+			_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, orderitem_errors.ErrUpdateOrderItem, method, "FAILED_ORDER_ITEM_NOT_IN_ORDER", span, status, orderitem_errors.ErrFailedOrderItemNotFound, zap.Int("order_item.id", item.OrderItemID))
+			return errResp
+		}
+
+		if err := ledger.Adjust(ctx, product.ID, current.Quantity, item.Quantity); err != nil {
+			return s.handleStockError(err, method, span, status, product.ID, item.Quantity-current.Quantity)
+		}
+
+		_, err := tx.OrderItemCommand.UpdateOrderItem(ctx, &requests.UpdateOrderItemRecordRequest{
+			OrderItemID: item.OrderItemID,
+			ProductID:   item.ProductID,
+			Quantity:    item.Quantity,
+			Price:       product.Price,
+		})
+		if err != nil {
+			_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_UPDATE_ORDER_ITEM", span, status, orderitem_errors.ErrFailedUpdateOrderItem, zap.Error(err))
+			return errResp
+		}
+
+		return nil
+	}
+
+	if err := ledger.Reserve(ctx, product.ID, item.Quantity); err != nil {
+		return s.handleStockError(err, method, span, status, product.ID, item.Quantity)
+	}
+
+	_, err = tx.OrderItemCommand.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   orderID,
+		ProductID: item.ProductID,
+		Quantity:  item.Quantity,
+		Price:     product.Price,
+	})
+	if err != nil {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_CREATE_ORDER_ITEM", span, status, orderitem_errors.ErrFailedCreateOrderItem, zap.Error(err))
+		return errResp
+	}
+
+	return nil
+}
+
+// attachCustomer links the order to the customer it is placed for; zero
+// means the request names none.
+func (s *orderCommandService) attachCustomer(ctx context.Context, tx *repository.TxRepositories, method string, span trace.Span, status *string, orderID int, merchantID int, customerID int) *response.ErrorResponse {
+	fail := func(err error, tracePrefix string, fields ...zap.Field) *response.ErrorResponse {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, tracePrefix, span, status, errorhandler.CustomerErrorResponse(err, errorhandler.ErrFailedAttachCustomer), fields...)
+		return errResp
+	}
+
+	if customerID == 0 {
+		return nil
+	}
+	if customerID < 0 {
+		return fail(customer.ErrInvalidID, "FAILED_INVALID_CUSTOMER_ID")
+	}
+
+	c, err := tx.Customer.FindById(ctx, customerID)
+	if err != nil {
+		return fail(err, "FAILED_FIND_CUSTOMER", zap.Int("customer.id", customerID))
+	}
+	if c.MerchantID != merchantID {
+		return fail(customer.ErrOrderMerchant, "FAILED_CUSTOMER_ORDER_MERCHANT", zap.Int("customer.id", customerID), zap.Int("merchant.id", merchantID))
+	}
+
+	if err := tx.Customer.AttachOrder(ctx, orderID, customerID); err != nil {
+		return fail(err, "FAILED_ATTACH_CUSTOMER", zap.Int("customer.id", customerID))
+	}
+
+	span.SetAttributes(attribute.Int("customer.id", customerID))
+
+	return nil
+}
+
+// resolveBarcodes turns the scanned lines into order items of the merchant's
+// products.
+func (s *orderCommandService) resolveBarcodes(ctx context.Context, tx *repository.TxRepositories, method string, span trace.Span, status *string, merchantID int, scanned []barcode.Line) ([]requests.CreateOrderItemRequest, *response.ErrorResponse) {
+	items := make([]requests.CreateOrderItemRequest, 0, len(scanned))
+
+	for _, line := range scanned {
+		product, err := tx.ProductQuery.FindByBarcode(ctx, merchantID, line.Barcode)
+		if err != nil {
+			_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_PRODUCT_BY_BARCODE", span, status, errorhandler.BarcodeErrorResponse(err, errorhandler.ErrFailedResolveBarcode), zap.Error(err), zap.String("product.barcode", line.Barcode))
+			return nil, errResp
+		}
+
+		items = append(items, requests.CreateOrderItemRequest{
+			ProductID: product.ID,
+			Quantity:  line.Quantity,
+		})
+	}
+
+	return items, nil
+}
+
+// priceOrder applies the merchant's promotions to the order's items, keeps
+// the discounts they got and returns the total due. Coupon codes unlock the
+// promotions that need them.
+func (s *orderCommandService) priceOrder(ctx context.Context, tx *repository.TxRepositories, method string, span trace.Span, status *string, orderID int, merchantID int, couponCodes []string) (int, *response.ErrorResponse) {
+	lines, err := tx.Promotion.FindPricedItems(ctx, orderID)
+	if err != nil {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_CALCULATE_TOTAL_PRICE", span, status, orderitem_errors.ErrFailedCalculateTotal, zap.Error(err))
+		return 0, errResp
+	}
+
+	promotions, err := tx.Promotion.FindActiveByMerchant(ctx, merchantID)
+	if err != nil {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_PROMOTIONS", span, status, orderitem_errors.ErrFailedCalculateTotal, zap.Error(err))
+		return 0, errResp
+	}
+
+	timezone, err := tx.Promotion.FindMerchantTimezone(ctx, merchantID)
+	if err != nil {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_MERCHANT_TIMEZONE", span, status, orderitem_errors.ErrFailedCalculateTotal, zap.Error(err))
+		return 0, errResp
+	}
+
+	// Codes the order was priced with before still count, so an update
+	// does not need them presented again.
+	codes, err := tx.Promotion.FindCouponCodes(ctx, orderID)
+	if err != nil {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_FIND_ORDER_COUPONS", span, status, orderitem_errors.ErrFailedCalculateTotal, zap.Error(err))
+		return 0, errResp
+	}
+
+	priced := promotion.Evaluate(promotions, lines, append(codes, promotion.CouponCodes(couponCodes)...), time.Now(), promotion.Location(timezone))
+
+	if err := tx.Promotion.ReplaceDiscounts(ctx, orderID, priced.Discounts); err != nil {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_SAVE_ORDER_DISCOUNTS", span, status, orderitem_errors.ErrFailedCalculateTotal, zap.Error(err))
+		return 0, errResp
+	}
+
+	if err := tx.Promotion.SaveCouponCodes(ctx, orderID, priced.Coupons); err != nil {
+		_, errResp := errorhandler.HandleRepositorySingleError[*response.OrderResponse](s.logger, err, method, "FAILED_SAVE_ORDER_COUPONS", span, status, orderitem_errors.ErrFailedCalculateTotal, zap.Error(err))
+		return 0, errResp
+	}
+
+	span.SetAttributes(
+		attribute.Int("amount.gross", priced.Gross),
+		attribute.Int("amount.discount", priced.Discount),
+		attribute.Int("amount.total", priced.Total),
+	)
+
+	return priced.Total, nil
+}
+
+func (s *orderCommandService) handleStockError(err error, method string, span trace.Span, status *string, productID int, quantity int) *response.ErrorResponse {
+	fields := []zap.Field{zap.Int("product.id", productID), zap.Int("quantity", quantity)}
+
+	var errResp *response.ErrorResponse
+
+	switch {
+	case errors.Is(err, errInvalidQuantity):
+		_, errResp = s.errorhandler.HandleErrorInvalidCountStockTemplate(err, method, "FAILED_INVALID_QUANTITY", span, status, orderitem_errors.ErrFailedInvalidQuantity, fields...)
+	case errors.Is(err, repository.ErrInsufficientStock):
+		_, errResp = s.errorhandler.HandleErrorInsufficientStockTemplate(err, method, "FAILED_INSUFFICIENT_STOCK", span, status, order_errors.ErrInsufficientProductStock, fields...)
+	default:
+		_, errResp = s.errorhandler.HandleErrorInvalidCountStockTemplate(err, method, "FAILED_UPDATE_PRODUCT_COUNT_STOCK", span, status, product_errors.ErrFailedCountStock, fields...)
+	}
+
+	return errResp
+}
+
 func (s *orderCommandService) TrashedOrder(ctx context.Context, orderID int) (*response.OrderResponseDeleteAt, *response.ErrorResponse) {
 	const method = "TrashedOrder"
 
@@ -274,31 +506,54 @@ func (s *orderCommandService) TrashedOrder(ctx context.Context, orderID int) (*r
 		return errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_NOT_DELETE_AT_ORDER", span, &status, order_errors.ErrFailedNotDeleteAtOrder, zap.Error(err))
 	}
 
-	orderItems, err := s.orderItemQueryRepository.FindOrderItemByOrder(ctx, orderID)
-	if err != nil {
-		return errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_FIND_ORDER_ITEM_BY_ORDER", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
-	}
+	var (
+		trashedOrder *record.OrderRecord
+		errResp      *response.ErrorResponse
+	)
 
-	for _, item := range orderItems {
-		if item.DeletedAt != nil {
-			return errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_NOT_DELETE_AT_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedNotDeleteAtOrderItem, zap.Error(err))
+	err = s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		orderItems, err := tx.OrderItemQuery.FindOrderItemByOrder(ctx, orderID)
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_FIND_ORDER_ITEM_BY_ORDER", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
 		}
 
-		trashedItem, err := s.orderItemCommandRepository.TrashedOrderItem(ctx, item.ID)
-
+		refunded, err := tx.OrderItemQuery.FindRefundedQuantities(ctx, orderItems)
 		if err != nil {
-			return errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_TRASH_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedTrashedOrderItem, zap.Error(err))
+			_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_FIND_REFUNDED_QUANTITIES", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
 		}
 
-		s.logger.Debug("Order item trashed successfully",
-			zap.Int("order_item_id", trashedItem.ID),
-			zap.String("deleted_at", *trashedItem.DeletedAt))
-	}
+		if item, err := newStockLedger(tx.ProductCommand).ReleaseItems(ctx, outstandingItems(orderItems, refunded)); err != nil {
+			errResp = s.handleStockError(err, method, span, &status, item.ProductID, item.Quantity)
+			return errRollbackOrder
+		}
 
-	trashedOrder, err := s.orderCommandRepository.TrashedOrder(ctx, orderID)
+		for _, item := range orderItems {
+			trashedItem, err := tx.OrderItemCommand.TrashedOrderItem(ctx, item.ID)
+			if err != nil {
+				_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_TRASH_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedTrashedOrderItem, zap.Error(err))
+				return errRollbackOrder
+			}
 
+			s.logger.Debug("Order item trashed successfully",
+				zap.Int("order_item_id", trashedItem.ID),
+				zap.String("deleted_at", *trashedItem.DeletedAt))
+		}
+
+		trashedOrder, err = tx.OrderCommand.TrashedOrder(ctx, orderID)
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_TRASH_ORDER", span, &status, order_errors.ErrFailedTrashOrder, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_TRASH_ORDER", span, &status, order_errors.ErrFailedTrashOrder, zap.Error(err))
+		return s.errorhandler.HandleTrashedOrderError(err, method, "FAILED_COMMIT_TRASH_ORDER", span, &status, zap.Error(err))
 	}
 
 	so := s.mapping.ToOrderResponseDeleteAt(trashedOrder)
@@ -319,24 +574,50 @@ func (s *orderCommandService) RestoreOrder(ctx context.Context, order_id int) (*
 		end(status)
 	}()
 
-	orderItems, err := s.orderItemQueryRepository.FindOrderItemByOrder(ctx, order_id)
-
-	if err != nil {
-		return errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_FIND_ORDER_ITEM_BY_ORDER", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
-	}
+	var (
+		order   *record.OrderRecord
+		errResp *response.ErrorResponse
+	)
 
-	for _, item := range orderItems {
-		_, err := s.orderItemCommandRepository.RestoreOrderItem(ctx, item.ID)
+	err := s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		orderItems, err := tx.OrderItemQuery.FindTrashedOrderItemByOrder(ctx, order_id)
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_FIND_ORDER_ITEM_BY_ORDER", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
+		}
 
+		refunded, err := tx.OrderItemQuery.FindRefundedQuantities(ctx, orderItems)
 		if err != nil {
-			return errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_RESTORE_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedRestoreOrderItem, zap.Error(err))
+			_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_FIND_REFUNDED_QUANTITIES", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		if item, err := newStockLedger(tx.ProductCommand).ReserveItems(ctx, outstandingItems(orderItems, refunded)); err != nil {
+			errResp = s.handleStockError(err, method, span, &status, item.ProductID, item.Quantity)
+			return errRollbackOrder
 		}
-	}
 
-	order, err := s.orderCommandRepository.RestoreOrder(ctx, order_id)
+		for _, item := range orderItems {
+			_, err := tx.OrderItemCommand.RestoreOrderItem(ctx, item.ID)
+			if err != nil {
+				_, errResp = errorhandler.HandleRepositorySingleError[*response.OrderResponseDeleteAt](s.logger, err, method, "FAILED_RESTORE_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedRestoreOrderItem, zap.Error(err))
+				return errRollbackOrder
+			}
+		}
 
+		order, err = tx.OrderCommand.RestoreOrder(ctx, order_id)
+		if err != nil {
+			_, errResp = s.errorhandler.HandleRestoreOrderError(err, method, "FAILED_RESTORE_ORDER", span, &status, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return nil, errResp
+	}
 	if err != nil {
-		return s.errorhandler.HandleRestoreOrderError(err, method, "FAILED_RESTORE_ORDER", span, &status, zap.Error(err))
+		return s.errorhandler.HandleRestoreOrderError(err, method, "FAILED_COMMIT_RESTORE_ORDER", span, &status, zap.Error(err))
 	}
 
 	so := s.mapping.ToOrderResponseDeleteAt(order)
@@ -355,25 +636,68 @@ func (s *orderCommandService) DeleteOrderPermanent(ctx context.Context, order_id
 		end(status)
 	}()
 
-	orderItems, err := s.orderItemQueryRepository.FindOrderItemByOrder(ctx, order_id)
-
-	if err != nil {
-		return errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_FIND_ORDER_ITEM_BY_ORDER", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+	if _, err := s.orderQueryRepository.FindById(ctx, order_id); err == nil {
+		return errorhandler.HandleRepositorySingleError[bool](s.logger, order_errors.ErrDeleteOrderPermanent, method, "FAILED_ORDER_NOT_TRASHED", span, &status, order_errors.ErrFailedNotDeleteAtOrder, zap.Int("order.id", order_id))
 	}
 
-	for _, item := range orderItems {
-		_, err := s.orderItemCommandRepository.
-			DeleteOrderItemPermanent(ctx, item.ID)
+	var (
+		success bool
+		errResp *response.ErrorResponse
+	)
 
+	err := s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		// Items trashed together with the order already gave their units back;
+		// anything still active here is released before it disappears.
+		activeItems, err := tx.OrderItemQuery.FindOrderItemByOrder(ctx, order_id)
 		if err != nil {
-			return errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_DELETE_ORDER_ITEM_PERMANENT", span, &status, orderitem_errors.ErrFailedDeleteOrderItem, zap.Error(err))
+			_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_FIND_ORDER_ITEM_BY_ORDER", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		refunded, err := tx.OrderItemQuery.FindRefundedQuantities(ctx, activeItems)
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_FIND_REFUNDED_QUANTITIES", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		if item, err := newStockLedger(tx.ProductCommand).ReleaseItems(ctx, outstandingItems(activeItems, refunded)); err != nil {
+			errResp = s.handleStockError(err, method, span, &status, item.ProductID, item.Quantity)
+			return errRollbackOrder
 		}
-	}
 
-	success, err := s.orderCommandRepository.DeleteOrderPermanent(ctx, order_id)
+		for _, item := range activeItems {
+			if _, err := tx.OrderItemCommand.TrashedOrderItem(ctx, item.ID); err != nil {
+				_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_TRASH_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedTrashedOrderItem, zap.Error(err))
+				return errRollbackOrder
+			}
+		}
 
+		orderItems, err := tx.OrderItemQuery.FindTrashedOrderItemByOrder(ctx, order_id)
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_FIND_ORDER_ITEM_BY_ORDER", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		for _, item := range orderItems {
+			if _, err := tx.OrderItemCommand.DeleteOrderItemPermanent(ctx, item.ID); err != nil {
+				_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_DELETE_ORDER_ITEM_PERMANENT", span, &status, orderitem_errors.ErrFailedDeleteOrderItem, zap.Error(err))
+				return errRollbackOrder
+			}
+		}
+
+		success, err = tx.OrderCommand.DeleteOrderPermanent(ctx, order_id)
+		if err != nil {
+			_, errResp = s.errorhandler.HandleDeleteOrderError(err, method, "FAILED_DELETE_ORDER_PERMANENT", span, &status, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return false, errResp
+	}
 	if err != nil {
-		return s.errorhandler.HandleDeleteOrderError(err, method, "FAILED_DELETE_ORDER_PERMANENT", span, &status, zap.Error(err))
+		return s.errorhandler.HandleDeleteOrderError(err, method, "FAILED_COMMIT_DELETE_ORDER_PERMANENT", span, &status, zap.Error(err))
 	}
 
 	logSuccess("Successfully deleted order permanently", zap.Int("order.id", order_id))
@@ -390,15 +714,53 @@ func (s *orderCommandService) RestoreAllOrder(ctx context.Context) (bool, *respo
 		end(status)
 	}()
 
-	successItems, err := s.orderItemCommandRepository.RestoreAllOrderItem(ctx)
+	var (
+		success bool
+		errResp *response.ErrorResponse
+	)
 
-	if err != nil || !successItems {
-		return errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_RESTORE_ALL_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedRestoreAllOrderItem, zap.Error(err))
-	}
+	err := s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		orderItems, err := tx.OrderItemQuery.FindTrashedOrderItems(ctx)
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_FIND_TRASHED_ORDER_ITEMS", span, &status, orderitem_errors.ErrFailedFindOrderItemsByTrashed, zap.Error(err))
+			return errRollbackOrder
+		}
 
-	success, err := s.orderCommandRepository.RestoreAllOrder(ctx)
-	if err != nil || !success {
-		return errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_RESTORE_ALL_ORDER", span, &status, order_errors.ErrFailedRestoreAllOrder, zap.Error(err))
+		refunded, err := tx.OrderItemQuery.FindRefundedQuantities(ctx, orderItems)
+		if err != nil {
+			_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_FIND_REFUNDED_QUANTITIES", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		if item, err := newStockLedger(tx.ProductCommand).ReserveItems(ctx, outstandingItems(orderItems, refunded)); err != nil {
+			errResp = s.handleStockError(err, method, span, &status, item.ProductID, item.Quantity)
+			return errRollbackOrder
+		}
+
+		successItems, err := tx.OrderItemCommand.RestoreAllOrderItem(ctx)
+		if err != nil || !successItems {
+			_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_RESTORE_ALL_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedRestoreAllOrderItem, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		success, err = tx.OrderCommand.RestoreAllOrder(ctx)
+		if err != nil || !success {
+			_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_RESTORE_ALL_ORDER", span, &status, order_errors.ErrFailedRestoreAllOrder, zap.Error(err))
+			return errRollbackOrder
+		}
+
+		// A dry run checks the stock can be reserved again, then undoes it.
+		if scope, ok := bulk.FromContext(ctx); ok && scope.DryRun {
+			return errBulkDryRun
+		}
+
+		return nil
+	})
+	if errResp != nil {
+		return false, errResp
+	}
+	if err != nil && !errors.Is(err, errBulkDryRun) {
+		return s.errorhandler.HandleRestoreAllOrderError(err, method, "FAILED_COMMIT_RESTORE_ALL_ORDER", span, &status, zap.Error(err))
 	}
 
 	logSuccess("Successfully restored all orders", zap.Bool("success", success))
@@ -415,16 +777,31 @@ func (s *orderCommandService) DeleteAllOrderPermanent(ctx context.Context) (bool
 		end(status)
 	}()
 
-	successItems, err := s.orderCommandRepository.DeleteAllOrderPermanent(ctx)
+	var (
+		success bool
+		errResp *response.ErrorResponse
+	)
 
-	if err != nil || !successItems {
-		return errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_DELETE_ALL_ORDER_ITEM_PERMANENT", span, &status, orderitem_errors.ErrFailedDeleteAllOrderItem, zap.Error(err))
-	}
+	err := s.unitOfWork.WithTx(ctx, func(tx *repository.TxRepositories) error {
+		successItems, err := tx.OrderItemCommand.DeleteAllOrderPermanent(ctx)
+		if err != nil || !successItems {
+			_, errResp = errorhandler.HandleRepositorySingleError[bool](s.logger, err, method, "FAILED_DELETE_ALL_ORDER_ITEM_PERMANENT", span, &status, orderitem_errors.ErrFailedDeleteAllOrderItem, zap.Error(err))
+			return errRollbackOrder
+		}
 
-	success, err := s.orderCommandRepository.DeleteAllOrderPermanent(ctx)
+		success, err = tx.OrderCommand.DeleteAllOrderPermanent(ctx)
+		if err != nil || !success {
+			_, errResp = s.errorhandler.HandleDeleteAllOrderError(err, method, "FAILED_DELETE_ALL_ORDER_PERMANENT", span, &status, zap.Error(err))
+			return errRollbackOrder
+		}
 
-	if err != nil || !success {
-		return s.errorhandler.HandleDeleteAllOrderError(err, method, "FAILED_DELETE_ALL_ORDER_PERMANENT", span, &status, zap.Error(err))
+		return nil
+	})
+	if errResp != nil {
+		return false, errResp
+	}
+	if err != nil {
+		return s.errorhandler.HandleDeleteAllOrderError(err, method, "FAILED_COMMIT_DELETE_ALL_ORDER_PERMANENT", span, &status, zap.Error(err))
 	}
 
 	logSuccess("Successfully deleted all orders permanently", zap.Bool("success", success))
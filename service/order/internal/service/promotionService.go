@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/promotion"
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/order_errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type promotionService struct {
+	errorhandler         errorhandler.PromotionError
+	trace                trace.Tracer
+	promotionRepository  repository.PromotionRepository
+	orderQueryRepository repository.OrderQueryRepository
+	logger               logger.LoggerInterface
+	requestCounter       *prometheus.CounterVec
+	requestDuration      *prometheus.HistogramVec
+}
+
+func NewPromotionService(
+	errorhandler errorhandler.PromotionError,
+	promotionRepository repository.PromotionRepository,
+	orderQueryRepository repository.OrderQueryRepository,
+	logger logger.LoggerInterface,
+) *promotionService {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "promotion_service_request_count",
+			Help: "Total number of requests to the PromotionService",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "promotion_service_request_duration",
+			Help:    "Histogram of request durations for the PromotionService",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return &promotionService{
+		errorhandler:         errorhandler,
+		trace:                otel.Tracer("promotion-service"),
+		promotionRepository:  promotionRepository,
+		orderQueryRepository: orderQueryRepository,
+		logger:               logger,
+		requestCounter:       requestCounter,
+		requestDuration:      requestDuration,
+	}
+}
+
+// CreatePromotion adds a rule to the merchant's promotions. It applies to
+// orders created or updated from then on; existing orders keep their prices.
+func (s *promotionService) CreatePromotion(ctx context.Context, req *promotion.Promotion) (*promotion.Promotion, *response.ErrorResponse) {
+	const method = "CreatePromotion"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("merchant.id", req.MerchantID), attribute.String("promotion.kind", string(req.Kind)))
+
+	defer func() {
+		end(status)
+	}()
+
+	req.Name = strings.TrimSpace(req.Name)
+	req.CouponCode = strings.TrimSpace(req.CouponCode)
+	if req.StartsAt.IsZero() {
+		req.StartsAt = time.Now()
+	}
+
+	if err := req.Validate(); err != nil {
+		return s.errorhandler.HandleCreatePromotionError(err, method, "FAILED_INVALID_PROMOTION", span, &status, zap.Int("merchant.id", req.MerchantID))
+	}
+
+	res, err := s.promotionRepository.CreatePromotion(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleCreatePromotionError(err, method, "FAILED_CREATE_PROMOTION", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully created promotion", zap.Int("promotion.id", res.ID), zap.Int("merchant.id", res.MerchantID))
+
+	return res, nil
+}
+
+func (s *promotionService) FindById(ctx context.Context, promotionID int) (*promotion.Promotion, *response.ErrorResponse) {
+	const method = "FindById"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("promotion.id", promotionID))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.promotionRepository.FindById(ctx, promotionID)
+	if err != nil {
+		return s.errorhandler.HandleFindPromotionError(err, method, "FAILED_FIND_PROMOTION", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched promotion", zap.Int("promotion.id", promotionID))
+
+	return res, nil
+}
+
+func (s *promotionService) FindByMerchant(ctx context.Context, merchantID int) ([]*promotion.Promotion, *response.ErrorResponse) {
+	const method = "FindByMerchant"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("merchant.id", merchantID))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.promotionRepository.FindByMerchant(ctx, merchantID)
+	if err != nil {
+		return s.errorhandler.HandleFindPromotionsError(err, method, "FAILED_FIND_PROMOTIONS_BY_MERCHANT", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched promotions", zap.Int("merchant.id", merchantID), zap.Int("count", len(res)))
+
+	return res, nil
+}
+
+// TrashPromotion ends a promotion. Orders priced with it keep their
+// discounts.
+func (s *promotionService) TrashPromotion(ctx context.Context, promotionID int) (bool, *response.ErrorResponse) {
+	const method = "TrashPromotion"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("promotion.id", promotionID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.promotionRepository.FindById(ctx, promotionID); err != nil {
+		return s.errorhandler.HandleTrashPromotionError(err, method, "FAILED_FIND_PROMOTION", span, &status, zap.Error(err))
+	}
+
+	if err := s.promotionRepository.TrashPromotion(ctx, promotionID); err != nil {
+		return s.errorhandler.HandleTrashPromotionError(err, method, "FAILED_TRASH_PROMOTION", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully trashed promotion", zap.Int("promotion.id", promotionID))
+
+	return true, nil
+}
+
+// FindOrderDiscounts lists the discounts the order's items were sold with.
+func (s *promotionService) FindOrderDiscounts(ctx context.Context, orderID int) ([]promotion.Discount, *response.ErrorResponse) {
+	const method = "FindOrderDiscounts"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("order.id", orderID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.orderQueryRepository.FindById(ctx, orderID); err != nil {
+		return errorhandler.HandleRepositorySingleError[[]promotion.Discount](s.logger, err, method, "FAILED_FIND_ORDER_BY_ID", span, &status, order_errors.ErrFailedFindOrderById, zap.Error(err))
+	}
+
+	res, err := s.promotionRepository.FindDiscountsByOrder(ctx, orderID)
+	if err != nil {
+		return s.errorhandler.HandleFindOrderDiscountsError(err, method, "FAILED_FIND_ORDER_DISCOUNTS", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched order discounts", zap.Int("order.id", orderID))
+
+	return res, nil
+}
+
+func (s *promotionService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
+	context.Context,
+	trace.Span,
+	func(string),
+	string,
+	func(string, ...zap.Field),
+) {
+	start := time.Now()
+	status := "success"
+
+	ctx, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+
+	s.logger.Debug("Start: " + method)
+
+	end := func(status string) {
+		s.recordMetrics(method, status, start)
+		code := codes.Ok
+		if status != "success" {
+			code = codes.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess := func(msg string, fields ...zap.Field) {
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	return ctx, span, end, status, logSuccess
+}
+
+func (s *promotionService) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
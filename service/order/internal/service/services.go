@@ -15,6 +15,8 @@ type Service struct {
 	OrderCommand         OrderCommandService
 	OrderStats           OrderStatsService
 	OrderStatsByMerchant OrderStatByMerchantService
+	Promotion            PromotionService
+	Customer             CustomerService
 }
 
 type Deps struct {
@@ -29,8 +31,10 @@ func NewService(deps *Deps) *Service {
 	mapper := response_service.NewOrderResponseMapper()
 	return &Service{
 		OrderQuery:           NewOrderQueryService(deps.ErrorHandler.OrderQueryError, deps.Mencache.OrderQueryCache, deps.Repositories.OrderQuery, deps.Logger, mapper),
-		OrderCommand:         NewOrderCommandService(deps.ErrorHandler.OrderCommandError, deps.Mencache.OrderCommandCache, deps.Repositories.CashierQuery, deps.Repositories.OrderItemQuery, deps.Repositories.OrderItemCommand, deps.Repositories.OrderQuery, deps.Repositories.OrderCommand, deps.Repositories.ProductQuery, deps.Repositories.ProductCommand, deps.Repositories.MerchantQuery, deps.Logger, mapper),
+		OrderCommand:         NewOrderCommandService(deps.ErrorHandler.OrderCommandError, deps.Mencache.OrderCommandCache, deps.Repositories.CashierQuery, deps.Repositories.OrderItemQuery, deps.Repositories.OrderItemCommand, deps.Repositories.OrderQuery, deps.Repositories.OrderCommand, deps.Repositories.ProductQuery, deps.Repositories.ProductCommand, deps.Repositories.MerchantQuery, deps.Repositories.UnitOfWork, deps.Logger, mapper),
 		OrderStats:           NewOrderStatsService(deps.ErrorHandler.OrderStats, deps.Mencache.OrderStatsCache, deps.Repositories.OrderStats, deps.Logger, mapper),
 		OrderStatsByMerchant: NewOrderStatsByMerchantService(deps.Mencache.OrderStatsByMerchantCache, deps.ErrorHandler.OrderStatsByMerchant, deps.Repositories.OrderStatsByMerchant, deps.Logger, mapper),
+		Promotion:            NewPromotionService(deps.ErrorHandler.PromotionError, deps.Repositories.Promotion, deps.Repositories.OrderQuery, deps.Logger),
+		Customer:             NewCustomerService(deps.ErrorHandler.CustomerError, deps.Repositories.Customer, deps.Repositories.OrderQuery, deps.Repositories.MerchantQuery, deps.Logger),
 	}
 }
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
+)
+
+var errInvalidQuantity = errors.New("order item quantity must be positive")
+
+// stockLedger keeps product stock in step with the order item lifecycle.
+// Units are reserved while an item is active and released once it leaves
+// that state, so every movement happens exactly once per item.
+type stockLedger struct {
+	products repository.ProductCommandRepository
+}
+
+func newStockLedger(products repository.ProductCommandRepository) *stockLedger {
+	return &stockLedger{
+		products: products,
+	}
+}
+
+func (l *stockLedger) Reserve(ctx context.Context, productID int, quantity int) error {
+	if quantity <= 0 {
+		return errInvalidQuantity
+	}
+
+	_, err := l.products.DecrementProductCountStock(ctx, productID, quantity)
+
+	return err
+}
+
+func (l *stockLedger) Release(ctx context.Context, productID int, quantity int) error {
+	if quantity <= 0 {
+		return nil
+	}
+
+	_, err := l.products.IncrementProductCountStock(ctx, productID, quantity)
+
+	return err
+}
+
+func (l *stockLedger) Adjust(ctx context.Context, productID int, from int, to int) error {
+	if to <= 0 {
+		return errInvalidQuantity
+	}
+
+	switch delta := to - from; {
+	case delta > 0:
+		return l.Reserve(ctx, productID, delta)
+	case delta < 0:
+		return l.Release(ctx, productID, -delta)
+	}
+
+	return nil
+}
+
+func (l *stockLedger) ReserveItems(ctx context.Context, items []*record.OrderItemRecord) (*record.OrderItemRecord, error) {
+	for _, item := range items {
+		if err := l.Reserve(ctx, item.ProductID, item.Quantity); err != nil {
+			return item, err
+		}
+	}
+
+	return nil, nil
+}
+
+func (l *stockLedger) ReleaseItems(ctx context.Context, items []*record.OrderItemRecord) (*record.OrderItemRecord, error) {
+	for _, item := range items {
+		if err := l.Release(ctx, item.ProductID, item.Quantity); err != nil {
+			return item, err
+		}
+	}
+
+	return nil, nil
+}
+
+// outstandingItems takes off each item the units refunds already gave back
+// to stock, so trashing or restoring the order does not move them twice.
+func outstandingItems(items []*record.OrderItemRecord, refunded map[int]int) []*record.OrderItemRecord {
+	outstanding := make([]*record.OrderItemRecord, 0, len(items))
+
+	for _, item := range items {
+		quantity := item.Quantity - refunded[item.ID]
+		if quantity <= 0 {
+			continue
+		}
+
+		left := *item
+		left.Quantity = quantity
+		outstanding = append(outstanding, &left)
+	}
+
+	return outstanding
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MamangRust/monolith-point-of-sale-order/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
+)
+
+// fakeProductStock records stock movements against an in-memory count.
+type fakeProductStock struct {
+	stock map[int]int
+	moves []int
+}
+
+func newFakeProductStock(stock map[int]int) *fakeProductStock {
+	return &fakeProductStock{stock: stock}
+}
+
+func (f *fakeProductStock) UpdateProductCountStock(ctx context.Context, productID int, stock int) (*record.ProductRecord, error) {
+	f.stock[productID] = stock
+
+	return &record.ProductRecord{ID: productID, CountInStock: stock}, nil
+}
+
+func (f *fakeProductStock) DecrementProductCountStock(ctx context.Context, productID int, quantity int) (*record.ProductRecord, error) {
+	if f.stock[productID] < quantity {
+		return nil, repository.ErrInsufficientStock
+	}
+
+	f.stock[productID] -= quantity
+	f.moves = append(f.moves, -quantity)
+
+	return &record.ProductRecord{ID: productID, CountInStock: f.stock[productID]}, nil
+}
+
+func (f *fakeProductStock) IncrementProductCountStock(ctx context.Context, productID int, quantity int) (*record.ProductRecord, error) {
+	f.stock[productID] += quantity
+	f.moves = append(f.moves, quantity)
+
+	return &record.ProductRecord{ID: productID, CountInStock: f.stock[productID]}, nil
+}
+
+func TestStockLedgerAdjust(t *testing.T) {
+	tests := []struct {
+		name      string
+		stock     int
+		from      int
+		to        int
+		wantStock int
+		wantErr   error
+	}{
+		{name: "grow reserves the delta", stock: 10, from: 2, to: 5, wantStock: 7},
+		{name: "shrink releases the delta", stock: 10, from: 5, to: 2, wantStock: 13},
+		{name: "unchanged moves nothing", stock: 10, from: 3, to: 3, wantStock: 10},
+		{name: "grow past stock fails", stock: 2, from: 1, to: 4, wantStock: 2, wantErr: repository.ErrInsufficientStock},
+		{name: "zero quantity is rejected", stock: 10, from: 3, to: 0, wantStock: 10, wantErr: errInvalidQuantity},
+		{name: "negative quantity is rejected", stock: 10, from: 3, to: -1, wantStock: 10, wantErr: errInvalidQuantity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := newFakeProductStock(map[int]int{1: tt.stock})
+
+			err := newStockLedger(products).Adjust(context.Background(), 1, tt.from, tt.to)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Adjust() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := products.stock[1]; got != tt.wantStock {
+				t.Errorf("stock = %d, want %d", got, tt.wantStock)
+			}
+		})
+	}
+}
+
+func TestStockLedgerReserveItems(t *testing.T) {
+	items := []*record.OrderItemRecord{
+		{ID: 1, ProductID: 1, Quantity: 2},
+		{ID: 2, ProductID: 2, Quantity: 5},
+		{ID: 3, ProductID: 1, Quantity: 1},
+	}
+
+	t.Run("reserves every item", func(t *testing.T) {
+		products := newFakeProductStock(map[int]int{1: 3, 2: 5})
+
+		failed, err := newStockLedger(products).ReserveItems(context.Background(), items)
+		if err != nil || failed != nil {
+			t.Fatalf("ReserveItems() = %v, %v, want no failure", failed, err)
+		}
+		if products.stock[1] != 0 || products.stock[2] != 0 {
+			t.Errorf("stock = %v, want everything reserved", products.stock)
+		}
+	})
+
+	t.Run("reports the item that ran out", func(t *testing.T) {
+		products := newFakeProductStock(map[int]int{1: 2, 2: 5})
+
+		failed, err := newStockLedger(products).ReserveItems(context.Background(), items)
+		if !errors.Is(err, repository.ErrInsufficientStock) {
+			t.Fatalf("ReserveItems() error = %v, want %v", err, repository.ErrInsufficientStock)
+		}
+		if failed == nil || failed.ID != 3 {
+			t.Errorf("failed item = %+v, want item 3", failed)
+		}
+	})
+}
+
+func TestStockLedgerReleaseItems(t *testing.T) {
+	products := newFakeProductStock(map[int]int{1: 0, 2: 0})
+
+	items := []*record.OrderItemRecord{
+		{ID: 1, ProductID: 1, Quantity: 2},
+		{ID: 2, ProductID: 2, Quantity: 0},
+		{ID: 3, ProductID: 1, Quantity: 1},
+	}
+
+	if _, err := newStockLedger(products).ReleaseItems(context.Background(), items); err != nil {
+		t.Fatalf("ReleaseItems() error = %v", err)
+	}
+
+	if products.stock[1] != 3 || products.stock[2] != 0 {
+		t.Errorf("stock = %v, want map[1:3 2:0]", products.stock)
+	}
+	if len(products.moves) != 2 {
+		t.Errorf("moves = %v, want empty items skipped", products.moves)
+	}
+}
+
+func TestOutstandingItems(t *testing.T) {
+	items := []*record.OrderItemRecord{
+		{ID: 1, ProductID: 1, Quantity: 3},
+		{ID: 2, ProductID: 2, Quantity: 2},
+		{ID: 3, ProductID: 3, Quantity: 4},
+	}
+
+	got := outstandingItems(items, map[int]int{1: 1, 2: 2})
+
+	want := map[int]int{1: 2, 3: 4}
+	if len(got) != len(want) {
+		t.Fatalf("outstandingItems() = %d items, want %d", len(got), len(want))
+	}
+	for _, item := range got {
+		if item.Quantity != want[item.ID] {
+			t.Errorf("item %d quantity = %d, want %d", item.ID, item.Quantity, want[item.ID])
+		}
+	}
+	if items[0].Quantity != 3 {
+		t.Errorf("outstandingItems() changed the order item, quantity = %d", items[0].Quantity)
+	}
+}
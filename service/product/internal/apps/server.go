@@ -9,9 +9,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	otel_pkg "github.com/MamangRust/monolith-point-of-sale-pkg/otel"
 	"github.com/MamangRust/monolith-point-of-sale-product/internal/errorhandler"
@@ -28,6 +33,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -44,11 +50,14 @@ func init() {
 }
 
 type Server struct {
-	Logger   logger.LoggerInterface
-	DB       *db.Queries
-	Services *service.Service
-	Handlers *handler.Handler
-	Ctx      context.Context
+	Logger       logger.LoggerInterface
+	DB           *db.Queries
+	Repositories *repository.Repositories
+	Services     *service.Service
+	Handlers     *handler.Handler
+	Relay        *outbox.Relay
+	Conn         *audit.DB
+	Ctx          context.Context
 }
 
 func NewServer(ctx context.Context) (*Server, func(context.Context) error, error) {
@@ -62,13 +71,14 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 	}
 	flag.Parse()
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 
 	shutdownTracerProvider, err := otel_pkg.InitTracerProvider("Product-service", ctx)
 
@@ -102,6 +112,8 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Logger: logger,
 	})
 
+	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
+
 	errorhandler := errorhandler.NewErrorHandler(logger)
 
 	services := service.NewService(&service.Deps{
@@ -116,12 +128,17 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Service: services,
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, myKafka, logger)
+
 	return &Server{
-		Logger:   logger,
-		DB:       DB,
-		Services: services,
-		Handlers: handlers,
-		Ctx:      ctx,
+		Logger:       logger,
+		DB:           DB,
+		Repositories: repositories,
+		Services:     services,
+		Handlers:     handlers,
+		Relay:        relay,
+		Conn:         conn,
+		Ctx:          ctx,
 	}, shutdownTracerProvider, nil
 }
 
@@ -150,6 +167,21 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			tenant.Middleware(s.Repositories.Tenant, s.Logger),
+			tenant.Guard(
+				pb.ProductService_RestoreAllProduct_FullMethodName,
+				pb.ProductService_DeleteAllProductPermanent_FullMethodName,
+			),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "product",
+				RequestID: middleware.RequestID,
+				Snapshots: map[string]audit.Snapshot{
+					"pb.ProductService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Product.FindById(ctx, &pb.FindByIdProductRequest{Id: int32(id)})
+					},
+				},
+			}, s.Logger),
+			bulk.Middleware(s.Logger),
 		),
 	)
 
@@ -160,6 +192,8 @@ func (s *Server) Run() {
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
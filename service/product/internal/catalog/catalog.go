@@ -0,0 +1,68 @@
+package catalog
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/barcode"
+)
+
+const maxSKULength = 64
+
+var (
+	ErrInvalidSKU      = errors.New("sku may only hold letters, digits, dashes, dots, slashes and underscores")
+	ErrCodeTaken       = errors.New("sku or barcode is already used by another product of the merchant")
+	ErrBarcodeNotFound = errors.New("no product has this barcode")
+)
+
+// Codes are the SKU and barcode a call sets on a product; nil leaves the
+// product's code unchanged.
+type Codes struct {
+	SKU     *string
+	Barcode *string
+}
+
+func NormalizeSKU(sku string) (string, error) {
+	sku = strings.ToUpper(strings.TrimSpace(sku))
+	if len(sku) > maxSKULength {
+		return "", ErrInvalidSKU
+	}
+
+	for _, r := range sku {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '.', r == '/', r == '_':
+		default:
+			return "", ErrInvalidSKU
+		}
+	}
+
+	return sku, nil
+}
+
+// NewCodes normalizes the SKU and barcode a call sets. An empty code
+// clears it.
+func NewCodes(sku, code *string) (*Codes, error) {
+	codes := &Codes{}
+
+	if sku != nil {
+		normalized, err := NormalizeSKU(*sku)
+		if err != nil {
+			return nil, err
+		}
+		codes.SKU = &normalized
+	}
+
+	if code != nil {
+		normalized := strings.TrimSpace(*code)
+		if normalized != "" {
+			var err error
+			if normalized, err = barcode.Normalize(normalized); err != nil {
+				return nil, err
+			}
+		}
+		codes.Barcode = &normalized
+	}
+
+	return codes, nil
+}
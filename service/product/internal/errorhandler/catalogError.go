@@ -0,0 +1,33 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/barcode"
+	"github.com/MamangRust/monolith-point-of-sale-product/internal/catalog"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+)
+
+var (
+	ErrInvalidBarcode         = response.NewErrorResponse("Barcode must be a valid EAN-13, UPC-A or EAN-8 code", http.StatusBadRequest)
+	ErrInvalidSKU             = response.NewErrorResponse("SKU may only hold letters, digits, dashes, dots, slashes and underscores", http.StatusBadRequest)
+	ErrProductCodeTaken       = response.NewErrorResponse("SKU or barcode is already used by another product", http.StatusConflict)
+	ErrProductBarcodeNotFound = response.NewErrorResponse("Product with this barcode not found", http.StatusNotFound)
+	ErrFailedFindByBarcode    = response.NewErrorResponse("Failed to find product by barcode", http.StatusInternalServerError)
+)
+
+func productCodeErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, barcode.ErrInvalid):
+		return ErrInvalidBarcode
+	case errors.Is(err, catalog.ErrInvalidSKU):
+		return ErrInvalidSKU
+	case errors.Is(err, catalog.ErrCodeTaken):
+		return ErrProductCodeTaken
+	case errors.Is(err, catalog.ErrBarcodeNotFound):
+		return ErrProductBarcodeNotFound
+	default:
+		return fallback
+	}
+}
@@ -22,6 +22,7 @@ type ProductQueryError interface {
 		errResp *response.ErrorResponse,
 		fields ...zap.Field,
 	) ([]*response.ProductResponseDeleteAt, *int, *response.ErrorResponse)
+	HandleFindByBarcodeError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.ProductResponse, *response.ErrorResponse)
 }
 
 type ProductCommandError interface {
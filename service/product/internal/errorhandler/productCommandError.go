@@ -39,11 +39,11 @@ func (p *productCommandError) HandleRepositorySingleError(
 }
 
 func (p *productCommandError) HandleCreateProductError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.ProductResponse, *response.ErrorResponse) {
-	return handleErrorRepository[*response.ProductResponse](p.logger, err, method, tracePrefix, span, status, product_errors.ErrFailedCreateProduct, fields...)
+	return handleErrorRepository[*response.ProductResponse](p.logger, err, method, tracePrefix, span, status, productCodeErrorResponse(err, product_errors.ErrFailedCreateProduct), fields...)
 }
 
 func (p *productCommandError) HandleUpdateProductError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.ProductResponse, *response.ErrorResponse) {
-	return handleErrorRepository[*response.ProductResponse](p.logger, err, method, tracePrefix, span, status, product_errors.ErrFailedUpdateProduct, fields...)
+	return handleErrorRepository[*response.ProductResponse](p.logger, err, method, tracePrefix, span, status, productCodeErrorResponse(err, product_errors.ErrFailedUpdateProduct), fields...)
 }
 
 func (p *productCommandError) HandleTrashedProductError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.ProductResponseDeleteAt, *response.ErrorResponse) {
@@ -51,7 +51,7 @@ func (p *productCommandError) HandleTrashedProductError(err error, method, trace
 }
 
 func (p *productCommandError) HandleRestoreProductError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.ProductResponseDeleteAt, *response.ErrorResponse) {
-	return handleErrorRepository[*response.ProductResponseDeleteAt](p.logger, err, method, tracePrefix, span, status, product_errors.ErrFailedRestoreProduct, fields...)
+	return handleErrorRepository[*response.ProductResponseDeleteAt](p.logger, err, method, tracePrefix, span, status, productCodeErrorResponse(err, product_errors.ErrFailedRestoreProduct), fields...)
 }
 
 func (p *productCommandError) HandleDeleteProductError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse) {
@@ -48,3 +48,7 @@ func (p *productQueryError) HandleRepositorySingleError(
 ) (*response.ProductResponse, *response.ErrorResponse) {
 	return handleErrorRepository[*response.ProductResponse](p.logger, err, method, tracePrefix, span, status, errResp, fields...)
 }
+
+func (p *productQueryError) HandleFindByBarcodeError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.ProductResponse, *response.ErrorResponse) {
+	return handleErrorRepository[*response.ProductResponse](p.logger, err, method, tracePrefix, span, status, productCodeErrorResponse(err, ErrFailedFindByBarcode), fields...)
+}
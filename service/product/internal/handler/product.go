@@ -8,10 +8,10 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/product_errors"
+	protomapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/proto"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
 	"google.golang.org/protobuf/types/known/emptypb"
-
-	protomapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type productHandleGrpc struct {
@@ -187,6 +187,28 @@ func (s *productHandleGrpc) FindById(ctx context.Context, request *pb.FindByIdPr
 
 }
 
+func (s *productHandleGrpc) FindByBarcode(ctx context.Context, request *pb.FindByBarcodeProductRequest) (*pb.ApiResponseProduct, error) {
+	merchantID := int(request.GetMerchantId())
+
+	if merchantID <= 0 {
+		return nil, product_errors.ErrGrpcInvalidMerchantID
+	}
+
+	if request.GetBarcode() == "" {
+		return nil, product_errors.ErrGrpcBarcodeRequired
+	}
+
+	product, err := s.productQueryService.FindByBarcode(ctx, merchantID, request.GetBarcode())
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	so := s.mapping.ToProtoResponseProduct("success", "Successfully fetched product", product)
+
+	return so, nil
+}
+
 func (s *productHandleGrpc) FindByActive(ctx context.Context, request *pb.FindAllProductRequest) (*pb.ApiResponsePaginationProductDeleteAt, error) {
 	page := int(request.GetPage())
 	pageSize := int(request.GetPageSize())
@@ -273,6 +295,8 @@ func (s *productHandleGrpc) Create(ctx context.Context, request *pb.CreateProduc
 		Brand:        request.GetBrand(),
 		Weight:       int(request.GetWeight()),
 		ImageProduct: request.GetImageProduct(),
+		SKU:          fromStringValue(request.GetSku()),
+		Barcode:      fromStringValue(request.GetBarcode()),
 	}
 
 	if err := req.Validate(); err != nil {
@@ -307,6 +331,8 @@ func (s *productHandleGrpc) Update(ctx context.Context, request *pb.UpdateProduc
 		Brand:        request.GetBrand(),
 		Weight:       int(request.GetWeight()),
 		ImageProduct: request.GetImageProduct(),
+		SKU:          fromStringValue(request.GetSku()),
+		Barcode:      fromStringValue(request.GetBarcode()),
 	}
 
 	if err := req.Validate(); err != nil {
@@ -399,3 +425,13 @@ func (s *productHandleGrpc) DeleteAllProductPermanent(ctx context.Context, _ *em
 
 	return so, nil
 }
+
+// fromStringValue returns nil for a field the request leaves out.
+func fromStringValue(value *wrapperspb.StringValue) *string {
+	if value == nil {
+		return nil
+	}
+
+	v := value.GetValue()
+	return &v
+}
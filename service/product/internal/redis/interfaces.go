@@ -25,8 +25,12 @@ type ProductQueryCache interface {
 
 	GetCachedProduct(ctx context.Context, productID int) (*response.ProductResponse, bool)
 	SetCachedProduct(ctx context.Context, data *response.ProductResponse)
+
+	GetCachedProductByBarcode(ctx context.Context, merchantID int, barcode string) (*response.ProductResponse, bool)
+	SetCachedProductByBarcode(ctx context.Context, merchantID int, barcode string, data *response.ProductResponse)
 }
 
 type ProductCommandCache interface {
 	DeleteCachedProduct(ctx context.Context, productID int)
+	DeleteCachedProductBarcode(ctx context.Context, merchantID int, barcode string)
 }
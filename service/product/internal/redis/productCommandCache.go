@@ -16,3 +16,11 @@ func NewProductCommandCache(store *CacheStore) *productCommandCache {
 func (c *productCommandCache) DeleteCachedProduct(ctx context.Context, productID int) {
 	DeleteFromCache(ctx, c.store, fmt.Sprintf(productByIdCacheKey, productID))
 }
+
+func (c *productCommandCache) DeleteCachedProductBarcode(ctx context.Context, merchantID int, barcode string) {
+	if barcode == "" {
+		return
+	}
+
+	DeleteFromCache(ctx, c.store, fmt.Sprintf(productBarcodeCacheKey, merchantID, barcode))
+}
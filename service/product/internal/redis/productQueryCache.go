@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
@@ -18,6 +19,8 @@ const (
 	productTrashedCacheKey = "product:trashed:page:%d:pageSize:%d:search:%s"
 	productByIdCacheKey    = "product:id:%d"
 
+	productBarcodeCacheKey = "product:merchant:%d:barcode:%s"
+
 	ttlDefault = 5 * time.Minute
 )
 
@@ -198,3 +201,21 @@ func (p *productQueryCache) SetCachedProduct(ctx context.Context, data *response
 	key := fmt.Sprintf(productByIdCacheKey, data.ID)
 	SetToCache(ctx, p.store, key, data, ttlDefault)
 }
+
+// Barcode lookups are keyed by merchant, so a merchant-scoped caller shares
+// the entries of the merchants it may see.
+func (p *productQueryCache) GetCachedProductByBarcode(ctx context.Context, merchantID int, barcode string) (*response.ProductResponse, bool) {
+	if !tenant.Allows(ctx, merchantID) {
+		return nil, false
+	}
+
+	return getCached[response.ProductResponse](ctx, p.store, fmt.Sprintf(productBarcodeCacheKey, merchantID, barcode))
+}
+
+func (p *productQueryCache) SetCachedProductByBarcode(ctx context.Context, merchantID int, barcode string, data *response.ProductResponse) {
+	if data == nil || !tenant.Allows(ctx, merchantID) {
+		return
+	}
+
+	setCached(ctx, p.store, fmt.Sprintf(productBarcodeCacheKey, merchantID, barcode), data, ttlDefault)
+}
@@ -2,7 +2,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-product/internal/catalog"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 )
@@ -23,11 +26,12 @@ type ProductQueryRepository interface {
 	FindByCategory(ctx context.Context, req *requests.ProductByCategoryRequest) ([]*record.ProductRecord, *int, error)
 	FindById(ctx context.Context, user_id int) (*record.ProductRecord, error)
 	FindByIdTrashed(ctx context.Context, id int) (*record.ProductRecord, error)
+	FindByBarcode(ctx context.Context, merchant_id int, barcode string) (*record.ProductRecord, error)
 }
 
 type ProductCommandRepository interface {
-	CreateProduct(ctx context.Context, request *requests.CreateProductRequest) (*record.ProductRecord, error)
-	UpdateProduct(ctx context.Context, request *requests.UpdateProductRequest) (*record.ProductRecord, error)
+	CreateProduct(ctx context.Context, request *requests.CreateProductRequest, codes *catalog.Codes) (*record.ProductRecord, error)
+	UpdateProduct(ctx context.Context, request *requests.UpdateProductRequest, codes *catalog.Codes) (*record.ProductRecord, error)
 	UpdateProductCountStock(ctx context.Context, product_id int, stock int) (*record.ProductRecord, error)
 	TrashedProduct(ctx context.Context, user_id int) (*record.ProductRecord, error)
 	RestoreProduct(ctx context.Context, user_id int) (*record.ProductRecord, error)
@@ -35,3 +39,16 @@ type ProductCommandRepository interface {
 	RestoreAllProducts(ctx context.Context) (bool, error)
 	DeleteAllProductPermanent(ctx context.Context) (bool, error)
 }
+
+type TenantRepository interface {
+	FindMerchantIDsByUser(ctx context.Context, userID int) ([]int, error)
+}
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
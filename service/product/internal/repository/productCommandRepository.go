@@ -3,27 +3,54 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/MamangRust/monolith-point-of-sale-product/internal/catalog"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/product_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/lib/pq"
 )
 
+const lockProductBarcode = `-- name: LockProductBarcode :one
+SELECT barcode FROM products WHERE product_id = $1 FOR UPDATE
+`
+
+const updateProductSKU = `-- name: UpdateProductSKU :one
+UPDATE products SET sku = $2::TEXT WHERE product_id = $1 RETURNING COALESCE(sku, '')
+`
+
+const getProductSKU = `-- name: GetProductSKU :one
+SELECT COALESCE(sku, '') FROM products WHERE product_id = $1
+`
+
 type productCommandRepository struct {
 	db      *db.Queries
+	conn    *audit.DB
 	mapping recordmapper.ProductRecordMapping
 }
 
-func NewProductCommandRepository(db *db.Queries, mapping recordmapper.ProductRecordMapping) *productCommandRepository {
+func NewProductCommandRepository(db *db.Queries, conn *audit.DB, mapping recordmapper.ProductRecordMapping) *productCommandRepository {
 	return &productCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
 
-func (r *productCommandRepository) CreateProduct(ctx context.Context, request *requests.CreateProductRequest) (*record.ProductRecord, error) {
+func (r *productCommandRepository) CreateProduct(ctx context.Context, request *requests.CreateProductRequest, codes *catalog.Codes) (*record.ProductRecord, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, product_errors.ErrCreateProduct
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
 	req := db.CreateProductParams{
 		MerchantID:   int32(request.MerchantID),
 		CategoryID:   int32(request.CategoryID),
@@ -38,18 +65,48 @@ func (r *productCommandRepository) CreateProduct(ctx context.Context, request *r
 			Valid:  true,
 		},
 		ImageProduct: sql.NullString{String: request.ImageProduct, Valid: true},
+		Barcode:      toNullCode(codes.Barcode),
 	}
 
-	product, err := r.db.CreateProduct(ctx, req)
+	product, err := r.db.WithTx(tx.Tx).CreateProduct(ctx, req)
+	if err != nil {
+		return nil, codeError(err, product_errors.ErrCreateProduct)
+	}
 
+	sku, err := setProductSKU(ctx, tx.Tx, product.ProductID, codes.SKU)
 	if err != nil {
+		return nil, codeError(err, product_errors.ErrCreateProduct)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, product_errors.ErrCreateProduct
 	}
 
-	return r.mapping.ToProductRecord(product), nil
+	created := r.mapping.ToProductRecord(product)
+	created.SKU = sku
+
+	return created, nil
 }
 
-func (r *productCommandRepository) UpdateProduct(ctx context.Context, request *requests.UpdateProductRequest) (*record.ProductRecord, error) {
+// UpdateProduct keeps the product's barcode and SKU unless codes sets them.
+func (r *productCommandRepository) UpdateProduct(ctx context.Context, request *requests.UpdateProductRequest, codes *catalog.Codes) (*record.ProductRecord, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, product_errors.ErrUpdateProduct
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var barcode sql.NullString
+	if err := tx.QueryRowContext(ctx, lockProductBarcode, int32(*request.ProductID)).Scan(&barcode); err != nil {
+		return nil, product_errors.ErrUpdateProduct
+	}
+
+	if codes.Barcode != nil {
+		barcode = toNullCode(codes.Barcode)
+	}
+
 	req := db.UpdateProductParams{
 		ProductID:    int32(*request.ProductID),
 		CategoryID:   int32(request.CategoryID),
@@ -60,15 +117,27 @@ func (r *productCommandRepository) UpdateProduct(ctx context.Context, request *r
 		Brand:        sql.NullString{String: request.Brand, Valid: true},
 		Weight:       sql.NullInt32{Int32: int32(request.Weight), Valid: true},
 		ImageProduct: sql.NullString{String: request.ImageProduct, Valid: true},
+		Barcode:      barcode,
 	}
 
-	res, err := r.db.UpdateProduct(ctx, req)
+	res, err := r.db.WithTx(tx.Tx).UpdateProduct(ctx, req)
+	if err != nil {
+		return nil, codeError(err, product_errors.ErrUpdateProduct)
+	}
 
+	sku, err := setProductSKU(ctx, tx.Tx, res.ProductID, codes.SKU)
 	if err != nil {
+		return nil, codeError(err, product_errors.ErrUpdateProduct)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, product_errors.ErrUpdateProduct
 	}
 
-	return r.mapping.ToProductRecord(res), nil
+	updated := r.mapping.ToProductRecord(res)
+	updated.SKU = sku
+
+	return updated, nil
 }
 
 func (r *productCommandRepository) UpdateProductCountStock(ctx context.Context, product_id int, stock int) (*record.ProductRecord, error) {
@@ -81,7 +150,7 @@ func (r *productCommandRepository) UpdateProductCountStock(ctx context.Context,
 		return nil, product_errors.ErrUpdateProductCountStock
 	}
 
-	return r.mapping.ToProductRecord(res), nil
+	return r.withSKU(ctx, r.mapping.ToProductRecord(res), product_errors.ErrUpdateProductCountStock)
 }
 
 func (r *productCommandRepository) TrashedProduct(ctx context.Context, product_id int) (*record.ProductRecord, error) {
@@ -91,17 +160,17 @@ func (r *productCommandRepository) TrashedProduct(ctx context.Context, product_i
 		return nil, product_errors.ErrTrashedProduct
 	}
 
-	return r.mapping.ToProductRecord(res), nil
+	return r.withSKU(ctx, r.mapping.ToProductRecord(res), product_errors.ErrTrashedProduct)
 }
 
 func (r *productCommandRepository) RestoreProduct(ctx context.Context, product_id int) (*record.ProductRecord, error) {
 	res, err := r.db.RestoreProduct(ctx, int32(product_id))
 
 	if err != nil {
-		return nil, product_errors.ErrRestoreProduct
+		return nil, codeError(err, product_errors.ErrRestoreProduct)
 	}
 
-	return r.mapping.ToProductRecord(res), nil
+	return r.withSKU(ctx, r.mapping.ToProductRecord(res), product_errors.ErrRestoreProduct)
 }
 
 func (r *productCommandRepository) DeleteProductPermanent(ctx context.Context, product_id int) (bool, error) {
@@ -115,21 +184,68 @@ func (r *productCommandRepository) DeleteProductPermanent(ctx context.Context, p
 }
 
 func (r *productCommandRepository) RestoreAllProducts(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllProducts(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkProducts, bulk.Restore)
 
 	if err != nil {
 		return false, product_errors.ErrRestoreAllProducts
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *productCommandRepository) DeleteAllProductPermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentProducts(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkProducts, bulk.Delete)
 
 	if err != nil {
 		return false, product_errors.ErrDeleteAllProductPermanent
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
+
+// setProductSKU sets the product's SKU when sku is given, clearing it when
+// sku is empty, and returns the SKU the product is left with.
+func setProductSKU(ctx context.Context, tx *sql.Tx, productID int32, sku *string) (string, error) {
+	var stored string
+
+	if sku == nil {
+		err := tx.QueryRowContext(ctx, getProductSKU, productID).Scan(&stored)
+		return stored, err
+	}
+
+	err := tx.QueryRowContext(ctx, updateProductSKU, productID, toNullCode(sku)).Scan(&stored)
+	return stored, err
+}
+
+// withSKU fills in the SKU of a product changed through the generated
+// queries, which do not return it.
+func (r *productCommandRepository) withSKU(ctx context.Context, product *record.ProductRecord, fallback error) (*record.ProductRecord, error) {
+	if err := loadSKUs(ctx, r.conn, product); err != nil {
+		return nil, fallback
+	}
+
+	return product, nil
+}
+
+func toNullCode(code *string) sql.NullString {
+	if code == nil || *code == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *code, Valid: true}
+}
+
+// codeError reports a clash with another product's SKU or barcode as
+// catalog.ErrCodeTaken and any other failure as fallback.
+func codeError(err error, fallback error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return catalog.ErrCodeTaken
+	}
+
+	return fallback
+}
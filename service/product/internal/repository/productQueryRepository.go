@@ -3,22 +3,124 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/MamangRust/monolith-point-of-sale-product/internal/catalog"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/product_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/lib/pq"
 )
 
+// Merchant-scoped variants of GetProducts / GetProductsTrashed /
+// GetProductsByCategoryName used for callers that are restricted to their
+// own merchants.
+const getProductsByMerchantIDs = `-- name: GetProductsByMerchantIDs :many
+SELECT
+    product_id, merchant_id, category_id, name, description, price, count_in_stock, brand, weight, slug_product, image_product, barcode, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM products as p
+WHERE deleted_at IS NULL
+AND ($1::TEXT IS NULL
+       OR p.name ILIKE '%' || $1 || '%'
+       OR p.description ILIKE '%' || $1 || '%'
+       OR p.brand ILIKE '%' || $1 || '%'
+       OR p.slug_product ILIKE '%' || $1 || '%'
+       OR p.barcode ILIKE '%' || $1 || '%')
+AND p.merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+const getProductsTrashedByMerchantIDs = `-- name: GetProductsTrashedByMerchantIDs :many
+SELECT
+    product_id, merchant_id, category_id, name, description, price, count_in_stock, brand, weight, slug_product, image_product, barcode, created_at, updated_at, deleted_at,
+    COUNT(*) OVER() AS total_count
+FROM products as p
+WHERE deleted_at IS NOT NULL
+AND ($1::TEXT IS NULL
+       OR p.name ILIKE '%' || $1 || '%'
+       OR p.description ILIKE '%' || $1 || '%'
+       OR p.brand ILIKE '%' || $1 || '%'
+       OR p.slug_product ILIKE '%' || $1 || '%'
+       OR p.barcode ILIKE '%' || $1 || '%')
+AND p.merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3
+`
+
+const getProductsByCategoryNameAndMerchantIDs = `-- name: GetProductsByCategoryNameAndMerchantIDs :many
+WITH filtered_products AS (
+    SELECT
+        p.product_id,
+        p.merchant_id,
+        p.category_id,
+        p.slug_product,
+        p.weight,
+        p.name,
+        p.description,
+        p.price,
+        p.count_in_stock,
+        p.brand,
+        p.image_product,
+        p.barcode,
+        p.created_at,
+        p.updated_at,
+        p.deleted_at,
+        c.name AS category_name
+    FROM
+        products p
+    JOIN
+        categories c ON p.category_id = c.category_id
+    WHERE
+        p.deleted_at IS NULL
+        AND c.name = $1
+        AND (
+            $2 IS NULL
+            OR p.name ILIKE '%' || $2 || '%'
+            OR p.description ILIKE '%' || $2 || '%'
+        )
+        AND (
+            ($3 IS NULL OR p.price >= $3)
+            AND ($4 IS NULL OR p.price <= $4)
+        )
+        AND p.merchant_id = ANY($7::INT[])
+)
+SELECT
+    (SELECT COUNT(*) FROM filtered_products) AS total_count,
+    fp.product_id, fp.merchant_id, fp.category_id, fp.slug_product, fp.weight, fp.name, fp.description, fp.price, fp.count_in_stock, fp.brand, fp.image_product, fp.barcode, fp.created_at, fp.updated_at, fp.deleted_at, fp.category_name
+FROM
+    filtered_products fp
+ORDER BY
+    fp.created_at DESC
+LIMIT $5 OFFSET $6
+`
+
+const getProductByBarcode = `-- name: GetProductByBarcode :one
+SELECT product_id, merchant_id, category_id, name, description, price, count_in_stock, brand, weight, slug_product, image_product, barcode, created_at, updated_at, deleted_at, COALESCE(sku, '')
+FROM products
+WHERE merchant_id = $1
+    AND barcode = $2
+    AND deleted_at IS NULL
+`
+
+const getProductSKUs = `-- name: GetProductSKUs :many
+SELECT product_id, sku FROM products WHERE product_id = ANY($1::INT[]) AND sku IS NOT NULL
+`
+
 type productQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.ProductRecordMapping
 }
 
-func NewProductQueryRepository(db *db.Queries, mapping recordmapper.ProductRecordMapping) *productQueryRepository {
+func NewProductQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.ProductRecordMapping) *productQueryRepository {
 	return &productQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -26,6 +128,15 @@ func NewProductQueryRepository(db *db.Queries, mapping recordmapper.ProductRecor
 func (r *productQueryRepository) FindAllProducts(ctx context.Context, req *requests.FindAllProducts) ([]*record.ProductRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getProductsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, product_errors.ErrFindAllProducts
+		}
+
+		return r.withSKUs(ctx, r.mapping.ToProductsRecordPagination(res), totalCount(res), product_errors.ErrFindAllProducts)
+	}
+
 	reqDb := db.GetProductsParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -46,12 +157,26 @@ func (r *productQueryRepository) FindAllProducts(ctx context.Context, req *reque
 		totalCount = 0
 	}
 
-	return r.mapping.ToProductsRecordPagination(res), &totalCount, nil
+	return r.withSKUs(ctx, r.mapping.ToProductsRecordPagination(res), &totalCount, product_errors.ErrFindAllProducts)
 }
 
 func (r *productQueryRepository) FindByActive(ctx context.Context, req *requests.FindAllProducts) ([]*record.ProductRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getProductsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, product_errors.ErrFindByActive
+		}
+
+		active := make([]*db.GetProductsActiveRow, len(res))
+		for i, row := range res {
+			active[i] = (*db.GetProductsActiveRow)(row)
+		}
+
+		return r.withSKUs(ctx, r.mapping.ToProductsRecordActivePagination(active), totalCount(res), product_errors.ErrFindByActive)
+	}
+
 	reqDb := db.GetProductsActiveParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -72,12 +197,26 @@ func (r *productQueryRepository) FindByActive(ctx context.Context, req *requests
 		totalCount = 0
 	}
 
-	return r.mapping.ToProductsRecordActivePagination(res), &totalCount, nil
+	return r.withSKUs(ctx, r.mapping.ToProductsRecordActivePagination(res), &totalCount, product_errors.ErrFindByActive)
 }
 
 func (r *productQueryRepository) FindByTrashed(ctx context.Context, req *requests.FindAllProducts) ([]*record.ProductRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getProductsTrashedByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, product_errors.ErrFindByTrashed
+		}
+
+		trashed := make([]*db.GetProductsTrashedRow, len(res))
+		for i, row := range res {
+			trashed[i] = (*db.GetProductsTrashedRow)(row)
+		}
+
+		return r.withSKUs(ctx, r.mapping.ToProductsRecordTrashedPagination(trashed), totalCount(res), product_errors.ErrFindByTrashed)
+	}
+
 	reqDb := db.GetProductsTrashedParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -98,12 +237,17 @@ func (r *productQueryRepository) FindByTrashed(ctx context.Context, req *request
 		totalCount = 0
 	}
 
-	return r.mapping.ToProductsRecordTrashedPagination(res), &totalCount, nil
+	return r.withSKUs(ctx, r.mapping.ToProductsRecordTrashedPagination(res), &totalCount, product_errors.ErrFindByTrashed)
 }
 
 func (r *productQueryRepository) FindByMerchant(ctx context.Context, req *requests.ProductByMerchantRequest) ([]*record.ProductRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if !tenant.Allows(ctx, req.MerchantID) {
+		zero := 0
+		return []*record.ProductRecord{}, &zero, nil
+	}
+
 	reqDb := db.GetProductsByMerchantParams{
 		MerchantID: int32(req.MerchantID),
 		Column2:    sql.NullString{String: req.Search, Valid: true},
@@ -128,7 +272,7 @@ func (r *productQueryRepository) FindByMerchant(ctx context.Context, req *reques
 		totalCount = 0
 	}
 
-	return r.mapping.ToProductsRecordMerchantPagination(res), &totalCount, nil
+	return r.withSKUs(ctx, r.mapping.ToProductsRecordMerchantPagination(res), &totalCount, product_errors.ErrFindByMerchant)
 }
 
 func (r *productQueryRepository) FindByCategory(ctx context.Context, req *requests.ProductByCategoryRequest) ([]*record.ProductRecord, *int, error) {
@@ -143,7 +287,16 @@ func (r *productQueryRepository) FindByCategory(ctx context.Context, req *reques
 		Offset:  int32(offset),
 	}
 
-	res, err := r.db.GetProductsByCategoryName(ctx, reqDb)
+	var (
+		res []*db.GetProductsByCategoryNameRow
+		err error
+	)
+
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err = r.findByCategoryAndMerchantIDs(ctx, reqDb, scope)
+	} else {
+		res, err = r.db.GetProductsByCategoryName(ctx, reqDb)
+	}
 
 	if err != nil {
 		return nil, nil, product_errors.ErrFindByCategory
@@ -157,7 +310,7 @@ func (r *productQueryRepository) FindByCategory(ctx context.Context, req *reques
 		totalCount = 0
 	}
 
-	return r.mapping.ToProductsRecordCategoryPagination(res), &totalCount, nil
+	return r.withSKUs(ctx, r.mapping.ToProductsRecordCategoryPagination(res), &totalCount, product_errors.ErrFindByCategory)
 }
 
 func (r *productQueryRepository) FindById(ctx context.Context, product_id int) (*record.ProductRecord, error) {
@@ -167,7 +320,11 @@ func (r *productQueryRepository) FindById(ctx context.Context, product_id int) (
 		return nil, product_errors.ErrFindById
 	}
 
-	return r.mapping.ToProductRecord(res), nil
+	if !tenant.Allows(ctx, int(res.MerchantID)) {
+		return nil, product_errors.ErrFindById
+	}
+
+	return r.withSKU(ctx, r.mapping.ToProductRecord(res), product_errors.ErrFindById)
 }
 
 func (r *productQueryRepository) FindByIdTrashed(ctx context.Context, product_id int) (*record.ProductRecord, error) {
@@ -177,5 +334,186 @@ func (r *productQueryRepository) FindByIdTrashed(ctx context.Context, product_id
 		return nil, product_errors.ErrFindByIdTrashed
 	}
 
-	return r.mapping.ToProductRecord(res), nil
+	if !tenant.Allows(ctx, int(res.MerchantID)) {
+		return nil, product_errors.ErrFindByIdTrashed
+	}
+
+	return r.withSKU(ctx, r.mapping.ToProductRecord(res), product_errors.ErrFindByIdTrashed)
+}
+
+// FindByBarcode returns the live product of the merchant with the given
+// normalized barcode.
+func (r *productQueryRepository) FindByBarcode(ctx context.Context, merchant_id int, barcode string) (*record.ProductRecord, error) {
+	if !tenant.Allows(ctx, merchant_id) {
+		return nil, catalog.ErrBarcodeNotFound
+	}
+
+	var (
+		p   db.Product
+		sku string
+	)
+
+	err := r.conn.QueryRowContext(ctx, getProductByBarcode, int32(merchant_id), barcode).Scan(
+		&p.ProductID,
+		&p.MerchantID,
+		&p.CategoryID,
+		&p.Name,
+		&p.Description,
+		&p.Price,
+		&p.CountInStock,
+		&p.Brand,
+		&p.Weight,
+		&p.SlugProduct,
+		&p.ImageProduct,
+		&p.Barcode,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+		&p.DeletedAt,
+		&sku,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, catalog.ErrBarcodeNotFound
+	}
+	if err != nil {
+		return nil, product_errors.ErrFindById
+	}
+
+	product := r.mapping.ToProductRecord(&p)
+	product.SKU = sku
+
+	return product, nil
+}
+
+func (r *productQueryRepository) findByMerchantIDs(ctx context.Context, query string, req *requests.FindAllProducts, scope *tenant.Scope) ([]*db.GetProductsRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	return queryRows(ctx, r.conn, query, func(rows *sql.Rows, i *db.GetProductsRow) error {
+		return rows.Scan(
+			&i.ProductID,
+			&i.MerchantID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Description,
+			&i.Price,
+			&i.CountInStock,
+			&i.Brand,
+			&i.Weight,
+			&i.SlugProduct,
+			&i.ImageProduct,
+			&i.Barcode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TotalCount,
+		)
+	}, req.Search, req.PageSize, offset, pq.Array(scope.Int64MerchantIDs()))
+}
+
+func (r *productQueryRepository) findByCategoryAndMerchantIDs(ctx context.Context, arg db.GetProductsByCategoryNameParams, scope *tenant.Scope) ([]*db.GetProductsByCategoryNameRow, error) {
+	return queryRows(ctx, r.conn, getProductsByCategoryNameAndMerchantIDs, func(rows *sql.Rows, i *db.GetProductsByCategoryNameRow) error {
+		return rows.Scan(
+			&i.TotalCount,
+			&i.ProductID,
+			&i.MerchantID,
+			&i.CategoryID,
+			&i.SlugProduct,
+			&i.Weight,
+			&i.Name,
+			&i.Description,
+			&i.Price,
+			&i.CountInStock,
+			&i.Brand,
+			&i.ImageProduct,
+			&i.Barcode,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CategoryName,
+		)
+	}, arg.Name, arg.Column2, arg.Column3, arg.Column4, arg.Limit, arg.Offset, pq.Array(scope.Int64MerchantIDs()))
+}
+
+// withSKU fills in the SKU of a product read through the generated
+// queries, which do not select it.
+func (r *productQueryRepository) withSKU(ctx context.Context, product *record.ProductRecord, fallback error) (*record.ProductRecord, error) {
+	if err := loadSKUs(ctx, r.conn, product); err != nil {
+		return nil, fallback
+	}
+
+	return product, nil
+}
+
+func (r *productQueryRepository) withSKUs(ctx context.Context, products []*record.ProductRecord, total *int, fallback error) ([]*record.ProductRecord, *int, error) {
+	if err := loadSKUs(ctx, r.conn, products...); err != nil {
+		return nil, nil, fallback
+	}
+
+	return products, total, nil
+}
+
+// loadSKUs sets the SKU of products in one query.
+func loadSKUs(ctx context.Context, conn db.DBTX, products ...*record.ProductRecord) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*record.ProductRecord, len(products))
+	ids := make([]int64, len(products))
+	for i, product := range products {
+		byID[product.ID] = product
+		ids[i] = int64(product.ID)
+	}
+
+	rows, err := conn.QueryContext(ctx, getProductSKUs, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id  int32
+			sku string
+		)
+		if err := rows.Scan(&id, &sku); err != nil {
+			return err
+		}
+		if product, ok := byID[int(id)]; ok {
+			product.SKU = sku
+		}
+	}
+
+	return rows.Err()
+}
+
+func queryRows[T any](ctx context.Context, conn db.DBTX, query string, scan func(rows *sql.Rows, item *T) error, args ...interface{}) ([]*T, error) {
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*T
+	for rows.Next() {
+		var i T
+		if err := scan(rows, &i); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func totalCount(rows []*db.GetProductsRow) *int {
+	total := 0
+	if len(rows) > 0 {
+		total = int(rows[0].TotalCount)
+	}
+
+	return &total
 }
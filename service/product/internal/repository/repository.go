@@ -1,26 +1,36 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "product"
+
 type Repositories struct {
 	ProductQuery   ProductQueryRepository
 	ProductCommand ProductCommandRepository
 	CategoryQuery  CategoryQueryRepository
 	MerchantQuery  MerchantQueryRepository
+	Tenant         TenantRepository
+	Outbox         OutboxRepository
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	mapperMerchant := recordmapper.NewMerchantRecordMapper()
 	mapperCategory := recordmapper.NewCategoryRecordMapper()
 	mapperProduct := recordmapper.NewProductRecordMapper()
 
 	return &Repositories{
-		ProductQuery:   NewProductQueryRepository(DB, mapperProduct),
-		ProductCommand: NewProductCommandRepository(DB, mapperProduct),
+		ProductQuery:   NewProductQueryRepository(DB, conn, mapperProduct),
+		ProductCommand: NewProductCommandRepository(DB, conn, mapperProduct),
 		CategoryQuery:  NewCategoryQueryRepository(DB, mapperCategory),
 		MerchantQuery:  NewMerchantQueryRepository(DB, mapperMerchant),
+		Tenant:         tenant.NewRepository(conn),
+		Outbox:         outbox.NewRepository(conn, outboxSource),
 	}
 }
@@ -12,6 +12,7 @@ type ProductQueryService interface {
 	FindByMerchant(ctx context.Context, req *requests.ProductByMerchantRequest) ([]*response.ProductResponse, *int, *response.ErrorResponse)
 	FindByCategory(ctx context.Context, req *requests.ProductByCategoryRequest) ([]*response.ProductResponse, *int, *response.ErrorResponse)
 	FindById(ctx context.Context, productID int) (*response.ProductResponse, *response.ErrorResponse)
+	FindByBarcode(ctx context.Context, merchantID int, code string) (*response.ProductResponse, *response.ErrorResponse)
 	FindByActive(ctx context.Context, req *requests.FindAllProducts) ([]*response.ProductResponseDeleteAt, *int, *response.ErrorResponse)
 	FindByTrashed(ctx context.Context, req *requests.FindAllProducts) ([]*response.ProductResponseDeleteAt, *int, *response.ErrorResponse)
 }
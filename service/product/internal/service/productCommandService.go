@@ -7,6 +7,7 @@ import (
 
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/utils"
+	"github.com/MamangRust/monolith-point-of-sale-product/internal/catalog"
 	"github.com/MamangRust/monolith-point-of-sale-product/internal/errorhandler"
 	mencache "github.com/MamangRust/monolith-point-of-sale-product/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-product/internal/repository"
@@ -103,14 +104,20 @@ func (s *productCommandService) CreateProduct(ctx context.Context, req *requests
 		return errorhandler.HandleRepositorySingleError[*response.ProductResponse](s.logger, err, method, "FAILED_FIND_MERCHANT_BY_ID", span, &status, merchant_errors.ErrFailedFindMerchantById, zap.Error(err))
 	}
 
+	productCodes, err := catalog.NewCodes(req.SKU, req.Barcode)
+
+	if err != nil {
+		return s.errorhandler.HandleCreateProductError(err, method, "INVALID_PRODUCT_CODES", span, &status, zap.Error(err))
+	}
+
 	slug := utils.GenerateSlug(req.Name)
 
 	req.SlugProduct = &slug
 
-	product, err := s.productCommandRepository.CreateProduct(ctx, req)
+	product, err := s.productCommandRepository.CreateProduct(ctx, req, productCodes)
 
 	if err != nil {
-		return errorhandler.HandleRepositorySingleError[*response.ProductResponse](s.logger, err, method, "FAILED_CREATE_PRODUCT", span, &status, product_errors.ErrFailedCreateProduct, zap.Error(err))
+		return s.errorhandler.HandleCreateProductError(err, method, "FAILED_CREATE_PRODUCT", span, &status, zap.Error(err))
 	}
 
 	so := s.mapping.ToProductResponse(product)
@@ -141,11 +148,23 @@ func (s *productCommandService) UpdateProduct(ctx context.Context, req *requests
 		return errorhandler.HandleRepositorySingleError[*response.ProductResponse](s.logger, err, method, "FAILED_FIND_MERCHANT_BY_ID", span, &status, merchant_errors.ErrFailedFindMerchantById, zap.Error(err))
 	}
 
+	productCodes, err := catalog.NewCodes(req.SKU, req.Barcode)
+
+	if err != nil {
+		return s.errorhandler.HandleUpdateProductError(err, method, "INVALID_PRODUCT_CODES", span, &status, zap.Error(err))
+	}
+
+	existing, err := s.productQueryRepository.FindById(ctx, *req.ProductID)
+
+	if err != nil {
+		return errorhandler.HandleRepositorySingleError[*response.ProductResponse](s.logger, err, method, "FAILED_FIND_PRODUCT_BY_ID", span, &status, product_errors.ErrFailedFindProductById, zap.Error(err))
+	}
+
 	slug := utils.GenerateSlug(req.Name)
 
 	req.SlugProduct = &slug
 
-	product, err := s.productCommandRepository.UpdateProduct(ctx, req)
+	product, err := s.productCommandRepository.UpdateProduct(ctx, req, productCodes)
 
 	if err != nil {
 		return s.errorhandler.HandleUpdateProductError(err, method, "FAILED_UPDATE_PRODUCT", span, &status, zap.Error(err))
@@ -154,6 +173,8 @@ func (s *productCommandService) UpdateProduct(ctx context.Context, req *requests
 	so := s.mapping.ToProductResponse(product)
 
 	s.mencache.DeleteCachedProduct(ctx, *req.ProductID)
+	s.mencache.DeleteCachedProductBarcode(ctx, existing.MerchantID, existing.Barcode)
+	s.mencache.DeleteCachedProductBarcode(ctx, product.MerchantID, product.Barcode)
 
 	logSuccess("Successfully updated product", zap.Int("product.id", *req.ProductID), zap.Bool("success", true))
 
@@ -178,6 +199,7 @@ func (s *productCommandService) TrashProduct(ctx context.Context, productID int)
 	so := s.mapping.ToProductResponseDeleteAt(product)
 
 	s.mencache.DeleteCachedProduct(ctx, productID)
+	s.mencache.DeleteCachedProductBarcode(ctx, product.MerchantID, product.Barcode)
 
 	logSuccess("Successfully trashed product", zap.Int("product.id", productID), zap.Bool("success", true))
 
@@ -202,6 +224,7 @@ func (s *productCommandService) RestoreProduct(ctx context.Context, productID in
 	so := s.mapping.ToProductResponseDeleteAt(product)
 
 	s.mencache.DeleteCachedProduct(ctx, productID)
+	s.mencache.DeleteCachedProductBarcode(ctx, product.MerchantID, product.Barcode)
 
 	logSuccess("Successfully restored product", zap.Int("product.id", productID), zap.Bool("success", true))
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/barcode"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-product/internal/errorhandler"
 	mencache "github.com/MamangRust/monolith-point-of-sale-product/internal/redis"
@@ -197,6 +198,38 @@ func (s *productQueryService) FindById(ctx context.Context, productID int) (*res
 	return so, nil
 }
 
+func (s *productQueryService) FindByBarcode(ctx context.Context, merchantID int, code string) (*response.ProductResponse, *response.ErrorResponse) {
+	const method = "FindByBarcode"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("merchant.id", merchantID), attribute.String("product.barcode", code))
+
+	defer func() {
+		end(status)
+	}()
+
+	code, err := barcode.Normalize(code)
+	if err != nil {
+		return s.errorhandler.HandleFindByBarcodeError(err, method, "INVALID_BARCODE", span, &status, zap.Error(err))
+	}
+
+	if data, found := s.mencache.GetCachedProductByBarcode(ctx, merchantID, code); found {
+		logSuccess("Data found in cache", zap.Int("merchant.id", merchantID), zap.String("product.barcode", code))
+		return data, nil
+	}
+
+	product, err := s.productQueryRepository.FindByBarcode(ctx, merchantID, code)
+	if err != nil {
+		return s.errorhandler.HandleFindByBarcodeError(err, method, "FAILED_FIND_PRODUCT_BY_BARCODE", span, &status, zap.Error(err))
+	}
+
+	so := s.mapping.ToProductResponse(product)
+	s.mencache.SetCachedProductByBarcode(ctx, merchantID, code, so)
+
+	logSuccess("Successfully fetched product by barcode", zap.Int("merchant.id", merchantID), zap.String("product.barcode", code))
+
+	return so, nil
+}
+
 func (s *productQueryService) FindByActive(ctx context.Context, req *requests.FindAllProducts) ([]*response.ProductResponseDeleteAt, *int, *response.ErrorResponse) {
 	const method = "FindByActive"
 
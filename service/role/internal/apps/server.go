@@ -9,9 +9,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	otel_pkg "github.com/MamangRust/monolith-point-of-sale-pkg/otel"
 	"github.com/MamangRust/monolith-point-of-sale-role/internal/errorhandler"
@@ -28,6 +32,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -48,6 +53,8 @@ type Server struct {
 	DB       *db.Queries
 	Services *service.Service
 	Handlers *handler.Handler
+	Relay    *outbox.Relay
+	Conn     *audit.DB
 	Ctx      context.Context
 }
 
@@ -62,13 +69,14 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 	}
 	flag.Parse()
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 
 	shutdownTracerProvider, err := otel_pkg.InitTracerProvider("Role-service", ctx)
 	if err != nil {
@@ -96,11 +104,25 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		return nil, nil, err
 	}
 
+	identityRedis := redis.NewClient(&redis.Options{
+		Addr:         fmt.Sprintf("%s:%s", viper.GetString("REDIS_HOST"), viper.GetString("REDIS_PORT")),
+		Password:     viper.GetString("REDIS_PASSWORD"),
+		DB:           viper.GetInt("REDIS_DB_AUTH"),
+		DialTimeout:  5 * time.Second,
+		ReadTimeout:  3 * time.Second,
+		WriteTimeout: 3 * time.Second,
+		PoolSize:     5,
+		MinIdleConns: 1,
+	})
+
 	mencache := mencache.NewMencache(&mencache.Deps{
-		Redis:  myredis,
-		Logger: logger,
+		Redis:         myredis,
+		Logger:        logger,
+		IdentityRedis: identityRedis,
 	})
 
+	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
+
 	errorhandler := errorhandler.NewErrorHandler(logger)
 
 	services := service.NewService(&service.Deps{
@@ -114,11 +136,15 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Service: services,
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, myKafka, logger)
+
 	return &Server{
 		Logger:   logger,
 		DB:       DB,
 		Services: services,
 		Handlers: handlers,
+		Relay:    relay,
+		Conn:     conn,
 		Ctx:      ctx,
 	}, shutdownTracerProvider, nil
 }
@@ -148,6 +174,22 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "role",
+				RequestID: middleware.RequestID,
+				Actions: map[string]string{
+					pb.RoleService_AssignRoleToUser_FullMethodName:   "assign_role",
+					pb.RoleService_RemoveRoleFromUser_FullMethodName: "remove_role",
+					pb.RoleService_AssignPermission_FullMethodName:   "assign_permission",
+					pb.RoleService_RevokePermission_FullMethodName:   "revoke_permission",
+				},
+				Snapshots: map[string]audit.Snapshot{
+					"pb.RoleService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Role.FindByIdRole(ctx, &pb.FindByIdRoleRequest{RoleId: int32(id)})
+					},
+				},
+			}, s.Logger),
+			bulk.Middleware(s.Logger),
 		),
 	)
 
@@ -158,6 +200,8 @@ func (s *Server) Run() {
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
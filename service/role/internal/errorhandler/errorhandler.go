@@ -5,11 +5,15 @@ import "github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 type ErrorHandler struct {
 	RoleQueryError   RoleQueryErrorHandler
 	RoleCommandError RoleCommandErrorHandler
+	PermissionError  PermissionErrorHandler
+	UserRoleError    UserRoleErrorHandler
 }
 
 func NewErrorHandler(logger logger.LoggerInterface) *ErrorHandler {
 	return &ErrorHandler{
 		RoleQueryError:   NewRoleQueryError(logger),
 		RoleCommandError: NewRoleCommandError(logger),
+		PermissionError:  NewPermissionError(logger),
+		UserRoleError:    NewUserRoleError(logger),
 	}
 }
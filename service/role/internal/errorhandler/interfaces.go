@@ -1,6 +1,7 @@
 package errorhandler
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -62,3 +63,27 @@ type RoleQueryErrorHandler interface {
 		fields ...zap.Field,
 	) (*response.RoleResponse, *response.ErrorResponse)
 }
+
+type PermissionErrorHandler interface {
+	HandleRepositoryListError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) ([]*permission.Permission, *response.ErrorResponse)
+	HandleAssignPermissionError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) ([]*permission.Permission, *response.ErrorResponse)
+	HandleRevokePermissionError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) ([]*permission.Permission, *response.ErrorResponse)
+	HandleCheckPermissionError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) (bool, *response.ErrorResponse)
+}
+
+type UserRoleErrorHandler interface {
+	HandleAssignRoleError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) ([]*response.RoleResponse, *response.ErrorResponse)
+	HandleRemoveRoleError(
+		err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+	) ([]*response.RoleResponse, *response.ErrorResponse)
+}
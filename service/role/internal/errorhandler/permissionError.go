@@ -0,0 +1,108 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/role_errors"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPermissionNotFound     = response.NewErrorResponse("Permission not found", http.StatusNotFound)
+	ErrInvalidPermissionName  = response.NewErrorResponse("Permission name must look like resource:action", http.StatusBadRequest)
+	ErrInvalidPermissionUser  = response.NewErrorResponse("Invalid user ID", http.StatusBadRequest)
+	ErrFailedFindPermissions  = response.NewErrorResponse("Failed to fetch permissions", http.StatusInternalServerError)
+	ErrFailedAssignPermission = response.NewErrorResponse("Failed to assign permission to role", http.StatusInternalServerError)
+	ErrFailedRevokePermission = response.NewErrorResponse("Failed to revoke permission from role", http.StatusInternalServerError)
+	ErrFailedCheckPermission  = response.NewErrorResponse("Failed to check permission", http.StatusInternalServerError)
+)
+
+type permissionError struct {
+	logger logger.LoggerInterface
+}
+
+func NewPermissionError(logger logger.LoggerInterface) *permissionError {
+	return &permissionError{
+		logger: logger,
+	}
+}
+
+func (e *permissionError) HandleRepositoryListError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) ([]*permission.Permission, *response.ErrorResponse) {
+	return handleErrorRepository[[]*permission.Permission](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		permissionErrorResponse(err, ErrFailedFindPermissions),
+		fields...,
+	)
+}
+
+func (e *permissionError) HandleAssignPermissionError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) ([]*permission.Permission, *response.ErrorResponse) {
+	return handleErrorRepository[[]*permission.Permission](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		permissionErrorResponse(err, ErrFailedAssignPermission),
+		fields...,
+	)
+}
+
+func (e *permissionError) HandleRevokePermissionError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) ([]*permission.Permission, *response.ErrorResponse) {
+	return handleErrorRepository[[]*permission.Permission](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		permissionErrorResponse(err, ErrFailedRevokePermission),
+		fields...,
+	)
+}
+
+func (e *permissionError) HandleCheckPermissionError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) (bool, *response.ErrorResponse) {
+	return handleErrorRepository[bool](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		permissionErrorResponse(err, ErrFailedCheckPermission),
+		fields...,
+	)
+}
+
+func permissionErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, permission.ErrNotFound):
+		return ErrPermissionNotFound
+	case errors.Is(err, permission.ErrRoleNotFound):
+		return role_errors.ErrRoleNotFoundRes
+	case errors.Is(err, permission.ErrInvalidName):
+		return ErrInvalidPermissionName
+	case errors.Is(err, permission.ErrInvalidUser):
+		return ErrInvalidPermissionUser
+	default:
+		return fallback
+	}
+}
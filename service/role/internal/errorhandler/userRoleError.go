@@ -0,0 +1,79 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/userrole"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/role_errors"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidUserRoleUser  = response.NewErrorResponse("Invalid user ID", http.StatusBadRequest)
+	ErrUserRoleUserNotFound = response.NewErrorResponse("User not found", http.StatusNotFound)
+	ErrUserRoleNotAssigned  = response.NewErrorResponse("Role is not assigned to user", http.StatusNotFound)
+	ErrUserRoleLastAdmin    = response.NewErrorResponse("Cannot remove the last administrator", http.StatusConflict)
+	ErrFailedAssignUserRole = response.NewErrorResponse("Failed to assign role to user", http.StatusInternalServerError)
+	ErrFailedRemoveUserRole = response.NewErrorResponse("Failed to remove role from user", http.StatusInternalServerError)
+)
+
+type userRoleError struct {
+	logger logger.LoggerInterface
+}
+
+func NewUserRoleError(logger logger.LoggerInterface) *userRoleError {
+	return &userRoleError{
+		logger: logger,
+	}
+}
+
+func (e *userRoleError) HandleAssignRoleError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) ([]*response.RoleResponse, *response.ErrorResponse) {
+	return handleErrorRepository[[]*response.RoleResponse](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		userRoleErrorResponse(err, ErrFailedAssignUserRole),
+		fields...,
+	)
+}
+
+func (e *userRoleError) HandleRemoveRoleError(
+	err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field,
+) ([]*response.RoleResponse, *response.ErrorResponse) {
+	return handleErrorRepository[[]*response.RoleResponse](
+		e.logger,
+		err,
+		method,
+		tracePrefix,
+		span,
+		status,
+		userRoleErrorResponse(err, ErrFailedRemoveUserRole),
+		fields...,
+	)
+}
+
+func userRoleErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, userrole.ErrInvalidUser):
+		return ErrInvalidUserRoleUser
+	case errors.Is(err, userrole.ErrUserNotFound):
+		return ErrUserRoleUserNotFound
+	case errors.Is(err, userrole.ErrRoleNotFound):
+		return role_errors.ErrRoleNotFoundRes
+	case errors.Is(err, userrole.ErrNotAssigned):
+		return ErrUserRoleNotAssigned
+	case errors.Is(err, userrole.ErrLastAdmin):
+		return ErrUserRoleLastAdmin
+	default:
+		return fallback
+	}
+}
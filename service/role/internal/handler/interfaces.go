@@ -22,4 +22,11 @@ type RoleHandleGrpc interface {
 	DeleteRolePermanent(ctx context.Context, req *pb.FindByIdRoleRequest) (*pb.ApiResponseRoleDelete, error)
 	RestoreAllRole(ctx context.Context, req *emptypb.Empty) (*pb.ApiResponseRoleAll, error)
 	DeleteAllRolePermanent(ctx context.Context, req *emptypb.Empty) (*pb.ApiResponseRoleAll, error)
+	AssignRoleToUser(ctx context.Context, req *pb.UserRoleRequest) (*pb.ApiResponsesRole, error)
+	RemoveRoleFromUser(ctx context.Context, req *pb.UserRoleRequest) (*pb.ApiResponsesRole, error)
+	FindAllPermissions(ctx context.Context, req *emptypb.Empty) (*pb.ApiResponsesPermission, error)
+	FindPermissionsByRole(ctx context.Context, req *pb.FindByIdRoleRequest) (*pb.ApiResponsesPermission, error)
+	AssignPermission(ctx context.Context, req *pb.RolePermissionRequest) (*pb.ApiResponsesPermission, error)
+	RevokePermission(ctx context.Context, req *pb.RolePermissionRequest) (*pb.ApiResponsesPermission, error)
+	CheckPermission(ctx context.Context, req *pb.CheckPermissionRequest) (*pb.ApiResponseCheckPermission, error)
 }
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/role_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *roleHandleGrpc) FindAllPermissions(ctx context.Context, req *emptypb.Empty) (*pb.ApiResponsesPermission, error) {
+	permissions, err := s.permission.FindAll(ctx)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return toProtoResponsesPermission("Successfully fetched permissions", permissions), nil
+}
+
+func (s *roleHandleGrpc) FindPermissionsByRole(ctx context.Context, req *pb.FindByIdRoleRequest) (*pb.ApiResponsesPermission, error) {
+	roleID := int(req.GetRoleId())
+
+	if roleID == 0 {
+		return nil, role_errors.ErrGrpcRoleInvalidId
+	}
+
+	permissions, err := s.permission.FindByRoleId(ctx, roleID)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return toProtoResponsesPermission("Successfully fetched role permissions", permissions), nil
+}
+
+func (s *roleHandleGrpc) AssignPermission(ctx context.Context, req *pb.RolePermissionRequest) (*pb.ApiResponsesPermission, error) {
+	roleID := int(req.GetRoleId())
+
+	if roleID == 0 {
+		return nil, role_errors.ErrGrpcRoleInvalidId
+	}
+
+	permissions, err := s.permission.AssignPermission(ctx, roleID, req.GetPermission())
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return toProtoResponsesPermission("Successfully assigned permission", permissions), nil
+}
+
+func (s *roleHandleGrpc) RevokePermission(ctx context.Context, req *pb.RolePermissionRequest) (*pb.ApiResponsesPermission, error) {
+	roleID := int(req.GetRoleId())
+
+	if roleID == 0 {
+		return nil, role_errors.ErrGrpcRoleInvalidId
+	}
+
+	permissions, err := s.permission.RevokePermission(ctx, roleID, req.GetPermission())
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return toProtoResponsesPermission("Successfully revoked permission", permissions), nil
+}
+
+func (s *roleHandleGrpc) CheckPermission(ctx context.Context, req *pb.CheckPermissionRequest) (*pb.ApiResponseCheckPermission, error) {
+	allowed, err := s.permission.CheckPermission(ctx, int(req.GetUserId()), req.GetPermission(), int(req.GetMerchantId()))
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseCheckPermission{
+		Status:  "success",
+		Message: "Successfully checked permission",
+		Allowed: allowed,
+	}, nil
+}
+
+func toProtoResponsesPermission(message string, permissions []*permission.Permission) *pb.ApiResponsesPermission {
+	data := make([]*pb.PermissionResponse, len(permissions))
+	for i, p := range permissions {
+		data[i] = &pb.PermissionResponse{
+			Id:          int32(p.ID),
+			Name:        p.Name,
+			Description: p.Description,
+		}
+	}
+
+	return &pb.ApiResponsesPermission{
+		Status:  "success",
+		Message: message,
+		Data:    data,
+	}
+}
@@ -17,6 +17,8 @@ type roleHandleGrpc struct {
 	pb.UnimplementedRoleServiceServer
 	roleQuery   service.RoleQueryService
 	roleCommand service.RoleCommandService
+	permission  service.PermissionService
+	userRole    service.UserRoleService
 	mapping     protomapper.RoleProtoMapper
 }
 
@@ -24,6 +26,8 @@ func NewRoleHandleGrpc(service *service.Service) *roleHandleGrpc {
 	return &roleHandleGrpc{
 		roleQuery:   service.RoleQuery,
 		roleCommand: service.RoleCommand,
+		permission:  service.Permission,
+		userRole:    service.UserRole,
 		mapping:     protomapper.NewRoleProtoMapper(),
 	}
 }
@@ -102,6 +106,38 @@ func (s *roleHandleGrpc) FindByUserId(ctx context.Context, req *pb.FindByIdUserR
 	return roleResponse, nil
 }
 
+func (s *roleHandleGrpc) AssignRoleToUser(ctx context.Context, req *pb.UserRoleRequest) (*pb.ApiResponsesRole, error) {
+	roleID := int(req.GetRoleId())
+
+	if roleID == 0 {
+		return nil, role_errors.ErrGrpcRoleInvalidId
+	}
+
+	roles, err := s.userRole.AssignRole(ctx, int(req.GetUserId()), roleID)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return s.mapping.ToProtoResponsesRole("success", "Successfully assigned role to user", roles), nil
+}
+
+func (s *roleHandleGrpc) RemoveRoleFromUser(ctx context.Context, req *pb.UserRoleRequest) (*pb.ApiResponsesRole, error) {
+	roleID := int(req.GetRoleId())
+
+	if roleID == 0 {
+		return nil, role_errors.ErrGrpcRoleInvalidId
+	}
+
+	roles, err := s.userRole.RemoveRole(ctx, int(req.GetUserId()), roleID)
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return s.mapping.ToProtoResponsesRole("success", "Successfully removed role from user", roles), nil
+}
+
 func (s *roleHandleGrpc) FindByActive(ctx context.Context, req *pb.FindAllRoleRequest) (*pb.ApiResponsePaginationRoleDeleteAt, error) {
 	page := int(req.GetPage())
 	pageSize := int(req.GetPageSize())
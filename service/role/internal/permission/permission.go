@@ -0,0 +1,27 @@
+package permission
+
+import (
+	"errors"
+	"regexp"
+)
+
+var (
+	ErrNotFound     = errors.New("permission not found")
+	ErrRoleNotFound = errors.New("role not found")
+	ErrInvalidName  = errors.New("permission name must look like resource:action")
+	ErrInvalidUser  = errors.New("user id must be positive")
+)
+
+var namePattern = regexp.MustCompile(`^[a-z][a-z_]*:[a-z][a-z_]*$`)
+
+// Permission is one action a role may be granted, named resource:action,
+// e.g. order:create or transaction:refund.
+type Permission struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func ValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
@@ -3,6 +3,7 @@ package mencache
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
@@ -24,3 +25,19 @@ type RoleQueryCache interface {
 	GetCachedRoleActive(ctx context.Context, req *requests.FindAllRoles) ([]*response.RoleResponseDeleteAt, *int, bool)
 	GetCachedRoleTrashed(ctx context.Context, req *requests.FindAllRoles) ([]*response.RoleResponseDeleteAt, *int, bool)
 }
+
+type PermissionCache interface {
+	SetCachedPermissions(ctx context.Context, data []*permission.Permission)
+	SetCachedRolePermissions(ctx context.Context, roleId int, data []*permission.Permission)
+	SetCachedPermissionCheck(ctx context.Context, userId int, name string, merchantId int, allowed bool)
+
+	GetCachedPermissions(ctx context.Context) ([]*permission.Permission, bool)
+	GetCachedRolePermissions(ctx context.Context, roleId int) ([]*permission.Permission, bool)
+	GetCachedPermissionCheck(ctx context.Context, userId int, name string, merchantId int) (bool, bool)
+
+	DeleteCachedRolePermissions(ctx context.Context, roleId int)
+}
+
+type UserRoleCache interface {
+	DeleteCachedUserRoles(ctx context.Context, userId int)
+}
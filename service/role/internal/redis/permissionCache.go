@@ -0,0 +1,113 @@
+package mencache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	permissionAllCacheKey     = "role:permission:all"
+	permissionByRoleCacheKey  = "role:permission:role:%d"
+	permissionCheckCacheKey   = "role:permission:check:v%d:user:%d:name:%s:merchant:%d"
+	permissionVersionCacheKey = "role:permission:version"
+)
+
+type permissionCheckCached struct {
+	Allowed bool `json:"allowed"`
+}
+
+type permissionCache struct {
+	store *CacheStore
+}
+
+func NewPermissionCache(store *CacheStore) *permissionCache {
+	return &permissionCache{store: store}
+}
+
+func (c *permissionCache) SetCachedPermissions(ctx context.Context, data []*permission.Permission) {
+	SetToCache(ctx, c.store, permissionAllCacheKey, &data, ttlDefault)
+}
+
+func (c *permissionCache) GetCachedPermissions(ctx context.Context) ([]*permission.Permission, bool) {
+	result, found := GetFromCache[[]*permission.Permission](ctx, c.store, permissionAllCacheKey)
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return *result, true
+}
+
+func (c *permissionCache) SetCachedRolePermissions(ctx context.Context, roleId int, data []*permission.Permission) {
+	key := fmt.Sprintf(permissionByRoleCacheKey, roleId)
+
+	SetToCache(ctx, c.store, key, &data, ttlDefault)
+}
+
+func (c *permissionCache) GetCachedRolePermissions(ctx context.Context, roleId int) ([]*permission.Permission, bool) {
+	key := fmt.Sprintf(permissionByRoleCacheKey, roleId)
+
+	result, found := GetFromCache[[]*permission.Permission](ctx, c.store, key)
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return *result, true
+}
+
+func (c *permissionCache) DeleteCachedRolePermissions(ctx context.Context, roleId int) {
+	DeleteFromCache(ctx, c.store, fmt.Sprintf(permissionByRoleCacheKey, roleId))
+
+	invalidatePermissionChecks(ctx, c.store)
+}
+
+func (c *permissionCache) SetCachedPermissionCheck(ctx context.Context, userId int, name string, merchantId int, allowed bool) {
+	version, ok := permissionVersion(ctx, c.store)
+	if !ok {
+		return
+	}
+
+	key := fmt.Sprintf(permissionCheckCacheKey, version, userId, name, merchantId)
+
+	SetToCache(ctx, c.store, key, &permissionCheckCached{Allowed: allowed}, ttlDefault)
+}
+
+func (c *permissionCache) GetCachedPermissionCheck(ctx context.Context, userId int, name string, merchantId int) (bool, bool) {
+	version, ok := permissionVersion(ctx, c.store)
+	if !ok {
+		return false, false
+	}
+
+	key := fmt.Sprintf(permissionCheckCacheKey, version, userId, name, merchantId)
+
+	result, found := GetFromCache[permissionCheckCached](ctx, c.store, key)
+	if !found || result == nil {
+		return false, false
+	}
+
+	return result.Allowed, true
+}
+
+// Check results are keyed by a version that every change to grants or roles
+// bumps, so stale decisions are never read again and expire on their own.
+func permissionVersion(ctx context.Context, store *CacheStore) (int64, bool) {
+	version, err := store.redis.Get(ctx, permissionVersionCacheKey).Int64()
+	if err == redis.Nil {
+		return 0, true
+	}
+	if err != nil {
+		store.logger.Error("Failed to read permission cache version", zap.Error(err))
+		return 0, false
+	}
+
+	return version, true
+}
+
+func invalidatePermissionChecks(ctx context.Context, store *CacheStore) {
+	if err := store.redis.Incr(ctx, permissionVersionCacheKey).Err(); err != nil {
+		store.logger.Error("Failed to invalidate permission checks", zap.Error(err))
+	}
+}
@@ -8,18 +8,31 @@ import (
 type Mencache struct {
 	RoleCommandCache RoleCommandCache
 	RoleQueryCache   RoleQueryCache
+	PermissionCache  PermissionCache
+	UserRoleCache    UserRoleCache
 }
 
 type Deps struct {
 	Redis  *redis.Client
 	Logger logger.LoggerInterface
+
+	// IdentityRedis is the auth service's database, whose cached user info
+	// goes stale when a user's roles change. Optional.
+	IdentityRedis *redis.Client
 }
 
 func NewMencache(deps *Deps) *Mencache {
 	cacheStore := NewCacheStore(deps.Redis, deps.Logger)
 
+	var identityStore *CacheStore
+	if deps.IdentityRedis != nil {
+		identityStore = NewCacheStore(deps.IdentityRedis, deps.Logger)
+	}
+
 	return &Mencache{
 		RoleCommandCache: NewRoleCommandCache(cacheStore),
 		RoleQueryCache:   NewRoleQueryCache(cacheStore),
+		PermissionCache:  NewPermissionCache(cacheStore),
+		UserRoleCache:    NewUserRoleCache(cacheStore, identityStore),
 	}
 }
@@ -17,4 +17,6 @@ func (s *roleCommandCache) DeleteCachedRole(ctx context.Context, id int) {
 	key := fmt.Sprintf(roleByIdCacheKey, id)
 
 	DeleteFromCache(ctx, s.store, key)
+
+	invalidatePermissionChecks(ctx, s.store)
 }
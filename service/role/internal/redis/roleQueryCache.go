@@ -12,6 +12,7 @@ import (
 const (
 	roleAllCacheKey     = "role:all:page:%d:pageSize:%d:search:%s"
 	roleByIdCacheKey    = "role:id:%d"
+	roleByUserCacheKey  = "role:user:%d"
 	roleActiveCacheKey  = "role:active:page:%d:pageSize:%d:search:%s"
 	roleTrashedCacheKey = "role:trashed:page:%d:pageSize:%d:search:%s"
 
@@ -66,7 +67,7 @@ func (m *roleQueryCache) SetCachedRoleByUserId(ctx context.Context, userId int,
 		data = []*response.RoleResponse{}
 	}
 
-	key := fmt.Sprintf(roleByIdCacheKey, userId)
+	key := fmt.Sprintf(roleByUserCacheKey, userId)
 
 	SetToCache(ctx, m.store, key, &data, ttlDefault)
 }
@@ -127,7 +128,7 @@ func (m *roleQueryCache) GetCachedRoleById(ctx context.Context, id int) (*respon
 }
 
 func (m *roleQueryCache) GetCachedRoleByUserId(ctx context.Context, userId int) ([]*response.RoleResponse, bool) {
-	key := fmt.Sprintf(roleByIdCacheKey, userId)
+	key := fmt.Sprintf(roleByUserCacheKey, userId)
 
 	result, found := GetFromCache[[]*response.RoleResponse](ctx, m.store, key)
 
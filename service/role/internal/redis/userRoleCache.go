@@ -0,0 +1,43 @@
+package mencache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// The auth service caches user info under this key in its own Redis
+// database; keep the format in sync with its identity cache.
+const identityUserInfoCacheKey = "identity:user_info:%d"
+
+// rolesChangedChannel is where the API gateway listens for users whose
+// cached roles it has to drop; keep it in sync with the gateway.
+const rolesChangedChannel = "apigateway:roles:changed"
+
+type userRoleCache struct {
+	store    *CacheStore
+	identity *CacheStore
+}
+
+func NewUserRoleCache(store *CacheStore, identity *CacheStore) *userRoleCache {
+	return &userRoleCache{store: store, identity: identity}
+}
+
+// DeleteCachedUserRoles drops everything cached about the user's roles: the
+// role list, permission checks, the auth service's user info and the
+// gateway's role cache.
+func (c *userRoleCache) DeleteCachedUserRoles(ctx context.Context, userId int) {
+	DeleteFromCache(ctx, c.store, fmt.Sprintf(roleByUserCacheKey, userId))
+
+	invalidatePermissionChecks(ctx, c.store)
+
+	if c.identity != nil {
+		DeleteFromCache(ctx, c.identity, fmt.Sprintf(identityUserInfoCacheKey, userId))
+
+		if err := c.identity.redis.Publish(ctx, rolesChangedChannel, strconv.Itoa(userId)).Err(); err != nil {
+			c.identity.logger.Error("Failed to announce role change", zap.Int("user.id", userId), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,6 @@
+package repository
+
+import "github.com/MamangRust/monolith-point-of-sale-common/bulk"
+
+// Tables the DeleteAll and RestoreAll calls of this service run on.
+var bulkRoles = bulk.Table{Name: "roles", ID: "role_id"}
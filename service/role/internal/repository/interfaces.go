@@ -2,7 +2,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 )
@@ -26,3 +29,27 @@ type RoleCommandRepository interface {
 	RestoreAllRole(ctx context.Context) (bool, error)
 	DeleteAllRolePermanent(ctx context.Context) (bool, error)
 }
+
+type PermissionRepository interface {
+	FindAll(ctx context.Context) ([]*permission.Permission, error)
+	FindByName(ctx context.Context, name string) (*permission.Permission, error)
+	FindByRoleId(ctx context.Context, role_id int) ([]*permission.Permission, error)
+	AssignToRole(ctx context.Context, role_id int, permission_id int) error
+	RevokeFromRole(ctx context.Context, role_id int, permission_id int) error
+	Check(ctx context.Context, user_id int, name string, merchant_id int) (bool, error)
+}
+
+type UserRoleRepository interface {
+	UserExists(ctx context.Context, user_id int) (bool, error)
+	AssignRole(ctx context.Context, user_id int, role_id int) error
+	RemoveRole(ctx context.Context, user_id int, role_id int) (bool, error)
+}
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
+)
+
+var (
+	ErrFindPermissions  = errors.New("failed to find permissions")
+	ErrAssignPermission = errors.New("failed to assign permission to role")
+	ErrRevokePermission = errors.New("failed to revoke permission from role")
+	ErrCheckPermission  = errors.New("failed to check permission")
+	ErrFindPermission   = errors.New("failed to find permission")
+)
+
+const getPermissions = `-- name: GetPermissions :many
+SELECT permission_id, name, description
+FROM permissions
+ORDER BY name
+`
+
+const getPermissionByName = `-- name: GetPermissionByName :one
+SELECT permission_id, name, description
+FROM permissions
+WHERE name = $1
+`
+
+const getRolePermissions = `-- name: GetRolePermissions :many
+SELECT p.permission_id, p.name, p.description
+FROM permissions p
+JOIN role_permissions rp ON rp.permission_id = p.permission_id
+WHERE rp.role_id = $1
+ORDER BY p.name
+`
+
+const assignRolePermission = `-- name: AssignRolePermission :exec
+INSERT INTO role_permissions (role_id, permission_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+const revokeRolePermission = `-- name: RevokeRolePermission :exec
+DELETE FROM role_permissions
+WHERE role_id = $1 AND permission_id = $2
+`
+
+// Administrators hold a permission for every merchant; anyone else only for
+// the merchants they own or work for. Merchant 0 skips the membership check.
+const checkUserPermission = `-- name: CheckUserPermission :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_roles ur
+    JOIN roles r ON r.role_id = ur.role_id AND r.deleted_at IS NULL
+    JOIN role_permissions rp ON rp.role_id = r.role_id
+    JOIN permissions p ON p.permission_id = rp.permission_id
+    WHERE ur.user_id = $1
+      AND ur.deleted_at IS NULL
+      AND p.name = $2
+      AND (
+          $3::int = 0
+          OR r.role_name = 'ROLE_ADMIN'
+          OR EXISTS (SELECT 1 FROM merchants m WHERE m.merchant_id = $3 AND m.user_id = $1 AND m.deleted_at IS NULL)
+          OR EXISTS (SELECT 1 FROM cashiers c WHERE c.merchant_id = $3 AND c.user_id = $1 AND c.deleted_at IS NULL)
+      )
+)
+`
+
+type permissionRepository struct {
+	conn db.DBTX
+}
+
+func NewPermissionRepository(conn db.DBTX) *permissionRepository {
+	return &permissionRepository{
+		conn: conn,
+	}
+}
+
+func (r *permissionRepository) FindAll(ctx context.Context) ([]*permission.Permission, error) {
+	return r.query(ctx, getPermissions)
+}
+
+func (r *permissionRepository) FindByName(ctx context.Context, name string) (*permission.Permission, error) {
+	var res permission.Permission
+
+	err := r.conn.QueryRowContext(ctx, getPermissionByName, name).Scan(&res.ID, &res.Name, &res.Description)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, permission.ErrNotFound
+		}
+		return nil, ErrFindPermission
+	}
+
+	return &res, nil
+}
+
+func (r *permissionRepository) FindByRoleId(ctx context.Context, roleId int) ([]*permission.Permission, error) {
+	return r.query(ctx, getRolePermissions, roleId)
+}
+
+func (r *permissionRepository) AssignToRole(ctx context.Context, roleId int, permissionId int) error {
+	if _, err := r.conn.ExecContext(ctx, assignRolePermission, roleId, permissionId); err != nil {
+		return ErrAssignPermission
+	}
+
+	return nil
+}
+
+func (r *permissionRepository) RevokeFromRole(ctx context.Context, roleId int, permissionId int) error {
+	if _, err := r.conn.ExecContext(ctx, revokeRolePermission, roleId, permissionId); err != nil {
+		return ErrRevokePermission
+	}
+
+	return nil
+}
+
+func (r *permissionRepository) Check(ctx context.Context, userId int, name string, merchantId int) (bool, error) {
+	var allowed bool
+
+	if err := r.conn.QueryRowContext(ctx, checkUserPermission, userId, name, merchantId).Scan(&allowed); err != nil {
+		return false, ErrCheckPermission
+	}
+
+	return allowed, nil
+}
+
+func (r *permissionRepository) query(ctx context.Context, query string, args ...interface{}) ([]*permission.Permission, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, ErrFindPermissions
+	}
+	defer rows.Close()
+
+	res := []*permission.Permission{}
+	for rows.Next() {
+		var p permission.Permission
+		if err := rows.Scan(&p.ID, &p.Name, &p.Description); err != nil {
+			return nil, ErrFindPermissions
+		}
+		res = append(res, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrFindPermissions
+	}
+
+	return res, nil
+}
@@ -1,20 +1,31 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "role"
+
 type Repositories struct {
 	RoleCommand RoleCommandRepository
 	RoleQuery   RoleQueryRepository
+	Permission  PermissionRepository
+	UserRole    UserRoleRepository
+	Outbox      OutboxRepository
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	roleMapper := recordmapper.NewRoleRecordMapper()
 
 	return &Repositories{
-		RoleCommand: NewRoleCommandRepository(DB, roleMapper),
+		RoleCommand: NewRoleCommandRepository(DB, conn, roleMapper),
 		RoleQuery:   NewRoleQueryRepository(DB, roleMapper),
+		Permission:  NewPermissionRepository(conn),
+		UserRole:    NewUserRoleRepository(conn),
+		Outbox:      outbox.NewRepository(conn, outboxSource),
 	}
 }
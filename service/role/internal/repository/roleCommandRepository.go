@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -14,12 +15,14 @@ import (
 
 type roleCommandRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.RoleRecordMapping
 }
 
-func NewRoleCommandRepository(db *db.Queries, mapping recordmapper.RoleRecordMapping) *roleCommandRepository {
+func NewRoleCommandRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.RoleRecordMapping) *roleCommandRepository {
 	return &roleCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -87,21 +90,25 @@ func (r *roleCommandRepository) DeleteRolePermanent(ctx context.Context, role_id
 }
 
 func (r *roleCommandRepository) RestoreAllRole(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllRoles(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkRoles, bulk.Restore)
 
 	if err != nil {
 		return false, fmt.Errorf("no trashed roles available to restore")
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *roleCommandRepository) DeleteAllRolePermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentRoles(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkRoles, bulk.Delete)
 
 	if err != nil {
 		return false, fmt.Errorf("cannot permanently delete all roles: operation disabled for system protection")
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
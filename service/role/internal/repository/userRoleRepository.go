@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+)
+
+var (
+	ErrFindUser           = errors.New("failed to find user")
+	ErrAssignRoleToUser   = errors.New("failed to assign role to user")
+	ErrRemoveRoleFromUser = errors.New("failed to remove role from user")
+)
+
+const userExists = `-- name: UserExists :one
+SELECT EXISTS (SELECT 1 FROM users WHERE user_id = $1 AND deleted_at IS NULL)
+`
+
+const assignUserRole = `-- name: AssignUserRole :exec
+INSERT INTO user_roles (user_id, role_id, created_at, updated_at)
+SELECT $1, $2, current_timestamp, current_timestamp
+WHERE NOT EXISTS (
+    SELECT 1 FROM user_roles WHERE user_id = $1 AND role_id = $2 AND deleted_at IS NULL
+)
+`
+
+// The administrator role is only removed while another user still holds it,
+// so the system cannot be left without anyone able to manage roles.
+const removeUserRole = `-- name: RemoveUserRole :execrows
+DELETE FROM user_roles ur
+USING roles r
+WHERE r.role_id = ur.role_id
+  AND ur.user_id = $1
+  AND ur.role_id = $2
+  AND (
+      r.role_name <> 'ROLE_ADMIN'
+      OR EXISTS (
+          SELECT 1 FROM user_roles other
+          JOIN users u ON u.user_id = other.user_id AND u.deleted_at IS NULL
+          WHERE other.role_id = $2 AND other.user_id <> $1 AND other.deleted_at IS NULL
+      )
+  )
+`
+
+type userRoleRepository struct {
+	conn db.DBTX
+}
+
+func NewUserRoleRepository(conn db.DBTX) *userRoleRepository {
+	return &userRoleRepository{
+		conn: conn,
+	}
+}
+
+func (r *userRoleRepository) UserExists(ctx context.Context, userId int) (bool, error) {
+	var exists bool
+
+	if err := r.conn.QueryRowContext(ctx, userExists, userId).Scan(&exists); err != nil {
+		return false, ErrFindUser
+	}
+
+	return exists, nil
+}
+
+// AssignRole is a no-op when the user already holds the role.
+func (r *userRoleRepository) AssignRole(ctx context.Context, userId int, roleId int) error {
+	if _, err := r.conn.ExecContext(ctx, assignUserRole, userId, roleId); err != nil {
+		return ErrAssignRoleToUser
+	}
+
+	return nil
+}
+
+// RemoveRole reports false when nothing was removed, either because the user
+// does not hold the role or because they are the last administrator.
+func (r *userRoleRepository) RemoveRole(ctx context.Context, userId int, roleId int) (bool, error) {
+	res, err := r.conn.ExecContext(ctx, removeUserRole, userId, roleId)
+	if err != nil {
+		return false, ErrRemoveRoleFromUser
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, ErrRemoveRoleFromUser
+	}
+
+	return rows > 0, nil
+}
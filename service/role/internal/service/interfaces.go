@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 )
@@ -25,3 +26,16 @@ type RoleCommandService interface {
 	RestoreAllRole(ctx context.Context) (bool, *response.ErrorResponse)
 	DeleteAllRolePermanent(ctx context.Context) (bool, *response.ErrorResponse)
 }
+
+type PermissionService interface {
+	FindAll(ctx context.Context) ([]*permission.Permission, *response.ErrorResponse)
+	FindByRoleId(ctx context.Context, role_id int) ([]*permission.Permission, *response.ErrorResponse)
+	AssignPermission(ctx context.Context, role_id int, name string) ([]*permission.Permission, *response.ErrorResponse)
+	RevokePermission(ctx context.Context, role_id int, name string) ([]*permission.Permission, *response.ErrorResponse)
+	CheckPermission(ctx context.Context, user_id int, name string, merchant_id int) (bool, *response.ErrorResponse)
+}
+
+type UserRoleService interface {
+	AssignRole(ctx context.Context, user_id int, role_id int) ([]*response.RoleResponse, *response.ErrorResponse)
+	RemoveRole(ctx context.Context, user_id int, role_id int) ([]*response.RoleResponse, *response.ErrorResponse)
+}
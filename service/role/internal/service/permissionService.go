@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/permission"
+	mencache "github.com/MamangRust/monolith-point-of-sale-role/internal/redis"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type permissionService struct {
+	errorhandler    errorhandler.PermissionErrorHandler
+	mencache        mencache.PermissionCache
+	trace           trace.Tracer
+	permission      repository.PermissionRepository
+	roleQuery       repository.RoleQueryRepository
+	logger          logger.LoggerInterface
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func NewPermissionService(
+	errorhandler errorhandler.PermissionErrorHandler,
+	mencache mencache.PermissionCache,
+	permission repository.PermissionRepository,
+	roleQuery repository.RoleQueryRepository,
+	logger logger.LoggerInterface,
+) *permissionService {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "permission_service_request_total",
+			Help: "Total number of requests to the PermissionService",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "permission_service_request_duration_seconds",
+			Help:    "Histogram of request durations for the PermissionService",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return &permissionService{
+		errorhandler:    errorhandler,
+		mencache:        mencache,
+		trace:           otel.Tracer("permission-service"),
+		permission:      permission,
+		roleQuery:       roleQuery,
+		logger:          logger,
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+}
+
+func (s *permissionService) FindAll(ctx context.Context) ([]*permission.Permission, *response.ErrorResponse) {
+	const method = "FindAll"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method)
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, found := s.mencache.GetCachedPermissions(ctx); found {
+		logSuccess("Data found in cache")
+
+		return data, nil
+	}
+
+	res, err := s.permission.FindAll(ctx)
+	if err != nil {
+		return s.errorhandler.HandleRepositoryListError(err, method, "FAILED_FIND_ALL_PERMISSION", span, &status, zap.Error(err))
+	}
+
+	s.mencache.SetCachedPermissions(ctx, res)
+
+	logSuccess("Successfully fetched permissions", zap.Int("count", len(res)))
+
+	return res, nil
+}
+
+func (s *permissionService) FindByRoleId(ctx context.Context, roleId int) ([]*permission.Permission, *response.ErrorResponse) {
+	const method = "FindByRoleId"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("role.id", roleId))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, found := s.mencache.GetCachedRolePermissions(ctx, roleId); found {
+		logSuccess("Data found in cache", zap.Int("role.id", roleId))
+
+		return data, nil
+	}
+
+	if _, err := s.roleQuery.FindById(ctx, roleId); err != nil {
+		return s.errorhandler.HandleRepositoryListError(permission.ErrRoleNotFound, method, "FAILED_FIND_ROLE", span, &status, zap.Int("role.id", roleId), zap.Error(err))
+	}
+
+	res, err := s.permission.FindByRoleId(ctx, roleId)
+	if err != nil {
+		return s.errorhandler.HandleRepositoryListError(err, method, "FAILED_FIND_PERMISSION_BY_ROLE", span, &status, zap.Error(err))
+	}
+
+	s.mencache.SetCachedRolePermissions(ctx, roleId, res)
+
+	logSuccess("Successfully fetched role permissions", zap.Int("role.id", roleId), zap.Int("count", len(res)))
+
+	return res, nil
+}
+
+// AssignPermission grants a permission to a role and returns everything the
+// role is granted afterwards. Granting it twice is not an error.
+func (s *permissionService) AssignPermission(ctx context.Context, roleId int, name string) ([]*permission.Permission, *response.ErrorResponse) {
+	const method = "AssignPermission"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("role.id", roleId), attribute.String("permission", name))
+
+	defer func() {
+		end(status)
+	}()
+
+	perm, err := s.findRolePermission(ctx, roleId, name)
+	if err != nil {
+		return s.errorhandler.HandleAssignPermissionError(err, method, "FAILED_ASSIGN_PERMISSION", span, &status, zap.Int("role.id", roleId), zap.String("permission", name))
+	}
+
+	if err := s.permission.AssignToRole(ctx, roleId, perm.ID); err != nil {
+		return s.errorhandler.HandleAssignPermissionError(err, method, "FAILED_ASSIGN_PERMISSION", span, &status, zap.Error(err))
+	}
+
+	s.mencache.DeleteCachedRolePermissions(ctx, roleId)
+
+	res, err := s.permission.FindByRoleId(ctx, roleId)
+	if err != nil {
+		return s.errorhandler.HandleRepositoryListError(err, method, "FAILED_FIND_PERMISSION_BY_ROLE", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully assigned permission", zap.Int("role.id", roleId), zap.String("permission", name))
+
+	return res, nil
+}
+
+// RevokePermission removes a permission from a role and returns what the
+// role is still granted. Revoking one the role does not hold is not an error.
+func (s *permissionService) RevokePermission(ctx context.Context, roleId int, name string) ([]*permission.Permission, *response.ErrorResponse) {
+	const method = "RevokePermission"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("role.id", roleId), attribute.String("permission", name))
+
+	defer func() {
+		end(status)
+	}()
+
+	perm, err := s.findRolePermission(ctx, roleId, name)
+	if err != nil {
+		return s.errorhandler.HandleRevokePermissionError(err, method, "FAILED_REVOKE_PERMISSION", span, &status, zap.Int("role.id", roleId), zap.String("permission", name))
+	}
+
+	if err := s.permission.RevokeFromRole(ctx, roleId, perm.ID); err != nil {
+		return s.errorhandler.HandleRevokePermissionError(err, method, "FAILED_REVOKE_PERMISSION", span, &status, zap.Error(err))
+	}
+
+	s.mencache.DeleteCachedRolePermissions(ctx, roleId)
+
+	res, err := s.permission.FindByRoleId(ctx, roleId)
+	if err != nil {
+		return s.errorhandler.HandleRepositoryListError(err, method, "FAILED_FIND_PERMISSION_BY_ROLE", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully revoked permission", zap.Int("role.id", roleId), zap.String("permission", name))
+
+	return res, nil
+}
+
+// CheckPermission reports whether any of the user's roles grants the
+// permission for merchantId. A merchantId of 0 asks about the permission
+// alone, for actions that do not belong to a merchant.
+func (s *permissionService) CheckPermission(ctx context.Context, userId int, name string, merchantId int) (bool, *response.ErrorResponse) {
+	const method = "CheckPermission"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method,
+		attribute.Int("user.id", userId), attribute.String("permission", name), attribute.Int("merchant.id", merchantId))
+
+	defer func() {
+		end(status)
+	}()
+
+	switch {
+	case userId <= 0:
+		return s.errorhandler.HandleCheckPermissionError(permission.ErrInvalidUser, method, "INVALID_PERMISSION_CHECK", span, &status, zap.Int("user.id", userId))
+	case !permission.ValidName(name) || merchantId < 0:
+		return s.errorhandler.HandleCheckPermissionError(permission.ErrInvalidName, method, "INVALID_PERMISSION_CHECK", span, &status, zap.String("permission", name), zap.Int("merchant.id", merchantId))
+	}
+
+	if allowed, found := s.mencache.GetCachedPermissionCheck(ctx, userId, name, merchantId); found {
+		logSuccess("Data found in cache", zap.Int("user.id", userId), zap.String("permission", name), zap.Bool("allowed", allowed))
+
+		return allowed, nil
+	}
+
+	allowed, err := s.permission.Check(ctx, userId, name, merchantId)
+	if err != nil {
+		return s.errorhandler.HandleCheckPermissionError(err, method, "FAILED_CHECK_PERMISSION", span, &status, zap.Error(err))
+	}
+
+	s.mencache.SetCachedPermissionCheck(ctx, userId, name, merchantId, allowed)
+
+	logSuccess("Successfully checked permission", zap.Int("user.id", userId), zap.String("permission", name), zap.Bool("allowed", allowed))
+
+	return allowed, nil
+}
+
+func (s *permissionService) findRolePermission(ctx context.Context, roleId int, name string) (*permission.Permission, error) {
+	if !permission.ValidName(name) {
+		return nil, permission.ErrInvalidName
+	}
+
+	if _, err := s.roleQuery.FindById(ctx, roleId); err != nil {
+		return nil, permission.ErrRoleNotFound
+	}
+
+	return s.permission.FindByName(ctx, name)
+}
+
+func (s *permissionService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
+	context.Context,
+	trace.Span,
+	func(string),
+	string,
+	func(string, ...zap.Field),
+) {
+	start := time.Now()
+	status := "success"
+
+	ctx, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+
+	s.logger.Debug("Start: " + method)
+
+	end := func(status string) {
+		s.recordMetrics(method, status, start)
+		code := codes.Ok
+		if status != "success" {
+			code = codes.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess := func(msg string, fields ...zap.Field) {
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	return ctx, span, end, status, logSuccess
+}
+
+func (s *permissionService) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
@@ -13,6 +13,8 @@ import (
 type Service struct {
 	RoleQuery   RoleQueryService
 	RoleCommand RoleCommandService
+	Permission  PermissionService
+	UserRole    UserRoleService
 }
 
 type Deps struct {
@@ -29,5 +31,7 @@ func NewService(deps *Deps) *Service {
 	return &Service{
 		RoleQuery:   NewRoleQueryService(deps.ErrorHandler.RoleQueryError, deps.Mencache.RoleQueryCache, deps.Repositories.RoleQuery, deps.Logger, roleMapper),
 		RoleCommand: NewRoleCommandService(deps.ErrorHandler.RoleCommandError, deps.Mencache.RoleCommandCache, deps.Repositories.RoleCommand, deps.Logger, roleMapper),
+		Permission:  NewPermissionService(deps.ErrorHandler.PermissionError, deps.Mencache.PermissionCache, deps.Repositories.Permission, deps.Repositories.RoleQuery, deps.Logger),
+		UserRole:    NewUserRoleService(deps.ErrorHandler.UserRoleError, deps.Mencache.UserRoleCache, deps.Repositories.UserRole, deps.Repositories.RoleQuery, deps.Logger, roleMapper),
 	}
 }
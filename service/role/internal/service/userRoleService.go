@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/errorhandler"
+	mencache "github.com/MamangRust/monolith-point-of-sale-role/internal/redis"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-role/internal/userrole"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	response_service "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/service"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type userRoleService struct {
+	errorhandler    errorhandler.UserRoleErrorHandler
+	mencache        mencache.UserRoleCache
+	trace           trace.Tracer
+	userRole        repository.UserRoleRepository
+	roleQuery       repository.RoleQueryRepository
+	logger          logger.LoggerInterface
+	mapping         response_service.RoleResponseMapper
+	requestCounter  *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+func NewUserRoleService(
+	errorhandler errorhandler.UserRoleErrorHandler,
+	mencache mencache.UserRoleCache,
+	userRole repository.UserRoleRepository,
+	roleQuery repository.RoleQueryRepository,
+	logger logger.LoggerInterface,
+	mapping response_service.RoleResponseMapper,
+) *userRoleService {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "user_role_service_request_total",
+			Help: "Total number of requests to the UserRoleService",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "user_role_service_request_duration_seconds",
+			Help:    "Histogram of request durations for the UserRoleService",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return &userRoleService{
+		errorhandler:    errorhandler,
+		mencache:        mencache,
+		trace:           otel.Tracer("user-role-service"),
+		userRole:        userRole,
+		roleQuery:       roleQuery,
+		logger:          logger,
+		mapping:         mapping,
+		requestCounter:  requestCounter,
+		requestDuration: requestDuration,
+	}
+}
+
+// AssignRole gives the user a role and returns the roles they hold
+// afterwards. Assigning a role the user already holds is not an error.
+func (s *userRoleService) AssignRole(ctx context.Context, userId int, roleId int) ([]*response.RoleResponse, *response.ErrorResponse) {
+	const method = "AssignRole"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("user.id", userId), attribute.Int("role.id", roleId))
+
+	defer func() {
+		end(status)
+	}()
+
+	role, err := s.findUserAndRole(ctx, userId, roleId)
+	if err != nil {
+		return s.errorhandler.HandleAssignRoleError(err, method, "FAILED_ASSIGN_ROLE", span, &status, zap.Int("user.id", userId), zap.Int("role.id", roleId))
+	}
+
+	if err := s.userRole.AssignRole(ctx, userId, roleId); err != nil {
+		return s.errorhandler.HandleAssignRoleError(err, method, "FAILED_ASSIGN_ROLE", span, &status, zap.Error(err))
+	}
+
+	s.mencache.DeleteCachedUserRoles(ctx, userId)
+
+	s.audit(ctx, "assign", userId, role)
+
+	roles, err := s.roleQuery.FindByUserId(ctx, userId)
+	if err != nil {
+		return s.errorhandler.HandleAssignRoleError(err, method, "FAILED_FIND_ROLE_BY_USER_ID", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully assigned role to user", zap.Int("user.id", userId), zap.Int("role.id", roleId))
+
+	return s.mapping.ToRolesResponse(roles), nil
+}
+
+// RemoveRole takes a role away from the user and returns the roles they
+// still hold. The last administrator keeps the administrator role.
+func (s *userRoleService) RemoveRole(ctx context.Context, userId int, roleId int) ([]*response.RoleResponse, *response.ErrorResponse) {
+	const method = "RemoveRole"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("user.id", userId), attribute.Int("role.id", roleId))
+
+	defer func() {
+		end(status)
+	}()
+
+	role, err := s.findUserAndRole(ctx, userId, roleId)
+	if err != nil {
+		return s.errorhandler.HandleRemoveRoleError(err, method, "FAILED_REMOVE_ROLE", span, &status, zap.Int("user.id", userId), zap.Int("role.id", roleId))
+	}
+
+	removed, err := s.userRole.RemoveRole(ctx, userId, roleId)
+	if err != nil {
+		return s.errorhandler.HandleRemoveRoleError(err, method, "FAILED_REMOVE_ROLE", span, &status, zap.Error(err))
+	}
+
+	if !removed {
+		err := userrole.ErrNotAssigned
+		if role.Name == userrole.RoleAdmin && s.holdsRole(ctx, userId, roleId) {
+			err = userrole.ErrLastAdmin
+		}
+
+		return s.errorhandler.HandleRemoveRoleError(err, method, "FAILED_REMOVE_ROLE", span, &status, zap.Int("user.id", userId), zap.Int("role.id", roleId))
+	}
+
+	s.mencache.DeleteCachedUserRoles(ctx, userId)
+
+	s.audit(ctx, "remove", userId, role)
+
+	roles, err := s.roleQuery.FindByUserId(ctx, userId)
+	if err != nil {
+		return s.errorhandler.HandleRemoveRoleError(err, method, "FAILED_FIND_ROLE_BY_USER_ID", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully removed role from user", zap.Int("user.id", userId), zap.Int("role.id", roleId))
+
+	return s.mapping.ToRolesResponse(roles), nil
+}
+
+func (s *userRoleService) findUserAndRole(ctx context.Context, userId int, roleId int) (*record.RoleRecord, error) {
+	if userId <= 0 {
+		return nil, userrole.ErrInvalidUser
+	}
+
+	exists, err := s.userRole.UserExists(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, userrole.ErrUserNotFound
+	}
+
+	role, err := s.roleQuery.FindById(ctx, roleId)
+	if err != nil {
+		return nil, userrole.ErrRoleNotFound
+	}
+
+	return role, nil
+}
+
+func (s *userRoleService) holdsRole(ctx context.Context, userId int, roleId int) bool {
+	roles, err := s.roleQuery.FindByUserId(ctx, userId)
+	if err != nil {
+		return false
+	}
+
+	for _, role := range roles {
+		if role.ID == roleId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// audit records who changed whose roles; role changes grant or take away
+// access, so they are logged at info level unlike the rest of the service.
+func (s *userRoleService) audit(ctx context.Context, action string, userId int, role *record.RoleRecord) {
+	s.logger.Info("Audit: user role changed",
+		zap.String("action", action),
+		zap.Int("actor.id", userrole.Actor(ctx)),
+		zap.Int("user.id", userId),
+		zap.Int("role.id", role.ID),
+		zap.String("role.name", role.Name),
+	)
+}
+
+func (s *userRoleService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
+	context.Context,
+	trace.Span,
+	func(string),
+	string,
+	func(string, ...zap.Field),
+) {
+	start := time.Now()
+	status := "success"
+
+	ctx, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+
+	s.logger.Debug("Start: " + method)
+
+	end := func(status string) {
+		s.recordMetrics(method, status, start)
+		code := codes.Ok
+		if status != "success" {
+			code = codes.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess := func(msg string, fields ...zap.Field) {
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	return ctx, span, end, status, logSuccess
+}
+
+func (s *userRoleService) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,41 @@
+package userrole
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataUserID is set by the API gateway to the caller of every
+// authenticated request.
+const MetadataUserID = "x-user-id"
+
+const RoleAdmin = "ROLE_ADMIN"
+
+var (
+	ErrInvalidUser  = errors.New("user id must be positive")
+	ErrUserNotFound = errors.New("user not found")
+	ErrRoleNotFound = errors.New("role not found")
+	ErrNotAssigned  = errors.New("role is not assigned to user")
+	ErrLastAdmin    = errors.New("cannot remove the last administrator")
+)
+
+// Actor returns the user who made the call, or 0 for calls from inside the
+// cluster.
+func Actor(ctx context.Context) int {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0
+	}
+
+	values := md.Get(MetadataUserID)
+	if len(values) == 0 {
+		return 0
+	}
+
+	id, _ := strconv.Atoi(values[0])
+
+	return id
+}
@@ -0,0 +1,9 @@
+package record
+
+type RoleRecord struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+	DeletedAt *string `json:"deleted_at"`
+}
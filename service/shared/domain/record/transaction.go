@@ -0,0 +1,56 @@
+package record
+
+type TransactionRecord struct {
+	ID             int     `json:"id"`
+	OrderID        int     `json:"order_id"`
+	MerchantID     int     `json:"merchant_id"`
+	PaymentMethod  string  `json:"payment_method"`
+	Amount         int     `json:"amount"`
+	ChangeAmount   int     `json:"change_amount"`
+	SubtotalAmount int     `json:"subtotal_amount"`
+	TaxAmount      int     `json:"tax_amount"`
+	PaymentStatus  string  `json:"payment_status"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+	DeletedAt      *string `json:"deleted_at"`
+}
+
+type TransactionMonthlyAmountSuccessRecord struct {
+	Year         string `json:"year"`
+	Month        string `json:"month"`
+	TotalSuccess int    `json:"total_success"`
+	TotalAmount  int    `json:"total_amount"`
+}
+
+type TransactionMonthlyAmountFailedRecord struct {
+	Year        string `json:"year"`
+	Month       string `json:"month"`
+	TotalFailed int    `json:"total_failed"`
+	TotalAmount int    `json:"total_amount"`
+}
+
+type TransactionYearlyAmountSuccessRecord struct {
+	Year         string `json:"year"`
+	TotalSuccess int    `json:"total_success"`
+	TotalAmount  int    `json:"total_amount"`
+}
+
+type TransactionYearlyAmountFailedRecord struct {
+	Year        string `json:"year"`
+	TotalFailed int    `json:"total_failed"`
+	TotalAmount int    `json:"total_amount"`
+}
+
+type TransactionMonthlyMethodRecord struct {
+	Month             string `json:"month"`
+	PaymentMethod     string `json:"payment_method"`
+	TotalTransactions int    `json:"total_transactions"`
+	TotalAmount       int    `json:"total_amount"`
+}
+
+type TransactionYearlyMethodRecord struct {
+	Year              string `json:"year"`
+	PaymentMethod     string `json:"payment_method"`
+	TotalTransactions int    `json:"total_transactions"`
+	TotalAmount       int    `json:"total_amount"`
+}
@@ -0,0 +1,130 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+type MonthAmountTransaction struct {
+	Year  int `json:"year" validate:"required"`
+	Month int `json:"month" validate:"required"`
+}
+
+type MonthAmountTransactionMerchant struct {
+	MerchantID int `json:"merchant_id" validate:"required"`
+	Year       int `json:"year" validate:"required"`
+	Month      int `json:"month" validate:"required"`
+}
+
+type YearAmountTransactionMerchant struct {
+	MerchantID int `json:"merchant_id" validate:"required"`
+	Year       int `json:"year" validate:"required"`
+}
+
+type MonthMethodTransaction struct {
+	Year  int `json:"year" validate:"required"`
+	Month int `json:"month" validate:"required"`
+}
+
+type MonthMethodTransactionMerchant struct {
+	MerchantID int `json:"merchant_id" validate:"required"`
+	Year       int `json:"year" validate:"required"`
+	Month      int `json:"month" validate:"required"`
+}
+
+type YearMethodTransactionMerchant struct {
+	MerchantID int `json:"merchant_id" validate:"required"`
+	Year       int `json:"year" validate:"required"`
+}
+
+type FindAllTransaction struct {
+	Search   string `json:"search" validate:"required"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+type FindAllTransactionByMerchant struct {
+	MerchantID int    `json:"merchant_id" validate:"required"`
+	Search     string `json:"search" validate:"required"`
+	Page       int    `json:"page" validate:"min=1"`
+	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+// TenderRequest is one of the payments that settle a transaction. A
+// loyalty_points tender pays with the customer's points.
+type TenderRequest struct {
+	Method string `json:"method" validate:"required"`
+	Amount int    `json:"amount" validate:"required,min=1"`
+}
+
+type CreateTransactionRequest struct {
+	OrderID       int             `json:"order_id" validate:"required"`
+	CashierID     int             `json:"cashier_id" validate:"required"`
+	MerchantID    int             `json:"merchant_id"`
+	PaymentMethod string          `json:"payment_method" validate:"required_without=Tenders"`
+	Amount        int             `json:"amount" validate:"required_without=Tenders"`
+	Tenders       []TenderRequest `json:"tenders" validate:"omitempty,dive"`
+	ChangeAmount  *int            `json:"change_amount"`
+	PaymentStatus *string         `json:"payment_status" `
+}
+
+type UpdateTransactionRequest struct {
+	TransactionID *int            `json:"transaction_id"`
+	OrderID       int             `json:"order_id" validate:"required"`
+	CashierID     int             `json:"cashier_id" validate:"required"`
+	MerchantID    int             `json:"merchant_id"`
+	PaymentMethod string          `json:"payment_method" validate:"required_without=Tenders"`
+	Amount        int             `json:"amount" validate:"required_without=Tenders"`
+	Tenders       []TenderRequest `json:"tenders" validate:"omitempty,dive"`
+	ChangeAmount  *int            `json:"change_amount"`
+	PaymentStatus *string         `json:"payment_status"`
+}
+
+func (r *CreateTransactionRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *UpdateTransactionRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type RefundItemRequest struct {
+	OrderItemID int `json:"order_item_id" validate:"required"`
+	Quantity    int `json:"quantity" validate:"required,min=1"`
+}
+
+// RefundTransactionRequest refunds the listed items, or everything still
+// refundable when Items is empty.
+type RefundTransactionRequest struct {
+	Reason string              `json:"reason" validate:"required"`
+	Items  []RefundItemRequest `json:"items" validate:"omitempty,dive"`
+}
+
+type VoidTransactionRequest struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+func (r *RefundTransactionRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *VoidTransactionRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package cashier_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrApiCashierInvalidYear = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "invalid year", http.StatusBadRequest)
+	}
+
+	ErrApiCashierInvalidMonth = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "invalid month", http.StatusBadRequest)
+	}
+
+	ErrApiCashierNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "cashier not found", http.StatusNotFound)
+	}
+	ErrApiCashierInvalidId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "invalid cashier id", http.StatusBadRequest)
+	}
+
+	ErrApiCashierInvalidMerchantId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "invalid merchant id", http.StatusBadRequest)
+	}
+
+	ErrApiCashierFailedFindAll = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find all cashiers", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedFindById = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find cashier by id", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedFindByActive = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find active cashiers", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedFindByTrashed = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find trashed cashiers", http.StatusInternalServerError)
+	}
+
+	ErrApiCashierFailedMonthlyTotalSales = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find monthly total sales", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedYearlyTotalSales = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find yearly total sales", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedMonthlyTotalSalesByMerchant = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find monthly total sales by merchant", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedYearlyTotalSalesByMerchant = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find yearly total sales by merchant", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedMonthlyTotalSalesById = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find monthly total sales by cashier id", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedYearlyTotalSalesById = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find yearly total sales by cashier id", http.StatusInternalServerError)
+	}
+
+	ErrApiCashierFailedMonthSales = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find monthly sales", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedYearSales = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find yearly sales", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedMonthSalesByMerchant = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find monthly sales by merchant", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedYearSalesByMerchant = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find yearly sales by merchant", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedMonthSalesById = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find monthly sales by cashier id", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedYearSalesById = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find yearly sales by cashier id", http.StatusInternalServerError)
+	}
+
+	ErrApiCashierFailedCreate = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to create cashier", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedUpdate = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to update cashier", http.StatusInternalServerError)
+	}
+
+	ErrApiValidateCreateCashier = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "validation failed: invalid create cashier request", http.StatusBadRequest)
+	}
+
+	ErrApiValidateUpdateCashier = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "validation failed: invalid update cashier request", http.StatusBadRequest)
+	}
+
+	ErrApiBindCreateCashier = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid create cashier request", http.StatusBadRequest)
+	}
+
+	ErrApiBindUpdateCashier = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid update cashier request", http.StatusBadRequest)
+	}
+
+	ErrApiCashierFailedTrashed = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to trash cashier", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedRestore = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to restore cashier", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedDeletePermanent = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to permanently delete cashier", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedRestoreAll = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to restore all cashiers", http.StatusInternalServerError)
+	}
+	ErrApiCashierFailedDeleteAllPermanent = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to permanently delete all cashiers", http.StatusInternalServerError)
+	}
+
+	ErrApiBindOpenShift = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid open shift request", http.StatusBadRequest)
+	}
+	ErrApiBindShiftMovement = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid cash movement request", http.StatusBadRequest)
+	}
+	ErrApiBindCloseShift = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid close shift request", http.StatusBadRequest)
+	}
+	ErrApiShiftInvalidId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "invalid shift ID", http.StatusBadRequest)
+	}
+	ErrApiShiftInvalid = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "invalid shift request: cash amounts must not be negative and a movement needs a positive amount, a kind of pay_in or pay_out and a reason", http.StatusBadRequest)
+	}
+	ErrApiShiftNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Shift or Cashier not found", http.StatusNotFound)
+	}
+	ErrApiShiftAlreadyOpen = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "cashier already has an open shift", http.StatusConflict)
+	}
+	ErrApiShiftClosed = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "shift is already closed", http.StatusConflict)
+	}
+	ErrApiFailedOpenShift = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to open shift", http.StatusInternalServerError)
+	}
+	ErrApiFailedFindOpenShift = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find open shift", http.StatusInternalServerError)
+	}
+	ErrApiFailedRecordShiftMovement = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to record cash movement", http.StatusInternalServerError)
+	}
+	ErrApiFailedCloseShift = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to close shift", http.StatusInternalServerError)
+	}
+	ErrApiFailedFindShiftReport = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to build shift report", http.StatusInternalServerError)
+	}
+	ErrApiFailedFindShiftSales = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to find shift sales", http.StatusInternalServerError)
+	}
+)
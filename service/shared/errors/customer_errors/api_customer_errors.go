@@ -0,0 +1,94 @@
+package customer_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrApiInvalidCustomerId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid customer ID", http.StatusBadRequest)
+	}
+
+	ErrApiInvalidMerchantId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid merchant ID", http.StatusBadRequest)
+	}
+
+	ErrApiInvalidOrderId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid order ID", http.StatusBadRequest)
+	}
+
+	ErrApiContactRequired = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Phone or email is required", http.StatusBadRequest)
+	}
+
+	ErrApiBindCreateCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid request body for create customer", http.StatusBadRequest)
+	}
+
+	ErrApiBindUpdateCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid request body for update customer", http.StatusBadRequest)
+	}
+
+	ErrApiBindAttachCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid request body for attach customer", http.StatusBadRequest)
+	}
+
+	ErrApiInvalidCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid customer name, email or phone number", http.StatusBadRequest)
+	}
+
+	ErrApiCustomerOrderMerchant = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Customer belongs to another merchant than the order", http.StatusBadRequest)
+	}
+
+	ErrApiCustomerNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Customer not found", http.StatusNotFound)
+	}
+
+	ErrApiCustomerContactTaken = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Email or phone number is already used by another customer", http.StatusConflict)
+	}
+
+	ErrApiFailedCreateCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to create customer", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch customer", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindCustomers = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch customers", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedUpdateCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to update customer", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedTrashCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to move customer to trash", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedRestoreCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to restore customer", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedDeleteCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to permanently delete customer", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedAttachCustomer = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to attach customer to order", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindCustomerPurchases = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch customer purchases", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindCustomerStats = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch customer stats", http.StatusInternalServerError)
+	}
+)
@@ -0,0 +1,50 @@
+package loyalty_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrApiInvalidMerchantId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid merchant ID", http.StatusBadRequest)
+	}
+
+	ErrApiInvalidCustomerId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid customer ID", http.StatusBadRequest)
+	}
+
+	ErrApiBindSaveLoyaltyProgram = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid request body for save loyalty program", http.StatusBadRequest)
+	}
+
+	ErrApiInvalidLoyaltyProgram = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid loyalty program", http.StatusBadRequest)
+	}
+
+	ErrApiLoyaltyProgramNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Merchant has no active loyalty program", http.StatusNotFound)
+	}
+
+	ErrApiLoyaltyCustomerNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Customer not found", http.StatusNotFound)
+	}
+
+	ErrApiFailedFindLoyaltyProgram = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch loyalty program", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedSaveLoyaltyProgram = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to save loyalty program", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindLoyaltyBalance = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch loyalty balance", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindLoyaltyHistory = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch loyalty history", http.StatusInternalServerError)
+	}
+)
@@ -0,0 +1,12 @@
+package loyalty_errors
+
+import (
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	ErrGrpcFailedInvalidMerchantId = response.NewGrpcError("error", "Invalid merchant ID", int(codes.InvalidArgument))
+	ErrGrpcFailedInvalidCustomerId = response.NewGrpcError("error", "Invalid customer ID", int(codes.InvalidArgument))
+)
@@ -0,0 +1,16 @@
+package product_errors
+
+import (
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	ErrGrpcInvalidID         = response.NewGrpcError("error", "invalid ID", int(codes.InvalidArgument))
+	ErrGrpcInvalidMerchantID = response.NewGrpcError("error", "invalid merchant ID", int(codes.InvalidArgument))
+	ErrGrpcBarcodeRequired   = response.NewGrpcError("error", "barcode is required", int(codes.InvalidArgument))
+
+	ErrGrpcValidateCreateProduct = response.NewGrpcError("error", "validation failed: invalid create product request", int(codes.InvalidArgument))
+	ErrGrpcValidateUpdateProduct = response.NewGrpcError("error", "validation failed: invalid update product request", int(codes.InvalidArgument))
+)
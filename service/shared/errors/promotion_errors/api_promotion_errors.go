@@ -0,0 +1,62 @@
+package promotion_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrApiInvalidPromotionId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid promotion ID", http.StatusBadRequest)
+	}
+
+	ErrApiInvalidMerchantId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid merchant ID", http.StatusBadRequest)
+	}
+
+	ErrApiInvalidOrderId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid order ID", http.StatusBadRequest)
+	}
+
+	ErrApiBindCreatePromotion = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid request body for create promotion", http.StatusBadRequest)
+	}
+
+	ErrApiInvalidPromotion = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Invalid promotion rule or validity window", http.StatusBadRequest)
+	}
+
+	ErrApiPromotionNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Promotion not found", http.StatusNotFound)
+	}
+
+	ErrApiOrderNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Order not found", http.StatusNotFound)
+	}
+
+	ErrApiPromotionCouponTaken = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Coupon code is already used by another promotion", http.StatusConflict)
+	}
+
+	ErrApiFailedCreatePromotion = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to create promotion", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindPromotion = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch promotion", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindPromotions = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch promotions", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedTrashPromotion = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to trash promotion", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindOrderDiscounts = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Failed to fetch order discounts", http.StatusInternalServerError)
+	}
+)
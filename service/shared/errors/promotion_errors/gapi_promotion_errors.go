@@ -0,0 +1,14 @@
+package promotion_errors
+
+import (
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	ErrGrpcFailedInvalidId         = response.NewGrpcError("error", "Invalid ID", int(codes.InvalidArgument))
+	ErrGrpcFailedInvalidMerchantId = response.NewGrpcError("error", "Invalid merchant ID", int(codes.InvalidArgument))
+	ErrGrpcFailedInvalidOrderId    = response.NewGrpcError("error", "Invalid order ID", int(codes.InvalidArgument))
+	ErrGrpcInvalidPromotionTime    = response.NewGrpcError("error", "starts_at and ends_at must be RFC 3339 times", int(codes.InvalidArgument))
+)
@@ -0,0 +1,127 @@
+package role_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrApiRoleNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Role not found", http.StatusNotFound)
+	}
+
+	ErrApiRoleInvalidId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "invalid Role id", http.StatusNotFound)
+	}
+
+	ErrApiFailedFindAll = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to fetch Roles", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindActive = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to fetch active Roles", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedFindTrashed = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to fetch trashed Roles", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedCreateRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to create Role", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedUpdateRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to update Role", http.StatusInternalServerError)
+	}
+
+	ErrApiValidateCreateRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "validation failed: invalid create Role request", http.StatusBadRequest)
+	}
+
+	ErrApiValidateUpdateRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "validation failed: invalid update Role request", http.StatusBadRequest)
+	}
+
+	ErrInvalidRoleId = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "invalid Role id", http.StatusBadRequest)
+	}
+
+	ErrApiBindCreateRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid create Role request", http.StatusBadRequest)
+	}
+
+	ErrApiBindUpdateRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid update Role request", http.StatusBadRequest)
+	}
+
+	ErrApiFailedTrashedRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to move Role to trash", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedRestoreRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to restore Role", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedDeletePermanent = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to delete Role permanently", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedRestoreAll = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to restore all Roles", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedDeleteAll = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to delete all Roles permanently", http.StatusInternalServerError)
+	}
+
+	ErrApiPermissionNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "Role or Permission not found", http.StatusNotFound)
+	}
+
+	ErrApiInvalidPermission = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "permission must look like resource:action", http.StatusBadRequest)
+	}
+
+	ErrApiBindPermission = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid permission request", http.StatusBadRequest)
+	}
+
+	ErrApiFailedFindPermissions = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to fetch Permissions", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedAssignPermission = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to assign Permission to Role", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedRevokePermission = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to revoke Permission from Role", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedCheckPermission = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to check Permission", http.StatusInternalServerError)
+	}
+
+	ErrApiBindUserRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "bind failed: invalid user role request", http.StatusBadRequest)
+	}
+
+	ErrApiUserRoleNotFound = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "User, Role or assignment not found", http.StatusNotFound)
+	}
+
+	ErrApiUserRoleLastAdmin = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "cannot remove the last administrator", http.StatusConflict)
+	}
+
+	ErrApiFailedAssignUserRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to assign Role to user", http.StatusInternalServerError)
+	}
+
+	ErrApiFailedRemoveUserRole = func(c echo.Context) error {
+		return response.NewApiErrorResponse(c, "error", "failed to remove Role from user", http.StatusInternalServerError)
+	}
+)
@@ -0,0 +1,20 @@
+package transaction_errors
+
+import (
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	ErrGrpcInvalidID         = response.NewGrpcError("error", "invalid ID", int(codes.InvalidArgument))
+	ErrGrpcInvalidMonth      = response.NewGrpcError("error", "invalid month", int(codes.InvalidArgument))
+	ErrGrpcInvalidYear       = response.NewGrpcError("error", "invalid year", int(codes.InvalidArgument))
+	ErrGrpcInvalidMerchantId = response.NewGrpcError("error", "invalid merchant ID", int(codes.InvalidArgument))
+
+	ErrGrpcValidateCreateTransaction = response.NewGrpcError("error", "validation failed: invalid create transaction request", int(codes.InvalidArgument))
+	ErrGrpcValidateUpdateTransaction = response.NewGrpcError("error", "validation failed: invalid update transaction request", int(codes.InvalidArgument))
+	ErrGrpcValidateRefundTransaction = response.NewGrpcError("error", "validation failed: invalid refund transaction request", int(codes.InvalidArgument))
+	ErrGrpcValidateVoidTransaction   = response.NewGrpcError("error", "validation failed: invalid void transaction request", int(codes.InvalidArgument))
+	ErrGrpcUnsupportedPaymentMethod  = response.NewGrpcError("error", "validation failed: payment method must be cash, card, e_wallet, voucher or loyalty_points", int(codes.InvalidArgument))
+)
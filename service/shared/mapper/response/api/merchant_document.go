@@ -0,0 +1,109 @@
+package response_api
+
+import (
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+)
+
+type merchantDocumentResponse struct{}
+
+func NewMerchantDocumentResponseMapper() *merchantDocumentResponse {
+	return &merchantDocumentResponse{}
+}
+
+func (m *merchantDocumentResponse) ToApiResponseMerchantDocument(doc *pb.ApiResponseMerchantDocument) *response.ApiResponseMerchantDocument {
+	return &response.ApiResponseMerchantDocument{
+		Status:  doc.Status,
+		Message: doc.Message,
+		Data:    m.mapMerchantDocument(doc.Data),
+	}
+}
+
+func (m *merchantDocumentResponse) ToApiResponsesMerchantDocument(docs *pb.ApiResponsesMerchantDocument) *response.ApiResponsesMerchantDocument {
+	return &response.ApiResponsesMerchantDocument{
+		Status:  docs.Status,
+		Message: docs.Message,
+		Data:    m.mapMerchantDocuments(docs.Data),
+	}
+}
+
+func (m *merchantDocumentResponse) ToApiResponsePaginationMerchantDocument(docs *pb.ApiResponsePaginationMerchantDocument) *response.ApiResponsePaginationMerchantDocument {
+	return &response.ApiResponsePaginationMerchantDocument{
+		Status:     docs.Status,
+		Message:    docs.Message,
+		Data:       m.mapMerchantDocuments(docs.Data),
+		Pagination: mapPaginationMeta(docs.Pagination),
+	}
+}
+
+func (m *merchantDocumentResponse) ToApiResponsePaginationMerchantDocumentDeleteAt(docs *pb.ApiResponsePaginationMerchantDocumentAt) *response.ApiResponsePaginationMerchantDocumentDeleteAt {
+	return &response.ApiResponsePaginationMerchantDocumentDeleteAt{
+		Status:     docs.Status,
+		Message:    docs.Message,
+		Data:       m.mapMerchantDocumentsDeletedAt(docs.Data),
+		Pagination: mapPaginationMeta(docs.Pagination),
+	}
+}
+
+func (m *merchantDocumentResponse) ToApiResponseMerchantDocumentAll(resp *pb.ApiResponseMerchantDocumentAll) *response.ApiResponseMerchantDocumentAll {
+	return &response.ApiResponseMerchantDocumentAll{
+		Status:  resp.Status,
+		Message: resp.Message,
+	}
+}
+
+func (m *merchantDocumentResponse) ToApiResponseMerchantDocumentDeleteAt(resp *pb.ApiResponseMerchantDocumentDelete) *response.ApiResponseMerchantDocumentDelete {
+	return &response.ApiResponseMerchantDocumentDelete{
+		Status:  resp.Status,
+		Message: resp.Message,
+	}
+}
+
+func (m *merchantDocumentResponse) mapMerchantDocument(doc *pb.MerchantDocument) *response.MerchantDocumentResponse {
+	return &response.MerchantDocumentResponse{
+		ID:           int(doc.DocumentId),
+		MerchantID:   int(doc.MerchantId),
+		DocumentType: doc.DocumentType,
+		DocumentURL:  doc.DocumentUrl,
+		Status:       doc.Status,
+		Note:         doc.Note,
+		CreatedAt:    doc.UploadedAt,
+		UpdatedAt:    doc.UpdatedAt,
+	}
+}
+
+func (m *merchantDocumentResponse) mapMerchantDocuments(docs []*pb.MerchantDocument) []*response.MerchantDocumentResponse {
+	var responses []*response.MerchantDocumentResponse
+	for _, doc := range docs {
+		responses = append(responses, m.mapMerchantDocument(doc))
+	}
+	return responses
+}
+
+func (m *merchantDocumentResponse) mapMerchantDocumentDeletedAt(doc *pb.MerchantDocumentDeleteAt) *response.MerchantDocumentResponseDeleteAt {
+	var deletedAt *string
+
+	if doc.DeletedAt != nil {
+		deletedAt = &doc.DeletedAt.Value
+	}
+
+	return &response.MerchantDocumentResponseDeleteAt{
+		ID:           int(doc.DocumentId),
+		MerchantID:   int(doc.MerchantId),
+		DocumentType: doc.DocumentType,
+		DocumentURL:  doc.DocumentUrl,
+		Status:       doc.Status,
+		Note:         doc.Note,
+		CreatedAt:    doc.UploadedAt,
+		UpdatedAt:    doc.UpdatedAt,
+		DeletedAt:    deletedAt,
+	}
+}
+
+func (m *merchantDocumentResponse) mapMerchantDocumentsDeletedAt(docs []*pb.MerchantDocumentDeleteAt) []*response.MerchantDocumentResponseDeleteAt {
+	var responses []*response.MerchantDocumentResponseDeleteAt
+	for _, doc := range docs {
+		responses = append(responses, m.mapMerchantDocumentDeletedAt(doc))
+	}
+	return responses
+}
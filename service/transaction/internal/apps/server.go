@@ -9,6 +9,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
@@ -18,6 +22,7 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
 	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/errorhandler"
 	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/handler"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
 	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/middleware"
 	mencache "github.com/MamangRust/monolith-point-of-sale-transacton/internal/redis"
 	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/repository"
@@ -29,6 +34,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -45,11 +51,15 @@ func init() {
 }
 
 type Server struct {
-	Logger   logger.LoggerInterface
-	DB       *db.Queries
-	Services *service.Service
-	Handlers *handler.Handler
-	Ctx      context.Context
+	Logger       logger.LoggerInterface
+	DB           *db.Queries
+	Repositories *repository.Repositories
+	Services     *service.Service
+	Handlers     *handler.Handler
+	Relay        *outbox.Relay
+	Expirer      *loyalty.Expirer
+	Conn         *audit.DB
+	Ctx          context.Context
 }
 
 func NewServer(ctx context.Context) (*Server, func(context.Context) error, error) {
@@ -63,13 +73,14 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 	}
 	flag.Parse()
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
 
 	shutdownTracerProvider, err := otel_pkg.InitTracerProvider("Transaction-service", ctx)
@@ -109,19 +120,26 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		ErrorHandler: errorhandler,
 		Repositories: repositories,
 		Logger:       logger,
-		Kafka:        myKafka,
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, myKafka, logger)
+
+	expirer := loyalty.NewExpirer(repositories.Loyalty, logger)
+
 	handlers := handler.NewHandler(&handler.Deps{
 		Service: services,
 	})
 
 	return &Server{
-		Logger:   logger,
-		DB:       DB,
-		Services: services,
-		Handlers: handlers,
-		Ctx:      ctx,
+		Logger:       logger,
+		DB:           DB,
+		Repositories: repositories,
+		Services:     services,
+		Handlers:     handlers,
+		Relay:        relay,
+		Expirer:      expirer,
+		Conn:         conn,
+		Ctx:          ctx,
 	}, shutdownTracerProvider, nil
 }
 
@@ -150,16 +168,48 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			tenant.Middleware(s.Repositories.Tenant, s.Logger),
+			tenant.Guard(
+				pb.TransactionService_FindMonthStatusSuccess_FullMethodName,
+				pb.TransactionService_FindYearStatusSuccess_FullMethodName,
+				pb.TransactionService_FindMonthStatusFailed_FullMethodName,
+				pb.TransactionService_FindYearStatusFailed_FullMethodName,
+				pb.TransactionService_FindMonthMethodSuccess_FullMethodName,
+				pb.TransactionService_FindYearMethodSuccess_FullMethodName,
+				pb.TransactionService_FindMonthMethodFailed_FullMethodName,
+				pb.TransactionService_FindYearMethodFailed_FullMethodName,
+				pb.TransactionService_RestoreAllTransaction_FullMethodName,
+				pb.TransactionService_DeleteAllTransactionPermanent_FullMethodName,
+			),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "transaction",
+				RequestID: middleware.RequestID,
+				Actions: map[string]string{
+					pb.TransactionService_RefundTransaction_FullMethodName: "refund",
+					pb.TransactionService_VoidTransaction_FullMethodName:   "void",
+					pb.LoyaltyService_SaveProgram_FullMethodName:           "save_program",
+				},
+				Snapshots: map[string]audit.Snapshot{
+					"pb.TransactionService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.Transaction.FindById(ctx, &pb.FindByIdTransactionRequest{Id: int32(id)})
+					},
+				},
+			}, s.Logger),
+			bulk.Middleware(s.Logger),
 		),
 	)
 
 	pb.RegisterTransactionServiceServer(grpcServer, s.Handlers.Transaction)
+	pb.RegisterLoyaltyServiceServer(grpcServer, s.Handlers.Loyalty)
 
 	metricsServer := http.NewServeMux()
 	metricsServer.Handle("/metrics", promhttp.Handler())
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+	go s.Expirer.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
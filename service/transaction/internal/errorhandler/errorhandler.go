@@ -7,6 +7,7 @@ type ErrorHandler struct {
 	TransactionCommandError        TransactionCommandError
 	TransactionStatsError          TransactionStatsError
 	TransactonStatsByMerchantError TransactionStatsByMerchantError
+	LoyaltyError                   LoyaltyError
 }
 
 func NewErrorHandler(logger logger.LoggerInterface) *ErrorHandler {
@@ -15,5 +16,6 @@ func NewErrorHandler(logger logger.LoggerInterface) *ErrorHandler {
 		TransactionCommandError:        NewTransactionCommandError(logger),
 		TransactionStatsError:          NewTransactionStatsError(logger),
 		TransactonStatsByMerchantError: NewTransactionStatsByMerchantError(logger),
+		LoyaltyError:                   NewLoyaltyError(logger),
 	}
 }
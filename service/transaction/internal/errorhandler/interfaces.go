@@ -2,6 +2,7 @@ package errorhandler
 
 import (
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
@@ -127,6 +128,12 @@ type TransactionCommandError interface {
 	) (*response.TransactionResponse, *response.ErrorResponse)
 	HandleInsufficientBalance(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
 	HandleInvalidOrderItem(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
+	HandleInvalidTender(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
+	HandleInvalidRefund(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
+	HandleRefundConflict(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
+	HandleRefundTransactionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
+	HandleCalculateTaxError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
+	HandleLoyaltyError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
 	HandleCreateTransactionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
 	HandleUpdateTransactionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse)
 	HandleTrashedTransactionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponseDeleteAt, *response.ErrorResponse)
@@ -135,3 +142,10 @@ type TransactionCommandError interface {
 	HandleRestoreAllTransactionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse)
 	HandleDeleteAllTransactionPermanentError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (bool, *response.ErrorResponse)
 }
+
+type LoyaltyError interface {
+	HandleFindProgramError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*loyalty.Program, *response.ErrorResponse)
+	HandleSaveProgramError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*loyalty.Program, *response.ErrorResponse)
+	HandleFindBalanceError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*loyalty.Balance, *response.ErrorResponse)
+	HandleFindHistoryError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*loyalty.Entry, *int, *response.ErrorResponse)
+}
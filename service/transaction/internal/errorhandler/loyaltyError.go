@@ -0,0 +1,73 @@
+package errorhandler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrLoyaltyProgramNotFound    = response.NewErrorResponse("Merchant has no active loyalty program", http.StatusNotFound)
+	ErrInvalidLoyaltyProgram     = response.NewErrorResponse("Invalid loyalty program", http.StatusBadRequest)
+	ErrLoyaltyCustomerNotFound   = response.NewErrorResponse("Customer not found", http.StatusNotFound)
+	ErrLoyaltyNoCustomer         = response.NewErrorResponse("Points can only be redeemed for an order with a customer", http.StatusBadRequest)
+	ErrInvalidLoyaltyRedemption  = response.NewErrorResponse("Redeemed amount must be a whole number of points", http.StatusBadRequest)
+	ErrInsufficientLoyaltyPoints = response.NewErrorResponse("Customer does not have enough points", http.StatusBadRequest)
+	ErrFailedFindLoyaltyProgram  = response.NewErrorResponse("Failed to fetch loyalty program", http.StatusInternalServerError)
+	ErrFailedSaveLoyaltyProgram  = response.NewErrorResponse("Failed to save loyalty program", http.StatusInternalServerError)
+	ErrFailedFindLoyaltyBalance  = response.NewErrorResponse("Failed to fetch loyalty balance", http.StatusInternalServerError)
+	ErrFailedFindLoyaltyHistory  = response.NewErrorResponse("Failed to fetch loyalty history", http.StatusInternalServerError)
+	ErrFailedLoyaltyPoints       = response.NewErrorResponse("Failed to work out loyalty points", http.StatusInternalServerError)
+)
+
+type loyaltyError struct {
+	logger logger.LoggerInterface
+}
+
+func NewLoyaltyError(logger logger.LoggerInterface) *loyaltyError {
+	return &loyaltyError{
+		logger: logger,
+	}
+}
+
+func (e *loyaltyError) HandleFindProgramError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*loyalty.Program, *response.ErrorResponse) {
+	return handleErrorRepository[*loyalty.Program](e.logger, err, method, tracePrefix, span, status, loyaltyErrorResponse(err, ErrFailedFindLoyaltyProgram), fields...)
+}
+
+func (e *loyaltyError) HandleSaveProgramError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*loyalty.Program, *response.ErrorResponse) {
+	return handleErrorRepository[*loyalty.Program](e.logger, err, method, tracePrefix, span, status, loyaltyErrorResponse(err, ErrFailedSaveLoyaltyProgram), fields...)
+}
+
+func (e *loyaltyError) HandleFindBalanceError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*loyalty.Balance, *response.ErrorResponse) {
+	return handleErrorRepository[*loyalty.Balance](e.logger, err, method, tracePrefix, span, status, loyaltyErrorResponse(err, ErrFailedFindLoyaltyBalance), fields...)
+}
+
+func (e *loyaltyError) HandleFindHistoryError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) ([]*loyalty.Entry, *int, *response.ErrorResponse) {
+	return handleErrorPagination[[]*loyalty.Entry](e.logger, err, method, tracePrefix, span, status, loyaltyErrorResponse(err, ErrFailedFindLoyaltyHistory), fields...)
+}
+
+// loyaltyErrorResponse maps a loyalty error to its response, or to fallback
+// when err is not one.
+func loyaltyErrorResponse(err error, fallback *response.ErrorResponse) *response.ErrorResponse {
+	switch {
+	case errors.Is(err, loyalty.ErrProgramNotFound):
+		return ErrLoyaltyProgramNotFound
+	case errors.Is(err, loyalty.ErrInvalidProgram):
+		return ErrInvalidLoyaltyProgram
+	case errors.Is(err, loyalty.ErrCustomerNotFound):
+		return ErrLoyaltyCustomerNotFound
+	case errors.Is(err, loyalty.ErrNoCustomer):
+		return ErrLoyaltyNoCustomer
+	case errors.Is(err, loyalty.ErrInvalidRedemption):
+		return ErrInvalidLoyaltyRedemption
+	case errors.Is(err, loyalty.ErrInsufficientPoints):
+		return ErrInsufficientLoyaltyPoints
+	default:
+		return fallback
+	}
+}
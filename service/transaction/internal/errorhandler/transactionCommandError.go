@@ -1,6 +1,8 @@
 package errorhandler
 
 import (
+	"net/http"
+
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	orderitem_errors "github.com/MamangRust/monolith-point-of-sale-shared/errors/order_item_errors"
@@ -9,6 +11,15 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	ErrFailedCalculateTax  = response.NewErrorResponse("Failed to calculate transaction tax", http.StatusInternalServerError)
+	ErrFailedInvalidTender = response.NewErrorResponse("Invalid payment tender", http.StatusBadRequest)
+
+	ErrFailedInvalidRefund     = response.NewErrorResponse("Invalid refund request", http.StatusBadRequest)
+	ErrFailedRefundConflict    = response.NewErrorResponse("Transaction changed while the refund was prepared", http.StatusConflict)
+	ErrFailedRefundTransaction = response.NewErrorResponse("Failed to refund transaction", http.StatusInternalServerError)
+)
+
 type transactonCommandError struct {
 	logger logger.LoggerInterface
 }
@@ -29,6 +40,32 @@ func (t *transactonCommandError) HandleInvalidOrderItem(err error, method, trace
 	return handleErrorInvalidQuantityOrderItem[*response.TransactionResponse](t.logger, err, method, tracePrefix, span, status, orderitem_errors.ErrFailedFindOrderItemByOrder, fields...)
 }
 
+func (t *transactonCommandError) HandleCalculateTaxError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse) {
+	return handleErrorRepository[*response.TransactionResponse](t.logger, err, method, tracePrefix, span, status, ErrFailedCalculateTax, fields...)
+}
+
+func (t *transactonCommandError) HandleInvalidTender(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse) {
+	return handleErrorTemplate[*response.TransactionResponse](t.logger, err, method, tracePrefix, "Invalid payment tender", span, status, ErrFailedInvalidTender, fields...)
+}
+
+func (t *transactonCommandError) HandleInvalidRefund(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse) {
+	return handleErrorTemplate[*response.TransactionResponse](t.logger, err, method, tracePrefix, "Invalid refund request", span, status, ErrFailedInvalidRefund, fields...)
+}
+
+func (t *transactonCommandError) HandleRefundConflict(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse) {
+	return handleErrorTemplate[*response.TransactionResponse](t.logger, err, method, tracePrefix, "Refund conflict", span, status, ErrFailedRefundConflict, fields...)
+}
+
+func (t *transactonCommandError) HandleRefundTransactionError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse) {
+	return handleErrorRepository[*response.TransactionResponse](t.logger, err, method, tracePrefix, span, status, ErrFailedRefundTransaction, fields...)
+}
+
+// HandleLoyaltyError reports a payment whose loyalty points could not be
+// worked out or posted.
+func (t *transactonCommandError) HandleLoyaltyError(err error, method, tracePrefix string, span trace.Span, status *string, fields ...zap.Field) (*response.TransactionResponse, *response.ErrorResponse) {
+	return handleErrorRepository[*response.TransactionResponse](t.logger, err, method, tracePrefix, span, status, loyaltyErrorResponse(err, ErrFailedLoyaltyPoints), fields...)
+}
+
 func (t *transactonCommandError) HandleRepositorySingleError(
 	err error,
 	method, tracePrefix string,
@@ -8,10 +8,12 @@ type Deps struct {
 
 type Handler struct {
 	Transaction TransactionHandleGrpc
+	Loyalty     LoyaltyHandleGrpc
 }
 
 func NewHandler(deps *Deps) *Handler {
 	return &Handler{
 		Transaction: NewTransactionHandleGrpc(deps.Service),
+		Loyalty:     NewLoyaltyHandleGrpc(deps.Service),
 	}
 }
@@ -5,3 +5,7 @@ import "github.com/MamangRust/monolith-point-of-sale-shared/pb"
 type TransactionHandleGrpc interface {
 	pb.TransactionServiceServer
 }
+
+type LoyaltyHandleGrpc interface {
+	pb.LoyaltyServiceServer
+}
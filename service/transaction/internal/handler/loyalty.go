@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-shared/errors/loyalty_errors"
+	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type loyaltyHandleGrpc struct {
+	pb.UnimplementedLoyaltyServiceServer
+	loyalty service.LoyaltyService
+}
+
+func NewLoyaltyHandleGrpc(service *service.Service) *loyaltyHandleGrpc {
+	return &loyaltyHandleGrpc{
+		loyalty: service.Loyalty,
+	}
+}
+
+func (s *loyaltyHandleGrpc) FindProgram(ctx context.Context, req *pb.FindLoyaltyProgramRequest) (*pb.ApiResponseLoyaltyProgram, error) {
+	merchantID := int(req.GetMerchantId())
+
+	if merchantID <= 0 {
+		return nil, loyalty_errors.ErrGrpcFailedInvalidMerchantId
+	}
+
+	res, err := s.loyalty.FindProgram(ctx, merchantID)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseLoyaltyProgram{
+		Status:  "success",
+		Message: "Successfully fetched loyalty program",
+		Data:    toProtoLoyaltyProgram(res),
+	}, nil
+}
+
+func (s *loyaltyHandleGrpc) SaveProgram(ctx context.Context, req *pb.SaveLoyaltyProgramRequest) (*pb.ApiResponseLoyaltyProgram, error) {
+	merchantID := int(req.GetMerchantId())
+
+	if merchantID <= 0 {
+		return nil, loyalty_errors.ErrGrpcFailedInvalidMerchantId
+	}
+
+	res, err := s.loyalty.SaveProgram(ctx, &loyalty.Program{
+		MerchantID:    merchantID,
+		SpendPerPoint: int(req.GetSpendPerPoint()),
+		PointValue:    int(req.GetPointValue()),
+		ExpiryDays:    int(req.GetExpiryDays()),
+		Active:        req.GetActive(),
+	})
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseLoyaltyProgram{
+		Status:  "success",
+		Message: "Successfully saved loyalty program",
+		Data:    toProtoLoyaltyProgram(res),
+	}, nil
+}
+
+func (s *loyaltyHandleGrpc) GetBalance(ctx context.Context, req *pb.FindLoyaltyBalanceRequest) (*pb.ApiResponseLoyaltyBalance, error) {
+	customerID := int(req.GetCustomerId())
+
+	if customerID <= 0 {
+		return nil, loyalty_errors.ErrGrpcFailedInvalidCustomerId
+	}
+
+	res, err := s.loyalty.FindBalance(ctx, customerID)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	return &pb.ApiResponseLoyaltyBalance{
+		Status:  "success",
+		Message: "Successfully fetched loyalty balance",
+		Data: &pb.LoyaltyBalanceResponse{
+			CustomerId: int32(res.CustomerID),
+			MerchantId: int32(res.MerchantID),
+			Points:     int32(res.Points),
+			Value:      int32(res.Value),
+		},
+	}, nil
+}
+
+func (s *loyaltyHandleGrpc) FindHistory(ctx context.Context, req *pb.FindLoyaltyHistoryRequest) (*pb.ApiResponsePaginationLoyaltyEntry, error) {
+	customerID := int(req.GetCustomerId())
+
+	if customerID <= 0 {
+		return nil, loyalty_errors.ErrGrpcFailedInvalidCustomerId
+	}
+
+	reqService := &loyalty.HistoryRequest{
+		CustomerID: customerID,
+		Page:       int(req.GetPage()),
+		PageSize:   int(req.GetPageSize()),
+	}
+
+	res, total, err := s.loyalty.FindHistory(ctx, reqService)
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	data := make([]*pb.LoyaltyEntryResponse, len(res))
+	for i, e := range res {
+		entry := &pb.LoyaltyEntryResponse{
+			Id:            e.ID,
+			CustomerId:    int32(e.CustomerID),
+			MerchantId:    int32(e.MerchantID),
+			TransactionId: int32(e.TransactionID),
+			Kind:          string(e.Kind),
+			Points:        int32(e.Points),
+			CreatedAt:     e.CreatedAt.Format(time.RFC3339),
+		}
+
+		if e.ExpiresAt != nil {
+			entry.ExpiresAt = wrapperspb.String(e.ExpiresAt.Format(time.RFC3339))
+		}
+
+		data[i] = entry
+	}
+
+	return &pb.ApiResponsePaginationLoyaltyEntry{
+		Status:  "success",
+		Message: "Successfully fetched loyalty history",
+		Data:    data,
+		Pagination: &pb.PaginationMeta{
+			CurrentPage:  int32(reqService.Page),
+			PageSize:     int32(reqService.PageSize),
+			TotalPages:   int32(math.Ceil(float64(*total) / float64(reqService.PageSize))),
+			TotalRecords: int32(*total),
+		},
+	}, nil
+}
+
+func toProtoLoyaltyProgram(p *loyalty.Program) *pb.LoyaltyProgramResponse {
+	return &pb.LoyaltyProgramResponse{
+		MerchantId:    int32(p.MerchantID),
+		SpendPerPoint: int32(p.SpendPerPoint),
+		PointValue:    int32(p.PointValue),
+		ExpiryDays:    int32(p.ExpiryDays),
+		Active:        p.Active,
+	}
+}
@@ -2,15 +2,19 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log"
 	"math"
+	"strings"
 
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/transaction_errors"
 	protomapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/proto"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/refund"
 	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/service"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tender"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -629,6 +633,7 @@ func (s *transactionHandleGrpc) Create(ctx context.Context, request *pb.CreateTr
 		OrderID:       int(request.GetOrderId()),
 		PaymentMethod: request.GetPaymentMethod(),
 		Amount:        int(request.GetAmount()),
+		Tenders:       tenderRequests(request.GetTenders()),
 	}
 
 	if err := req.Validate(); err != nil {
@@ -636,7 +641,15 @@ func (s *transactionHandleGrpc) Create(ctx context.Context, request *pb.CreateTr
 		return nil, transaction_errors.ErrGrpcValidateCreateTransaction
 	}
 
-	transaction, err := s.transactionCommand.CreateTransaction(ctx, req)
+	tenders, tenderErr := parseTenders(req.PaymentMethod, req.Amount, req.Tenders)
+	if errors.Is(tenderErr, tender.ErrUnsupportedMethod) {
+		return nil, transaction_errors.ErrGrpcUnsupportedPaymentMethod
+	}
+	if tenderErr != nil {
+		return nil, transaction_errors.ErrGrpcValidateCreateTransaction
+	}
+
+	transaction, err := s.transactionCommand.CreateTransaction(ctx, req, tenders)
 
 	if err != nil {
 		return nil, response.ToGrpcErrorFromErrorResponse(err)
@@ -658,13 +671,22 @@ func (s *transactionHandleGrpc) Update(ctx context.Context, request *pb.UpdateTr
 		OrderID:       int(request.GetOrderId()),
 		PaymentMethod: request.GetPaymentMethod(),
 		Amount:        int(request.GetAmount()),
+		Tenders:       tenderRequests(request.GetTenders()),
 	}
 
 	if err := req.Validate(); err != nil {
 		return nil, transaction_errors.ErrGrpcValidateUpdateTransaction
 	}
 
-	transaction, err := s.transactionCommand.UpdateTransaction(ctx, req)
+	tenders, tenderErr := parseTenders(req.PaymentMethod, req.Amount, req.Tenders)
+	if errors.Is(tenderErr, tender.ErrUnsupportedMethod) {
+		return nil, transaction_errors.ErrGrpcUnsupportedPaymentMethod
+	}
+	if tenderErr != nil {
+		return nil, transaction_errors.ErrGrpcValidateUpdateTransaction
+	}
+
+	transaction, err := s.transactionCommand.UpdateTransaction(ctx, req, tenders)
 
 	if err != nil {
 		return nil, response.ToGrpcErrorFromErrorResponse(err)
@@ -674,6 +696,62 @@ func (s *transactionHandleGrpc) Update(ctx context.Context, request *pb.UpdateTr
 	return so, nil
 }
 
+func (s *transactionHandleGrpc) RefundTransaction(ctx context.Context, request *pb.RefundTransactionRequest) (*pb.ApiResponseTransaction, error) {
+	id := int(request.GetTransactionId())
+
+	if id == 0 {
+		return nil, transaction_errors.ErrGrpcInvalidID
+	}
+
+	if request.GetOperatorId() <= 0 || strings.TrimSpace(request.GetReason()) == "" {
+		return nil, transaction_errors.ErrGrpcValidateRefundTransaction
+	}
+
+	items := make([]refund.Item, len(request.GetItems()))
+	for i, item := range request.GetItems() {
+		items[i] = refund.Item{OrderItemID: int(item.GetOrderItemId()), Quantity: int(item.GetQuantity())}
+	}
+
+	transaction, err := s.transactionCommand.RefundTransaction(ctx, &refund.Request{
+		TransactionID: id,
+		OperatorID:    int(request.GetOperatorId()),
+		Reason:        request.GetReason(),
+		Items:         items,
+	})
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	so := s.mapping.ToProtoResponseTransaction("success", "Successfully refunded transaction", transaction)
+	return so, nil
+}
+
+func (s *transactionHandleGrpc) VoidTransaction(ctx context.Context, request *pb.VoidTransactionRequest) (*pb.ApiResponseTransaction, error) {
+	id := int(request.GetTransactionId())
+
+	if id == 0 {
+		return nil, transaction_errors.ErrGrpcInvalidID
+	}
+
+	if request.GetOperatorId() <= 0 || strings.TrimSpace(request.GetReason()) == "" {
+		return nil, transaction_errors.ErrGrpcValidateVoidTransaction
+	}
+
+	transaction, err := s.transactionCommand.VoidTransaction(ctx, &refund.VoidRequest{
+		TransactionID: id,
+		OperatorID:    int(request.GetOperatorId()),
+		Reason:        request.GetReason(),
+	})
+
+	if err != nil {
+		return nil, response.ToGrpcErrorFromErrorResponse(err)
+	}
+
+	so := s.mapping.ToProtoResponseTransaction("success", "Successfully voided transaction", transaction)
+	return so, nil
+}
+
 func (s *transactionHandleGrpc) TrashedTransaction(ctx context.Context, request *pb.FindByIdTransactionRequest) (*pb.ApiResponseTransactionDeleteAt, error) {
 	id := int(request.GetId())
 
@@ -751,3 +829,36 @@ func (s *transactionHandleGrpc) DeleteAllTransactionPermanent(ctx context.Contex
 
 	return so, nil
 }
+
+func tenderRequests(tenders []*pb.TransactionTender) []requests.TenderRequest {
+	if len(tenders) == 0 {
+		return nil
+	}
+
+	reqs := make([]requests.TenderRequest, len(tenders))
+	for i, t := range tenders {
+		reqs[i] = requests.TenderRequest{Method: t.GetMethod(), Amount: int(t.GetAmount())}
+	}
+
+	return reqs
+}
+
+// parseTenders turns the request's tenders into the ones to settle. Callers
+// that only send payment_method and amount pay with a single tender.
+func parseTenders(method string, amount int, reqs []requests.TenderRequest) ([]tender.Tender, error) {
+	if len(reqs) == 0 {
+		return tender.Single(method, amount)
+	}
+
+	tenders := make([]tender.Tender, len(reqs))
+	for i, r := range reqs {
+		m, err := tender.ParseMethod(r.Method)
+		if err != nil {
+			return nil, err
+		}
+
+		tenders[i] = tender.Tender{Method: m, Amount: r.Amount}
+	}
+
+	return tenders, nil
+}
@@ -0,0 +1,61 @@
+package loyalty
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const expiryInterval = time.Hour
+
+type Ledger interface {
+	ExpirePoints(ctx context.Context, now time.Time) (int, error)
+}
+
+// Expirer takes the points that have run out off the customers' balances.
+type Expirer struct {
+	ledger Ledger
+	logger logger.LoggerInterface
+
+	expired prometheus.Counter
+}
+
+func NewExpirer(ledger Ledger, logger logger.LoggerInterface) *Expirer {
+	expired := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "loyalty_points_expired_total",
+		Help: "Total number of loyalty points expired",
+	})
+
+	prometheus.MustRegister(expired)
+
+	return &Expirer{
+		ledger:  ledger,
+		logger:  logger,
+		expired: expired,
+	}
+}
+
+// Run expires points every hour until ctx is cancelled.
+func (e *Expirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(expiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := e.ledger.ExpirePoints(ctx, time.Now())
+			if n > 0 {
+				e.expired.Add(float64(n))
+				e.logger.Debug("expired loyalty points", zap.Int("count", n))
+			}
+			if err != nil {
+				e.logger.Error("failed to expire loyalty points", zap.Error(err))
+			}
+		}
+	}
+}
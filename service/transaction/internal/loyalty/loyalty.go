@@ -0,0 +1,165 @@
+package loyalty
+
+import (
+	"errors"
+	"time"
+)
+
+type Kind string
+
+const (
+	KindEarn   Kind = "earn"
+	KindRedeem Kind = "redeem"
+	// KindAdjust takes back what a transaction posted before it was paid
+	// again with different figures.
+	KindAdjust  Kind = "adjust"
+	KindRefund  Kind = "refund"
+	KindTrash   Kind = "trash"
+	KindRestore Kind = "restore"
+	KindExpire  Kind = "expire"
+)
+
+var (
+	ErrProgramNotFound    = errors.New("merchant has no active loyalty program")
+	ErrInvalidProgram     = errors.New("invalid loyalty program")
+	ErrCustomerNotFound   = errors.New("customer not found")
+	ErrNoCustomer         = errors.New("points can only be redeemed for an order with a customer")
+	ErrInvalidRedemption  = errors.New("redeemed amount must be a positive whole number of points")
+	ErrInsufficientPoints = errors.New("customer does not have enough points")
+)
+
+// Program is a merchant's loyalty rule: a point for every SpendPerPoint
+// paid, worth PointValue when redeemed and kept for ExpiryDays, or forever
+// when ExpiryDays is zero.
+type Program struct {
+	MerchantID    int  `json:"merchant_id"`
+	SpendPerPoint int  `json:"spend_per_point"`
+	PointValue    int  `json:"point_value"`
+	ExpiryDays    int  `json:"expiry_days"`
+	Active        bool `json:"active"`
+}
+
+func (p *Program) Validate() error {
+	if p.SpendPerPoint <= 0 || p.PointValue <= 0 || p.ExpiryDays < 0 {
+		return ErrInvalidProgram
+	}
+
+	return nil
+}
+
+// Earned is the points paying amount earns.
+func (p *Program) Earned(amount int) int {
+	if amount <= 0 {
+		return 0
+	}
+
+	return amount / p.SpendPerPoint
+}
+
+// PointsFor is the points needed to settle value.
+func (p *Program) PointsFor(value int) (int, error) {
+	if value <= 0 || value%p.PointValue != 0 {
+		return 0, ErrInvalidRedemption
+	}
+
+	return value / p.PointValue, nil
+}
+
+func (p *Program) ExpiresAt(now time.Time) *time.Time {
+	if p.ExpiryDays == 0 {
+		return nil
+	}
+
+	at := now.AddDate(0, 0, p.ExpiryDays)
+	return &at
+}
+
+// Posting is what paying a transaction does to a customer's points.
+type Posting struct {
+	CustomerID int
+	MerchantID int
+	Redeem     int
+	Earn       int
+	ExpiresAt  *time.Time
+}
+
+// NewPosting works out the points a customer redeems and earns on a
+// payment of total, of which redeemed was settled with points. Points are
+// only earned on the rest. It returns nil when the payment touches no
+// points.
+func NewPosting(program *Program, customerID int, total int, redeemed int, now time.Time) (*Posting, error) {
+	if program == nil || !program.Active {
+		if redeemed > 0 {
+			return nil, ErrProgramNotFound
+		}
+		return nil, nil
+	}
+
+	if customerID == 0 {
+		if redeemed > 0 {
+			return nil, ErrNoCustomer
+		}
+		return nil, nil
+	}
+
+	posting := &Posting{
+		CustomerID: customerID,
+		MerchantID: program.MerchantID,
+		Earn:       program.Earned(total - redeemed),
+		ExpiresAt:  program.ExpiresAt(now),
+	}
+
+	if redeemed > 0 {
+		points, err := program.PointsFor(redeemed)
+		if err != nil {
+			return nil, err
+		}
+		posting.Redeem = points
+	}
+
+	return posting, nil
+}
+
+// Reversal is the points to post when amount of the remaining paid total
+// of a transaction is given back, net being the points the transaction
+// still holds. Refunding all that remains reverses everything.
+func Reversal(net int, amount int, remaining int) int {
+	if remaining <= 0 || amount >= remaining {
+		return -net
+	}
+
+	return -net * amount / remaining
+}
+
+// Expiring is how many points of a balance expire. Points are spent oldest
+// first, so of the earned points past their expiry only those not covered
+// by what has been taken from the balance since (debited) are still there.
+func Expiring(expired int, debited int, balance int) int {
+	return max(0, min(expired-max(0, debited), balance))
+}
+
+type Entry struct {
+	ID            int64      `json:"id"`
+	CustomerID    int        `json:"customer_id"`
+	MerchantID    int        `json:"merchant_id"`
+	TransactionID int        `json:"transaction_id,omitempty"`
+	Kind          Kind       `json:"kind"`
+	Points        int        `json:"points"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// Balance is what a customer holds with a merchant; Value is what the
+// points are worth under the merchant's program.
+type Balance struct {
+	CustomerID int `json:"customer_id"`
+	MerchantID int `json:"merchant_id"`
+	Points     int `json:"points"`
+	Value      int `json:"value"`
+}
+
+type HistoryRequest struct {
+	CustomerID int
+	Page       int
+	PageSize   int
+}
@@ -0,0 +1,96 @@
+package receipt
+
+import (
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tax"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tender"
+)
+
+// Topic is consumed by the email service, which renders Event as an HTML
+// receipt.
+const Topic = "email-service-topic-transaction-create"
+
+type Merchant struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Phone   string `json:"phone"`
+}
+
+type Cashier struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Item struct {
+	ProductID int    `json:"product_id"`
+	Name      string `json:"name"`
+	Quantity  int    `json:"quantity"`
+	Price     int    `json:"price"`
+	Discount  int    `json:"discount,omitempty"`
+	Total     int    `json:"total"`
+}
+
+type Tender struct {
+	Method   string `json:"method"`
+	Tendered int    `json:"tendered"`
+	Amount   int    `json:"amount"`
+}
+
+type Event struct {
+	Email         string   `json:"email"`
+	TransactionID int      `json:"transaction_id"`
+	OrderID       int      `json:"order_id"`
+	Merchant      Merchant `json:"merchant"`
+	Cashier       Cashier  `json:"cashier"`
+	Items         []Item   `json:"items"`
+	Subtotal      int      `json:"subtotal"`
+	Tax           int      `json:"tax"`
+	Total         int      `json:"total"`
+	Tenders       []Tender `json:"tenders"`
+	Change        int      `json:"change"`
+	PaidAt        string   `json:"paid_at"`
+}
+
+// NewEvent builds the receipt of a settled transaction, to be sent to email.
+func NewEvent(
+	email string,
+	transaction *record.TransactionRecord,
+	merchant *record.MerchantRecord,
+	cashier *record.CashierRecord,
+	items []Item,
+	breakdown *tax.Breakdown,
+	settlement *tender.Settlement,
+) *Event {
+	tenders := make([]Tender, len(settlement.Tenders))
+	for i, t := range settlement.Tenders {
+		tenders[i] = Tender{
+			Method:   string(t.Method),
+			Tendered: t.Tendered,
+			Amount:   t.Amount,
+		}
+	}
+
+	return &Event{
+		Email:         email,
+		TransactionID: transaction.ID,
+		OrderID:       transaction.OrderID,
+		Merchant: Merchant{
+			ID:      merchant.ID,
+			Name:    merchant.Name,
+			Address: merchant.Address,
+			Phone:   merchant.ContactPhone,
+		},
+		Cashier: Cashier{
+			ID:   cashier.ID,
+			Name: cashier.Name,
+		},
+		Items:    items,
+		Subtotal: breakdown.Subtotal,
+		Tax:      breakdown.Tax,
+		Total:    breakdown.Total,
+		Tenders:  tenders,
+		Change:   settlement.Change,
+		PaidAt:   transaction.CreatedAt,
+	}
+}
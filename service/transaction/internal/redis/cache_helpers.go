@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
@@ -19,7 +20,13 @@ func NewCacheStore(redis *redis.Client, logger logger.LoggerInterface) *CacheSto
 	return &CacheStore{redis: redis, logger: logger}
 }
 
+// Cached entries are shared by every caller, so merchant-scoped callers
+// always read through to the repository and never populate the cache.
 func GetFromCache[T any](ctx context.Context, store *CacheStore, key string) (*T, bool) {
+	if _, restricted := tenant.Restricted(ctx); restricted {
+		return nil, false
+	}
+
 	cached, err := store.redis.Get(ctx, key).Result()
 	if err == redis.Nil {
 		return nil, false
@@ -39,6 +46,10 @@ func GetFromCache[T any](ctx context.Context, store *CacheStore, key string) (*T
 }
 
 func SetToCache[T any](ctx context.Context, store *CacheStore, key string, data *T, expiration time.Duration) {
+	if _, restricted := tenant.Restricted(ctx); restricted {
+		return
+	}
+
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		store.logger.Error("Failed to marshal cache", zap.Error(err), zap.String("cacheKey", key))
@@ -0,0 +1,171 @@
+package refund
+
+import (
+	"errors"
+	"strings"
+)
+
+type Kind string
+
+const (
+	KindRefund Kind = "refund"
+	KindVoid   Kind = "void"
+)
+
+const (
+	StatusPartiallyRefunded = "partially_refunded"
+	StatusRefunded          = "refunded"
+	StatusVoided            = "voided"
+)
+
+var (
+	ErrReasonRequired   = errors.New("refund reason is required")
+	ErrOperatorRequired = errors.New("refund operator is required")
+	ErrUnknownItem      = errors.New("order item does not belong to the transaction")
+	ErrInvalidQuantity  = errors.New("refund quantity must be positive")
+	ErrExceedsQuantity  = errors.New("refund quantity exceeds what is left to refund")
+	ErrNothingToRefund  = errors.New("nothing left to refund")
+)
+
+type Item struct {
+	OrderItemID int
+	Quantity    int
+}
+
+// Request refunds the listed items, or everything still refundable when
+// Items is empty.
+type Request struct {
+	TransactionID int
+	OperatorID    int
+	Reason        string
+	Items         []Item
+}
+
+type VoidRequest struct {
+	TransactionID int
+	OperatorID    int
+	Reason        string
+}
+
+// Discount is the promotion discount of the whole item, not of one unit.
+type OrderItem struct {
+	ID        int
+	ProductID int
+	Price     int
+	Quantity  int
+	Discount  int
+}
+
+// net is what quantity units of the item were sold for after discounts.
+func (i OrderItem) net(quantity int) int {
+	if i.Quantity == 0 {
+		return 0
+	}
+
+	return (i.Price*i.Quantity - i.Discount) * quantity / i.Quantity
+}
+
+type Line struct {
+	OrderItemID int
+	ProductID   int
+	Quantity    int
+	Amount      int
+}
+
+type Refund struct {
+	TransactionID int
+	Kind          Kind
+	OperatorID    int
+	Reason        string
+	Amount        int
+	Lines         []Line
+	Status        string
+}
+
+// Ledger is what has already been given back against a transaction.
+type Ledger struct {
+	Quantities map[int]int
+	Amount     int
+}
+
+// Plan works out the lines and amount for a refund. Line amounts are the
+// items' share of the amount charged, so tax is returned pro rata; the
+// refund that empties the transaction takes whatever remains to absorb
+// rounding.
+func Plan(kind Kind, transactionID int, operatorID int, reason string, charged int, items []OrderItem, ledger Ledger, requested []Item) (*Refund, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, ErrReasonRequired
+	}
+	if operatorID <= 0 {
+		return nil, ErrOperatorRequired
+	}
+
+	byID := make(map[int]OrderItem, len(items))
+	gross, remaining := 0, 0
+	for _, item := range items {
+		byID[item.ID] = item
+		gross += item.net(item.Quantity)
+		remaining += item.Quantity - ledger.Quantities[item.ID]
+	}
+
+	if len(requested) == 0 {
+		for _, item := range items {
+			if left := item.Quantity - ledger.Quantities[item.ID]; left > 0 {
+				requested = append(requested, Item{OrderItemID: item.ID, Quantity: left})
+			}
+		}
+	}
+
+	if len(requested) == 0 || remaining <= 0 || gross == 0 {
+		return nil, ErrNothingToRefund
+	}
+
+	taken := make(map[int]int, len(requested))
+	lines := make([]Line, 0, len(requested))
+	amount, count := 0, 0
+
+	for _, req := range requested {
+		item, ok := byID[req.OrderItemID]
+		if !ok {
+			return nil, ErrUnknownItem
+		}
+		if req.Quantity <= 0 {
+			return nil, ErrInvalidQuantity
+		}
+
+		taken[item.ID] += req.Quantity
+		if taken[item.ID] > item.Quantity-ledger.Quantities[item.ID] {
+			return nil, ErrExceedsQuantity
+		}
+
+		lineAmount := item.net(req.Quantity) * charged / gross
+		lines = append(lines, Line{
+			OrderItemID: item.ID,
+			ProductID:   item.ProductID,
+			Quantity:    req.Quantity,
+			Amount:      lineAmount,
+		})
+		amount += lineAmount
+		count += req.Quantity
+	}
+
+	status := StatusPartiallyRefunded
+	if count == remaining {
+		amount = charged - ledger.Amount
+		status = StatusRefunded
+	}
+
+	if kind == KindVoid {
+		status = StatusVoided
+	}
+
+	return &Refund{
+		TransactionID: transactionID,
+		Kind:          kind,
+		OperatorID:    operatorID,
+		Reason:        strings.TrimSpace(reason),
+		Amount:        amount,
+		Lines:         lines,
+		Status:        status,
+	}, nil
+}
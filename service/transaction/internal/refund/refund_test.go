@@ -0,0 +1,143 @@
+package refund
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPlan(t *testing.T) {
+	// 2 x 1000 and 1 x 3000 with 500 off, charged 5000 plus 10% tax.
+	items := []OrderItem{
+		{ID: 1, ProductID: 10, Price: 1000, Quantity: 2},
+		{ID: 2, ProductID: 20, Price: 3000, Quantity: 1, Discount: 500},
+	}
+	const charged = 4950
+
+	tests := []struct {
+		name       string
+		kind       Kind
+		operatorID int
+		reason     string
+		ledger     Ledger
+		requested  []Item
+		wantAmount int
+		wantLines  []Line
+		wantStatus string
+		wantErr    error
+	}{
+		{
+			name:       "one unit is refunded pro rata",
+			kind:       KindRefund,
+			operatorID: 7,
+			reason:     "damaged",
+			requested:  []Item{{OrderItemID: 1, Quantity: 1}},
+			wantAmount: 1100,
+			wantLines:  []Line{{OrderItemID: 1, ProductID: 10, Quantity: 1, Amount: 1100}},
+			wantStatus: StatusPartiallyRefunded,
+		},
+		{
+			name:       "discounted item refunds what was paid",
+			kind:       KindRefund,
+			operatorID: 7,
+			reason:     "wrong size",
+			requested:  []Item{{OrderItemID: 2, Quantity: 1}},
+			wantAmount: 2750,
+			wantLines:  []Line{{OrderItemID: 2, ProductID: 20, Quantity: 1, Amount: 2750}},
+			wantStatus: StatusPartiallyRefunded,
+		},
+		{
+			name:       "no items refunds everything left",
+			kind:       KindRefund,
+			operatorID: 7,
+			reason:     "changed mind",
+			ledger:     Ledger{Quantities: map[int]int{1: 1}, Amount: 1100},
+			wantAmount: 3850,
+			wantLines: []Line{
+				{OrderItemID: 1, ProductID: 10, Quantity: 1, Amount: 1100},
+				{OrderItemID: 2, ProductID: 20, Quantity: 1, Amount: 2750},
+			},
+			wantStatus: StatusRefunded,
+		},
+		{
+			name:       "last refund absorbs rounding",
+			kind:       KindRefund,
+			operatorID: 7,
+			reason:     "changed mind",
+			ledger:     Ledger{Quantities: map[int]int{2: 1}, Amount: 2751},
+			requested:  []Item{{OrderItemID: 1, Quantity: 2}},
+			wantAmount: 2199,
+			wantLines:  []Line{{OrderItemID: 1, ProductID: 10, Quantity: 2, Amount: 2200}},
+			wantStatus: StatusRefunded,
+		},
+		{
+			name:       "void cancels the whole transaction",
+			kind:       KindVoid,
+			operatorID: 7,
+			reason:     "rang up twice",
+			wantAmount: charged,
+			wantLines: []Line{
+				{OrderItemID: 1, ProductID: 10, Quantity: 2, Amount: 2200},
+				{OrderItemID: 2, ProductID: 20, Quantity: 1, Amount: 2750},
+			},
+			wantStatus: StatusVoided,
+		},
+		{name: "reason is required", kind: KindRefund, operatorID: 7, reason: "  ", wantErr: ErrReasonRequired},
+		{name: "operator is required", kind: KindRefund, reason: "damaged", wantErr: ErrOperatorRequired},
+		{
+			name:       "unknown item",
+			kind:       KindRefund,
+			operatorID: 7,
+			reason:     "damaged",
+			requested:  []Item{{OrderItemID: 9, Quantity: 1}},
+			wantErr:    ErrUnknownItem,
+		},
+		{
+			name:       "zero quantity",
+			kind:       KindRefund,
+			operatorID: 7,
+			reason:     "damaged",
+			requested:  []Item{{OrderItemID: 1, Quantity: 0}},
+			wantErr:    ErrInvalidQuantity,
+		},
+		{
+			name:       "more than is left",
+			kind:       KindRefund,
+			operatorID: 7,
+			reason:     "damaged",
+			ledger:     Ledger{Quantities: map[int]int{1: 1}, Amount: 1100},
+			requested:  []Item{{OrderItemID: 1, Quantity: 1}, {OrderItemID: 1, Quantity: 1}},
+			wantErr:    ErrExceedsQuantity,
+		},
+		{
+			name:       "nothing left",
+			kind:       KindRefund,
+			operatorID: 7,
+			reason:     "damaged",
+			ledger:     Ledger{Quantities: map[int]int{1: 2, 2: 1}, Amount: charged},
+			wantErr:    ErrNothingToRefund,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Plan(tt.kind, 1, tt.operatorID, tt.reason, charged, items, tt.ledger, tt.requested)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Plan() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Amount != tt.wantAmount {
+				t.Errorf("Amount = %d, want %d", got.Amount, tt.wantAmount)
+			}
+			if !reflect.DeepEqual(got.Lines, tt.wantLines) {
+				t.Errorf("Lines = %+v, want %+v", got.Lines, tt.wantLines)
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", got.Status, tt.wantStatus)
+			}
+		})
+	}
+}
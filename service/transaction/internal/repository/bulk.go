@@ -0,0 +1,6 @@
+package repository
+
+import "github.com/MamangRust/monolith-point-of-sale-common/bulk"
+
+// Tables the DeleteAll and RestoreAll calls of this service run on.
+var bulkTransactions = bulk.Table{Name: "transactions", ID: "transaction_id", Merchant: "merchant_id = $1"}
@@ -2,9 +2,15 @@ package repository
 
 import (
 	"context"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"time"
 
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/receipt"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/refund"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tax"
 )
 
 type CashierQueryRepository interface {
@@ -17,10 +23,18 @@ type MerchantQueryRepository interface {
 
 type OrderItemQueryRepository interface {
 	FindOrderItemByOrder(ctx context.Context, order_id int) ([]*record.OrderItemRecord, error)
+	FindTaxableItemsByOrder(ctx context.Context, order_id int) ([]tax.Line, error)
+	FindReceiptItemsByOrder(ctx context.Context, order_id int) ([]receipt.Item, error)
+	FindDiscountsByOrder(ctx context.Context, order_id int) (map[int]int, error)
+}
+
+type TaxProfileRepository interface {
+	FindByMerchant(ctx context.Context, merchant_id int) (*tax.Profile, error)
 }
 
 type OrderQueryRepository interface {
 	FindById(ctx context.Context, id int) (*record.OrderRecord, error)
+	FindCustomerEmail(ctx context.Context, order_id int) (string, error)
 }
 
 type TransactionStatsRepository interface {
@@ -57,11 +71,38 @@ type TransactionQueryRepository interface {
 }
 
 type TransactionCommandRepository interface {
-	CreateTransaction(ctx context.Context, request *requests.CreateTransactionRequest) (*record.TransactionRecord, error)
-	UpdateTransaction(ctx context.Context, request *requests.UpdateTransactionRequest) (*record.TransactionRecord, error)
+	CreateTransaction(ctx context.Context, request *requests.CreateTransactionRequest, details *TransactionDetails) (*record.TransactionRecord, error)
+	UpdateTransaction(ctx context.Context, request *requests.UpdateTransactionRequest, details *TransactionDetails) (*record.TransactionRecord, error)
 	TrashTransaction(ctx context.Context, transaction_id int) (*record.TransactionRecord, error)
 	RestoreTransaction(ctx context.Context, transaction_id int) (*record.TransactionRecord, error)
 	DeleteTransactionPermanently(ctx context.Context, transaction_id int) (bool, error)
 	RestoreAllTransactions(ctx context.Context) (bool, error)
 	DeleteAllTransactionPermanent(ctx context.Context) (bool, error)
 }
+
+type TransactionRefundRepository interface {
+	FindLedger(ctx context.Context, transaction_id int) (*refund.Ledger, error)
+	CreateRefund(ctx context.Context, rf *refund.Refund, previousStatus string, previous *refund.Ledger) (*record.TransactionRecord, error)
+}
+
+type LoyaltyRepository interface {
+	FindProgram(ctx context.Context, merchant_id int) (*loyalty.Program, error)
+	SaveProgram(ctx context.Context, program *loyalty.Program) (*loyalty.Program, error)
+	FindOrderCustomer(ctx context.Context, order_id int) (int, error)
+	FindBalance(ctx context.Context, customer_id int) (*loyalty.Balance, error)
+	FindHistory(ctx context.Context, req *loyalty.HistoryRequest) ([]*loyalty.Entry, *int, error)
+	ExpirePoints(ctx context.Context, now time.Time) (int, error)
+}
+
+type TenantRepository interface {
+	FindMerchantIDsByUser(ctx context.Context, userID int) ([]int, error)
+}
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
@@ -0,0 +1,479 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrFindLoyaltyProgram = errors.New("failed to find loyalty program")
+	ErrSaveLoyaltyProgram = errors.New("failed to save loyalty program")
+	ErrFindLoyaltyBalance = errors.New("failed to find loyalty balance")
+	ErrFindLoyaltyHistory = errors.New("failed to find loyalty history")
+	ErrFindOrderCustomer  = errors.New("failed to find order customer")
+	ErrExpireLoyalty      = errors.New("failed to expire loyalty points")
+)
+
+const getLoyaltyProgram = `-- name: GetLoyaltyProgram :one
+SELECT merchant_id, spend_per_point, point_value, expiry_days, active
+FROM loyalty_programs
+WHERE merchant_id = $1
+`
+
+const upsertLoyaltyProgram = `-- name: UpsertLoyaltyProgram :one
+INSERT INTO loyalty_programs (merchant_id, spend_per_point, point_value, expiry_days, active)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (merchant_id) DO UPDATE
+SET spend_per_point = EXCLUDED.spend_per_point,
+    point_value = EXCLUDED.point_value,
+    expiry_days = EXCLUDED.expiry_days,
+    active = EXCLUDED.active,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING merchant_id, spend_per_point, point_value, expiry_days, active
+`
+
+const getOrderCustomer = `-- name: GetOrderCustomer :one
+SELECT COALESCE(c.customer_id, 0)
+FROM orders o
+LEFT JOIN customers c ON c.customer_id = o.customer_id
+    AND c.deleted_at IS NULL
+WHERE o.order_id = $1
+`
+
+const getCustomerMerchant = `-- name: GetCustomerMerchant :one
+SELECT merchant_id
+FROM customers
+WHERE customer_id = $1
+    AND deleted_at IS NULL
+`
+
+// lockLoyaltyCustomer serialises the postings of a customer so a balance
+// is never spent twice. NO KEY UPDATE leaves the customer free to be
+// referenced by new orders meanwhile.
+const lockLoyaltyCustomer = `-- name: LockLoyaltyCustomer :exec
+SELECT customer_id
+FROM customers
+WHERE customer_id = $1
+FOR NO KEY UPDATE
+`
+
+const getLoyaltyBalance = `-- name: GetLoyaltyBalance :one
+SELECT COALESCE(SUM(points), 0)::int
+FROM loyalty_entries
+WHERE customer_id = $1
+    AND merchant_id = $2
+`
+
+const getLoyaltyHistory = `-- name: GetLoyaltyHistory :many
+SELECT
+    loyalty_entry_id,
+    customer_id,
+    merchant_id,
+    COALESCE(transaction_id, 0),
+    kind,
+    points,
+    expires_at,
+    created_at,
+    COUNT(*) OVER() AS total_count
+FROM loyalty_entries
+WHERE customer_id = $1
+ORDER BY created_at DESC, loyalty_entry_id DESC
+LIMIT $2 OFFSET $3
+`
+
+// getTransactionLoyaltyPoints sums the entries of a transaction by the
+// customer they went to, optionally only those of the given kinds.
+const getTransactionLoyaltyPoints = `-- name: GetTransactionLoyaltyPoints :many
+SELECT customer_id, merchant_id, SUM(points)::int
+FROM loyalty_entries
+WHERE transaction_id = $1
+    AND (cardinality($2::TEXT[]) = 0 OR kind = ANY($2::TEXT[]))
+GROUP BY customer_id, merchant_id
+`
+
+const createLoyaltyEntry = `-- name: CreateLoyaltyEntry :exec
+INSERT INTO loyalty_entries (customer_id, merchant_id, transaction_id, kind, points, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+// getExpiringLoyalty lists the balances holding earned points past their
+// expiry, with what has been taken from them since they were earned.
+const getExpiringLoyalty = `-- name: GetExpiringLoyalty :many
+SELECT
+    customer_id,
+    merchant_id,
+    COALESCE(SUM(points) FILTER (WHERE kind = 'earn' AND expires_at <= $1), 0)::int AS expired,
+    COALESCE(-SUM(points) FILTER (WHERE kind <> 'earn'), 0)::int AS debited,
+    SUM(points)::int AS balance
+FROM loyalty_entries
+WHERE ($2::INT = 0 OR customer_id = $2)
+    AND ($3::INT = 0 OR merchant_id = $3)
+GROUP BY customer_id, merchant_id
+HAVING SUM(points) > 0
+    AND bool_or(kind = 'earn' AND expires_at <= $1)
+`
+
+type loyaltyRepository struct {
+	conn *audit.DB
+}
+
+func NewLoyaltyRepository(conn *audit.DB) *loyaltyRepository {
+	return &loyaltyRepository{
+		conn: conn,
+	}
+}
+
+func (r *loyaltyRepository) FindProgram(ctx context.Context, merchant_id int) (*loyalty.Program, error) {
+	if !tenant.Allows(ctx, merchant_id) {
+		return nil, loyalty.ErrProgramNotFound
+	}
+
+	program, err := scanProgram(r.conn.QueryRowContext(ctx, getLoyaltyProgram, int32(merchant_id)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, loyalty.ErrProgramNotFound
+	}
+	if err != nil {
+		return nil, ErrFindLoyaltyProgram
+	}
+
+	return program, nil
+}
+
+func (r *loyaltyRepository) SaveProgram(ctx context.Context, program *loyalty.Program) (*loyalty.Program, error) {
+	if !tenant.Allows(ctx, program.MerchantID) {
+		return nil, loyalty.ErrProgramNotFound
+	}
+
+	res, err := scanProgram(r.conn.QueryRowContext(ctx, upsertLoyaltyProgram,
+		int32(program.MerchantID),
+		int32(program.SpendPerPoint),
+		int32(program.PointValue),
+		int32(program.ExpiryDays),
+		program.Active,
+	))
+	if err != nil {
+		return nil, ErrSaveLoyaltyProgram
+	}
+
+	return res, nil
+}
+
+// FindOrderCustomer returns the customer an order was placed for, or zero
+// when it has none.
+func (r *loyaltyRepository) FindOrderCustomer(ctx context.Context, order_id int) (int, error) {
+	var customerID int
+
+	err := r.conn.QueryRowContext(ctx, getOrderCustomer, int32(order_id)).Scan(&customerID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrFindOrderCustomer
+	}
+
+	return customerID, nil
+}
+
+// FindBalance returns the points a customer holds with their merchant and
+// what they are worth under the merchant's program, if it has one.
+func (r *loyaltyRepository) FindBalance(ctx context.Context, customer_id int) (*loyalty.Balance, error) {
+	merchantID, err := r.findCustomerMerchant(ctx, customer_id)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := &loyalty.Balance{CustomerID: customer_id, MerchantID: merchantID}
+
+	if err := r.conn.QueryRowContext(ctx, getLoyaltyBalance, int32(customer_id), int32(merchantID)).Scan(&balance.Points); err != nil {
+		return nil, ErrFindLoyaltyBalance
+	}
+
+	program, err := scanProgram(r.conn.QueryRowContext(ctx, getLoyaltyProgram, int32(merchantID)))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrFindLoyaltyBalance
+	}
+	if program != nil {
+		balance.Value = balance.Points * program.PointValue
+	}
+
+	return balance, nil
+}
+
+func (r *loyaltyRepository) FindHistory(ctx context.Context, req *loyalty.HistoryRequest) ([]*loyalty.Entry, *int, error) {
+	if _, err := r.findCustomerMerchant(ctx, req.CustomerID); err != nil {
+		return nil, nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+
+	rows, err := r.conn.QueryContext(ctx, getLoyaltyHistory, int32(req.CustomerID), req.PageSize, offset)
+	if err != nil {
+		return nil, nil, ErrFindLoyaltyHistory
+	}
+	defer rows.Close()
+
+	entries := []*loyalty.Entry{}
+	total := 0
+	for rows.Next() {
+		var (
+			e         loyalty.Entry
+			kind      string
+			expiresAt sql.NullTime
+		)
+
+		if err := rows.Scan(
+			&e.ID,
+			&e.CustomerID,
+			&e.MerchantID,
+			&e.TransactionID,
+			&kind,
+			&e.Points,
+			&expiresAt,
+			&e.CreatedAt,
+			&total,
+		); err != nil {
+			return nil, nil, ErrFindLoyaltyHistory
+		}
+
+		e.Kind = loyalty.Kind(kind)
+		if expiresAt.Valid {
+			e.ExpiresAt = &expiresAt.Time
+		}
+		entries = append(entries, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, ErrFindLoyaltyHistory
+	}
+
+	return entries, &total, nil
+}
+
+// ExpirePoints posts an expire entry for every balance holding earned
+// points past their expiry at now and returns how many points expired.
+// Each balance is expired in its own transaction, locked like a sale, so
+// the job never holds up the tills for long.
+func (r *loyaltyRepository) ExpirePoints(ctx context.Context, now time.Time) (int, error) {
+	candidates, err := findExpiring(ctx, r.conn, now, 0, 0)
+	if err != nil {
+		return 0, ErrExpireLoyalty
+	}
+
+	expired := 0
+	for _, c := range candidates {
+		n, err := r.expireBalance(ctx, now, c.customerID, c.merchantID)
+		if err != nil {
+			return expired, ErrExpireLoyalty
+		}
+		expired += n
+	}
+
+	return expired, nil
+}
+
+func (r *loyaltyRepository) expireBalance(ctx context.Context, now time.Time, customerID int, merchantID int) (int, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, lockLoyaltyCustomer, int32(customerID)); err != nil {
+		return 0, err
+	}
+
+	balances, err := findExpiring(ctx, tx, now, customerID, merchantID)
+	if err != nil || len(balances) == 0 {
+		return 0, err
+	}
+
+	n := loyalty.Expiring(balances[0].expired, balances[0].debited, balances[0].balance)
+	if n == 0 {
+		return 0, nil
+	}
+
+	if err := createEntry(ctx, tx, customerID, merchantID, 0, loyalty.KindExpire, -n, nil); err != nil {
+		return 0, err
+	}
+
+	return n, tx.Commit()
+}
+
+func (r *loyaltyRepository) findCustomerMerchant(ctx context.Context, customer_id int) (int, error) {
+	var merchantID int
+
+	err := r.conn.QueryRowContext(ctx, getCustomerMerchant, int32(customer_id)).Scan(&merchantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, loyalty.ErrCustomerNotFound
+	}
+	if err != nil {
+		return 0, ErrFindLoyaltyBalance
+	}
+
+	if !tenant.Allows(ctx, merchantID) {
+		return 0, loyalty.ErrCustomerNotFound
+	}
+
+	return merchantID, nil
+}
+
+// postSettlement writes what paying a transaction does to the customer's
+// points inside the transaction's own database transaction. Entries the
+// transaction posted before, when it is paid again, are taken back first.
+func postSettlement(ctx context.Context, tx *sql.Tx, transactionID int, posting *loyalty.Posting) error {
+	if err := postReversal(ctx, tx, transactionID, loyalty.KindAdjust, nil, func(net int) int { return -net }); err != nil {
+		return err
+	}
+
+	if posting == nil {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, lockLoyaltyCustomer, int32(posting.CustomerID)); err != nil {
+		return err
+	}
+
+	if posting.Redeem > 0 {
+		var balance int
+		if err := tx.QueryRowContext(ctx, getLoyaltyBalance, int32(posting.CustomerID), int32(posting.MerchantID)).Scan(&balance); err != nil {
+			return err
+		}
+
+		if balance < posting.Redeem {
+			return loyalty.ErrInsufficientPoints
+		}
+
+		if err := createEntry(ctx, tx, posting.CustomerID, posting.MerchantID, transactionID, loyalty.KindRedeem, -posting.Redeem, nil); err != nil {
+			return err
+		}
+	}
+
+	if posting.Earn > 0 {
+		if err := createEntry(ctx, tx, posting.CustomerID, posting.MerchantID, transactionID, loyalty.KindEarn, posting.Earn, posting.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// postReversal posts an entry of the given kind for every customer holding
+// points from the transaction, of points worked out from the net the kinds
+// sum to (all entries when kinds is empty). Nothing is posted for zero.
+func postReversal(ctx context.Context, tx *sql.Tx, transactionID int, kind loyalty.Kind, kinds []string, points func(net int) int) error {
+	balances, err := findTransactionPoints(ctx, tx, transactionID, kinds)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range balances {
+		if _, err := tx.ExecContext(ctx, lockLoyaltyCustomer, int32(b.customerID)); err != nil {
+			return err
+		}
+
+		n := points(b.net)
+		if n == 0 {
+			continue
+		}
+
+		if err := createEntry(ctx, tx, b.customerID, b.merchantID, transactionID, kind, n, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type transactionPoints struct {
+	customerID int
+	merchantID int
+	net        int
+}
+
+func findTransactionPoints(ctx context.Context, conn db.DBTX, transactionID int, kinds []string) ([]transactionPoints, error) {
+	if kinds == nil {
+		kinds = []string{}
+	}
+
+	rows, err := conn.QueryContext(ctx, getTransactionLoyaltyPoints, int32(transactionID), pq.Array(kinds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []transactionPoints
+	for rows.Next() {
+		var p transactionPoints
+		if err := rows.Scan(&p.customerID, &p.merchantID, &p.net); err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+
+	return res, rows.Err()
+}
+
+type expiringBalance struct {
+	customerID int
+	merchantID int
+	expired    int
+	debited    int
+	balance    int
+}
+
+func findExpiring(ctx context.Context, conn db.DBTX, now time.Time, customerID int, merchantID int) ([]expiringBalance, error) {
+	rows, err := conn.QueryContext(ctx, getExpiringLoyalty, now, int32(customerID), int32(merchantID))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []expiringBalance
+	for rows.Next() {
+		var b expiringBalance
+		if err := rows.Scan(&b.customerID, &b.merchantID, &b.expired, &b.debited, &b.balance); err != nil {
+			return nil, err
+		}
+		res = append(res, b)
+	}
+
+	return res, rows.Err()
+}
+
+func createEntry(ctx context.Context, conn db.DBTX, customerID int, merchantID int, transactionID int, kind loyalty.Kind, points int, expiresAt *time.Time) error {
+	_, err := conn.ExecContext(ctx, createLoyaltyEntry,
+		int32(customerID),
+		int32(merchantID),
+		sql.NullInt32{Int32: int32(transactionID), Valid: transactionID > 0},
+		string(kind),
+		int32(points),
+		toNullTime(expiresAt),
+	)
+
+	return err
+}
+
+func scanProgram(row *sql.Row) (*loyalty.Program, error) {
+	var p loyalty.Program
+
+	if err := row.Scan(&p.MerchantID, &p.SpendPerPoint, &p.PointValue, &p.ExpiryDays, &p.Active); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: *t, Valid: true}
+}
@@ -7,16 +7,48 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	orderitem_errors "github.com/MamangRust/monolith-point-of-sale-shared/errors/order_item_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/receipt"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tax"
 )
 
+const getTaxableOrderItems = `-- name: GetTaxableOrderItems :many
+SELECT oi.product_id, p.category_id, oi.price, oi.quantity,
+    COALESCE((SELECT SUM(d.amount) FROM order_item_discounts d WHERE d.order_item_id = oi.order_item_id), 0)::int
+FROM order_items oi
+JOIN products p ON p.product_id = oi.product_id
+WHERE oi.order_id = $1
+    AND oi.deleted_at IS NULL
+ORDER BY oi.order_item_id
+`
+
+const getReceiptOrderItems = `-- name: GetReceiptOrderItems :many
+SELECT oi.product_id, p.name, oi.quantity, oi.price,
+    COALESCE((SELECT SUM(d.amount) FROM order_item_discounts d WHERE d.order_item_id = oi.order_item_id), 0)::int
+FROM order_items oi
+JOIN products p ON p.product_id = oi.product_id
+WHERE oi.order_id = $1
+    AND oi.deleted_at IS NULL
+ORDER BY oi.order_item_id
+`
+
+const getOrderItemDiscounts = `-- name: GetOrderItemDiscounts :many
+SELECT d.order_item_id, SUM(d.amount)::int
+FROM order_item_discounts d
+JOIN order_items oi ON oi.order_item_id = d.order_item_id
+WHERE oi.order_id = $1
+GROUP BY d.order_item_id
+`
+
 type orderItemQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.OrderItemRecordMapping
 }
 
-func NewOrderItemQueryRepository(db *db.Queries, mapping recordmapper.OrderItemRecordMapping) *orderItemQueryRepository {
+func NewOrderItemQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.OrderItemRecordMapping) *orderItemQueryRepository {
 	return &orderItemQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -30,3 +62,75 @@ func (r *orderItemQueryRepository) FindOrderItemByOrder(ctx context.Context, ord
 
 	return r.mapping.ToOrderItemsRecord(res), nil
 }
+
+func (r *orderItemQueryRepository) FindTaxableItemsByOrder(ctx context.Context, order_id int) ([]tax.Line, error) {
+	rows, err := r.conn.QueryContext(ctx, getTaxableOrderItems, int32(order_id))
+	if err != nil {
+		return nil, orderitem_errors.ErrFindOrderItemByOrder
+	}
+	defer rows.Close()
+
+	lines := []tax.Line{}
+	for rows.Next() {
+		var line tax.Line
+		if err := rows.Scan(&line.ProductID, &line.CategoryID, &line.Price, &line.Quantity, &line.Discount); err != nil {
+			return nil, orderitem_errors.ErrFindOrderItemByOrder
+		}
+		lines = append(lines, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, orderitem_errors.ErrFindOrderItemByOrder
+	}
+
+	return lines, nil
+}
+
+func (r *orderItemQueryRepository) FindReceiptItemsByOrder(ctx context.Context, order_id int) ([]receipt.Item, error) {
+	rows, err := r.conn.QueryContext(ctx, getReceiptOrderItems, int32(order_id))
+	if err != nil {
+		return nil, orderitem_errors.ErrFindOrderItemByOrder
+	}
+	defer rows.Close()
+
+	items := []receipt.Item{}
+	for rows.Next() {
+		var item receipt.Item
+		if err := rows.Scan(&item.ProductID, &item.Name, &item.Quantity, &item.Price, &item.Discount); err != nil {
+			return nil, orderitem_errors.ErrFindOrderItemByOrder
+		}
+		item.Total = item.Price*item.Quantity - item.Discount
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, orderitem_errors.ErrFindOrderItemByOrder
+	}
+
+	return items, nil
+}
+
+// FindDiscountsByOrder returns the promotion discount of each of the order's
+// items, keyed by order item.
+func (r *orderItemQueryRepository) FindDiscountsByOrder(ctx context.Context, order_id int) (map[int]int, error) {
+	rows, err := r.conn.QueryContext(ctx, getOrderItemDiscounts, int32(order_id))
+	if err != nil {
+		return nil, orderitem_errors.ErrFindOrderItemByOrder
+	}
+	defer rows.Close()
+
+	discounts := map[int]int{}
+	for rows.Next() {
+		var orderItemID, amount int
+		if err := rows.Scan(&orderItemID, &amount); err != nil {
+			return nil, orderitem_errors.ErrFindOrderItemByOrder
+		}
+		discounts[orderItemID] = amount
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, orderitem_errors.ErrFindOrderItemByOrder
+	}
+
+	return discounts, nil
+}
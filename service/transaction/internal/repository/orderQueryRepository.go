@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
@@ -9,14 +11,24 @@ import (
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+const getOrderCustomerEmail = `-- name: GetOrderCustomerEmail :one
+SELECT COALESCE(c.email, '')
+FROM orders o
+JOIN customers c ON c.customer_id = o.customer_id
+WHERE o.order_id = $1
+    AND c.deleted_at IS NULL
+`
+
 type orderQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.OrderRecordMapping
 }
 
-func NewOrderQueryRepository(db *db.Queries, mapping recordmapper.OrderRecordMapping) *orderQueryRepository {
+func NewOrderQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.OrderRecordMapping) *orderQueryRepository {
 	return &orderQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -30,3 +42,16 @@ func (r orderQueryRepository) FindById(ctx context.Context, order_id int) (*reco
 
 	return r.mapping.ToOrderRecord(res), nil
 }
+
+// FindCustomerEmail returns the email of the customer the order was placed
+// for, or an empty string when it has none.
+func (r orderQueryRepository) FindCustomerEmail(ctx context.Context, order_id int) (string, error) {
+	var email string
+
+	err := r.conn.QueryRowContext(ctx, getOrderCustomerEmail, int32(order_id)).Scan(&email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", order_errors.ErrFindById
+	}
+
+	return email, nil
+}
@@ -0,0 +1,8 @@
+package repository
+
+// OutboxEvent is an event to enqueue alongside a write.
+type OutboxEvent struct {
+	Topic   string
+	Key     string
+	Payload []byte
+}
@@ -1,10 +1,16 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "transaction"
+
 type Repositories struct {
 	CashierQuery                 CashierQueryRepository
 	MerchantQuery                MerchantQueryRepository
@@ -14,9 +20,14 @@ type Repositories struct {
 	TransactionQueryRepository   TransactionQueryRepository
 	TransactionStatsRepository   TransactionStatsRepository
 	TransactionStatsByMerchant   TransactionStatsByMerchantRepository
+	TaxProfile                   TaxProfileRepository
+	TransactionRefund            TransactionRefundRepository
+	Loyalty                      LoyaltyRepository
+	Tenant                       TenantRepository
+	Outbox                       OutboxRepository
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	mapperOrderItem := recordmapper.NewOrderItemRecordMapper()
 	mapperOrder := recordmapper.NewOrderRecordMapper()
 	mapperTransaction := recordmapper.NewTransactionRecordMapper()
@@ -26,11 +37,16 @@ func NewRepositories(DB *db.Queries) *Repositories {
 	return &Repositories{
 		CashierQuery:                 NewCashierQueryRepository(DB, mapperCashier),
 		MerchantQuery:                NewMerchantQueryRepository(DB, mapperMerchant),
-		OrderQuery:                   NewOrderQueryRepository(DB, mapperOrder),
-		OrderItemQuery:               NewOrderItemQueryRepository(DB, mapperOrderItem),
-		TransactionCommandRepository: NewTransactionCommandRepository(DB, mapperTransaction),
-		TransactionQueryRepository:   NewTransactionQueryRepository(DB, mapperTransaction),
-		TransactionStatsRepository:   NewTransactionStatsRepository(DB, mapperTransaction),
-		TransactionStatsByMerchant:   NewTransactionStatsByMerchantRepository(DB, mapperTransaction),
+		OrderQuery:                   NewOrderQueryRepository(DB, conn, mapperOrder),
+		OrderItemQuery:               NewOrderItemQueryRepository(DB, conn, mapperOrderItem),
+		TransactionCommandRepository: NewTransactionCommandRepository(DB, conn, mapperTransaction),
+		TransactionQueryRepository:   NewTransactionQueryRepository(DB, conn, mapperTransaction),
+		TransactionStatsRepository:   NewTransactionStatsRepository(DB, conn, mapperTransaction),
+		TransactionStatsByMerchant:   NewTransactionStatsByMerchantRepository(DB, conn, mapperTransaction),
+		TaxProfile:                   NewTaxProfileRepository(conn),
+		TransactionRefund:            NewTransactionRefundRepository(conn, mapperTransaction),
+		Loyalty:                      NewLoyaltyRepository(conn),
+		Tenant:                       tenant.NewRepository(conn),
+		Outbox:                       outbox.NewRepository(conn, outboxSource),
 	}
 }
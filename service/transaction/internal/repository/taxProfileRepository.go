@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tax"
+)
+
+var (
+	ErrTaxProfileNotFound  = errors.New("tax profile not found")
+	ErrFindTaxProfile      = errors.New("failed to find tax profile")
+	ErrFindTaxCategoryRate = errors.New("failed to find tax category rates")
+)
+
+const getMerchantTaxProfile = `-- name: GetMerchantTaxProfile :one
+SELECT merchant_id, rate_bps, mode, rounding
+FROM merchant_tax_profiles
+WHERE merchant_id = $1
+`
+
+const getMerchantTaxCategoryRates = `-- name: GetMerchantTaxCategoryRates :many
+SELECT category_id, rate_bps
+FROM merchant_tax_category_rates
+WHERE merchant_id = $1
+`
+
+type taxProfileRepository struct {
+	conn db.DBTX
+}
+
+func NewTaxProfileRepository(conn db.DBTX) *taxProfileRepository {
+	return &taxProfileRepository{
+		conn: conn,
+	}
+}
+
+func (r *taxProfileRepository) FindByMerchant(ctx context.Context, merchant_id int) (*tax.Profile, error) {
+	var (
+		profile  tax.Profile
+		mode     string
+		rounding string
+	)
+
+	err := r.conn.QueryRowContext(ctx, getMerchantTaxProfile, int32(merchant_id)).Scan(
+		&profile.MerchantID,
+		&profile.RateBps,
+		&mode,
+		&rounding,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTaxProfileNotFound
+	}
+
+	if err != nil {
+		return nil, ErrFindTaxProfile
+	}
+
+	profile.Mode = tax.Mode(mode)
+	profile.Rounding = tax.Rounding(rounding)
+
+	rates, err := r.findCategoryRates(ctx, merchant_id)
+	if err != nil {
+		return nil, ErrFindTaxCategoryRate
+	}
+
+	profile.CategoryRates = rates
+
+	return &profile, nil
+}
+
+func (r *taxProfileRepository) findCategoryRates(ctx context.Context, merchant_id int) (map[int]int, error) {
+	rows, err := r.conn.QueryContext(ctx, getMerchantTaxCategoryRates, int32(merchant_id))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := make(map[int]int)
+	for rows.Next() {
+		var categoryID, rate int
+		if err := rows.Scan(&categoryID, &rate); err != nil {
+			return nil, err
+		}
+		rates[categoryID] = rate
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
@@ -2,81 +2,177 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/transaction_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tax"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tender"
 )
 
+const updateTransactionTax = `-- name: UpdateTransactionTax :exec
+UPDATE transactions
+SET subtotal_amount = $2,
+    tax_amount = $3
+WHERE transaction_id = $1
+`
+
+const deleteTransactionTenders = `-- name: DeleteTransactionTenders :exec
+DELETE FROM transaction_tenders
+WHERE transaction_id = $1
+`
+
+const createTransactionTender = `-- name: CreateTransactionTender :exec
+INSERT INTO transaction_tenders (transaction_id, method, tendered, amount)
+VALUES ($1, $2, $3, $4)
+`
+
+// getUnrestoredTransactions lists the live transactions whose points are
+// still reversed by a trash entry, such as those brought back by a bulk
+// restore.
+const getUnrestoredTransactions = `-- name: GetUnrestoredTransactions :many
+SELECT DISTINCT e.transaction_id
+FROM loyalty_entries e
+JOIN transactions t ON t.transaction_id = e.transaction_id
+WHERE t.deleted_at IS NULL
+    AND e.kind IN ('trash', 'restore')
+GROUP BY e.transaction_id, e.customer_id, e.merchant_id
+HAVING SUM(e.points) <> 0
+`
+
+// TransactionDetails carries what the service derived for a transaction
+// beyond the columns of the transactions row itself.
+type TransactionDetails struct {
+	Tax        *tax.Breakdown
+	Settlement *tender.Settlement
+	// Loyalty is what the payment does to the customer's points; nil when
+	// it touches none.
+	Loyalty *loyalty.Posting
+
+	// Events, when set, builds the outbox events for the written
+	// transaction so they commit together with it.
+	Events func(transaction *record.TransactionRecord) ([]*OutboxEvent, error)
+}
+
 type transactionCommandRepository struct {
 	db      *db.Queries
+	conn    *audit.DB
 	mapping recordmapper.TransactionRecordMapping
 }
 
-func NewTransactionCommandRepository(db *db.Queries, mapping recordmapper.TransactionRecordMapping) *transactionCommandRepository {
+func NewTransactionCommandRepository(db *db.Queries, conn *audit.DB, mapping recordmapper.TransactionRecordMapping) *transactionCommandRepository {
 	return &transactionCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
 
-func (r *transactionCommandRepository) CreateTransaction(ctx context.Context, request *requests.CreateTransactionRequest) (*record.TransactionRecord, error) {
+func (r *transactionCommandRepository) CreateTransaction(ctx context.Context, request *requests.CreateTransactionRequest, details *TransactionDetails) (*record.TransactionRecord, error) {
 	req := db.CreateTransactionParams{
 		OrderID:       int32(request.OrderID),
 		MerchantID:    int32(request.MerchantID),
 		PaymentMethod: request.PaymentMethod,
 		Amount:        int32(request.Amount),
+		ChangeAmount:  toNullInt32(request.ChangeAmount),
 		PaymentStatus: *request.PaymentStatus,
 	}
 
-	transaction, err := r.db.CreateTransaction(ctx, req)
+	transaction, err := r.withDetails(ctx, details, func(q *db.Queries) (*db.Transaction, error) {
+		return q.CreateTransaction(ctx, req)
+	})
 
+	if errors.Is(err, loyalty.ErrInsufficientPoints) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, transaction_errors.ErrCreateTransaction
 	}
 
-	return r.mapping.ToTransactionRecord(transaction), nil
+	return transaction, nil
 }
 
-func (r *transactionCommandRepository) UpdateTransaction(ctx context.Context, request *requests.UpdateTransactionRequest) (*record.TransactionRecord, error) {
+func (r *transactionCommandRepository) UpdateTransaction(ctx context.Context, request *requests.UpdateTransactionRequest, details *TransactionDetails) (*record.TransactionRecord, error) {
 	req := db.UpdateTransactionParams{
 		TransactionID: int32(*request.TransactionID),
 		MerchantID:    int32(request.MerchantID),
 		PaymentMethod: request.PaymentMethod,
 		Amount:        int32(request.Amount),
+		ChangeAmount:  toNullInt32(request.ChangeAmount),
 		OrderID:       int32(request.OrderID),
 		PaymentStatus: *request.PaymentStatus,
 	}
 
-	res, err := r.db.UpdateTransaction(ctx, req)
+	transaction, err := r.withDetails(ctx, details, func(q *db.Queries) (*db.Transaction, error) {
+		return q.UpdateTransaction(ctx, req)
+	})
 
+	if errors.Is(err, loyalty.ErrInsufficientPoints) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, transaction_errors.ErrUpdateTransaction
 	}
 
-	return r.mapping.ToTransactionRecord(res), nil
+	return transaction, nil
 }
 
+// TrashTransaction trashes the transaction and takes back the points it
+// still holds for the customer.
 func (r *transactionCommandRepository) TrashTransaction(ctx context.Context, transaction_id int) (*record.TransactionRecord, error) {
-	res, err := r.db.TrashTransaction(ctx, int32(transaction_id))
+	res, err := r.withLoyalty(ctx, func(tx *sql.Tx) (*db.Transaction, error) {
+		res, err := r.db.WithTx(tx).TrashTransaction(ctx, int32(transaction_id))
+		if err != nil {
+			return nil, err
+		}
+
+		return res, postReversal(ctx, tx, transaction_id, loyalty.KindTrash, nil, func(net int) int { return -net })
+	})
 
 	if err != nil {
 		return nil, transaction_errors.ErrTrashTransaction
 	}
 
-	return r.mapping.ToTransactionRecord(res), nil
+	transaction := r.mapping.ToTransactionRecord(res)
+
+	if err := attachBreakdowns(ctx, r.conn, transaction); err != nil {
+		return nil, transaction_errors.ErrTrashTransaction
+	}
+
+	return transaction, nil
 }
 
+// RestoreTransaction restores the transaction and gives back the points
+// trashing it took.
 func (r *transactionCommandRepository) RestoreTransaction(ctx context.Context, transaction_id int) (*record.TransactionRecord, error) {
-	res, err := r.db.RestoreTransaction(ctx, int32(transaction_id))
+	res, err := r.withLoyalty(ctx, func(tx *sql.Tx) (*db.Transaction, error) {
+		res, err := r.db.WithTx(tx).RestoreTransaction(ctx, int32(transaction_id))
+		if err != nil {
+			return nil, err
+		}
+
+		return res, restorePoints(ctx, tx, transaction_id)
+	})
 
 	if err != nil {
 		return nil, transaction_errors.ErrRestoreTransaction
 	}
 
-	return r.mapping.ToTransactionRecord(res), nil
+	transaction := r.mapping.ToTransactionRecord(res)
+
+	if err := attachBreakdowns(ctx, r.conn, transaction); err != nil {
+		return nil, transaction_errors.ErrRestoreTransaction
+	}
+
+	return transaction, nil
 }
 
 func (r *transactionCommandRepository) DeleteTransactionPermanently(ctx context.Context, transaction_id int) (bool, error) {
@@ -90,19 +186,167 @@ func (r *transactionCommandRepository) DeleteTransactionPermanently(ctx context.
 }
 
 func (r *transactionCommandRepository) RestoreAllTransactions(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllTransactions(ctx)
+	var affected int64
+
+	_, err := r.withLoyalty(ctx, func(tx *sql.Tx) (*db.Transaction, error) {
+		var err error
+		if affected, err = bulk.Apply(ctx, tx, bulkTransactions, bulk.Restore); err != nil {
+			return nil, err
+		}
+
+		if scope, _ := bulk.FromContext(ctx); scope.DryRun {
+			return nil, nil
+		}
+
+		return nil, r.restoreAllPoints(ctx, tx)
+	})
 
 	if err != nil {
 		return false, transaction_errors.ErrRestoreAllTransactions
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *transactionCommandRepository) DeleteAllTransactionPermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentTransactions(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkTransactions, bulk.Delete)
 
 	if err != nil {
 		return false, transaction_errors.ErrDeleteAllTransactionPermanent
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
+
+// withDetails writes the transaction row, its tax breakdown, its tenders,
+// its loyalty points and its outbox events in one database transaction, so
+// a stored amount never lacks the figures it was derived from.
+func (r *transactionCommandRepository) withDetails(ctx context.Context, details *TransactionDetails, write func(q *db.Queries) (*db.Transaction, error)) (*record.TransactionRecord, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	res, err := write(r.db.WithTx(tx.Tx))
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID := res.TransactionID
+
+	if _, err := tx.ExecContext(ctx, updateTransactionTax, transactionID, int32(details.Tax.Subtotal), int32(details.Tax.Tax)); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteTransactionTenders, transactionID); err != nil {
+		return nil, err
+	}
+
+	for _, t := range details.Settlement.Tenders {
+		if _, err := tx.ExecContext(ctx, createTransactionTender, transactionID, string(t.Method), int32(t.Tendered), int32(t.Amount)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := postSettlement(ctx, tx.Tx, int(transactionID), details.Loyalty); err != nil {
+		return nil, err
+	}
+
+	transaction := r.mapping.ToTransactionRecord(res)
+	transaction.SubtotalAmount = details.Tax.Subtotal
+	transaction.TaxAmount = details.Tax.Tax
+
+	if details.Events != nil {
+		events, err := details.Events(transaction)
+		if err != nil {
+			return nil, err
+		}
+
+		outbox := outbox.NewRepository(tx, outboxSource)
+		for _, event := range events {
+			if err := outbox.Enqueue(ctx, event.Topic, event.Key, event.Payload); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// withLoyalty runs write in a database transaction so the points of the
+// transactions it touches move together with them.
+func (r *transactionCommandRepository) withLoyalty(ctx context.Context, write func(tx *sql.Tx) (*db.Transaction, error)) (*db.Transaction, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	res, err := write(tx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// restoreAllPoints gives back the points of every live transaction that
+// still has them taken by a trash entry.
+func (r *transactionCommandRepository) restoreAllPoints(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, getUnrestoredTransactions)
+	if err != nil {
+		return err
+	}
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := restorePoints(ctx, tx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func restorePoints(ctx context.Context, tx *sql.Tx, transactionID int) error {
+	return postReversal(ctx, tx, transactionID, loyalty.KindRestore, []string{string(loyalty.KindTrash), string(loyalty.KindRestore)}, func(net int) int { return -net })
+}
+
+func toNullInt32(v *int) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: int32(*v), Valid: true}
+}
@@ -2,22 +2,71 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/tenant"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/transaction_errors"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/lib/pq"
 )
 
+// Merchant-scoped variants of GetTransactions / GetTransactionsTrashed used
+// for callers that are restricted to their own merchants.
+const getTransactionsByMerchantIDs = `-- name: GetTransactionsByMerchantIDs :many
+SELECT transaction_id, order_id, merchant_id, payment_method, amount, change_amount, payment_status, created_at, updated_at, deleted_at, COUNT(*) OVER () AS total_count
+FROM transactions
+WHERE
+    deleted_at IS NULL
+    AND (
+        $1::TEXT IS NULL
+        OR payment_method ILIKE '%' || $1 || '%'
+        OR payment_status ILIKE '%' || $1 || '%'
+    )
+    AND merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET
+    $3
+`
+
+const getTransactionsTrashedByMerchantIDs = `-- name: GetTransactionsTrashedByMerchantIDs :many
+SELECT transaction_id, order_id, merchant_id, payment_method, amount, change_amount, payment_status, created_at, updated_at, deleted_at, COUNT(*) OVER () AS total_count
+FROM transactions
+WHERE
+    deleted_at IS NOT NULL
+    AND (
+        $1::TEXT IS NULL
+        OR payment_method ILIKE '%' || $1 || '%'
+        OR payment_status ILIKE '%' || $1 || '%'
+    )
+    AND merchant_id = ANY($4::INT[])
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET
+    $3
+`
+
+// getTransactionBreakdowns reads the tax breakdown the generated queries do
+// not select. Transactions written before breakdowns were stored report 0.
+const getTransactionBreakdowns = `-- name: GetTransactionBreakdowns :many
+SELECT transaction_id, COALESCE(subtotal_amount, 0), COALESCE(tax_amount, 0)
+FROM transactions
+WHERE transaction_id = ANY($1::INT[])
+`
+
 type transactionQueryRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.TransactionRecordMapping
 }
 
-func NewTransactionQueryRepository(db *db.Queries, mapping recordmapper.TransactionRecordMapping) *transactionQueryRepository {
+func NewTransactionQueryRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.TransactionRecordMapping) *transactionQueryRepository {
 	return &transactionQueryRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -25,6 +74,15 @@ func NewTransactionQueryRepository(db *db.Queries, mapping recordmapper.Transact
 func (r *transactionQueryRepository) FindAllTransactions(ctx context.Context, req *requests.FindAllTransaction) ([]*record.TransactionRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getTransactionsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, transaction_errors.ErrFindAllTransactions
+		}
+
+		return r.withBreakdowns(ctx, r.mapping.ToTransactionsRecordPagination(res), totalCount(res), transaction_errors.ErrFindAllTransactions)
+	}
+
 	reqDb := db.GetTransactionsParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -45,12 +103,26 @@ func (r *transactionQueryRepository) FindAllTransactions(ctx context.Context, re
 		totalCount = 0
 	}
 
-	return r.mapping.ToTransactionsRecordPagination(res), &totalCount, nil
+	return r.withBreakdowns(ctx, r.mapping.ToTransactionsRecordPagination(res), &totalCount, transaction_errors.ErrFindAllTransactions)
 }
 
 func (r *transactionQueryRepository) FindByActive(ctx context.Context, req *requests.FindAllTransaction) ([]*record.TransactionRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getTransactionsByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, transaction_errors.ErrFindByActive
+		}
+
+		active := make([]*db.GetTransactionsActiveRow, len(res))
+		for i, row := range res {
+			active[i] = (*db.GetTransactionsActiveRow)(row)
+		}
+
+		return r.withBreakdowns(ctx, r.mapping.ToTransactionsRecordActivePagination(active), totalCount(res), transaction_errors.ErrFindByActive)
+	}
+
 	reqDb := db.GetTransactionsActiveParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -71,12 +143,26 @@ func (r *transactionQueryRepository) FindByActive(ctx context.Context, req *requ
 		totalCount = 0
 	}
 
-	return r.mapping.ToTransactionsRecordActivePagination(res), &totalCount, nil
+	return r.withBreakdowns(ctx, r.mapping.ToTransactionsRecordActivePagination(res), &totalCount, transaction_errors.ErrFindByActive)
 }
 
 func (r *transactionQueryRepository) FindByTrashed(ctx context.Context, req *requests.FindAllTransaction) ([]*record.TransactionRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if scope, ok := tenant.Restricted(ctx); ok {
+		res, err := r.findByMerchantIDs(ctx, getTransactionsTrashedByMerchantIDs, req, scope)
+		if err != nil {
+			return nil, nil, transaction_errors.ErrFindByTrashed
+		}
+
+		trashed := make([]*db.GetTransactionsTrashedRow, len(res))
+		for i, row := range res {
+			trashed[i] = (*db.GetTransactionsTrashedRow)(row)
+		}
+
+		return r.withBreakdowns(ctx, r.mapping.ToTransactionsRecordTrashedPagination(trashed), totalCount(res), transaction_errors.ErrFindByTrashed)
+	}
+
 	reqDb := db.GetTransactionsTrashedParams{
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
@@ -97,7 +183,7 @@ func (r *transactionQueryRepository) FindByTrashed(ctx context.Context, req *req
 		totalCount = 0
 	}
 
-	return r.mapping.ToTransactionsRecordTrashedPagination(res), &totalCount, nil
+	return r.withBreakdowns(ctx, r.mapping.ToTransactionsRecordTrashedPagination(res), &totalCount, transaction_errors.ErrFindByTrashed)
 }
 
 func (r *transactionQueryRepository) FindByMerchant(
@@ -106,6 +192,11 @@ func (r *transactionQueryRepository) FindByMerchant(
 ) ([]*record.TransactionRecord, *int, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if !tenant.Allows(ctx, req.MerchantID) {
+		zero := 0
+		return []*record.TransactionRecord{}, &zero, nil
+	}
+
 	reqDb := db.GetTransactionByMerchantParams{
 		Column1: req.Search,
 		Column2: int32(req.MerchantID),
@@ -127,7 +218,7 @@ func (r *transactionQueryRepository) FindByMerchant(
 		totalCount = 0
 	}
 
-	return r.mapping.ToTransactionMerchantsRecordPagination(res), &totalCount, nil
+	return r.withBreakdowns(ctx, r.mapping.ToTransactionMerchantsRecordPagination(res), &totalCount, transaction_errors.ErrFindByMerchant)
 }
 
 func (r *transactionQueryRepository) FindById(ctx context.Context, transaction_id int) (*record.TransactionRecord, error) {
@@ -137,7 +228,17 @@ func (r *transactionQueryRepository) FindById(ctx context.Context, transaction_i
 		return nil, transaction_errors.ErrFindById
 	}
 
-	return r.mapping.ToTransactionRecord(res), nil
+	if !tenant.Allows(ctx, int(res.MerchantID)) {
+		return nil, transaction_errors.ErrFindById
+	}
+
+	transaction := r.mapping.ToTransactionRecord(res)
+
+	if err := attachBreakdowns(ctx, r.conn, transaction); err != nil {
+		return nil, transaction_errors.ErrFindById
+	}
+
+	return transaction, nil
 }
 
 func (r *transactionQueryRepository) FindByOrderId(ctx context.Context, order_id int) (*record.TransactionRecord, error) {
@@ -147,5 +248,86 @@ func (r *transactionQueryRepository) FindByOrderId(ctx context.Context, order_id
 		return nil, transaction_errors.ErrFindByOrderId
 	}
 
-	return r.mapping.ToTransactionRecord(res), nil
+	if !tenant.Allows(ctx, int(res.MerchantID)) {
+		return nil, transaction_errors.ErrFindByOrderId
+	}
+
+	transaction := r.mapping.ToTransactionRecord(res)
+
+	if err := attachBreakdowns(ctx, r.conn, transaction); err != nil {
+		return nil, transaction_errors.ErrFindByOrderId
+	}
+
+	return transaction, nil
+}
+
+func (r *transactionQueryRepository) findByMerchantIDs(ctx context.Context, query string, req *requests.FindAllTransaction, scope *tenant.Scope) ([]*db.GetTransactionsRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	return queryRows(ctx, r.conn, query, func(rows *sql.Rows, i *db.GetTransactionsRow) error {
+		return rows.Scan(
+			&i.TransactionID,
+			&i.OrderID,
+			&i.MerchantID,
+			&i.PaymentMethod,
+			&i.Amount,
+			&i.ChangeAmount,
+			&i.PaymentStatus,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TotalCount,
+		)
+	}, req.Search, req.PageSize, offset, pq.Array(scope.Int64MerchantIDs()))
+}
+
+func (r *transactionQueryRepository) withBreakdowns(ctx context.Context, transactions []*record.TransactionRecord, total *int, failure error) ([]*record.TransactionRecord, *int, error) {
+	if err := attachBreakdowns(ctx, r.conn, transactions...); err != nil {
+		return nil, nil, failure
+	}
+
+	return transactions, total, nil
+}
+
+// attachBreakdowns fills in the subtotal and tax of the given transactions.
+func attachBreakdowns(ctx context.Context, conn db.DBTX, transactions ...*record.TransactionRecord) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*record.TransactionRecord, len(transactions))
+	ids := make([]int64, 0, len(transactions))
+	for _, transaction := range transactions {
+		byID[transaction.ID] = transaction
+		ids = append(ids, int64(transaction.ID))
+	}
+
+	rows, err := conn.QueryContext(ctx, getTransactionBreakdowns, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, subtotal, taxAmount int
+		if err := rows.Scan(&id, &subtotal, &taxAmount); err != nil {
+			return err
+		}
+
+		if transaction, ok := byID[id]; ok {
+			transaction.SubtotalAmount = subtotal
+			transaction.TaxAmount = taxAmount
+		}
+	}
+
+	return rows.Err()
+}
+
+func totalCount(rows []*db.GetTransactionsRow) *int {
+	total := 0
+	if len(rows) > 0 {
+		total = int(rows[0].TotalCount)
+	}
+
+	return &total
 }
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
+	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/refund"
+)
+
+var (
+	ErrFindRefundLedger = errors.New("failed to find transaction refunds")
+	ErrCreateRefund     = errors.New("failed to create transaction refund")
+	ErrRefundConflict   = errors.New("transaction changed while the refund was prepared")
+)
+
+const lockTransactionForRefund = `-- name: LockTransactionForRefund :one
+SELECT payment_status, amount
+FROM transactions
+WHERE transaction_id = $1
+    AND deleted_at IS NULL
+FOR UPDATE
+`
+
+const getRefundedAmount = `-- name: GetRefundedAmount :one
+SELECT COALESCE(SUM(amount), 0)::int
+FROM transaction_refunds
+WHERE transaction_id = $1
+`
+
+const getRefundedQuantities = `-- name: GetRefundedQuantities :many
+SELECT ri.order_item_id, SUM(ri.quantity)::int
+FROM transaction_refund_items ri
+JOIN transaction_refunds r ON r.transaction_refund_id = ri.transaction_refund_id
+WHERE r.transaction_id = $1
+GROUP BY ri.order_item_id
+`
+
+const createTransactionRefund = `-- name: CreateTransactionRefund :one
+INSERT INTO transaction_refunds (transaction_id, kind, amount, reason, operator_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING transaction_refund_id
+`
+
+const createTransactionRefundItem = `-- name: CreateTransactionRefundItem :exec
+INSERT INTO transaction_refund_items (transaction_refund_id, order_item_id, product_id, quantity, amount)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+const restockProduct = `-- name: RestockProduct :exec
+UPDATE products
+SET count_in_stock = count_in_stock + $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE product_id = $1
+`
+
+const updateTransactionPaymentStatus = `-- name: UpdateTransactionPaymentStatus :one
+UPDATE transactions
+SET payment_status = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE transaction_id = $1
+RETURNING transaction_id, order_id, merchant_id, payment_method, amount, change_amount, payment_status, created_at, updated_at, deleted_at,
+    COALESCE(subtotal_amount, 0), COALESCE(tax_amount, 0)
+`
+
+type transactionRefundRepository struct {
+	conn    *audit.DB
+	mapping recordmapper.TransactionRecordMapping
+}
+
+func NewTransactionRefundRepository(conn *audit.DB, mapping recordmapper.TransactionRecordMapping) *transactionRefundRepository {
+	return &transactionRefundRepository{
+		conn:    conn,
+		mapping: mapping,
+	}
+}
+
+func (r *transactionRefundRepository) FindLedger(ctx context.Context, transaction_id int) (*refund.Ledger, error) {
+	ledger, err := findLedger(ctx, r.conn, transaction_id)
+	if err != nil {
+		return nil, ErrFindRefundLedger
+	}
+
+	return ledger, nil
+}
+
+// CreateRefund records the refund, puts its quantities back in stock,
+// takes back the customer's points in proportion and moves the transaction
+// to the refund's status. It fails with
+// ErrRefundConflict when the status or ledger no longer match what the
+// refund was planned against.
+func (r *transactionRefundRepository) CreateRefund(ctx context.Context, rf *refund.Refund, previousStatus string, previous *refund.Ledger) (*record.TransactionRecord, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, ErrCreateRefund
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var status string
+	var charged int
+	if err := tx.QueryRowContext(ctx, lockTransactionForRefund, int32(rf.TransactionID)).Scan(&status, &charged); err != nil {
+		return nil, ErrCreateRefund
+	}
+
+	current, err := findLedger(ctx, tx, rf.TransactionID)
+	if err != nil {
+		return nil, ErrCreateRefund
+	}
+
+	if status != previousStatus || current.Amount != previous.Amount {
+		return nil, ErrRefundConflict
+	}
+
+	var refundID int32
+	if err := tx.QueryRowContext(ctx, createTransactionRefund,
+		int32(rf.TransactionID),
+		string(rf.Kind),
+		int32(rf.Amount),
+		rf.Reason,
+		int32(rf.OperatorID),
+	).Scan(&refundID); err != nil {
+		return nil, ErrCreateRefund
+	}
+
+	for _, line := range rf.Lines {
+		if _, err := tx.ExecContext(ctx, createTransactionRefundItem, refundID, int32(line.OrderItemID), int32(line.ProductID), int32(line.Quantity), int32(line.Amount)); err != nil {
+			return nil, ErrCreateRefund
+		}
+
+		if _, err := tx.ExecContext(ctx, restockProduct, int32(line.ProductID), int32(line.Quantity)); err != nil {
+			return nil, ErrCreateRefund
+		}
+	}
+
+	var (
+		t                   db.Transaction
+		subtotal, taxAmount int
+	)
+	if err := tx.QueryRowContext(ctx, updateTransactionPaymentStatus, int32(rf.TransactionID), rf.Status).Scan(
+		&t.TransactionID,
+		&t.OrderID,
+		&t.MerchantID,
+		&t.PaymentMethod,
+		&t.Amount,
+		&t.ChangeAmount,
+		&t.PaymentStatus,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+		&t.DeletedAt,
+		&subtotal,
+		&taxAmount,
+	); err != nil {
+		return nil, ErrCreateRefund
+	}
+
+	remaining := charged - current.Amount
+	if rf.Status == refund.StatusRefunded || rf.Status == refund.StatusVoided {
+		remaining = rf.Amount
+	}
+
+	if err := postReversal(ctx, tx.Tx, rf.TransactionID, loyalty.KindRefund, nil, func(net int) int {
+		return loyalty.Reversal(net, rf.Amount, remaining)
+	}); err != nil {
+		return nil, ErrCreateRefund
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, ErrCreateRefund
+	}
+
+	transaction := r.mapping.ToTransactionRecord(&t)
+	transaction.SubtotalAmount = subtotal
+	transaction.TaxAmount = taxAmount
+
+	return transaction, nil
+}
+
+func findLedger(ctx context.Context, conn db.DBTX, transaction_id int) (*refund.Ledger, error) {
+	ledger := &refund.Ledger{Quantities: make(map[int]int)}
+
+	if err := conn.QueryRowContext(ctx, getRefundedAmount, int32(transaction_id)).Scan(&ledger.Amount); err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.QueryContext(ctx, getRefundedQuantities, int32(transaction_id))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var orderItemID, quantity int
+		if err := rows.Scan(&orderItemID, &quantity); err != nil {
+			return nil, err
+		}
+		ledger.Quantities[orderItemID] = quantity
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ledger, nil
+}
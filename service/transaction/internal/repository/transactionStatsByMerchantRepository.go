@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
@@ -11,14 +12,345 @@ import (
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+const getMonthlyNetAmountSuccessByMerchant = `-- name: GetMonthlyNetAmountSuccessByMerchant :many
+WITH
+    amount_movements AS (
+        SELECT
+            t.merchant_id,
+            t.created_at,
+            t.amount,
+            1 AS success_count,
+            t.deleted_at
+        FROM transactions t
+        WHERE t.payment_status IN ('success', 'partially_refunded', 'refunded', 'voided')
+        UNION ALL
+        SELECT
+            t.merchant_id,
+            r.created_at,
+            -r.amount,
+            0 AS success_count,
+            t.deleted_at
+        FROM transaction_refunds r
+        JOIN transactions t ON t.transaction_id = r.transaction_id
+    ),
+    monthly_data AS (
+        SELECT
+            EXTRACT(
+                YEAR
+                FROM t.created_at
+            )::integer AS year,
+            EXTRACT(
+                MONTH
+                FROM t.created_at
+            )::integer AS month,
+            COALESCE(SUM(t.success_count), 0) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        FROM amount_movements t
+        WHERE
+            t.deleted_at IS NULL
+            AND t.merchant_id = $5
+            AND (
+                (
+                    t.created_at >= $1::timestamp
+                    AND t.created_at <= $2::timestamp
+                )
+                OR (
+                    t.created_at >= $3::timestamp
+                    AND t.created_at <= $4::timestamp
+                )
+            )
+        GROUP BY
+            EXTRACT(
+                YEAR
+                FROM t.created_at
+            ),
+            EXTRACT(
+                MONTH
+                FROM t.created_at
+            )
+    ),
+    formatted_data AS (
+        SELECT
+            year::text,
+            TO_CHAR(
+                TO_DATE(month::text, 'MM'),
+                'Mon'
+            ) AS month,
+            total_success,
+            total_amount
+        FROM monthly_data
+        UNION ALL
+        SELECT
+            EXTRACT(
+                YEAR
+                FROM $1::timestamp
+            )::text AS year,
+            TO_CHAR($1::timestamp, 'Mon') AS month,
+            0 AS total_success,
+            0 AS total_amount
+        WHERE
+            NOT EXISTS (
+                SELECT 1
+                FROM monthly_data
+                WHERE
+                    year = EXTRACT(
+                        YEAR
+                        FROM $1::timestamp
+                    )::integer
+                    AND month = EXTRACT(
+                        MONTH
+                        FROM $1::timestamp
+                    )::integer
+            )
+        UNION ALL
+        SELECT
+            EXTRACT(
+                YEAR
+                FROM $3::timestamp
+            )::text AS year,
+            TO_CHAR($3::timestamp, 'Mon') AS month,
+            0 AS total_success,
+            0 AS total_amount
+        WHERE
+            NOT EXISTS (
+                SELECT 1
+                FROM monthly_data
+                WHERE
+                    year = EXTRACT(
+                        YEAR
+                        FROM $3::timestamp
+                    )::integer
+                    AND month = EXTRACT(
+                        MONTH
+                        FROM $3::timestamp
+                    )::integer
+            )
+    )
+SELECT year, month, total_success, total_amount
+FROM formatted_data
+ORDER BY year DESC, TO_DATE(month, 'Mon') DESC
+`
+const getYearlyNetAmountSuccessByMerchant = `-- name: GetYearlyNetAmountSuccessByMerchant :many
+WITH
+    amount_movements AS (
+        SELECT
+            t.merchant_id,
+            t.created_at,
+            t.amount,
+            1 AS success_count,
+            t.deleted_at
+        FROM transactions t
+        WHERE t.payment_status IN ('success', 'partially_refunded', 'refunded', 'voided')
+        UNION ALL
+        SELECT
+            t.merchant_id,
+            r.created_at,
+            -r.amount,
+            0 AS success_count,
+            t.deleted_at
+        FROM transaction_refunds r
+        JOIN transactions t ON t.transaction_id = r.transaction_id
+    ),
+    yearly_data AS (
+        SELECT
+            EXTRACT(
+                YEAR
+                FROM t.created_at
+            )::integer AS year,
+            COALESCE(SUM(t.success_count), 0) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        FROM amount_movements t
+        WHERE
+            t.deleted_at IS NULL
+            AND t.merchant_id = $2
+            AND (
+                EXTRACT(
+                    YEAR
+                    FROM t.created_at
+                ) = $1::integer
+                OR EXTRACT(
+                    YEAR
+                    FROM t.created_at
+                ) = $1::integer - 1
+            )
+        GROUP BY
+            EXTRACT(
+                YEAR
+                FROM t.created_at
+            )
+    ),
+    formatted_data AS (
+        SELECT
+            year::text,
+            total_success::integer,
+            total_amount::integer
+        FROM yearly_data
+        UNION ALL
+        SELECT
+            $1::text AS year,
+            0::integer AS total_success,
+            0::integer AS total_amount
+        WHERE
+            NOT EXISTS (
+                SELECT 1
+                FROM yearly_data
+                WHERE
+                    year = $1::integer
+            )
+        UNION ALL
+        SELECT ($1::integer - 1)::text AS year,
+            0::integer AS total_success,
+            0::integer AS total_amount
+        WHERE
+            NOT EXISTS (
+                SELECT 1
+                FROM yearly_data
+                WHERE
+                    year = $1::integer - 1
+            )
+    )
+SELECT year, total_success, total_amount
+FROM formatted_data
+ORDER BY year DESC
+`
+const getMonthlyTenderMethodsByMerchant = `-- name: GetMonthlyTenderMethodsByMerchant :many
+WITH
+    date_ranges AS (
+        SELECT
+            $1::timestamp AS range1_start,
+            $2::timestamp AS range1_end,
+            $3::timestamp AS range2_start,
+            $4::timestamp AS range2_end
+    ),
+    payment_methods AS (
+        SELECT DISTINCT
+            tt.method AS payment_method
+        FROM transaction_tenders tt
+        JOIN transactions t ON t.transaction_id = tt.transaction_id
+        WHERE t.deleted_at IS NULL
+    ),
+    all_months AS (
+        SELECT generate_series(
+            date_trunc('month', LEAST(
+                (SELECT range1_start FROM date_ranges),
+                (SELECT range2_start FROM date_ranges)
+            )),
+            date_trunc('month', GREATEST(
+                (SELECT range1_end FROM date_ranges),
+                (SELECT range2_end FROM date_ranges)
+            )),
+            interval '1 month'
+        )::date AS activity_month
+    ),
+    all_combinations AS (
+        SELECT
+            am.activity_month,
+            pm.payment_method
+        FROM all_months am
+        CROSS JOIN payment_methods pm
+    ),
+    monthly_tenders AS (
+        SELECT
+            date_trunc('month', t.created_at)::date AS activity_month,
+            tt.method AS payment_method,
+            COUNT(DISTINCT t.transaction_id) AS total_transactions,
+            COALESCE(SUM(tt.amount), 0)::NUMERIC AS total_amount
+        FROM transaction_tenders tt
+        JOIN transactions t ON t.transaction_id = tt.transaction_id
+        JOIN date_ranges dr ON (
+            t.created_at BETWEEN dr.range1_start AND dr.range1_end
+            OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
+        )
+        WHERE
+            t.deleted_at IS NULL
+            AND t.payment_status = $5
+            AND t.merchant_id = $6
+        GROUP BY
+            date_trunc('month', t.created_at),
+            tt.method
+    )
+SELECT
+    TO_CHAR(ac.activity_month, 'Mon') AS month,
+    ac.payment_method,
+    COALESCE(mt.total_transactions, 0) AS total_transactions,
+    COALESCE(mt.total_amount, 0) AS total_amount
+FROM all_combinations ac
+LEFT JOIN monthly_tenders mt ON
+    ac.activity_month = mt.activity_month
+    AND ac.payment_method = mt.payment_method
+ORDER BY
+    ac.activity_month,
+    ac.payment_method
+`
+
+const getYearlyTenderMethodsByMerchant = `-- name: GetYearlyTenderMethodsByMerchant :many
+WITH
+    year_range AS (
+        SELECT
+            EXTRACT(YEAR FROM $1::timestamp)::int - 1 AS start_year,
+            EXTRACT(YEAR FROM $1::timestamp)::int AS end_year
+    ),
+    payment_methods AS (
+        SELECT DISTINCT
+            tt.method AS payment_method
+        FROM transaction_tenders tt
+        JOIN transactions t ON t.transaction_id = tt.transaction_id
+        WHERE t.deleted_at IS NULL
+    ),
+    all_years AS (
+        SELECT generate_series(
+            (SELECT start_year FROM year_range),
+            (SELECT end_year FROM year_range)
+        )::int AS year
+    ),
+    all_combinations AS (
+        SELECT
+            ay.year::text AS year,
+            pm.payment_method
+        FROM all_years ay
+        CROSS JOIN payment_methods pm
+    ),
+    yearly_tenders AS (
+        SELECT
+            EXTRACT(YEAR FROM t.created_at)::int::text AS year,
+            tt.method AS payment_method,
+            COUNT(DISTINCT t.transaction_id) AS total_transactions,
+            COALESCE(SUM(tt.amount), 0)::NUMERIC AS total_amount
+        FROM transaction_tenders tt
+        JOIN transactions t ON t.transaction_id = tt.transaction_id
+        WHERE
+            t.deleted_at IS NULL
+            AND t.payment_status = $2
+            AND t.merchant_id = $3
+            AND EXTRACT(YEAR FROM t.created_at) BETWEEN (SELECT start_year FROM year_range) AND (SELECT end_year FROM year_range)
+        GROUP BY
+            EXTRACT(YEAR FROM t.created_at),
+            tt.method
+    )
+SELECT
+    ac.year,
+    ac.payment_method,
+    COALESCE(yt.total_transactions, 0) AS total_transactions,
+    COALESCE(yt.total_amount, 0) AS total_amount
+FROM all_combinations ac
+LEFT JOIN yearly_tenders yt ON
+    ac.year = yt.year
+    AND ac.payment_method = yt.payment_method
+ORDER BY
+    ac.year,
+    ac.payment_method
+`
+
 type transactionStatsByMerchantRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.TransactionRecordMapping
 }
 
-func NewTransactionStatsByMerchantRepository(db *db.Queries, mapping recordmapper.TransactionRecordMapping) *transactionStatsByMerchantRepository {
+func NewTransactionStatsByMerchantRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.TransactionRecordMapping) *transactionStatsByMerchantRepository {
 	return &transactionStatsByMerchantRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -30,13 +362,9 @@ func (r *transactionStatsByMerchantRepository) GetMonthlyAmountSuccessByMerchant
 	lastDayCurrentMonth := currentDate.AddDate(0, 1, -1)
 	lastDayPrevMonth := prevDate.AddDate(0, 1, -1)
 
-	res, err := r.db.GetMonthlyAmountTransactionSuccessByMerchant(ctx, db.GetMonthlyAmountTransactionSuccessByMerchantParams{
-		Column1:    currentDate,
-		Column2:    lastDayCurrentMonth,
-		Column3:    prevDate,
-		Column4:    lastDayPrevMonth,
-		MerchantID: int32(req.MerchantID),
-	})
+	res, err := queryRows(ctx, r.conn, getMonthlyNetAmountSuccessByMerchant, func(rows *sql.Rows, i *db.GetMonthlyAmountTransactionSuccessByMerchantRow) error {
+		return rows.Scan(&i.Year, &i.Month, &i.TotalSuccess, &i.TotalAmount)
+	}, currentDate, lastDayCurrentMonth, prevDate, lastDayPrevMonth, int32(req.MerchantID))
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetMonthlyAmountSuccessByMerchant
@@ -46,10 +374,9 @@ func (r *transactionStatsByMerchantRepository) GetMonthlyAmountSuccessByMerchant
 }
 
 func (r *transactionStatsByMerchantRepository) GetYearlyAmountSuccessByMerchant(ctx context.Context, req *requests.YearAmountTransactionMerchant) ([]*record.TransactionYearlyAmountSuccessRecord, error) {
-	res, err := r.db.GetYearlyAmountTransactionSuccessByMerchant(ctx, db.GetYearlyAmountTransactionSuccessByMerchantParams{
-		Column1:    int32(req.Year),
-		MerchantID: int32(req.MerchantID),
-	})
+	res, err := queryRows(ctx, r.conn, getYearlyNetAmountSuccessByMerchant, func(rows *sql.Rows, i *db.GetYearlyAmountTransactionSuccessByMerchantRow) error {
+		return rows.Scan(&i.Year, &i.TotalSuccess, &i.TotalAmount)
+	}, int32(req.Year), int32(req.MerchantID))
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetYearlyAmountSuccessByMerchant
@@ -100,34 +427,25 @@ func (r *transactionStatsByMerchantRepository) GetMonthlyTransactionMethodByMerc
 	lastDayCurrentMonth := currentDate.AddDate(0, 1, -1)
 	lastDayPrevMonth := prevDate.AddDate(0, 1, -1)
 
-	res, err := r.db.GetMonthlyTransactionMethodsByMerchantSuccess(ctx, db.GetMonthlyTransactionMethodsByMerchantSuccessParams{
-		Column1:    currentDate,
-		Column2:    lastDayCurrentMonth,
-		Column3:    prevDate,
-		Column4:    lastDayPrevMonth,
-		MerchantID: int32(req.MerchantID),
-	})
+	res, err := queryMonthlyMethods(ctx, r.conn, getMonthlyTenderMethodsByMerchant, currentDate, lastDayCurrentMonth, prevDate, lastDayPrevMonth, "success", int32(req.MerchantID))
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetMonthlyTransactionMethodByMerchant
 	}
 
-	return r.mapping.ToTransactionMonthlyByMerchantMethodSuccess(res), nil
+	return res, nil
 }
 
 func (r *transactionStatsByMerchantRepository) GetYearlyTransactionMethodByMerchantSuccess(ctx context.Context, req *requests.YearMethodTransactionMerchant) ([]*record.TransactionYearlyMethodRecord, error) {
 	yearStart := time.Date(req.Year, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	res, err := r.db.GetYearlyTransactionMethodsByMerchantSuccess(ctx, db.GetYearlyTransactionMethodsByMerchantSuccessParams{
-		Column1:    yearStart,
-		MerchantID: int32(req.MerchantID),
-	})
+	res, err := queryYearlyMethods(ctx, r.conn, getYearlyTenderMethodsByMerchant, yearStart, "success", int32(req.MerchantID))
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetYearlyTransactionMethodByMerchant
 	}
 
-	return r.mapping.ToTransactionYearlyMethodByMerchantSuccess(res), nil
+	return res, nil
 }
 
 func (r *transactionStatsByMerchantRepository) GetMonthlyTransactionMethodByMerchantFailed(ctx context.Context, req *requests.MonthMethodTransactionMerchant) ([]*record.TransactionMonthlyMethodRecord, error) {
@@ -137,32 +455,23 @@ func (r *transactionStatsByMerchantRepository) GetMonthlyTransactionMethodByMerc
 	lastDayCurrentMonth := currentDate.AddDate(0, 1, -1)
 	lastDayPrevMonth := prevDate.AddDate(0, 1, -1)
 
-	res, err := r.db.GetMonthlyTransactionMethodsByMerchantFailed(ctx, db.GetMonthlyTransactionMethodsByMerchantFailedParams{
-		Column1:    currentDate,
-		Column2:    lastDayCurrentMonth,
-		Column3:    prevDate,
-		Column4:    lastDayPrevMonth,
-		MerchantID: int32(req.MerchantID),
-	})
+	res, err := queryMonthlyMethods(ctx, r.conn, getMonthlyTenderMethodsByMerchant, currentDate, lastDayCurrentMonth, prevDate, lastDayPrevMonth, "failed", int32(req.MerchantID))
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetMonthlyTransactionMethodByMerchant
 	}
 
-	return r.mapping.ToTransactionMonthlyByMerchantMethodFailed(res), nil
+	return res, nil
 }
 
 func (r *transactionStatsByMerchantRepository) GetYearlyTransactionMethodByMerchantFailed(ctx context.Context, req *requests.YearMethodTransactionMerchant) ([]*record.TransactionYearlyMethodRecord, error) {
 	yearStart := time.Date(req.Year, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	res, err := r.db.GetYearlyTransactionMethodsByMerchantFailed(ctx, db.GetYearlyTransactionMethodsByMerchantFailedParams{
-		Column1:    yearStart,
-		MerchantID: int32(req.MerchantID),
-	})
+	res, err := queryYearlyMethods(ctx, r.conn, getYearlyTenderMethodsByMerchant, yearStart, "failed", int32(req.MerchantID))
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetYearlyTransactionMethodByMerchant
 	}
 
-	return r.mapping.ToTransactionYearlyMethodByMerchantFailed(res), nil
+	return res, nil
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
@@ -11,14 +12,346 @@ import (
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// Amount stats net refunds and voids out of the month or year they happened
+// in, alongside the original charge.
+const getMonthlyNetAmountSuccess = `-- name: GetMonthlyNetAmountSuccess :many
+WITH
+    amount_movements AS (
+        SELECT
+            t.merchant_id,
+            t.created_at,
+            t.amount,
+            1 AS success_count,
+            t.deleted_at
+        FROM transactions t
+        WHERE t.payment_status IN ('success', 'partially_refunded', 'refunded', 'voided')
+        UNION ALL
+        SELECT
+            t.merchant_id,
+            r.created_at,
+            -r.amount,
+            0 AS success_count,
+            t.deleted_at
+        FROM transaction_refunds r
+        JOIN transactions t ON t.transaction_id = r.transaction_id
+    ),
+    monthly_data AS (
+        SELECT
+            EXTRACT(
+                YEAR
+                FROM t.created_at
+            )::integer AS year,
+            EXTRACT(
+                MONTH
+                FROM t.created_at
+            )::integer AS month,
+            COALESCE(SUM(t.success_count), 0) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        FROM amount_movements t
+        WHERE
+            t.deleted_at IS NULL
+            AND (
+                (
+                    t.created_at >= $1::timestamp
+                    AND t.created_at <= $2::timestamp
+                )
+                OR (
+                    t.created_at >= $3::timestamp
+                    AND t.created_at <= $4::timestamp
+                )
+            )
+        GROUP BY
+            EXTRACT(
+                YEAR
+                FROM t.created_at
+            ),
+            EXTRACT(
+                MONTH
+                FROM t.created_at
+            )
+    ),
+    formatted_data AS (
+        SELECT
+            year::text,
+            TO_CHAR(
+                TO_DATE(month::text, 'MM'),
+                'Mon'
+            ) AS month,
+            total_success,
+            total_amount
+        FROM monthly_data
+        UNION ALL
+        SELECT
+            EXTRACT(
+                YEAR
+                FROM $1::timestamp
+            )::text AS year,
+            TO_CHAR($1::timestamp, 'Mon') AS month,
+            0 AS total_success,
+            0 AS total_amount
+        WHERE
+            NOT EXISTS (
+                SELECT 1
+                FROM monthly_data
+                WHERE
+                    year = EXTRACT(
+                        YEAR
+                        FROM $1::timestamp
+                    )::integer
+                    AND month = EXTRACT(
+                        MONTH
+                        FROM $1::timestamp
+                    )::integer
+            )
+        UNION ALL
+        SELECT
+            EXTRACT(
+                YEAR
+                FROM $3::timestamp
+            )::text AS year,
+            TO_CHAR($3::timestamp, 'Mon') AS month,
+            0 AS total_success,
+            0 AS total_amount
+        WHERE
+            NOT EXISTS (
+                SELECT 1
+                FROM monthly_data
+                WHERE
+                    year = EXTRACT(
+                        YEAR
+                        FROM $3::timestamp
+                    )::integer
+                    AND month = EXTRACT(
+                        MONTH
+                        FROM $3::timestamp
+                    )::integer
+            )
+    )
+SELECT year, month, total_success, total_amount
+FROM formatted_data
+ORDER BY year DESC, TO_DATE(month, 'Mon') DESC
+`
+const getYearlyNetAmountSuccess = `-- name: GetYearlyNetAmountSuccess :many
+WITH
+    amount_movements AS (
+        SELECT
+            t.merchant_id,
+            t.created_at,
+            t.amount,
+            1 AS success_count,
+            t.deleted_at
+        FROM transactions t
+        WHERE t.payment_status IN ('success', 'partially_refunded', 'refunded', 'voided')
+        UNION ALL
+        SELECT
+            t.merchant_id,
+            r.created_at,
+            -r.amount,
+            0 AS success_count,
+            t.deleted_at
+        FROM transaction_refunds r
+        JOIN transactions t ON t.transaction_id = r.transaction_id
+    ),
+    yearly_data AS (
+        SELECT
+            EXTRACT(
+                YEAR
+                FROM t.created_at
+            )::integer AS year,
+            COALESCE(SUM(t.success_count), 0) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        FROM amount_movements t
+        WHERE
+            t.deleted_at IS NULL
+            AND (
+                EXTRACT(
+                    YEAR
+                    FROM t.created_at
+                ) = $1::integer
+                OR EXTRACT(
+                    YEAR
+                    FROM t.created_at
+                ) = $1::integer - 1
+            )
+        GROUP BY
+            EXTRACT(
+                YEAR
+                FROM t.created_at
+            )
+    ),
+    formatted_data AS (
+        SELECT
+            year::text,
+            total_success::integer,
+            total_amount::integer
+        FROM yearly_data
+        UNION ALL
+        SELECT
+            $1::text AS year,
+            0::integer AS total_success,
+            0::integer AS total_amount
+        WHERE
+            NOT EXISTS (
+                SELECT 1
+                FROM yearly_data
+                WHERE
+                    year = $1::integer
+            )
+        UNION ALL
+        SELECT ($1::integer - 1)::text AS year,
+            0::integer AS total_success,
+            0::integer AS total_amount
+        WHERE
+            NOT EXISTS (
+                SELECT 1
+                FROM yearly_data
+                WHERE
+                    year = $1::integer - 1
+            )
+    )
+SELECT year, total_success, total_amount
+FROM formatted_data
+ORDER BY year DESC
+`
+
+// The method breakdowns are aggregated per tender rather than per
+// transaction, so a split payment counts towards each method it used.
+const getMonthlyTenderMethods = `-- name: GetMonthlyTenderMethods :many
+WITH
+    date_ranges AS (
+        SELECT
+            $1::timestamp AS range1_start,
+            $2::timestamp AS range1_end,
+            $3::timestamp AS range2_start,
+            $4::timestamp AS range2_end
+    ),
+    payment_methods AS (
+        SELECT DISTINCT
+            tt.method AS payment_method
+        FROM transaction_tenders tt
+        JOIN transactions t ON t.transaction_id = tt.transaction_id
+        WHERE t.deleted_at IS NULL
+    ),
+    all_months AS (
+        SELECT generate_series(
+            date_trunc('month', LEAST(
+                (SELECT range1_start FROM date_ranges),
+                (SELECT range2_start FROM date_ranges)
+            )),
+            date_trunc('month', GREATEST(
+                (SELECT range1_end FROM date_ranges),
+                (SELECT range2_end FROM date_ranges)
+            )),
+            interval '1 month'
+        )::date AS activity_month
+    ),
+    all_combinations AS (
+        SELECT
+            am.activity_month,
+            pm.payment_method
+        FROM all_months am
+        CROSS JOIN payment_methods pm
+    ),
+    monthly_tenders AS (
+        SELECT
+            date_trunc('month', t.created_at)::date AS activity_month,
+            tt.method AS payment_method,
+            COUNT(DISTINCT t.transaction_id) AS total_transactions,
+            COALESCE(SUM(tt.amount), 0)::NUMERIC AS total_amount
+        FROM transaction_tenders tt
+        JOIN transactions t ON t.transaction_id = tt.transaction_id
+        JOIN date_ranges dr ON (
+            t.created_at BETWEEN dr.range1_start AND dr.range1_end
+            OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
+        )
+        WHERE
+            t.deleted_at IS NULL
+            AND t.payment_status = $5
+        GROUP BY
+            date_trunc('month', t.created_at),
+            tt.method
+    )
+SELECT
+    TO_CHAR(ac.activity_month, 'Mon') AS month,
+    ac.payment_method,
+    COALESCE(mt.total_transactions, 0) AS total_transactions,
+    COALESCE(mt.total_amount, 0) AS total_amount
+FROM all_combinations ac
+LEFT JOIN monthly_tenders mt ON
+    ac.activity_month = mt.activity_month
+    AND ac.payment_method = mt.payment_method
+ORDER BY
+    ac.activity_month,
+    ac.payment_method
+`
+
+const getYearlyTenderMethods = `-- name: GetYearlyTenderMethods :many
+WITH
+    year_range AS (
+        SELECT
+            EXTRACT(YEAR FROM $1::timestamp)::int - 1 AS start_year,
+            EXTRACT(YEAR FROM $1::timestamp)::int AS end_year
+    ),
+    payment_methods AS (
+        SELECT DISTINCT
+            tt.method AS payment_method
+        FROM transaction_tenders tt
+        JOIN transactions t ON t.transaction_id = tt.transaction_id
+        WHERE t.deleted_at IS NULL
+    ),
+    all_years AS (
+        SELECT generate_series(
+            (SELECT start_year FROM year_range),
+            (SELECT end_year FROM year_range)
+        )::int AS year
+    ),
+    all_combinations AS (
+        SELECT
+            ay.year::text AS year,
+            pm.payment_method
+        FROM all_years ay
+        CROSS JOIN payment_methods pm
+    ),
+    yearly_tenders AS (
+        SELECT
+            EXTRACT(YEAR FROM t.created_at)::int::text AS year,
+            tt.method AS payment_method,
+            COUNT(DISTINCT t.transaction_id) AS total_transactions,
+            COALESCE(SUM(tt.amount), 0)::NUMERIC AS total_amount
+        FROM transaction_tenders tt
+        JOIN transactions t ON t.transaction_id = tt.transaction_id
+        WHERE
+            t.deleted_at IS NULL
+            AND t.payment_status = $2
+            AND EXTRACT(YEAR FROM t.created_at) BETWEEN (SELECT start_year FROM year_range) AND (SELECT end_year FROM year_range)
+        GROUP BY
+            EXTRACT(YEAR FROM t.created_at),
+            tt.method
+    )
+SELECT
+    ac.year,
+    ac.payment_method,
+    COALESCE(yt.total_transactions, 0) AS total_transactions,
+    COALESCE(yt.total_amount, 0) AS total_amount
+FROM all_combinations ac
+LEFT JOIN yearly_tenders yt ON
+    ac.year = yt.year
+    AND ac.payment_method = yt.payment_method
+ORDER BY
+    ac.year,
+    ac.payment_method
+`
+
 type transactonStatsRepository struct {
 	db      *db.Queries
+	conn    db.DBTX
 	mapping recordmapper.TransactionRecordMapping
 }
 
-func NewTransactionStatsRepository(db *db.Queries, mapping recordmapper.TransactionRecordMapping) *transactonStatsRepository {
+func NewTransactionStatsRepository(db *db.Queries, conn db.DBTX, mapping recordmapper.TransactionRecordMapping) *transactonStatsRepository {
 	return &transactonStatsRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
@@ -30,12 +363,9 @@ func (r *transactonStatsRepository) GetMonthlyAmountSuccess(ctx context.Context,
 	lastDayCurrentMonth := currentDate.AddDate(0, 1, -1)
 	lastDayPrevMonth := prevDate.AddDate(0, 1, -1)
 
-	res, err := r.db.GetMonthlyAmountTransactionSuccess(ctx, db.GetMonthlyAmountTransactionSuccessParams{
-		Column1: currentDate,
-		Column2: lastDayCurrentMonth,
-		Column3: prevDate,
-		Column4: lastDayPrevMonth,
-	})
+	res, err := queryRows(ctx, r.conn, getMonthlyNetAmountSuccess, func(rows *sql.Rows, i *db.GetMonthlyAmountTransactionSuccessRow) error {
+		return rows.Scan(&i.Year, &i.Month, &i.TotalSuccess, &i.TotalAmount)
+	}, currentDate, lastDayCurrentMonth, prevDate, lastDayPrevMonth)
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetMonthlyAmountSuccess
@@ -45,7 +375,9 @@ func (r *transactonStatsRepository) GetMonthlyAmountSuccess(ctx context.Context,
 }
 
 func (r *transactonStatsRepository) GetYearlyAmountSuccess(ctx context.Context, year int) ([]*record.TransactionYearlyAmountSuccessRecord, error) {
-	res, err := r.db.GetYearlyAmountTransactionSuccess(ctx, int32(year))
+	res, err := queryRows(ctx, r.conn, getYearlyNetAmountSuccess, func(rows *sql.Rows, i *db.GetYearlyAmountTransactionSuccessRow) error {
+		return rows.Scan(&i.Year, &i.TotalSuccess, &i.TotalAmount)
+	}, int32(year))
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetYearlyAmountSuccess
@@ -92,30 +424,25 @@ func (r *transactonStatsRepository) GetMonthlyTransactionMethodSuccess(ctx conte
 	lastDayCurrentMonth := currentDate.AddDate(0, 1, -1)
 	lastDayPrevMonth := prevDate.AddDate(0, 1, -1)
 
-	res, err := r.db.GetMonthlyTransactionMethodsSuccess(ctx, db.GetMonthlyTransactionMethodsSuccessParams{
-		Column1: currentDate,
-		Column2: lastDayCurrentMonth,
-		Column3: prevDate,
-		Column4: lastDayPrevMonth,
-	})
+	res, err := queryMonthlyMethods(ctx, r.conn, getMonthlyTenderMethods, currentDate, lastDayCurrentMonth, prevDate, lastDayPrevMonth, "success")
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetMonthlyTransactionMethod
 	}
 
-	return r.mapping.ToTransactionMonthlyMethodSuccess(res), nil
+	return res, nil
 }
 
 func (r *transactonStatsRepository) GetYearlyTransactionMethodSuccess(ctx context.Context, year int) ([]*record.TransactionYearlyMethodRecord, error) {
 	yearStart := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	res, err := r.db.GetYearlyTransactionMethodsSuccess(ctx, yearStart)
+	res, err := queryYearlyMethods(ctx, r.conn, getYearlyTenderMethods, yearStart, "success")
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetYearlyTransactionMethod
 	}
 
-	return r.mapping.ToTransactionYearlyMethodSuccess(res), nil
+	return res, nil
 }
 
 func (r *transactonStatsRepository) GetMonthlyTransactionMethodFailed(ctx context.Context, req *requests.MonthMethodTransaction) ([]*record.TransactionMonthlyMethodRecord, error) {
@@ -125,28 +452,68 @@ func (r *transactonStatsRepository) GetMonthlyTransactionMethodFailed(ctx contex
 	lastDayCurrentMonth := currentDate.AddDate(0, 1, -1)
 	lastDayPrevMonth := prevDate.AddDate(0, 1, -1)
 
-	res, err := r.db.GetMonthlyTransactionMethodsFailed(ctx, db.GetMonthlyTransactionMethodsFailedParams{
-		Column1: currentDate,
-		Column2: lastDayCurrentMonth,
-		Column3: prevDate,
-		Column4: lastDayPrevMonth,
-	})
+	res, err := queryMonthlyMethods(ctx, r.conn, getMonthlyTenderMethods, currentDate, lastDayCurrentMonth, prevDate, lastDayPrevMonth, "failed")
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetMonthlyTransactionMethod
 	}
 
-	return r.mapping.ToTransactionMonthlyMethodFailed(res), nil
+	return res, nil
 }
 
 func (r *transactonStatsRepository) GetYearlyTransactionMethodFailed(ctx context.Context, year int) ([]*record.TransactionYearlyMethodRecord, error) {
 	yearStart := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	res, err := r.db.GetYearlyTransactionMethodsFailed(ctx, yearStart)
+	res, err := queryYearlyMethods(ctx, r.conn, getYearlyTenderMethods, yearStart, "failed")
 
 	if err != nil {
 		return nil, transaction_errors.ErrGetYearlyTransactionMethod
 	}
 
-	return r.mapping.ToTransactionYearlyMethodFailed(res), nil
+	return res, nil
+}
+
+func queryMonthlyMethods(ctx context.Context, conn db.DBTX, query string, args ...interface{}) ([]*record.TransactionMonthlyMethodRecord, error) {
+	return queryRows(ctx, conn, query, func(rows *sql.Rows, i *record.TransactionMonthlyMethodRecord) error {
+		var totalAmount float64
+		if err := rows.Scan(&i.Month, &i.PaymentMethod, &i.TotalTransactions, &totalAmount); err != nil {
+			return err
+		}
+		i.TotalAmount = int(totalAmount)
+		return nil
+	}, args...)
+}
+
+func queryYearlyMethods(ctx context.Context, conn db.DBTX, query string, args ...interface{}) ([]*record.TransactionYearlyMethodRecord, error) {
+	return queryRows(ctx, conn, query, func(rows *sql.Rows, i *record.TransactionYearlyMethodRecord) error {
+		var totalAmount float64
+		if err := rows.Scan(&i.Year, &i.PaymentMethod, &i.TotalTransactions, &totalAmount); err != nil {
+			return err
+		}
+		i.TotalAmount = int(totalAmount)
+		return nil
+	}, args...)
+}
+
+func queryRows[T any](ctx context.Context, conn db.DBTX, query string, scan func(rows *sql.Rows, item *T) error, args ...interface{}) ([]*T, error) {
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*T
+	for rows.Next() {
+		var i T
+		if err := scan(rows, &i); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
 }
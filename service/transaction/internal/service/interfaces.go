@@ -5,6 +5,9 @@ import (
 
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/refund"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tender"
 )
 
 type TransactionStatsService interface {
@@ -44,11 +47,20 @@ type TransactionQueryService interface {
 }
 
 type TransactionCommandService interface {
-	CreateTransaction(ctx context.Context, req *requests.CreateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse)
-	UpdateTransaction(ctx context.Context, req *requests.UpdateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse)
+	CreateTransaction(ctx context.Context, req *requests.CreateTransactionRequest, tenders []tender.Tender) (*response.TransactionResponse, *response.ErrorResponse)
+	UpdateTransaction(ctx context.Context, req *requests.UpdateTransactionRequest, tenders []tender.Tender) (*response.TransactionResponse, *response.ErrorResponse)
+	RefundTransaction(ctx context.Context, req *refund.Request) (*response.TransactionResponse, *response.ErrorResponse)
+	VoidTransaction(ctx context.Context, req *refund.VoidRequest) (*response.TransactionResponse, *response.ErrorResponse)
 	TrashedTransaction(ctx context.Context, transaction_id int) (*response.TransactionResponseDeleteAt, *response.ErrorResponse)
 	RestoreTransaction(ctx context.Context, transaction_id int) (*response.TransactionResponseDeleteAt, *response.ErrorResponse)
 	DeleteTransactionPermanently(ctx context.Context, transactionID int) (bool, *response.ErrorResponse)
 	RestoreAllTransactions(ctx context.Context) (bool, *response.ErrorResponse)
 	DeleteAllTransactionPermanent(ctx context.Context) (bool, *response.ErrorResponse)
 }
+
+type LoyaltyService interface {
+	FindProgram(ctx context.Context, merchantID int) (*loyalty.Program, *response.ErrorResponse)
+	SaveProgram(ctx context.Context, program *loyalty.Program) (*loyalty.Program, *response.ErrorResponse)
+	FindBalance(ctx context.Context, customerID int) (*loyalty.Balance, *response.ErrorResponse)
+	FindHistory(ctx context.Context, req *loyalty.HistoryRequest) ([]*loyalty.Entry, *int, *response.ErrorResponse)
+}
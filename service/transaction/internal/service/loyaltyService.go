@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/repository"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type loyaltyService struct {
+	errorhandler      errorhandler.LoyaltyError
+	trace             trace.Tracer
+	loyaltyRepository repository.LoyaltyRepository
+	logger            logger.LoggerInterface
+	requestCounter    *prometheus.CounterVec
+	requestDuration   *prometheus.HistogramVec
+}
+
+func NewLoyaltyService(
+	errorhandler errorhandler.LoyaltyError,
+	loyaltyRepository repository.LoyaltyRepository,
+	logger logger.LoggerInterface,
+) *loyaltyService {
+	requestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "loyalty_service_request_count",
+			Help: "Total number of requests to the LoyaltyService",
+		},
+		[]string{"method", "status"},
+	)
+
+	requestDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "loyalty_service_request_duration",
+			Help:    "Histogram of request durations for the LoyaltyService",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	prometheus.MustRegister(requestCounter, requestDuration)
+
+	return &loyaltyService{
+		errorhandler:      errorhandler,
+		trace:             otel.Tracer("loyalty-service"),
+		loyaltyRepository: loyaltyRepository,
+		logger:            logger,
+		requestCounter:    requestCounter,
+		requestDuration:   requestDuration,
+	}
+}
+
+func (s *loyaltyService) FindProgram(ctx context.Context, merchantID int) (*loyalty.Program, *response.ErrorResponse) {
+	const method = "FindProgram"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("merchant.id", merchantID))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.loyaltyRepository.FindProgram(ctx, merchantID)
+	if err != nil {
+		return s.errorhandler.HandleFindProgramError(err, method, "FAILED_FIND_LOYALTY_PROGRAM", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched loyalty program", zap.Int("merchant.id", merchantID))
+
+	return res, nil
+}
+
+// SaveProgram sets a merchant's loyalty rule. A changed rule applies to
+// payments from then on; points already earned keep their value and expiry.
+func (s *loyaltyService) SaveProgram(ctx context.Context, program *loyalty.Program) (*loyalty.Program, *response.ErrorResponse) {
+	const method = "SaveProgram"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("merchant.id", program.MerchantID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if err := program.Validate(); err != nil {
+		return s.errorhandler.HandleSaveProgramError(err, method, "FAILED_INVALID_LOYALTY_PROGRAM", span, &status, zap.Error(err))
+	}
+
+	res, err := s.loyaltyRepository.SaveProgram(ctx, program)
+	if err != nil {
+		return s.errorhandler.HandleSaveProgramError(err, method, "FAILED_SAVE_LOYALTY_PROGRAM", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully saved loyalty program", zap.Int("merchant.id", program.MerchantID))
+
+	return res, nil
+}
+
+func (s *loyaltyService) FindBalance(ctx context.Context, customerID int) (*loyalty.Balance, *response.ErrorResponse) {
+	const method = "FindBalance"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", customerID))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.loyaltyRepository.FindBalance(ctx, customerID)
+	if err != nil {
+		return s.errorhandler.HandleFindBalanceError(err, method, "FAILED_FIND_LOYALTY_BALANCE", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched loyalty balance", zap.Int("customer.id", customerID))
+
+	return res, nil
+}
+
+func (s *loyaltyService) FindHistory(ctx context.Context, req *loyalty.HistoryRequest) ([]*loyalty.Entry, *int, *response.ErrorResponse) {
+	const method = "FindHistory"
+
+	req.Page, req.PageSize = s.normalizePagination(req.Page, req.PageSize)
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("customer.id", req.CustomerID), attribute.Int("page", req.Page), attribute.Int("pageSize", req.PageSize))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, total, err := s.loyaltyRepository.FindHistory(ctx, req)
+	if err != nil {
+		return s.errorhandler.HandleFindHistoryError(err, method, "FAILED_FIND_LOYALTY_HISTORY", span, &status, zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched loyalty history", zap.Int("customer.id", req.CustomerID), zap.Int("page", req.Page), zap.Int("pageSize", req.PageSize))
+
+	return res, total, nil
+}
+
+func (s *loyaltyService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
+	context.Context,
+	trace.Span,
+	func(string),
+	string,
+	func(string, ...zap.Field),
+) {
+	start := time.Now()
+	status := "success"
+
+	ctx, span := s.trace.Start(ctx, method)
+
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+
+	span.AddEvent("Start: " + method)
+
+	s.logger.Debug("Start: " + method)
+
+	end := func(status string) {
+		s.recordMetrics(method, status, start)
+		code := codes.Ok
+		if status != "success" {
+			code = codes.Error
+		}
+		span.SetStatus(code, status)
+		span.End()
+	}
+
+	logSuccess := func(msg string, fields ...zap.Field) {
+		span.AddEvent(msg)
+		s.logger.Debug(msg, fields...)
+	}
+
+	return ctx, span, end, status, logSuccess
+}
+
+func (s *loyaltyService) recordMetrics(method string, status string, start time.Time) {
+	s.requestCounter.WithLabelValues(method, status).Inc()
+	s.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (s *loyaltyService) normalizePagination(page, pageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	return page, pageSize
+}
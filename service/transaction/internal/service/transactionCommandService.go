@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
 	"time"
 
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
+	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/response"
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/cashier_errors"
@@ -14,8 +18,13 @@ import (
 	"github.com/MamangRust/monolith-point-of-sale-shared/errors/transaction_errors"
 	response_service "github.com/MamangRust/monolith-point-of-sale-shared/mapper/response/service"
 	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/errorhandler"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/loyalty"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/receipt"
 	mencache "github.com/MamangRust/monolith-point-of-sale-transacton/internal/redis"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/refund"
 	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/repository"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tax"
+	"github.com/MamangRust/monolith-point-of-sale-transacton/internal/tender"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -34,6 +43,10 @@ type transactionCommandService struct {
 	transactionCommandRepository repository.TransactionCommandRepository
 	orderQueryRepository         repository.OrderQueryRepository
 	orderItemQueryRepository     repository.OrderItemQueryRepository
+	taxProfileRepository         repository.TaxProfileRepository
+	refundRepository             repository.TransactionRefundRepository
+	loyaltyRepository            repository.LoyaltyRepository
+	taxCalculator                tax.Calculator
 	mapping                      response_service.TransactionResponseMapper
 	logger                       logger.LoggerInterface
 	requestCounter               *prometheus.CounterVec
@@ -49,6 +62,10 @@ func NewTransactionCommandService(
 	transactionCommandRepository repository.TransactionCommandRepository,
 	orderQueryRepository repository.OrderQueryRepository,
 	orderItemQueryRepository repository.OrderItemQueryRepository,
+	taxProfileRepository repository.TaxProfileRepository,
+	refundRepository repository.TransactionRefundRepository,
+	loyaltyRepository repository.LoyaltyRepository,
+	taxCalculator tax.Calculator,
 	mapping response_service.TransactionResponseMapper,
 	logger logger.LoggerInterface,
 ) *transactionCommandService {
@@ -81,6 +98,10 @@ func NewTransactionCommandService(
 		transactionCommandRepository: transactionCommandRepository,
 		orderQueryRepository:         orderQueryRepository,
 		orderItemQueryRepository:     orderItemQueryRepository,
+		taxProfileRepository:         taxProfileRepository,
+		refundRepository:             refundRepository,
+		loyaltyRepository:            loyaltyRepository,
+		taxCalculator:                taxCalculator,
 		mapping:                      mapping,
 		logger:                       logger,
 		requestCounter:               requestCounter,
@@ -88,7 +109,7 @@ func NewTransactionCommandService(
 	}
 }
 
-func (s *transactionCommandService) CreateTransaction(ctx context.Context, req *requests.CreateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+func (s *transactionCommandService) CreateTransaction(ctx context.Context, req *requests.CreateTransactionRequest, tenders []tender.Tender) (*response.TransactionResponse, *response.ErrorResponse) {
 	const method = "CreateTransaction"
 
 	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("cashier.id", req.CashierID), attribute.Int("merchant.id", req.MerchantID), attribute.Int("order.id", req.OrderID))
@@ -102,7 +123,7 @@ func (s *transactionCommandService) CreateTransaction(ctx context.Context, req *
 		return errorhandler.HandleRepositorySingleError[*response.TransactionResponse](s.logger, err, method, "FAILED_FIND_CASHIER", span, &status, cashier_errors.ErrFailedFindCashierById, zap.Error(err))
 	}
 
-	_, err = s.merchantQueryRepository.FindById(ctx, cashier.MerchantID)
+	merchant, err := s.merchantQueryRepository.FindById(ctx, cashier.MerchantID)
 	if err != nil {
 		return errorhandler.HandleRepositorySingleError[*response.TransactionResponse](s.logger, err, method, "FAILED_FIND_MERCHANT", span, &status, merchant_errors.ErrFailedFindMerchantById, zap.Error(err))
 	}
@@ -122,34 +143,43 @@ func (s *transactionCommandService) CreateTransaction(ctx context.Context, req *
 		return errorhandler.HandleCannotOrderItem[*response.TransactionResponse](s.logger, err, method, "CANNOT_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedOrderItemEmpty, zap.Error(err))
 	}
 
-	var totalAmount int
 	for _, item := range orderItems {
 		if item.Quantity <= 0 {
 			return errorhandler.HandleInvalidQuantityOrderItem[*response.TransactionResponse](s.logger, err, method, "INVALID_QUANTITY_ORDER_ITEM", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
 		}
-		totalAmount += item.Price * item.Quantity
 	}
 
-	ppn := totalAmount * 11 / 100
-	totalAmountWithTax := totalAmount + ppn
+	breakdown, errResp := s.calculateTax(ctx, method, span, &status, req.MerchantID, req.OrderID)
+	if errResp != nil {
+		return nil, errResp
+	}
 
-	span.SetAttributes(
-		attribute.Int("amount.subtotal", totalAmount),
-		attribute.Int("amount.tax", ppn),
-		attribute.Int("amount.total", totalAmountWithTax),
-	)
+	settlement, errResp := s.settle(method, span, &status, breakdown.Total, tenders)
+	if errResp != nil {
+		return nil, errResp
+	}
 
-	var paymentStatus string
-	if req.Amount >= totalAmountWithTax {
-		paymentStatus = "success"
-	} else {
-		return s.errorhandler.HandleInsufficientBalance(err, method, "FAILED_PAYMENT_INSUFFICIENT_BALANCE", span, &status, zap.Error(err))
+	posting, errResp := s.loyaltyPosting(ctx, method, span, &status, req.MerchantID, req.OrderID, settlement)
+	if errResp != nil {
+		return nil, errResp
 	}
 
-	req.Amount = totalAmountWithTax
+	paymentStatus := "success"
+
+	req.Amount = breakdown.Total
+	req.PaymentMethod = settlement.PaymentMethod()
+	req.ChangeAmount = &settlement.Change
 	req.PaymentStatus = &paymentStatus
 
-	transaction, err := s.transactionCommandRepository.CreateTransaction(ctx, req)
+	transaction, err := s.transactionCommandRepository.CreateTransaction(ctx, req, &repository.TransactionDetails{
+		Tax:        breakdown,
+		Settlement: settlement,
+		Loyalty:    posting,
+		Events:     s.receiptEvents(ctx, req.OrderID, merchant, cashier, breakdown, settlement),
+	})
+	if errors.Is(err, loyalty.ErrInsufficientPoints) {
+		return s.errorhandler.HandleLoyaltyError(err, method, "FAILED_INSUFFICIENT_LOYALTY_POINTS", span, &status, zap.Int("order.id", req.OrderID))
+	}
 	if err != nil {
 		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_CREATE_TRANSACTION", span, &status, transaction_errors.ErrFailedCreateTransaction, zap.Error(err))
 	}
@@ -159,7 +189,7 @@ func (s *transactionCommandService) CreateTransaction(ctx context.Context, req *
 	return s.mapping.ToTransactionResponse(transaction), nil
 }
 
-func (s *transactionCommandService) UpdateTransaction(ctx context.Context, req *requests.UpdateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+func (s *transactionCommandService) UpdateTransaction(ctx context.Context, req *requests.UpdateTransactionRequest, tenders []tender.Tender) (*response.TransactionResponse, *response.ErrorResponse) {
 	const method = "UpdateTransaction"
 
 	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("transaction.id", *req.TransactionID), attribute.Int("merchant.id", req.MerchantID), attribute.Int("order.id", req.OrderID))
@@ -178,7 +208,8 @@ func (s *transactionCommandService) UpdateTransaction(ctx context.Context, req *
 		return errorhandler.HandleRepositorySingleError[*response.TransactionResponse](s.logger, err, method, "FAILED_FIND_TRANSACTION_BY_ID", span, &status, transaction_errors.ErrFailedFindTransactionById, zap.Error(err))
 	}
 
-	if existingTx.PaymentStatus == "paid" || existingTx.PaymentStatus == "refunded" {
+	switch existingTx.PaymentStatus {
+	case "paid", refund.StatusPartiallyRefunded, refund.StatusRefunded, refund.StatusVoided:
 		return s.errorhandler.HandleInvalidOrderItem(err, method, "FAILED_PAYMENT_STATUS_CANNOT_BE_MODIFIED", span, &status, zap.Error(err))
 	}
 
@@ -193,30 +224,36 @@ func (s *transactionCommandService) UpdateTransaction(ctx context.Context, req *
 		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_FIND_ORDER", span, &status, order_errors.ErrFailedFindOrderById, zap.Error(err))
 	}
 
-	orderItems, err := s.orderItemQueryRepository.FindOrderItemByOrder(ctx, req.OrderID)
-	if err != nil {
-		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_FIND_ORDER_ITEMS", span, &status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+	breakdown, errResp := s.calculateTax(ctx, method, span, &status, req.MerchantID, req.OrderID)
+	if errResp != nil {
+		return nil, errResp
 	}
 
-	var totalAmount int
-	for _, item := range orderItems {
-		totalAmount += item.Price * item.Quantity
+	settlement, errResp := s.settle(method, span, &status, breakdown.Total, tenders)
+	if errResp != nil {
+		return nil, errResp
 	}
 
-	ppn := totalAmount * 11 / 100
-	totalAmountWithTax := totalAmount + ppn
-
-	var paymentStatus string
-	if req.Amount >= totalAmountWithTax {
-		paymentStatus = "success"
-	} else {
-		return s.errorhandler.HandleInsufficientBalance(err, method, "FAILED_PAYMENT_INSUFFICIENT_BALANCE", span, &status, zap.Error(err))
+	posting, errResp := s.loyaltyPosting(ctx, method, span, &status, req.MerchantID, req.OrderID, settlement)
+	if errResp != nil {
+		return nil, errResp
 	}
 
-	req.Amount = totalAmountWithTax
+	paymentStatus := "success"
+
+	req.Amount = breakdown.Total
+	req.PaymentMethod = settlement.PaymentMethod()
+	req.ChangeAmount = &settlement.Change
 	req.PaymentStatus = &paymentStatus
 
-	transaction, err := s.transactionCommandRepository.UpdateTransaction(ctx, req)
+	transaction, err := s.transactionCommandRepository.UpdateTransaction(ctx, req, &repository.TransactionDetails{
+		Tax:        breakdown,
+		Settlement: settlement,
+		Loyalty:    posting,
+	})
+	if errors.Is(err, loyalty.ErrInsufficientPoints) {
+		return s.errorhandler.HandleLoyaltyError(err, method, "FAILED_INSUFFICIENT_LOYALTY_POINTS", span, &status, zap.Int("order.id", req.OrderID))
+	}
 	if err != nil {
 		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_UPDATE_TRANSACTION", span, &status, transaction_errors.ErrFailedUpdateTransaction, zap.Error(err))
 	}
@@ -228,6 +265,44 @@ func (s *transactionCommandService) UpdateTransaction(ctx context.Context, req *
 	return s.mapping.ToTransactionResponse(transaction), nil
 }
 
+func (s *transactionCommandService) RefundTransaction(ctx context.Context, req *refund.Request) (*response.TransactionResponse, *response.ErrorResponse) {
+	const method = "RefundTransaction"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("transaction.id", req.TransactionID), attribute.Int("operator.id", req.OperatorID))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, errResp := s.applyRefund(ctx, method, span, &status, refund.KindRefund, req.TransactionID, req.OperatorID, req.Reason, req.Items)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	logSuccess("Successfully refunded transaction", zap.Int("transaction.id", req.TransactionID), zap.String("payment.status", res.PaymentStatus))
+
+	return res, nil
+}
+
+func (s *transactionCommandService) VoidTransaction(ctx context.Context, req *refund.VoidRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+	const method = "VoidTransaction"
+
+	ctx, span, end, status, logSuccess := s.startTracingAndLogging(ctx, method, attribute.Int("transaction.id", req.TransactionID), attribute.Int("operator.id", req.OperatorID))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, errResp := s.applyRefund(ctx, method, span, &status, refund.KindVoid, req.TransactionID, req.OperatorID, req.Reason, nil)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	logSuccess("Successfully voided transaction", zap.Int("transaction.id", req.TransactionID))
+
+	return res, nil
+}
+
 func (s *transactionCommandService) TrashedTransaction(ctx context.Context, transactionID int) (*response.TransactionResponseDeleteAt, *response.ErrorResponse) {
 	const method = "TrashedTransaction"
 
@@ -323,6 +398,188 @@ func (s *transactionCommandService) DeleteAllTransactionPermanent(ctx context.Co
 	return success, nil
 }
 
+func (s *transactionCommandService) calculateTax(ctx context.Context, method string, span trace.Span, status *string, merchantID int, orderID int) (*tax.Breakdown, *response.ErrorResponse) {
+	lines, err := s.orderItemQueryRepository.FindTaxableItemsByOrder(ctx, orderID)
+	if err != nil {
+		_, errResp := s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_FIND_ORDER_ITEMS", span, status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+		return nil, errResp
+	}
+
+	profile, err := s.taxProfileRepository.FindByMerchant(ctx, merchantID)
+	if errors.Is(err, repository.ErrTaxProfileNotFound) {
+		profile = tax.DefaultProfile(merchantID)
+	} else if err != nil {
+		_, errResp := s.errorhandler.HandleCalculateTaxError(err, method, "FAILED_FIND_TAX_PROFILE", span, status, zap.Int("merchant.id", merchantID))
+		return nil, errResp
+	}
+
+	breakdown, err := s.taxCalculator.Calculate(profile, lines)
+	if err != nil {
+		_, errResp := s.errorhandler.HandleCalculateTaxError(err, method, "FAILED_CALCULATE_TAX", span, status, zap.Int("merchant.id", merchantID))
+		return nil, errResp
+	}
+
+	span.SetAttributes(
+		attribute.Int("amount.subtotal", breakdown.Subtotal),
+		attribute.Int("amount.tax", breakdown.Tax),
+		attribute.Int("amount.total", breakdown.Total),
+		attribute.String("tax.mode", string(profile.Mode)),
+	)
+
+	return breakdown, nil
+}
+
+func (s *transactionCommandService) settle(method string, span trace.Span, status *string, total int, tenders []tender.Tender) (*tender.Settlement, *response.ErrorResponse) {
+	settlement, err := tender.Settle(total, tenders)
+	if errors.Is(err, tender.ErrInsufficientTender) {
+		_, errResp := s.errorhandler.HandleInsufficientBalance(err, method, "FAILED_PAYMENT_INSUFFICIENT_BALANCE", span, status, zap.Int("amount.total", total))
+		return nil, errResp
+	}
+	if err != nil {
+		_, errResp := s.errorhandler.HandleInvalidTender(err, method, "FAILED_INVALID_TENDER", span, status, zap.Error(err))
+		return nil, errResp
+	}
+
+	span.SetAttributes(
+		attribute.Int("amount.tendered", settlement.Tendered),
+		attribute.Int("amount.change", settlement.Change),
+		attribute.Int("tender.count", len(settlement.Tenders)),
+	)
+
+	return settlement, nil
+}
+
+// loyaltyPosting works out the points the order's customer redeems with the
+// loyalty tenders of the settlement and earns on the rest.
+func (s *transactionCommandService) loyaltyPosting(ctx context.Context, method string, span trace.Span, status *string, merchantID int, orderID int, settlement *tender.Settlement) (*loyalty.Posting, *response.ErrorResponse) {
+	redeemed := settlement.Paid(tender.MethodLoyalty)
+
+	customerID, err := s.loyaltyRepository.FindOrderCustomer(ctx, orderID)
+	if err != nil {
+		_, errResp := s.errorhandler.HandleLoyaltyError(err, method, "FAILED_FIND_ORDER_CUSTOMER", span, status, zap.Int("order.id", orderID))
+		return nil, errResp
+	}
+
+	program, err := s.loyaltyRepository.FindProgram(ctx, merchantID)
+	if err != nil && !errors.Is(err, loyalty.ErrProgramNotFound) {
+		_, errResp := s.errorhandler.HandleLoyaltyError(err, method, "FAILED_FIND_LOYALTY_PROGRAM", span, status, zap.Int("merchant.id", merchantID))
+		return nil, errResp
+	}
+
+	posting, err := loyalty.NewPosting(program, customerID, settlement.Total, redeemed, time.Now())
+	if err != nil {
+		_, errResp := s.errorhandler.HandleLoyaltyError(err, method, "FAILED_INVALID_LOYALTY_REDEMPTION", span, status, zap.Int("amount.redeemed", redeemed))
+		return nil, errResp
+	}
+
+	if posting != nil {
+		span.SetAttributes(
+			attribute.Int("customer.id", posting.CustomerID),
+			attribute.Int("loyalty.redeem", posting.Redeem),
+			attribute.Int("loyalty.earn", posting.Earn),
+		)
+	}
+
+	return posting, nil
+}
+
+// receiptEvents builds the receipt for the email service, which is enqueued
+// together with the transaction. It goes to the order's customer, or to the
+// merchant's contact address when the customer has no email. A receipt that
+// cannot be prepared is logged and skipped rather than failing the sale.
+func (s *transactionCommandService) receiptEvents(ctx context.Context, orderID int, merchant *record.MerchantRecord, cashier *record.CashierRecord, breakdown *tax.Breakdown, settlement *tender.Settlement) func(*record.TransactionRecord) ([]*repository.OutboxEvent, error) {
+	email, err := s.orderQueryRepository.FindCustomerEmail(ctx, orderID)
+	if err != nil {
+		s.logger.Error("Failed to load receipt customer", zap.Int("order.id", orderID), zap.Error(err))
+	}
+	if email == "" {
+		email = merchant.ContactEmail
+	}
+	if email == "" {
+		return nil
+	}
+
+	items, err := s.orderItemQueryRepository.FindReceiptItemsByOrder(ctx, orderID)
+	if err != nil {
+		s.logger.Error("Failed to load receipt items", zap.Int("order.id", orderID), zap.Error(err))
+		return nil
+	}
+
+	return func(transaction *record.TransactionRecord) ([]*repository.OutboxEvent, error) {
+		payload, err := json.Marshal(receipt.NewEvent(email, transaction, merchant, cashier, items, breakdown, settlement))
+		if err != nil {
+			s.logger.Error("Failed to marshal receipt event", zap.Int("transaction.id", transaction.ID), zap.Error(err))
+			return nil, nil
+		}
+
+		return []*repository.OutboxEvent{
+			{Topic: receipt.Topic, Key: strconv.Itoa(transaction.ID), Payload: payload},
+		}, nil
+	}
+}
+
+// applyRefund applies a refund or void. A void cancels a transaction outright,
+// so it is only allowed before anything has been refunded against it.
+func (s *transactionCommandService) applyRefund(ctx context.Context, method string, span trace.Span, status *string, kind refund.Kind, transactionID int, operatorID int, reason string, items []refund.Item) (*response.TransactionResponse, *response.ErrorResponse) {
+	existing, err := s.transactionQueryRepository.FindById(ctx, transactionID)
+	if err != nil {
+		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_FIND_TRANSACTION_BY_ID", span, status, transaction_errors.ErrFailedFindTransactionById, zap.Error(err))
+	}
+
+	ledger, err := s.refundRepository.FindLedger(ctx, transactionID)
+	if err != nil {
+		return s.errorhandler.HandleRefundTransactionError(err, method, "FAILED_FIND_REFUND_LEDGER", span, status, zap.Error(err))
+	}
+
+	refundable := existing.PaymentStatus == "success" || (kind == refund.KindRefund && existing.PaymentStatus == refund.StatusPartiallyRefunded)
+	if !refundable || (kind == refund.KindVoid && ledger.Amount > 0) {
+		return s.errorhandler.HandleCannotModifiedStatus(err, method, "FAILED_PAYMENT_STATUS_CANNOT_BE_REFUNDED", span, status, zap.String("payment.status", existing.PaymentStatus))
+	}
+
+	orderItems, err := s.orderItemQueryRepository.FindOrderItemByOrder(ctx, existing.OrderID)
+	if err != nil {
+		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_FIND_ORDER_ITEMS", span, status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+	}
+
+	discounts, err := s.orderItemQueryRepository.FindDiscountsByOrder(ctx, existing.OrderID)
+	if err != nil {
+		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_FIND_ORDER_ITEM_DISCOUNTS", span, status, orderitem_errors.ErrFailedFindOrderItemByOrder, zap.Error(err))
+	}
+
+	lines := make([]refund.OrderItem, 0, len(orderItems))
+	for _, item := range orderItems {
+		lines = append(lines, refund.OrderItem{
+			ID:        item.ID,
+			ProductID: item.ProductID,
+			Price:     item.Price,
+			Quantity:  item.Quantity,
+			Discount:  discounts[item.ID],
+		})
+	}
+
+	rf, err := refund.Plan(kind, transactionID, operatorID, reason, existing.Amount, lines, *ledger, items)
+	if err != nil {
+		return s.errorhandler.HandleInvalidRefund(err, method, "FAILED_INVALID_REFUND", span, status, zap.Error(err))
+	}
+
+	span.SetAttributes(
+		attribute.String("refund.kind", string(rf.Kind)),
+		attribute.Int("refund.amount", rf.Amount),
+	)
+
+	res, err := s.refundRepository.CreateRefund(ctx, rf, existing.PaymentStatus, ledger)
+	if errors.Is(err, repository.ErrRefundConflict) {
+		return s.errorhandler.HandleRefundConflict(err, method, "FAILED_REFUND_CONFLICT", span, status, zap.Int("transaction.id", transactionID))
+	}
+	if err != nil {
+		return s.errorhandler.HandleRefundTransactionError(err, method, "FAILED_CREATE_REFUND", span, status, zap.Error(err))
+	}
+
+	s.mencache.DeleteTransactionCache(ctx, transactionID)
+
+	return s.mapping.ToTransactionResponse(res), nil
+}
+
 func (s *transactionCommandService) startTracingAndLogging(ctx context.Context, method string, attrs ...attribute.KeyValue) (
 	context.Context,
 	trace.Span,
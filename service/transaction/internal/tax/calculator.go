@@ -0,0 +1,149 @@
+package tax
+
+import "errors"
+
+type Mode string
+
+const (
+	ModeExclusive Mode = "exclusive"
+	ModeInclusive Mode = "inclusive"
+)
+
+type Rounding string
+
+const (
+	RoundHalfUp Rounding = "half_up"
+	RoundDown   Rounding = "down"
+	RoundUp     Rounding = "up"
+)
+
+var ErrInvalidProfile = errors.New("invalid tax profile")
+
+// Rates are expressed in basis points, 1100 being 11%.
+type Profile struct {
+	MerchantID    int
+	RateBps       int
+	Mode          Mode
+	Rounding      Rounding
+	CategoryRates map[int]int
+}
+
+// DefaultProfile mirrors the flat 11% PPN applied before profiles existed.
+func DefaultProfile(merchantID int) *Profile {
+	return &Profile{
+		MerchantID: merchantID,
+		RateBps:    1100,
+		Mode:       ModeExclusive,
+		Rounding:   RoundDown,
+	}
+}
+
+func (p *Profile) RateFor(categoryID int) int {
+	if rate, ok := p.CategoryRates[categoryID]; ok {
+		return rate
+	}
+
+	return p.RateBps
+}
+
+func (p *Profile) Validate() error {
+	if p.RateBps < 0 {
+		return ErrInvalidProfile
+	}
+
+	for _, rate := range p.CategoryRates {
+		if rate < 0 {
+			return ErrInvalidProfile
+		}
+	}
+
+	switch p.Mode {
+	case ModeExclusive, ModeInclusive:
+	default:
+		return ErrInvalidProfile
+	}
+
+	switch p.Rounding {
+	case RoundHalfUp, RoundDown, RoundUp:
+	default:
+		return ErrInvalidProfile
+	}
+
+	return nil
+}
+
+// Discount is what the order's promotions took off the line; tax is due on
+// what is left.
+type Line struct {
+	ProductID  int
+	CategoryID int
+	Price      int
+	Quantity   int
+	Discount   int
+}
+
+type Breakdown struct {
+	Subtotal int
+	Tax      int
+	Total    int
+}
+
+type Calculator interface {
+	Calculate(profile *Profile, lines []Line) (*Breakdown, error)
+}
+
+type calculator struct{}
+
+func NewCalculator() *calculator {
+	return &calculator{}
+}
+
+// Calculate groups lines by their effective rate and rounds once per group,
+// so a receipt with many lines at the same rate does not accumulate
+// per-line rounding drift.
+func (c *calculator) Calculate(profile *Profile, lines []Line) (*Breakdown, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+
+	amounts := make(map[int]int)
+	gross := 0
+
+	for _, line := range lines {
+		amount := line.Price*line.Quantity - line.Discount
+		amounts[profile.RateFor(line.CategoryID)] += amount
+		gross += amount
+	}
+
+	tax := 0
+	for rate, amount := range amounts {
+		if profile.Mode == ModeInclusive {
+			tax += divide(amount*rate, 10000+rate, profile.Rounding)
+		} else {
+			tax += divide(amount*rate, 10000, profile.Rounding)
+		}
+	}
+
+	if profile.Mode == ModeInclusive {
+		return &Breakdown{Subtotal: gross - tax, Tax: tax, Total: gross}, nil
+	}
+
+	return &Breakdown{Subtotal: gross, Tax: tax, Total: gross + tax}, nil
+}
+
+func divide(numerator, denominator int, rounding Rounding) int {
+	quotient, remainder := numerator/denominator, numerator%denominator
+
+	switch rounding {
+	case RoundUp:
+		if remainder > 0 {
+			quotient++
+		}
+	case RoundHalfUp:
+		if remainder*2 >= denominator {
+			quotient++
+		}
+	}
+
+	return quotient
+}
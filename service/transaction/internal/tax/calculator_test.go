@@ -0,0 +1,123 @@
+package tax
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCalculatorCalculate(t *testing.T) {
+	const food, books = 1, 2
+
+	tests := []struct {
+		name    string
+		profile *Profile
+		lines   []Line
+		want    Breakdown
+		wantErr error
+	}{
+		{
+			name:    "default profile keeps the flat exclusive 11%",
+			profile: DefaultProfile(1),
+			lines:   []Line{{Price: 10000, Quantity: 2}},
+			want:    Breakdown{Subtotal: 20000, Tax: 2200, Total: 22200},
+		},
+		{
+			name:    "exclusive rounds down by default",
+			profile: DefaultProfile(1),
+			lines:   []Line{{Price: 999, Quantity: 1}},
+			want:    Breakdown{Subtotal: 999, Tax: 109, Total: 1108},
+		},
+		{
+			name:    "exclusive rounds half up",
+			profile: &Profile{RateBps: 1100, Mode: ModeExclusive, Rounding: RoundHalfUp},
+			lines:   []Line{{Price: 50, Quantity: 1}},
+			want:    Breakdown{Subtotal: 50, Tax: 6, Total: 56},
+		},
+		{
+			name:    "exclusive rounds up",
+			profile: &Profile{RateBps: 1000, Mode: ModeExclusive, Rounding: RoundUp},
+			lines:   []Line{{Price: 101, Quantity: 1}},
+			want:    Breakdown{Subtotal: 101, Tax: 11, Total: 112},
+		},
+		{
+			name:    "inclusive takes the tax out of the price",
+			profile: &Profile{RateBps: 1000, Mode: ModeInclusive, Rounding: RoundHalfUp},
+			lines:   []Line{{Price: 1100, Quantity: 1}},
+			want:    Breakdown{Subtotal: 1000, Tax: 100, Total: 1100},
+		},
+		{
+			name:    "inclusive rounding stays within the total",
+			profile: &Profile{RateBps: 1100, Mode: ModeInclusive, Rounding: RoundDown},
+			lines:   []Line{{Price: 1000, Quantity: 1}},
+			want:    Breakdown{Subtotal: 901, Tax: 99, Total: 1000},
+		},
+		{
+			name: "category override and exemption",
+			profile: &Profile{
+				RateBps:       1100,
+				Mode:          ModeExclusive,
+				Rounding:      RoundDown,
+				CategoryRates: map[int]int{food: 500, books: 0},
+			},
+			lines: []Line{
+				{CategoryID: 0, Price: 1000, Quantity: 1},
+				{CategoryID: food, Price: 1000, Quantity: 2},
+				{CategoryID: books, Price: 5000, Quantity: 1},
+			},
+			want: Breakdown{Subtotal: 8000, Tax: 210, Total: 8210},
+		},
+		{
+			name:    "lines at one rate are rounded once",
+			profile: &Profile{RateBps: 1100, Mode: ModeExclusive, Rounding: RoundDown},
+			lines:   []Line{{Price: 5, Quantity: 1}, {Price: 5, Quantity: 1}, {Price: 5, Quantity: 1}},
+			want:    Breakdown{Subtotal: 15, Tax: 1, Total: 16},
+		},
+		{
+			name:    "discounts are taken off before tax",
+			profile: DefaultProfile(1),
+			lines:   []Line{{Price: 10000, Quantity: 1, Discount: 2000}},
+			want:    Breakdown{Subtotal: 8000, Tax: 880, Total: 8880},
+		},
+		{
+			name:    "no lines",
+			profile: DefaultProfile(1),
+			want:    Breakdown{},
+		},
+		{
+			name:    "negative rate is rejected",
+			profile: &Profile{RateBps: -1, Mode: ModeExclusive, Rounding: RoundDown},
+			wantErr: ErrInvalidProfile,
+		},
+		{
+			name:    "negative category rate is rejected",
+			profile: &Profile{RateBps: 1100, Mode: ModeExclusive, Rounding: RoundDown, CategoryRates: map[int]int{food: -5}},
+			wantErr: ErrInvalidProfile,
+		},
+		{
+			name:    "unknown mode is rejected",
+			profile: &Profile{RateBps: 1100, Mode: "gross", Rounding: RoundDown},
+			wantErr: ErrInvalidProfile,
+		},
+		{
+			name:    "unknown rounding is rejected",
+			profile: &Profile{RateBps: 1100, Mode: ModeExclusive, Rounding: "bankers"},
+			wantErr: ErrInvalidProfile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCalculator().Calculate(tt.profile, tt.lines)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Calculate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if *got != tt.want {
+				t.Errorf("Calculate() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
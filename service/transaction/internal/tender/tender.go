@@ -0,0 +1,172 @@
+package tender
+
+import (
+	"errors"
+	"strings"
+)
+
+type Method string
+
+const (
+	MethodCash    Method = "cash"
+	MethodCard    Method = "card"
+	MethodEWallet Method = "e_wallet"
+	MethodVoucher Method = "voucher"
+	// MethodLoyalty is the part of a payment settled with the customer's
+	// loyalty points.
+	MethodLoyalty Method = "loyalty_points"
+
+	// MethodSplit is stored on the transaction row when more than one
+	// tender settled it; the tenders themselves keep the real methods.
+	MethodSplit = "split"
+)
+
+var (
+	ErrNoTenders          = errors.New("at least one tender is required")
+	ErrUnsupportedMethod  = errors.New("unsupported tender method")
+	ErrInvalidAmount      = errors.New("tender amount must be positive")
+	ErrInsufficientTender = errors.New("tenders do not cover the total")
+	ErrChangeWithoutCash  = errors.New("change can only be given against cash")
+)
+
+type Tender struct {
+	Method Method
+	Amount int
+}
+
+// Applied is a tender after settlement: Tendered is what the customer
+// handed over, Amount is the part of it kept against the total.
+type Applied struct {
+	Method   Method
+	Tendered int
+	Amount   int
+}
+
+type Settlement struct {
+	Total    int
+	Tendered int
+	Change   int
+	Tenders  []Applied
+}
+
+// aliases maps the spellings clients used for payment_method before it
+// was a fixed set onto the tender methods.
+var aliases = map[string]Method{
+	"tunai":       MethodCash,
+	"credit_card": MethodCard,
+	"debit_card":  MethodCard,
+	"credit":      MethodCard,
+	"debit":       MethodCard,
+	"ewallet":     MethodEWallet,
+	"wallet":      MethodEWallet,
+	"qris":        MethodEWallet,
+	"gopay":       MethodEWallet,
+	"ovo":         MethodEWallet,
+	"dana":        MethodEWallet,
+	"shopeepay":   MethodEWallet,
+	"gift_card":   MethodVoucher,
+	"coupon":      MethodVoucher,
+	"loyalty":     MethodLoyalty,
+	"points":      MethodLoyalty,
+}
+
+// ParseMethod normalises method to a tender method. Known aliases map onto
+// their method; anything else is ErrUnsupportedMethod.
+func ParseMethod(method string) (Method, error) {
+	m := strings.ToLower(strings.TrimSpace(method))
+	m = strings.NewReplacer("-", "_", " ", "_").Replace(m)
+
+	switch Method(m) {
+	case MethodCash, MethodCard, MethodEWallet, MethodVoucher, MethodLoyalty:
+		return Method(m), nil
+	}
+
+	if alias, ok := aliases[m]; ok {
+		return alias, nil
+	}
+
+	return "", ErrUnsupportedMethod
+}
+
+func Single(method string, amount int) ([]Tender, error) {
+	m, err := ParseMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Tender{{Method: m, Amount: amount}}, nil
+}
+
+// Settle checks the tenders against total and works out the change. Only
+// cash can be over-tendered; the change comes out of the last cash tenders.
+func Settle(total int, tenders []Tender) (*Settlement, error) {
+	if len(tenders) == 0 {
+		return nil, ErrNoTenders
+	}
+
+	applied := make([]Applied, len(tenders))
+	tendered, cash := 0, 0
+
+	for i, t := range tenders {
+		method, err := ParseMethod(string(t.Method))
+		if err != nil {
+			return nil, err
+		}
+		if t.Amount <= 0 {
+			return nil, ErrInvalidAmount
+		}
+
+		applied[i] = Applied{Method: method, Tendered: t.Amount, Amount: t.Amount}
+		tendered += t.Amount
+		if method == MethodCash {
+			cash += t.Amount
+		}
+	}
+
+	if tendered < total {
+		return nil, ErrInsufficientTender
+	}
+
+	change := tendered - total
+	if change > cash {
+		return nil, ErrChangeWithoutCash
+	}
+
+	remaining := change
+	for i := len(applied) - 1; i >= 0 && remaining > 0; i-- {
+		if applied[i].Method != MethodCash {
+			continue
+		}
+
+		given := min(applied[i].Amount, remaining)
+		applied[i].Amount -= given
+		remaining -= given
+	}
+
+	return &Settlement{
+		Total:    total,
+		Tendered: tendered,
+		Change:   change,
+		Tenders:  applied,
+	}, nil
+}
+
+// Paid returns what the tenders of method settled.
+func (s *Settlement) Paid(method Method) int {
+	paid := 0
+	for _, t := range s.Tenders {
+		if t.Method == method {
+			paid += t.Amount
+		}
+	}
+
+	return paid
+}
+
+func (s *Settlement) PaymentMethod() string {
+	if len(s.Tenders) == 1 {
+		return string(s.Tenders[0].Method)
+	}
+
+	return MethodSplit
+}
@@ -0,0 +1,157 @@
+package tender
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseMethod(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Method
+		wantErr error
+	}{
+		{in: "cash", want: MethodCash},
+		{in: " Cash ", want: MethodCash},
+		{in: "CARD", want: MethodCard},
+		{in: "e-wallet", want: MethodEWallet},
+		{in: "ewallet", want: MethodEWallet},
+		{in: "voucher", want: MethodVoucher},
+		{in: "loyalty_points", want: MethodLoyalty},
+		{in: "Credit Card", want: MethodCard},
+		{in: "debit-card", want: MethodCard},
+		{in: "QRIS", want: MethodEWallet},
+		{in: "gift card", want: MethodVoucher},
+		{in: "bank_transfer", wantErr: ErrUnsupportedMethod},
+		{in: "cheque", wantErr: ErrUnsupportedMethod},
+		{in: "other", wantErr: ErrUnsupportedMethod},
+		{in: "", wantErr: ErrUnsupportedMethod},
+		{in: "   ", wantErr: ErrUnsupportedMethod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMethod(tt.in)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMethod(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMethod(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSettle(t *testing.T) {
+	tests := []struct {
+		name        string
+		total       int
+		tenders     []Tender
+		wantChange  int
+		wantApplied []Applied
+		wantMethod  string
+		wantErr     error
+	}{
+		{
+			name:        "exact card",
+			total:       5000,
+			tenders:     []Tender{{Method: MethodCard, Amount: 5000}},
+			wantApplied: []Applied{{Method: MethodCard, Tendered: 5000, Amount: 5000}},
+			wantMethod:  "card",
+		},
+		{
+			name:        "cash over-tendered gives change",
+			total:       4500,
+			tenders:     []Tender{{Method: MethodCash, Amount: 5000}},
+			wantChange:  500,
+			wantApplied: []Applied{{Method: MethodCash, Tendered: 5000, Amount: 4500}},
+			wantMethod:  "cash",
+		},
+		{
+			name:  "split takes change from cash only",
+			total: 7000,
+			tenders: []Tender{
+				{Method: MethodCash, Amount: 5000},
+				{Method: MethodCard, Amount: 3000},
+			},
+			wantChange: 1000,
+			wantApplied: []Applied{
+				{Method: MethodCash, Tendered: 5000, Amount: 4000},
+				{Method: MethodCard, Tendered: 3000, Amount: 3000},
+			},
+			wantMethod: MethodSplit,
+		},
+		{
+			name:  "change comes out of the last cash tenders first",
+			total: 1500,
+			tenders: []Tender{
+				{Method: MethodCash, Amount: 1000},
+				{Method: MethodCash, Amount: 1000},
+			},
+			wantChange: 500,
+			wantApplied: []Applied{
+				{Method: MethodCash, Tendered: 1000, Amount: 1000},
+				{Method: MethodCash, Tendered: 1000, Amount: 500},
+			},
+			wantMethod: MethodSplit,
+		},
+		{
+			name:    "aliases are normalised",
+			total:   100,
+			tenders: []Tender{{Method: "QRIS", Amount: 100}},
+			wantApplied: []Applied{
+				{Method: MethodEWallet, Tendered: 100, Amount: 100},
+			},
+			wantMethod: "e_wallet",
+		},
+		{name: "no tenders", total: 100, wantErr: ErrNoTenders},
+		{
+			name:    "short tenders",
+			total:   5000,
+			tenders: []Tender{{Method: MethodCash, Amount: 4000}},
+			wantErr: ErrInsufficientTender,
+		},
+		{
+			name:    "card cannot be over-tendered",
+			total:   5000,
+			tenders: []Tender{{Method: MethodCard, Amount: 6000}},
+			wantErr: ErrChangeWithoutCash,
+		},
+		{
+			name:    "non-positive amount",
+			total:   0,
+			tenders: []Tender{{Method: MethodCash, Amount: 0}},
+			wantErr: ErrInvalidAmount,
+		},
+		{
+			name:    "empty method",
+			total:   100,
+			tenders: []Tender{{Method: "", Amount: 100}},
+			wantErr: ErrUnsupportedMethod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Settle(tt.total, tt.tenders)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Settle() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Change != tt.wantChange {
+				t.Errorf("Change = %d, want %d", got.Change, tt.wantChange)
+			}
+			if !reflect.DeepEqual(got.Tenders, tt.wantApplied) {
+				t.Errorf("Tenders = %+v, want %+v", got.Tenders, tt.wantApplied)
+			}
+			if m := got.PaymentMethod(); m != tt.wantMethod {
+				t.Errorf("PaymentMethod() = %q, want %q", m, tt.wantMethod)
+			}
+		})
+	}
+}
@@ -10,10 +10,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/database"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/dotenv"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/hash"
+	"github.com/MamangRust/monolith-point-of-sale-pkg/kafka"
 	"github.com/MamangRust/monolith-point-of-sale-pkg/logger"
 	otel_pkg "github.com/MamangRust/monolith-point-of-sale-pkg/otel"
 	"github.com/MamangRust/monolith-point-of-sale-shared/pb"
@@ -30,6 +34,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -50,6 +55,8 @@ type Server struct {
 	DB       *db.Queries
 	Services *service.Service
 	Handlers *handler.Handler
+	Relay    *outbox.Relay
+	Conn     *audit.DB
 	Ctx      context.Context
 }
 
@@ -65,17 +72,18 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 
 	flag.Parse()
 
-	conn, err := database.NewClient(logger)
+	client, err := database.NewClient(logger)
 
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
 
+	conn := audit.NewDB(client)
 	DB := db.New(conn)
 
 	hash := hash.NewHashingPassword()
 
-	repositories := repository.NewRepositories(DB)
+	repositories := repository.NewRepositories(conn, DB)
 
 	shutdownTracerProvider, err := otel_pkg.InitTracerProvider("User-service", ctx)
 
@@ -109,6 +117,8 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Logger: logger,
 	})
 
+	myKafka := kafka.NewKafka(logger, []string{viper.GetString("KAFKA_BROKERS")})
+
 	errorhandler := errorhandler.NewErrorHandler(logger)
 
 	services := service.NewService(&service.Deps{
@@ -123,11 +133,15 @@ func NewServer(ctx context.Context) (*Server, func(context.Context) error, error
 		Service: services,
 	})
 
+	relay := outbox.NewRelay(repositories.Outbox, myKafka, logger)
+
 	return &Server{
 		Logger:   logger,
 		DB:       DB,
 		Services: services,
 		Handlers: handlers,
+		Relay:    relay,
+		Conn:     conn,
 		Ctx:      ctx,
 	}, shutdownTracerProvider, nil
 }
@@ -155,6 +169,16 @@ func (s *Server) Run() {
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryMiddleware(s.Logger),
 			middleware.ContextMiddleware(60*time.Second, s.Logger),
+			audit.Interceptor(s.Conn, audit.Config{
+				Service:   "user",
+				RequestID: middleware.RequestID,
+				Snapshots: map[string]audit.Snapshot{
+					"pb.UserService": func(ctx context.Context, id int) (proto.Message, error) {
+						return s.Handlers.User.FindById(ctx, &pb.FindByIdUserRequest{Id: int32(id)})
+					},
+				},
+			}, s.Logger),
+			bulk.Middleware(s.Logger),
 		),
 	)
 
@@ -165,6 +189,8 @@ func (s *Server) Run() {
 
 	s.Logger.Info(fmt.Sprintf("Server running on port %d", port))
 
+	go s.Relay.Run(s.Ctx)
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 
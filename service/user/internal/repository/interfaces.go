@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
 )
@@ -16,7 +18,7 @@ type UserQueryRepository interface {
 }
 
 type UserCommandRepository interface {
-	CreateUser(ctx context.Context, request *requests.CreateUserRequest) (*record.UserRecord, error)
+	CreateUser(ctx context.Context, request *requests.CreateUserRequest, roleID int) (*record.UserRecord, error)
 	UpdateUser(ctx context.Context, request *requests.UpdateUserRequest) (*record.UserRecord, error)
 	TrashedUser(ctx context.Context, user_id int) (*record.UserRecord, error)
 	RestoreUser(ctx context.Context, user_id int) (*record.UserRecord, error)
@@ -28,3 +30,12 @@ type UserCommandRepository interface {
 type RoleQueryRepository interface {
 	FindByName(ctx context.Context, name string) (*record.RoleRecord, error)
 }
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, topic string, key string, payload []byte) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*outbox.Message, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error
+	Backlog(ctx context.Context) (int, time.Duration, error)
+	PurgeDelivered(ctx context.Context, olderThan time.Duration) (int64, error)
+}
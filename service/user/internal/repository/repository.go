@@ -1,23 +1,30 @@
 package repository
 
 import (
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/outbox"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	recordmapper "github.com/MamangRust/monolith-point-of-sale-shared/mapper/record"
 )
 
+// outboxSource tags the events this service's relay publishes.
+const outboxSource = "user"
+
 type Repositories struct {
 	UserCommand UserCommandRepository
 	UserQuery   UserQueryRepository
 	Role        RoleQueryRepository
+	Outbox      OutboxRepository
 }
 
-func NewRepositories(DB *db.Queries) *Repositories {
+func NewRepositories(conn *audit.DB, DB *db.Queries) *Repositories {
 	mapper := recordmapper.NewUserRecordMapper()
 	mapperrole := recordmapper.NewRoleRecordMapper()
 
 	return &Repositories{
-		UserCommand: NewUserCommandRepository(DB, mapper),
+		UserCommand: NewUserCommandRepository(DB, conn, mapper),
 		UserQuery:   NewUserQueryRepository(DB, mapper),
 		Role:        NewRoleRepository(DB, mapperrole),
+		Outbox:      outbox.NewRepository(conn, outboxSource),
 	}
 }
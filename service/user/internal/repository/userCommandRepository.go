@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 
+	"github.com/MamangRust/monolith-point-of-sale-common/audit"
+	"github.com/MamangRust/monolith-point-of-sale-common/bulk"
 	db "github.com/MamangRust/monolith-point-of-sale-pkg/database/schema"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/record"
 	"github.com/MamangRust/monolith-point-of-sale-shared/domain/requests"
@@ -12,17 +14,28 @@ import (
 
 type userCommandRepository struct {
 	db      *db.Queries
+	conn    *audit.DB
 	mapping recordmapper.UserRecordMapping
 }
 
-func NewUserCommandRepository(db *db.Queries, mapping recordmapper.UserRecordMapping) *userCommandRepository {
+func NewUserCommandRepository(db *db.Queries, conn *audit.DB, mapping recordmapper.UserRecordMapping) *userCommandRepository {
 	return &userCommandRepository{
 		db:      db,
+		conn:    conn,
 		mapping: mapping,
 	}
 }
 
-func (r *userCommandRepository) CreateUser(ctx context.Context, request *requests.CreateUserRequest) (*record.UserRecord, error) {
+// CreateUser creates the user together with its role assignment.
+func (r *userCommandRepository) CreateUser(ctx context.Context, request *requests.CreateUserRequest, roleID int) (*record.UserRecord, error) {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, user_errors.ErrCreateUser
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
 	req := db.CreateUserParams{
 		Firstname: request.FirstName,
 		Lastname:  request.LastName,
@@ -30,12 +43,25 @@ func (r *userCommandRepository) CreateUser(ctx context.Context, request *request
 		Password:  request.Password,
 	}
 
-	user, err := r.db.CreateUser(ctx, req)
+	user, err := r.db.WithTx(tx.Tx).CreateUser(ctx, req)
 
 	if err != nil {
 		return nil, user_errors.ErrCreateUser
 	}
 
+	_, err = r.db.WithTx(tx.Tx).AssignRoleToUser(ctx, db.AssignRoleToUserParams{
+		UserID: user.UserID,
+		RoleID: int32(roleID),
+	})
+
+	if err != nil {
+		return nil, user_errors.ErrCreateUser
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, user_errors.ErrCreateUser
+	}
+
 	return r.mapping.ToUserRecord(user), nil
 }
 
@@ -88,20 +114,25 @@ func (r *userCommandRepository) DeleteUserPermanent(ctx context.Context, user_id
 }
 
 func (r *userCommandRepository) RestoreAllUser(ctx context.Context) (bool, error) {
-	err := r.db.RestoreAllUsers(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkUsers, bulk.Restore)
 
 	if err != nil {
 		return false, user_errors.ErrRestoreAllUsers
 	}
 
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
 
 func (r *userCommandRepository) DeleteAllUserPermanent(ctx context.Context) (bool, error) {
-	err := r.db.DeleteAllPermanentUsers(ctx)
+	affected, err := bulk.Apply(ctx, r.conn, bulkUsers, bulk.Delete)
 
 	if err != nil {
 		return false, user_errors.ErrDeleteAllUsers
 	}
+
+	bulk.SetAffected(ctx, affected)
+
 	return true, nil
 }
@@ -22,6 +22,10 @@ import (
 	"go.uber.org/zap"
 )
 
+// defaultRoleName is the role a user created here starts with; admins grant
+// more through the role service.
+const defaultRoleName = "ROLE_CASHIER"
+
 type userCommandService struct {
 	errorhandler          errorhandler.UserCommandError
 	mencache              mencache.UserCommandCache
@@ -105,15 +109,13 @@ func (s *userCommandService) CreateUser(ctx context.Context, request *requests.C
 
 	request.Password = hash
 
-	const defaultRoleName = "Admin Access 1"
-
 	role, err := s.roleRepository.FindByName(ctx, defaultRoleName)
 
 	if err != nil || role == nil {
 		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_FIND_ROLE", span, &status, role_errors.ErrRoleNotFoundRes, zap.String("name", defaultRoleName), zap.Error(err))
 	}
 
-	res, err := s.userCommandRepository.CreateUser(ctx, request)
+	res, err := s.userCommandRepository.CreateUser(ctx, request, role.ID)
 
 	if err != nil {
 		return s.errorhandler.HandleCreateUserError(err, method, "FAILED_CREATE_USER", span, &status, zap.String("email", request.Email), zap.Error(err))
@@ -158,14 +160,6 @@ func (s *userCommandService) UpdateUser(ctx context.Context, request *requests.U
 		existingUser.Password = hash
 	}
 
-	const defaultRoleName = "Admin Access 1"
-
-	role, err := s.roleRepository.FindByName(ctx, defaultRoleName)
-
-	if err != nil || role == nil {
-		return s.errorhandler.HandleRepositorySingleError(err, method, "FAILED_FIND_ROLE", span, &status, role_errors.ErrRoleNotFoundRes)
-	}
-
 	res, err := s.userCommandRepository.UpdateUser(ctx, request)
 
 	if err != nil {